@@ -2,28 +2,56 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
-	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
 
+	"github.com/AtoyanMikhail/PRAssignmentService/database"
 	"github.com/AtoyanMikhail/PRAssignmentService/internal/api"
 	"github.com/AtoyanMikhail/PRAssignmentService/internal/config"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/grpcapi"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/health"
 	"github.com/AtoyanMikhail/PRAssignmentService/internal/logger"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/metrics"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/policy"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/ratelimit"
 	"github.com/AtoyanMikhail/PRAssignmentService/internal/repository"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/server"
 	"github.com/AtoyanMikhail/PRAssignmentService/internal/service"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/tracing"
 	"github.com/gin-gonic/gin"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 )
 
 func main() {
-	cfg, err := config.Load()
+	params, err := config.ParseFlags(os.Args[0], os.Args[1:], os.Stderr)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			os.Exit(0)
+		}
+		os.Exit(2)
+	}
+
+	cfg, err := config.Load(params)
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Failed to load config: %v\n", err)
 		os.Exit(1)
 	}
 
+	if params.PrintConfig {
+		if err := cfg.Print(os.Stdout); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	logger.Init(os.Stdout, cfg.Logger.Level, cfg.Logger.Format)
 	log := logger.Get()
 
@@ -32,6 +60,12 @@ func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
+	shutdownTracing, err := tracing.Init(ctx, cfg.Tracing)
+	if err != nil {
+		log.Fatalf("Unable to initialize tracing: %v", err)
+	}
+	log.Infof("Tracing initialized (enabled: %t)", cfg.Tracing.Enabled)
+
 	log.Info("Connecting to database...")
 	poolConfig, err := pgxpool.ParseConfig(cfg.Database.DSN())
 	if err != nil {
@@ -58,33 +92,125 @@ func main() {
 	store := repository.NewStore(pool)
 	log.Info("Repository layer initialized")
 
-	services := service.NewServices(store)
+	appMetrics := metrics.New()
+	appMetrics.RegisterPool(pool)
+	appMetrics.RegisterDomain(store)
+	log.Info("Metrics initialized")
+
+	policies, err := policy.NewStore(cfg.Assignment)
+	if err != nil {
+		log.Fatalf("Invalid assignment policy: %v", err)
+	}
+	go reloadPolicyOnSIGHUP(ctx, params, policies, log)
+
+	services := service.NewServices(store, appMetrics, policies, cfg.Idempotency.Lease)
 	log.Info("Service layer initialized")
 
-	handler := api.NewHandler(services)
+	workers := health.NewWorkers()
+	checker := health.NewChecker(cfg.Health.CheckTimeout)
+	checker.Register("database", health.DatabaseCheck(pool))
+	checker.Register("db_pool", health.PoolCheck(pool, cfg.Health.PoolSaturationThreshold))
+	checker.Register("migrations", health.MigrationCheck(pool, database.LatestMigrationVersion()))
+	checker.Register("workers", workers.Check)
+
+	if interval := cfg.Workload.ReconcileInterval; interval > 0 {
+		go service.RunWorkloadReconcile(ctx, services.Workload, interval, workers.Register("workload_reconcile", interval))
+	}
+
+	go services.Events.Run(ctx)
+	if retention := cfg.Events.Retention; retention > 0 {
+		go service.RunEventPrune(ctx, services.Events, retention, service.EventPruneInterval,
+			workers.Register("event_prune", service.EventPruneInterval))
+	}
+
+	handler := api.NewHandler(services, checker, policies)
 
 	gin.SetMode(gin.ReleaseMode)
-	router := gin.Default()
+	router := gin.New()
+	if err := router.SetTrustedProxies(cfg.Server.TrustedProxies); err != nil {
+		log.Fatalf("Invalid trusted proxies: %v", err)
+	}
 
-	router.Use(api.CORSMiddleware())
+	router.Use(otelgin.Middleware(cfg.Tracing.ServiceName, otelgin.WithFilter(tracing.SkipRoute("/metrics", "/health/live", "/health/ready"))))
+	router.Use(api.ClientCertIdentityMiddleware(cfg.Server.TLSClientIdentities))
 	router.Use(api.LoggingMiddleware(log))
+	router.Use(api.MetricsMiddleware(appMetrics))
+	router.Use(api.RecoveryMiddleware())
+	router.Use(api.CORSMiddleware())
+	router.Use(api.PolicyMiddleware(policies))
+	var limiter *ratelimit.Limiter
+	if cfg.RateLimit.Enabled {
+		limiter, err = ratelimit.New(cfg.RateLimit)
+		if err != nil {
+			log.Fatalf("Invalid rate limit config: %v", err)
+		}
+		go limiter.Run(ctx)
+		router.Use(api.RateLimitMiddleware(limiter, appMetrics, "/metrics", "/health", "/health/live", "/health/ready"))
+	}
+	adminAuth := api.NewAdminAuth(cfg.Admin)
+	adminMiddleware, err := api.AdminMiddleware(adminAuth)
+	if err != nil {
+		log.Fatalf("Failed to load admin operations: %v", err)
+	}
+	router.Use(adminMiddleware)
+	router.Use(api.BodySizeMiddleware(cfg.Server.MaxBodyBytes))
+	if cfg.Validation.Requests {
+		validation, err := api.ValidationMiddleware(cfg.Validation.Responses)
+		if err != nil {
+			log.Fatalf("Invalid OpenAPI spec: %v", err)
+		}
+		router.Use(validation)
+	}
+	if cfg.Idempotency.Enabled {
+		router.Use(api.IdempotencyMiddleware(services.Idempotency))
+		go service.RunIdempotencyPrune(ctx, services.Idempotency, cfg.Idempotency.Retention, service.IdempotencyPruneInterval,
+			workers.Register("idempotency_prune", service.IdempotencyPruneInterval))
+	}
 
+	router.GET("/metrics", gin.WrapH(appMetrics.Handler()))
+	router.GET("/openapi.yaml", api.SpecHandler)
+	router.GET("/docs", api.DocsHandler)
+	router.GET("/docs/assets/:name", api.DocsAssetsHandler)
 	api.RegisterHandlers(router, handler)
 
 	log.Info("HTTP server initialized")
 
-	server := &http.Server{
-		Addr:    fmt.Sprintf("%s:%s", cfg.Server.Host, cfg.Server.Port),
-		Handler: router,
+	srv, err := server.New(cfg.Server, router)
+	if err != nil {
+		log.Fatalf("Unable to configure server: %v", err)
 	}
 
 	go func() {
-		log.Infof("Starting HTTPS server on %s:%s...", cfg.Server.Host, cfg.Server.Port)
-		if err := server.ListenAndServeTLS(cfg.Server.TLSCertFile, cfg.Server.TLSKeyFile); err != nil && err != http.ErrServerClosed {
-			log.Fatalf("Failed to start HTTPS server: %v", err)
+		log.Infof("Starting %s server on %s...", srv.Scheme(), cfg.Server.Address())
+		if err := srv.Run(ctx); err != nil {
+			log.Fatalf("Failed to start server: %v", err)
 		}
 	}()
 
+	var grpcSrv *grpc.Server
+	if cfg.Server.GRPCEnabled {
+		opts := grpcapi.Interceptors{
+			Identities: cfg.Server.TLSClientIdentities,
+			Admin:      adminAuth,
+			Log:        log,
+			Metrics:    appMetrics,
+			Policies:   policies,
+			Limiter:    limiter,
+		}.ServerOptions()
+		if tlsConfig := srv.TLSConfig("h2"); tlsConfig != nil {
+			opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+		}
+		grpcSrv = grpc.NewServer(opts...)
+		grpcapi.NewServer(services).Register(grpcSrv)
+
+		go func() {
+			log.Infof("Starting gRPC server on %s...", cfg.Server.GRPCAddress())
+			if err := grpcapi.Serve(grpcSrv, cfg.Server.GRPCAddress()); err != nil {
+				log.Fatalf("Failed to start gRPC server: %v", err)
+			}
+		}()
+	}
+
 	log.Info("Application started. Press Ctrl+C to exit...")
 
 	<-ctx.Done()
@@ -95,12 +221,48 @@ func main() {
 	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.Server.ShutdownTimeout)
 	defer cancel()
 
-	if err := server.Shutdown(shutdownCtx); err != nil {
+	if err := srv.Shutdown(shutdownCtx); err != nil {
 		log.Errorf("Server forced to shutdown: %v", err)
 	}
+	if grpcSrv != nil {
+		grpcapi.Shutdown(shutdownCtx, grpcSrv)
+	}
+
+	if err := shutdownTracing(shutdownCtx); err != nil {
+		log.Errorf("Failed to flush traces: %v", err)
+	}
 
 	<-shutdownCtx.Done()
 
 	_ = log.Sync()
 	log.Info("Application stopped")
 }
+
+// reloadPolicyOnSIGHUP перечитывает конфигурацию по SIGHUP и применяет
+// секцию assignment. Остальные секции требуют перезапуска.
+func reloadPolicyOnSIGHUP(ctx context.Context, params config.Params, policies *policy.Store, log *logger.Logger) {
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	defer signal.Stop(hup)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-hup:
+		}
+
+		cfg, err := config.Load(params)
+		if err != nil {
+			log.Errorf("Assignment policy reload failed, keeping version %d: %v", policies.Current().Version, err)
+			continue
+		}
+
+		snap, err := policies.Update(cfg.Assignment, policy.SourceSIGHUP)
+		if err != nil {
+			log.Errorf("Assignment policy reload failed, keeping version %d: %v", policies.Current().Version, err)
+			continue
+		}
+		log.Infof("Assignment policy reloaded (version %d)", snap.Version)
+	}
+}
@@ -0,0 +1,38 @@
+// Package database содержит SQL миграции и запросы сервиса
+package database
+
+import (
+	"embed"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+// Migrations - файлы миграций golang-migrate, встроенные в бинарь
+//
+//go:embed migrations/*.sql
+var Migrations embed.FS
+
+// LatestMigrationVersion возвращает номер последней миграции, известной сборке
+func LatestMigrationVersion() uint {
+	entries, err := fs.ReadDir(Migrations, "migrations")
+	if err != nil {
+		return 0
+	}
+
+	var latest uint
+	for _, e := range entries {
+		prefix, _, ok := strings.Cut(e.Name(), "_")
+		if !ok {
+			continue
+		}
+		v, err := strconv.ParseUint(prefix, 10, 64)
+		if err != nil {
+			continue
+		}
+		if uint(v) > latest {
+			latest = uint(v)
+		}
+	}
+	return latest
+}
@@ -0,0 +1,8 @@
+-- Remove assignment reason columns
+DROP INDEX IF EXISTS idx_pr_reviewers_source;
+
+ALTER TABLE pr_reviewers
+    DROP CONSTRAINT IF EXISTS pr_reviewers_source_check,
+    DROP COLUMN IF EXISTS workload_at_assignment,
+    DROP COLUMN IF EXISTS strategy,
+    DROP COLUMN IF EXISTS source;
@@ -0,0 +1,19 @@
+-- Store why each reviewer was assigned to a pull request
+
+ALTER TABLE pr_reviewers
+    ADD COLUMN IF NOT EXISTS source VARCHAR(32) NOT NULL DEFAULT 'auto',
+    ADD COLUMN IF NOT EXISTS strategy VARCHAR(32),
+    ADD COLUMN IF NOT EXISTS workload_at_assignment BIGINT;
+
+ALTER TABLE pr_reviewers
+    ADD CONSTRAINT pr_reviewers_source_check CHECK (source IN (
+        'auto',
+        'manual',
+        'fallback_team',
+        'code_owner',
+        'sla_escalation',
+        'inactive_reassignment'
+    ));
+
+-- Index for fairness reports grouped by assignment source
+CREATE INDEX IF NOT EXISTS idx_pr_reviewers_source ON pr_reviewers(source);
@@ -0,0 +1,3 @@
+-- Remove skill tags
+DROP TABLE IF EXISTS pr_required_tags;
+DROP TABLE IF EXISTS user_skills;
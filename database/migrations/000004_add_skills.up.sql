@@ -0,0 +1,16 @@
+-- Reviewer skill tags and tags required to review a pull request
+
+CREATE TABLE IF NOT EXISTS user_skills (
+    user_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    tag VARCHAR(64) NOT NULL CHECK (tag <> ''),
+    level VARCHAR(16) NOT NULL CHECK (level IN ('novice', 'intermediate', 'expert')),
+    PRIMARY KEY (user_id, tag)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_skills_tag ON user_skills(tag);
+
+CREATE TABLE IF NOT EXISTS pr_required_tags (
+    pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    tag VARCHAR(64) NOT NULL CHECK (tag <> ''),
+    PRIMARY KEY (pull_request_id, tag)
+);
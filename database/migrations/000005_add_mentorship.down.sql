@@ -0,0 +1,7 @@
+-- Remove seniority and mentorship pairing
+ALTER TABLE teams
+    DROP COLUMN IF EXISTS mentorship_enabled;
+
+ALTER TABLE users
+    DROP CONSTRAINT IF EXISTS users_seniority_check,
+    DROP COLUMN IF EXISTS seniority;
@@ -0,0 +1,10 @@
+-- Reviewer seniority and optional per-team mentorship pairing
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS seniority VARCHAR(16) NOT NULL DEFAULT 'regular';
+
+ALTER TABLE users
+    ADD CONSTRAINT users_seniority_check CHECK (seniority IN ('learner', 'regular', 'senior'));
+
+ALTER TABLE teams
+    ADD COLUMN IF NOT EXISTS mentorship_enabled BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,35 @@
+-- Persistent reviewer rules and the audit trail of rule changes and overrides
+
+CREATE TABLE IF NOT EXISTS reviewer_rules (
+    id BIGSERIAL PRIMARY KEY,
+    effect VARCHAR(16) NOT NULL CHECK (effect IN ('block', 'prefer')),
+    reviewer_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    -- Правило относится либо к PR одного автора, либо к PR всех участников команды
+    author_id VARCHAR(255) REFERENCES users(user_id) ON DELETE CASCADE,
+    team_id BIGINT REFERENCES teams(id) ON DELETE CASCADE,
+    reason TEXT NOT NULL DEFAULT '',
+    created_by VARCHAR(255) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT reviewer_rules_target_check CHECK ((author_id IS NULL) <> (team_id IS NULL)),
+    CONSTRAINT reviewer_rules_prefer_check CHECK (effect = 'block' OR author_id IS NOT NULL),
+    CONSTRAINT reviewer_rules_self_check CHECK (author_id IS NULL OR author_id <> reviewer_id)
+);
+
+-- Для пары ревьюер-автор и ревьюер-команда может быть только одно правило
+CREATE UNIQUE INDEX IF NOT EXISTS ux_reviewer_rules_author ON reviewer_rules(reviewer_id, author_id) WHERE author_id IS NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS ux_reviewer_rules_team ON reviewer_rules(reviewer_id, team_id) WHERE team_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_reviewer_rules_author_id ON reviewer_rules(author_id);
+CREATE INDEX IF NOT EXISTS idx_reviewer_rules_team_id ON reviewer_rules(team_id);
+
+CREATE TABLE IF NOT EXISTS audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    action VARCHAR(64) NOT NULL,
+    actor VARCHAR(255) NOT NULL DEFAULT '',
+    pull_request_id VARCHAR(255),
+    user_id VARCHAR(255),
+    details JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_log_pull_request_id ON audit_log(pull_request_id);
+CREATE INDEX IF NOT EXISTS idx_audit_log_created_at ON audit_log(created_at);
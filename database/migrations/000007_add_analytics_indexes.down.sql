@@ -0,0 +1,3 @@
+-- Remove time-range analytics indexes
+DROP INDEX IF EXISTS idx_pr_reviewers_assigned_at;
+DROP INDEX IF EXISTS idx_pull_requests_merged_at;
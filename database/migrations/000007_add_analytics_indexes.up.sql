@@ -0,0 +1,7 @@
+-- Indexes for time-range analytics queries
+
+-- Throughput and percentiles by merge time
+CREATE INDEX IF NOT EXISTS idx_pull_requests_merged_at ON pull_requests(merged_at) WHERE merged_at IS NOT NULL;
+
+-- Reviewer throughput by assignment time
+CREATE INDEX IF NOT EXISTS idx_pr_reviewers_assigned_at ON pr_reviewers(assigned_at);
@@ -0,0 +1,3 @@
+-- Remove tie-break tracking from reviewer assignments
+ALTER TABLE pr_reviewers
+    DROP COLUMN IF EXISTS tie_break;
@@ -0,0 +1,7 @@
+-- Record whether a policy selection was decided by candidate order among equal scores
+
+ALTER TABLE pr_reviewers
+    ADD COLUMN IF NOT EXISTS tie_break BOOLEAN;
+
+COMMENT ON COLUMN pr_reviewers.tie_break IS
+    'TRUE if an unselected candidate had the same score; NULL for assignments not selected by policy';
@@ -0,0 +1,8 @@
+-- Remove maintained reviewer workload
+DROP TRIGGER IF EXISTS pull_requests_workload_status ON pull_requests;
+DROP TRIGGER IF EXISTS pull_requests_workload_delete ON pull_requests;
+DROP TRIGGER IF EXISTS pr_reviewers_workload ON pr_reviewers;
+DROP FUNCTION IF EXISTS pull_requests_workload();
+DROP FUNCTION IF EXISTS pr_reviewers_workload();
+DROP FUNCTION IF EXISTS adjust_reviewer_workload(VARCHAR, BIGINT);
+DROP TABLE IF EXISTS reviewer_workload;
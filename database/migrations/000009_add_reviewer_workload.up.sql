@@ -0,0 +1,85 @@
+-- Maintain open review counts per reviewer instead of aggregating on every assignment
+
+CREATE TABLE IF NOT EXISTS reviewer_workload (
+    user_id VARCHAR(255) PRIMARY KEY REFERENCES users(user_id) ON DELETE CASCADE,
+    open_reviews BIGINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO reviewer_workload (user_id, open_reviews)
+SELECT r.user_id, COUNT(*)
+FROM pr_reviewers r
+JOIN pull_requests p ON p.pull_request_id = r.pull_request_id AND p.status = 'OPEN'
+GROUP BY r.user_id
+ON CONFLICT (user_id) DO UPDATE SET open_reviews = EXCLUDED.open_reviews, updated_at = NOW();
+
+-- Adds delta to the reviewer's open review count, creating the row if needed
+CREATE OR REPLACE FUNCTION adjust_reviewer_workload(p_user_id VARCHAR, p_delta BIGINT) RETURNS VOID AS $$
+BEGIN
+    INSERT INTO reviewer_workload (user_id, open_reviews, updated_at)
+    VALUES (p_user_id, p_delta, NOW())
+    ON CONFLICT (user_id) DO UPDATE
+    SET open_reviews = reviewer_workload.open_reviews + EXCLUDED.open_reviews,
+        updated_at = NOW();
+END;
+$$ LANGUAGE plpgsql;
+
+-- Assign, replace and remove reviewers of open pull requests
+CREATE OR REPLACE FUNCTION pr_reviewers_workload() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP IN ('UPDATE', 'DELETE') THEN
+        IF EXISTS (SELECT 1 FROM pull_requests WHERE pull_request_id = OLD.pull_request_id AND status = 'OPEN') THEN
+            PERFORM adjust_reviewer_workload(OLD.user_id, -1);
+        END IF;
+    END IF;
+    IF TG_OP IN ('INSERT', 'UPDATE') THEN
+        IF EXISTS (SELECT 1 FROM pull_requests WHERE pull_request_id = NEW.pull_request_id AND status = 'OPEN') THEN
+            PERFORM adjust_reviewer_workload(NEW.user_id, 1);
+        END IF;
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER pr_reviewers_workload
+AFTER INSERT OR DELETE OR UPDATE OF user_id, pull_request_id ON pr_reviewers
+FOR EACH ROW EXECUTE FUNCTION pr_reviewers_workload();
+
+-- Merge, reopen and delete pull requests with assigned reviewers
+CREATE OR REPLACE FUNCTION pull_requests_workload() RETURNS TRIGGER AS $$
+DECLARE
+    delta BIGINT;
+BEGIN
+    IF TG_OP = 'DELETE' THEN
+        IF OLD.status <> 'OPEN' THEN
+            RETURN OLD;
+        END IF;
+        delta := -1;
+    ELSIF OLD.status = 'OPEN' AND NEW.status <> 'OPEN' THEN
+        delta := -1;
+    ELSIF OLD.status <> 'OPEN' AND NEW.status = 'OPEN' THEN
+        delta := 1;
+    ELSE
+        RETURN NEW;
+    END IF;
+
+    PERFORM adjust_reviewer_workload(r.user_id, delta * COUNT(*))
+    FROM pr_reviewers r
+    WHERE r.pull_request_id = OLD.pull_request_id
+    GROUP BY r.user_id;
+
+    IF TG_OP = 'DELETE' THEN
+        RETURN OLD;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+-- BEFORE DELETE: reviewers removed by cascade no longer see the pull request
+CREATE TRIGGER pull_requests_workload_delete
+BEFORE DELETE ON pull_requests
+FOR EACH ROW EXECUTE FUNCTION pull_requests_workload();
+
+CREATE TRIGGER pull_requests_workload_status
+AFTER UPDATE OF status ON pull_requests
+FOR EACH ROW EXECUTE FUNCTION pull_requests_workload();
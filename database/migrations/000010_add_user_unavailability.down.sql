@@ -0,0 +1,2 @@
+-- Remove reviewer unavailability periods
+DROP TABLE IF EXISTS user_unavailability;
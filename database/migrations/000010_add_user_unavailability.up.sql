@@ -0,0 +1,14 @@
+-- Planned reviewer unavailability periods (vacations, days off)
+
+CREATE TABLE IF NOT EXISTS user_unavailability (
+    id BIGSERIAL PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    starts_at TIMESTAMP NOT NULL,
+    ends_at TIMESTAMP NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    CONSTRAINT user_unavailability_period_check CHECK (ends_at > starts_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_user_unavailability_user_ends ON user_unavailability(user_id, ends_at);
+
@@ -0,0 +1,11 @@
+-- Remove assignment event log
+DROP TRIGGER IF EXISTS assignment_events_notify ON assignment_events;
+DROP TRIGGER IF EXISTS users_events ON users;
+DROP TRIGGER IF EXISTS pull_requests_events ON pull_requests;
+DROP TRIGGER IF EXISTS pr_reviewers_events ON pr_reviewers;
+DROP FUNCTION IF EXISTS assignment_events_notify();
+DROP FUNCTION IF EXISTS users_events();
+DROP FUNCTION IF EXISTS pull_requests_events();
+DROP FUNCTION IF EXISTS pr_reviewers_events();
+DROP FUNCTION IF EXISTS append_assignment_event(VARCHAR, VARCHAR, VARCHAR, VARCHAR, JSONB);
+DROP TABLE IF EXISTS assignment_events;
@@ -0,0 +1,107 @@
+-- Persisted log of assignment events streamed over SSE
+
+CREATE TABLE IF NOT EXISTS assignment_events (
+    id BIGSERIAL PRIMARY KEY,
+    event_type VARCHAR(64) NOT NULL,
+    pull_request_id VARCHAR(255),
+    author_id VARCHAR(255),
+    user_id VARCHAR(255),
+    previous_user_id VARCHAR(255),
+    team_name VARCHAR(255),
+    details JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX IF NOT EXISTS idx_assignment_events_created_at ON assignment_events(created_at);
+
+-- Appends an event. The transaction-scoped lock makes events commit in id
+-- order, so readers resuming after an id never skip a later-committed event.
+CREATE OR REPLACE FUNCTION append_assignment_event(
+    p_event_type VARCHAR,
+    p_pull_request_id VARCHAR,
+    p_user_id VARCHAR,
+    p_previous_user_id VARCHAR,
+    p_details JSONB
+) RETURNS VOID AS $$
+DECLARE
+    v_author_id VARCHAR;
+    v_team_name VARCHAR;
+BEGIN
+    PERFORM pg_advisory_xact_lock(hashtext('assignment_events'));
+
+    IF p_pull_request_id IS NOT NULL THEN
+        SELECT p.author_id, t.team_name INTO v_author_id, v_team_name
+        FROM pull_requests p
+        JOIN users u ON u.user_id = p.author_id
+        JOIN teams t ON t.id = u.team_id
+        WHERE p.pull_request_id = p_pull_request_id;
+    ELSE
+        SELECT t.team_name INTO v_team_name
+        FROM users u
+        JOIN teams t ON t.id = u.team_id
+        WHERE u.user_id = p_user_id;
+    END IF;
+
+    INSERT INTO assignment_events (event_type, pull_request_id, author_id, user_id, previous_user_id, team_name, details)
+    VALUES (p_event_type, p_pull_request_id, v_author_id, p_user_id, p_previous_user_id, v_team_name, p_details);
+END;
+$$ LANGUAGE plpgsql;
+
+-- Assigned and replaced reviewers
+CREATE OR REPLACE FUNCTION pr_reviewers_events() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' THEN
+        PERFORM append_assignment_event('reviewer.assigned', NEW.pull_request_id, NEW.user_id, NULL,
+            jsonb_build_object('source', NEW.source, 'strategy', NEW.strategy));
+    ELSIF OLD.user_id <> NEW.user_id THEN
+        PERFORM append_assignment_event('reviewer.replaced', NEW.pull_request_id, NEW.user_id, OLD.user_id,
+            jsonb_build_object('source', NEW.source, 'strategy', NEW.strategy));
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER pr_reviewers_events
+AFTER INSERT OR UPDATE OF user_id ON pr_reviewers
+FOR EACH ROW EXECUTE FUNCTION pr_reviewers_events();
+
+-- Merged pull requests
+CREATE OR REPLACE FUNCTION pull_requests_events() RETURNS TRIGGER AS $$
+BEGIN
+    IF OLD.status <> 'MERGED' AND NEW.status = 'MERGED' THEN
+        PERFORM append_assignment_event('pr.merged', NEW.pull_request_id, NULL, NULL,
+            jsonb_build_object('pull_request_name', NEW.pull_request_name));
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER pull_requests_events
+AFTER UPDATE OF status ON pull_requests
+FOR EACH ROW EXECUTE FUNCTION pull_requests_events();
+
+-- Deactivated users
+CREATE OR REPLACE FUNCTION users_events() RETURNS TRIGGER AS $$
+BEGIN
+    IF OLD.is_active AND NOT NEW.is_active THEN
+        PERFORM append_assignment_event('user.deactivated', NULL, NEW.user_id, NULL, '{}');
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER users_events
+AFTER UPDATE OF is_active ON users
+FOR EACH ROW EXECUTE FUNCTION users_events();
+
+-- Wakes up SSE listeners when the transaction commits
+CREATE OR REPLACE FUNCTION assignment_events_notify() RETURNS TRIGGER AS $$
+BEGIN
+    PERFORM pg_notify('assignment_events', NEW.id::text);
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER assignment_events_notify
+AFTER INSERT ON assignment_events
+FOR EACH ROW EXECUTE FUNCTION assignment_events_notify();
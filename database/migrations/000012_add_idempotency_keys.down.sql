@@ -0,0 +1,2 @@
+-- Remove stored idempotent responses
+DROP TABLE IF EXISTS idempotency_keys;
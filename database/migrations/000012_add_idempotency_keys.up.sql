@@ -0,0 +1,15 @@
+-- Stored responses of mutating requests sent with an Idempotency-Key header.
+-- A row without status_code is a request still being processed.
+
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    client VARCHAR(255) NOT NULL,
+    idempotency_key VARCHAR(255) NOT NULL,
+    request_hash VARCHAR(64) NOT NULL,
+    status_code INTEGER,
+    content_type VARCHAR(255) NOT NULL DEFAULT '',
+    response_body BYTEA NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (client, idempotency_key)
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_created_at ON idempotency_keys(created_at);
@@ -0,0 +1,4 @@
+-- Remove reviewer assignment log
+DROP TRIGGER IF EXISTS pr_reviewers_log ON pr_reviewers;
+DROP FUNCTION IF EXISTS pr_reviewers_log();
+DROP TABLE IF EXISTS reviewer_assignment_log;
@@ -0,0 +1,36 @@
+-- Append-only log of reviewer assignments. pr_reviewers keeps only current
+-- reviewers: replacements overwrite rows and removals delete them.
+
+CREATE TABLE IF NOT EXISTS reviewer_assignment_log (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    source VARCHAR(32) NOT NULL,
+    strategy VARCHAR(32),
+    tie_break BOOLEAN,
+    assigned_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_reviewer_assignment_log_pr ON reviewer_assignment_log(pull_request_id, assigned_at);
+CREATE INDEX IF NOT EXISTS idx_reviewer_assignment_log_user ON reviewer_assignment_log(user_id, assigned_at);
+
+-- Earlier replacements are not recoverable, current reviewers seed the log
+INSERT INTO reviewer_assignment_log (pull_request_id, user_id, source, strategy, tie_break, assigned_at)
+SELECT pull_request_id, user_id, source, strategy, tie_break, assigned_at
+FROM pr_reviewers
+ORDER BY assigned_at, id;
+
+-- Assigned and replacing reviewers
+CREATE OR REPLACE FUNCTION pr_reviewers_log() RETURNS TRIGGER AS $$
+BEGIN
+    IF TG_OP = 'INSERT' OR OLD.user_id <> NEW.user_id THEN
+        INSERT INTO reviewer_assignment_log (pull_request_id, user_id, source, strategy, tie_break, assigned_at)
+        VALUES (NEW.pull_request_id, NEW.user_id, NEW.source, NEW.strategy, NEW.tie_break, NEW.assigned_at);
+    END IF;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER pr_reviewers_log
+AFTER INSERT OR UPDATE OF user_id ON pr_reviewers
+FOR EACH ROW EXECUTE FUNCTION pr_reviewers_log();
@@ -0,0 +1,32 @@
+-- Append events without the global lock. Writers no longer serialize on the
+-- event log, so ids may commit out of order; readers wait for missing ids
+-- instead (see EventServiceImpl.dispatch).
+
+CREATE OR REPLACE FUNCTION append_assignment_event(
+    p_event_type VARCHAR,
+    p_pull_request_id VARCHAR,
+    p_user_id VARCHAR,
+    p_previous_user_id VARCHAR,
+    p_details JSONB
+) RETURNS VOID AS $$
+DECLARE
+    v_author_id VARCHAR;
+    v_team_name VARCHAR;
+BEGIN
+    IF p_pull_request_id IS NOT NULL THEN
+        SELECT p.author_id, t.team_name INTO v_author_id, v_team_name
+        FROM pull_requests p
+        JOIN users u ON u.user_id = p.author_id
+        JOIN teams t ON t.id = u.team_id
+        WHERE p.pull_request_id = p_pull_request_id;
+    ELSE
+        SELECT t.team_name INTO v_team_name
+        FROM users u
+        JOIN teams t ON t.id = u.team_id
+        WHERE u.user_id = p_user_id;
+    END IF;
+
+    INSERT INTO assignment_events (event_type, pull_request_id, author_id, user_id, previous_user_id, team_name, details)
+    VALUES (p_event_type, p_pull_request_id, v_author_id, p_user_id, p_previous_user_id, v_team_name, p_details);
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,4 @@
+-- Lease of an in-progress idempotency key. A row without status_code whose
+-- lease has expired belongs to a crashed request and can be taken over.
+
+ALTER TABLE idempotency_keys ADD COLUMN IF NOT EXISTS reserved_at TIMESTAMP NOT NULL DEFAULT NOW();
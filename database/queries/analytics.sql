@@ -0,0 +1,96 @@
+-- name: GetReviewTimeAnalytics :many
+-- Перцентили времени до первого назначения и до слияния для PR, созданных
+-- в каждом интервале [range_start, range_end). Пустые интервалы тоже
+-- возвращаются, чтобы ряд был непрерывным.
+WITH buckets AS (
+    SELECT s::timestamp as bucket_start
+    FROM generate_series(
+        date_trunc(@bucket::text, @range_start::timestamp),
+        @range_end::timestamp,
+        ('1 ' || @bucket::text)::interval
+    ) s
+    WHERE s < @range_end::timestamp
+), first_assignment AS (
+    -- Из журнала: замена ревьюера перезаписывает assigned_at в pr_reviewers
+    SELECT l.pull_request_id, MIN(l.assigned_at) as assigned_at
+    FROM reviewer_assignment_log l
+    GROUP BY l.pull_request_id
+), prs AS (
+    SELECT
+        date_trunc(@bucket::text, p.created_at) as bucket_start,
+        EXTRACT(EPOCH FROM fa.assigned_at - p.created_at)::float8 as to_first_assignment,
+        EXTRACT(EPOCH FROM p.merged_at - p.created_at)::float8 as to_merge
+    FROM pull_requests p
+    LEFT JOIN first_assignment fa ON fa.pull_request_id = p.pull_request_id
+    WHERE p.created_at >= @range_start::timestamp
+      AND p.created_at < @range_end::timestamp
+)
+SELECT
+    b.bucket_start,
+    COUNT(prs.bucket_start) as prs_opened,
+    COUNT(prs.to_merge) as prs_merged,
+    -- p50, p90 и p99 в секундах, NULL для интервала без подходящих PR
+    (percentile_cont(ARRAY[0.5, 0.9, 0.99]::float8[]) WITHIN GROUP (ORDER BY prs.to_first_assignment))::float8[] as first_assignment_percentiles,
+    (percentile_cont(ARRAY[0.5, 0.9, 0.99]::float8[]) WITHIN GROUP (ORDER BY prs.to_merge))::float8[] as merge_percentiles
+FROM buckets b
+LEFT JOIN prs ON prs.bucket_start = b.bucket_start
+GROUP BY b.bucket_start
+ORDER BY b.bucket_start;
+
+-- name: GetReviewerThroughput :many
+-- Количество назначений каждого ревьюера по интервалам и его доля среди
+-- всех назначений интервала. Интервалы без назначений ревьюера опускаются.
+SELECT
+    date_trunc(@bucket::text, r.assigned_at)::timestamp as bucket_start,
+    u.user_id,
+    u.username,
+    t.team_name,
+    COUNT(*) as reviews_assigned,
+    COUNT(*) FILTER (WHERE p.status = 'MERGED') as reviews_merged,
+    (COUNT(*)::float8 / SUM(COUNT(*)) OVER (PARTITION BY date_trunc(@bucket::text, r.assigned_at)))::float8 as share
+FROM pr_reviewers r
+JOIN users u ON u.user_id = r.user_id
+JOIN teams t ON t.id = u.team_id
+JOIN pull_requests p ON p.pull_request_id = r.pull_request_id
+WHERE r.assigned_at >= @range_start::timestamp
+  AND r.assigned_at < @range_end::timestamp
+GROUP BY date_trunc(@bucket::text, r.assigned_at), u.user_id, u.username, t.team_name
+ORDER BY bucket_start, reviews_assigned DESC, u.user_id;
+
+-- name: GetTeamThroughput :many
+-- Открытые и слитые PR каждой команды по интервалам с нарастающим итогом
+-- слитых PR с начала диапазона. Команда автора определяется текущей.
+WITH buckets AS (
+    SELECT s::timestamp as bucket_start
+    FROM generate_series(
+        date_trunc(@bucket::text, @range_start::timestamp),
+        @range_end::timestamp,
+        ('1 ' || @bucket::text)::interval
+    ) s
+    WHERE s < @range_end::timestamp
+), opened AS (
+    SELECT u.team_id, date_trunc(@bucket::text, p.created_at) as bucket_start, COUNT(*) as prs_opened
+    FROM pull_requests p
+    JOIN users u ON u.user_id = p.author_id
+    WHERE p.created_at >= @range_start::timestamp
+      AND p.created_at < @range_end::timestamp
+    GROUP BY u.team_id, date_trunc(@bucket::text, p.created_at)
+), merged AS (
+    SELECT u.team_id, date_trunc(@bucket::text, p.merged_at) as bucket_start, COUNT(*) as prs_merged
+    FROM pull_requests p
+    JOIN users u ON u.user_id = p.author_id
+    WHERE p.merged_at >= @range_start::timestamp
+      AND p.merged_at < @range_end::timestamp
+    GROUP BY u.team_id, date_trunc(@bucket::text, p.merged_at)
+)
+SELECT
+    b.bucket_start,
+    t.team_name,
+    COALESCE(o.prs_opened, 0)::bigint as prs_opened,
+    COALESCE(m.prs_merged, 0)::bigint as prs_merged,
+    (SUM(COALESCE(m.prs_merged, 0)) OVER (PARTITION BY t.id ORDER BY b.bucket_start))::bigint as prs_merged_cumulative
+FROM buckets b
+CROSS JOIN teams t
+LEFT JOIN opened o ON o.team_id = t.id AND o.bucket_start = b.bucket_start
+LEFT JOIN merged m ON m.team_id = t.id AND m.bucket_start = b.bucket_start
+ORDER BY t.team_name, b.bucket_start;
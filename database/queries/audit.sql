@@ -0,0 +1,10 @@
+-- name: AddAuditEntry :one
+INSERT INTO audit_log (action, actor, pull_request_id, user_id, details)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING *;
+
+-- name: ListAuditEntries :many
+SELECT * FROM audit_log
+WHERE @pull_request_id::text = '' OR pull_request_id = @pull_request_id
+ORDER BY id DESC
+LIMIT @max_entries;
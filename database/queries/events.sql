@@ -0,0 +1,23 @@
+-- name: ListEventsAfter :many
+-- События после after_id по возрастанию. Пустой фильтр не ограничивает выборку,
+-- user_id совпадает с ревьюером, замененным ревьюером или автором PR.
+SELECT * FROM assignment_events
+WHERE id > @after_id
+  AND (@pull_request_id::text = '' OR pull_request_id = @pull_request_id)
+  AND (@team_name::text = '' OR team_name = @team_name)
+  AND (@user_id::text = '' OR @user_id IN (user_id, previous_user_id, author_id))
+ORDER BY id
+LIMIT @max_events;
+
+-- name: ListEventsByIDs :many
+-- События с указанными id: перечитывание id, пропущенных при рассылке
+SELECT * FROM assignment_events
+WHERE id = ANY(@ids::bigint[])
+ORDER BY id;
+
+-- name: GetLatestEventID :one
+SELECT COALESCE(MAX(id), 0)::bigint FROM assignment_events;
+
+-- name: DeleteEventsBefore :execrows
+DELETE FROM assignment_events
+WHERE created_at < @before;
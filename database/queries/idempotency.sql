@@ -0,0 +1,29 @@
+-- name: ReserveIdempotencyKey :execrows
+-- Занимает ключ для нового запроса. Незавершенный запрос с тем же телом,
+-- чья аренда истекла, считается прерванным, и ключ занимается заново.
+-- 0 строк - ключ уже использован.
+INSERT INTO idempotency_keys (client, idempotency_key, request_hash)
+VALUES ($1, $2, $3)
+ON CONFLICT (client, idempotency_key) DO UPDATE
+SET reserved_at = NOW()
+WHERE idempotency_keys.status_code IS NULL
+  AND idempotency_keys.request_hash = EXCLUDED.request_hash
+  AND idempotency_keys.reserved_at < NOW() - make_interval(secs => @lease_seconds::float8);
+
+-- name: GetIdempotencyKey :one
+SELECT * FROM idempotency_keys
+WHERE client = $1 AND idempotency_key = $2;
+
+-- name: CompleteIdempotencyKey :exec
+UPDATE idempotency_keys
+SET status_code = $3, content_type = $4, response_body = $5
+WHERE client = $1 AND idempotency_key = $2;
+
+-- name: ReleaseIdempotencyKey :exec
+-- Освобождает ключ незавершенного запроса, чтобы его можно было повторить
+DELETE FROM idempotency_keys
+WHERE client = $1 AND idempotency_key = $2 AND status_code IS NULL;
+
+-- name: DeleteIdempotencyKeysBefore :execrows
+DELETE FROM idempotency_keys
+WHERE created_at < @before;
@@ -1,6 +1,6 @@
 -- name: AddReviewer :one
-INSERT INTO pr_reviewers (pull_request_id, user_id)
-VALUES ($1, $2)
+INSERT INTO pr_reviewers (pull_request_id, user_id, source, strategy, workload_at_assignment, tie_break)
+VALUES ($1, $2, $3, $4, $5, $6)
 RETURNING *;
 
 -- name: RemoveReviewer :exec
@@ -8,7 +8,7 @@ DELETE FROM pr_reviewers
 WHERE pull_request_id = $1 AND user_id = $2;
 
 -- name: GetReviewersByPRID :many
-SELECT pr.*, u.username, u.team_id, u.is_active
+SELECT pr.*, u.username, u.team_id, u.is_active, u.seniority
 FROM pr_reviewers pr
 JOIN users u ON pr.user_id = u.user_id
 WHERE pr.pull_request_id = $1
@@ -33,17 +33,27 @@ WHERE pull_request_id = $1;
 
 -- name: ReplaceReviewer :exec
 UPDATE pr_reviewers
-SET user_id = $3, assigned_at = NOW()
+SET user_id = $3, assigned_at = NOW(), source = $4, strategy = $5, workload_at_assignment = $6, tie_break = $7
 WHERE pull_request_id = $1 AND user_id = $2;
 
 -- name: GetOpenPRsWithInactiveReviewers :many
-SELECT DISTINCT p.pull_request_id, p.author_id, pr.user_id as inactive_reviewer_id, u.team_id
+SELECT DISTINCT p.pull_request_id, p.author_id, pr.user_id as inactive_reviewer_id, u.team_id,
+    a.team_id AS author_team_id
 FROM pull_requests p
 JOIN pr_reviewers pr ON p.pull_request_id = pr.pull_request_id
 JOIN users u ON pr.user_id = u.user_id
+JOIN users a ON p.author_id = a.user_id
 WHERE p.status = 'OPEN' AND u.is_active = false
 ORDER BY p.pull_request_id;
 
 -- name: RemoveInactiveReviewers :exec
 DELETE FROM pr_reviewers
 WHERE pull_request_id = $1 AND user_id = ANY($2::text[]);
+
+-- name: GetReviewersByPRIDs :many
+-- Ревьюеры нескольких PR, столбцы совпадают с GetReviewersByPRID
+SELECT pr.*, u.username, u.team_id, u.is_active, u.seniority
+FROM pr_reviewers pr
+JOIN users u ON pr.user_id = u.user_id
+WHERE pr.pull_request_id = ANY(@pull_request_ids::text[])
+ORDER BY pr.pull_request_id, pr.assigned_at;
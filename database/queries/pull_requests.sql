@@ -34,3 +34,8 @@ ORDER BY created_at DESC;
 
 -- name: PullRequestExists :one
 SELECT EXISTS(SELECT 1 FROM pull_requests WHERE pull_request_id = $1);
+
+-- name: ListOpenPullRequestsByAuthorID :many
+SELECT * FROM pull_requests
+WHERE author_id = $1 AND status = 'OPEN'
+ORDER BY created_at;
@@ -0,0 +1,25 @@
+-- name: ListReviewerRules :many
+SELECT sqlc.embed(r), t.team_name
+FROM reviewer_rules r
+LEFT JOIN teams t ON r.team_id = t.id
+WHERE @user_id::text = '' OR r.reviewer_id = @user_id OR r.author_id = @user_id
+ORDER BY r.id;
+
+-- name: ListReviewerRulesForAuthor :many
+-- Правила, действующие для PR автора: заданные для него самого и для его команды
+SELECT sqlc.embed(r), t.team_name
+FROM reviewer_rules r
+LEFT JOIN teams t ON r.team_id = t.id
+WHERE r.author_id = @author_id::text
+   OR r.team_id = (SELECT u.team_id FROM users u WHERE u.user_id = @author_id)
+ORDER BY r.id;
+
+-- name: CreateReviewerRule :one
+INSERT INTO reviewer_rules (effect, reviewer_id, author_id, team_id, reason, created_by)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING *;
+
+-- name: DeleteReviewerRule :one
+DELETE FROM reviewer_rules
+WHERE id = $1
+RETURNING *;
@@ -0,0 +1,37 @@
+-- name: GetTeamWorkload :many
+-- Открытые ревью участников команды. Таблица reviewer_workload обновляется
+-- триггерами на pr_reviewers и pull_requests.
+SELECT u.user_id, COALESCE(w.open_reviews, 0)::bigint as open_reviews
+FROM users u
+LEFT JOIN reviewer_workload w ON w.user_id = u.user_id
+WHERE u.team_id = $1;
+
+-- name: LockReviewerWorkload :exec
+-- Блокирует изменения нагрузки до конца транзакции. Назначения, начавшиеся
+-- раньше, завершаются до получения блокировки.
+LOCK TABLE reviewer_workload IN SHARE ROW EXCLUSIVE MODE;
+
+-- name: ReconcileReviewerWorkload :many
+-- Пересчитывает нагрузку по pr_reviewers и исправляет расхождения.
+-- Возвращает исправленные записи со старым и новым значением.
+WITH actual AS (
+    SELECT r.user_id, COUNT(*) as open_reviews
+    FROM pr_reviewers r
+    JOIN pull_requests p ON p.pull_request_id = r.pull_request_id AND p.status = 'OPEN'
+    GROUP BY r.user_id
+), drift AS (
+    SELECT
+        COALESCE(a.user_id, w.user_id)::varchar as user_id,
+        COALESCE(w.open_reviews, 0)::bigint as recorded,
+        COALESCE(a.open_reviews, 0)::bigint as actual
+    FROM actual a
+    FULL JOIN reviewer_workload w ON w.user_id = a.user_id
+    WHERE COALESCE(w.open_reviews, 0) <> COALESCE(a.open_reviews, 0)
+), fixed AS (
+    INSERT INTO reviewer_workload (user_id, open_reviews, updated_at)
+    SELECT d.user_id, d.actual, NOW() FROM drift d
+    ON CONFLICT (user_id) DO UPDATE
+    SET open_reviews = EXCLUDED.open_reviews, updated_at = NOW()
+)
+SELECT user_id, recorded, actual FROM drift
+ORDER BY user_id;
@@ -0,0 +1,32 @@
+-- name: ListUserSkills :many
+SELECT * FROM user_skills
+WHERE user_id = $1
+ORDER BY tag;
+
+-- name: DeleteUserSkills :exec
+DELETE FROM user_skills
+WHERE user_id = $1;
+
+-- name: AddUserSkills :exec
+INSERT INTO user_skills (user_id, tag, level)
+SELECT @user_id, unnest(@tags::text[]), unnest(@levels::text[]);
+
+-- name: ListTeamSkillsByTags :many
+SELECT us.*
+FROM user_skills us
+JOIN users u ON us.user_id = u.user_id
+WHERE u.team_id = @team_id AND us.tag = ANY(@tags::text[])
+ORDER BY us.user_id, us.tag;
+
+-- name: ListPRRequiredTags :many
+SELECT tag FROM pr_required_tags
+WHERE pull_request_id = $1
+ORDER BY tag;
+
+-- name: DeletePRRequiredTags :exec
+DELETE FROM pr_required_tags
+WHERE pull_request_id = $1;
+
+-- name: AddPRRequiredTags :exec
+INSERT INTO pr_required_tags (pull_request_id, tag)
+SELECT @pull_request_id, unnest(@tags::text[]);
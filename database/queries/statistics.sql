@@ -15,7 +15,8 @@ GROUP BY u.user_id, u.username, t.team_name
 ORDER BY total_assignments DESC;
 
 -- name: GetPRStats :many
--- Статистика по Pull Request'ам
+-- Статистика по Pull Request'ам, созданным в окне [window_start, window_end).
+-- NULL-граница окна не ограничивает выборку.
 SELECT 
     pr.pull_request_id,
     pr.pull_request_name,
@@ -26,36 +27,102 @@ SELECT
     pr.merged_at
 FROM pull_requests pr
 LEFT JOIN pr_reviewers r ON pr.pull_request_id = r.pull_request_id
+WHERE (sqlc.narg(window_start)::timestamp IS NULL OR pr.created_at >= sqlc.narg(window_start))
+  AND (sqlc.narg(window_end)::timestamp IS NULL OR pr.created_at < sqlc.narg(window_end))
 GROUP BY pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.created_at, pr.merged_at
 ORDER BY pr.created_at DESC;
 
 -- name: GetTeamStats :many
--- Статистика по командам
-SELECT 
+-- Статистика по командам. PR учитываются по времени создания, ревью - по
+-- времени назначения в окне [window_start, window_end). Каждая величина
+-- агрегируется отдельно, чтобы соединения не умножали строки друг друга.
+WITH members AS (
+    SELECT
+        u.team_id,
+        COUNT(*) as total_members,
+        COUNT(*) FILTER (WHERE u.is_active) as active_members
+    FROM users u
+    GROUP BY u.team_id
+), authored AS (
+    SELECT
+        u.team_id,
+        COUNT(*) as prs_authored,
+        COUNT(*) FILTER (WHERE p.status = 'MERGED') as prs_merged
+    FROM pull_requests p
+    JOIN users u ON p.author_id = u.user_id
+    WHERE (sqlc.narg(window_start)::timestamp IS NULL OR p.created_at >= sqlc.narg(window_start))
+      AND (sqlc.narg(window_end)::timestamp IS NULL OR p.created_at < sqlc.narg(window_end))
+    GROUP BY u.team_id
+), reviewed AS (
+    SELECT
+        u.team_id,
+        COUNT(*) as review_assignments,
+        COUNT(DISTINCT r.pull_request_id) as prs_reviewed
+    FROM pr_reviewers r
+    JOIN users u ON r.user_id = u.user_id
+    WHERE (sqlc.narg(window_start)::timestamp IS NULL OR r.assigned_at >= sqlc.narg(window_start))
+      AND (sqlc.narg(window_end)::timestamp IS NULL OR r.assigned_at < sqlc.narg(window_end))
+    GROUP BY u.team_id
+)
+SELECT
     t.team_name,
-    COUNT(DISTINCT u.user_id) as total_members,
-    COUNT(DISTINCT CASE WHEN u.is_active THEN u.user_id END) as active_members,
-    COUNT(DISTINCT pr.pull_request_id) as total_prs_authored,
-    COUNT(DISTINCT r.pull_request_id) as total_prs_reviewed
+    COALESCE(m.total_members, 0)::bigint as total_members,
+    COALESCE(m.active_members, 0)::bigint as active_members,
+    COALESCE(a.prs_authored, 0)::bigint as prs_authored,
+    COALESCE(a.prs_merged, 0)::bigint as prs_merged,
+    COALESCE(rv.prs_reviewed, 0)::bigint as prs_reviewed,
+    COALESCE(rv.review_assignments, 0)::bigint as review_assignments
 FROM teams t
-LEFT JOIN users u ON t.id = u.team_id
-LEFT JOIN pull_requests pr ON u.user_id = pr.author_id
-LEFT JOIN pr_reviewers r ON u.user_id = r.user_id
-GROUP BY t.team_name
+LEFT JOIN members m ON m.team_id = t.id
+LEFT JOIN authored a ON a.team_id = t.id
+LEFT JOIN reviewed rv ON rv.team_id = t.id
 ORDER BY t.team_name;
 
 -- name: GetUserWorkload :many
--- Рабочая нагрузка пользователей (только открытые PR)
+-- Рабочая нагрузка активных пользователей по таблице reviewer_workload
 SELECT 
     u.user_id,
     u.username,
     t.team_name,
     u.is_active,
-    COUNT(r.id) as open_reviews_count
+    COALESCE(w.open_reviews, 0)::bigint as open_reviews_count
 FROM users u
 LEFT JOIN teams t ON u.team_id = t.id
-LEFT JOIN pr_reviewers r ON u.user_id = r.user_id
-LEFT JOIN pull_requests pr ON r.pull_request_id = pr.pull_request_id AND pr.status = 'OPEN'
+LEFT JOIN reviewer_workload w ON w.user_id = u.user_id
 WHERE u.is_active = true
-GROUP BY u.user_id, u.username, t.team_name, u.is_active
 ORDER BY open_reviews_count DESC, u.username;
+
+-- name: GetTeamReviewLoad :many
+-- Доступные ревьюеры и открытые ревью по командам
+SELECT
+    t.team_name,
+    COUNT(u.user_id) FILTER (WHERE u.is_active)::bigint as active_members,
+    COALESCE(SUM(w.open_reviews), 0)::bigint as open_reviews
+FROM teams t
+LEFT JOIN users u ON u.team_id = t.id
+LEFT JOIN reviewer_workload w ON w.user_id = u.user_id
+GROUP BY t.id, t.team_name
+ORDER BY t.team_name;
+
+-- name: GetReviewerAssignmentCounts :many
+-- Назначения каждого участника команды за окно [window_start, window_end)
+-- по журналу назначений: замененные и снятые ревьюеры тоже учитываются.
+-- Учитываются активные участники и неактивные, получившие назначения в окне.
+-- Пустое имя команды - все команды.
+SELECT
+    t.team_name,
+    u.user_id,
+    u.username,
+    u.is_active,
+    COUNT(r.id) as assignments,
+    COUNT(r.id) FILTER (WHERE r.tie_break IS NOT NULL) as policy_assignments,
+    COUNT(r.id) FILTER (WHERE r.tie_break) as tie_break_assignments
+FROM users u
+JOIN teams t ON t.id = u.team_id
+LEFT JOIN reviewer_assignment_log r ON r.user_id = u.user_id
+    AND (sqlc.narg(window_start)::timestamp IS NULL OR r.assigned_at >= sqlc.narg(window_start))
+    AND (sqlc.narg(window_end)::timestamp IS NULL OR r.assigned_at < sqlc.narg(window_end))
+WHERE (@team_name::text = '' OR t.team_name = @team_name::text)
+GROUP BY t.team_name, u.user_id, u.username, u.is_active
+HAVING u.is_active OR COUNT(r.id) > 0
+ORDER BY t.team_name, u.user_id;
@@ -17,3 +17,13 @@ ORDER BY team_name;
 
 -- name: TeamExists :one
 SELECT EXISTS(SELECT 1 FROM teams WHERE team_name = $1);
+
+-- name: SetTeamMentorship :one
+UPDATE teams
+SET mentorship_enabled = $2
+WHERE team_name = $1
+RETURNING *;
+
+-- name: LockTeamAssignment :exec
+-- Сериализует назначение ревьюеров внутри команды до конца транзакции
+SELECT pg_advisory_xact_lock(hashtextextended('team_assignment:' || @team_id::bigint, 0));
@@ -0,0 +1,22 @@
+-- name: AddUnavailability :one
+INSERT INTO user_unavailability (user_id, starts_at, ends_at, reason)
+VALUES ($1, $2, $3, $4)
+RETURNING *;
+
+-- name: DeleteUnavailability :one
+DELETE FROM user_unavailability
+WHERE id = $1
+RETURNING *;
+
+-- name: ListUpcomingUnavailability :many
+-- Текущие и будущие периоды недоступности пользователя
+SELECT * FROM user_unavailability
+WHERE user_id = $1 AND ends_at > @since::timestamp
+ORDER BY starts_at;
+
+-- name: ListUnavailableTeamMembers :many
+-- Участники команды, недоступные в момент at
+SELECT DISTINCT a.user_id
+FROM user_unavailability a
+JOIN users u ON u.user_id = a.user_id
+WHERE u.team_id = @team_id AND a.starts_at <= @at::timestamp AND a.ends_at > @at::timestamp;
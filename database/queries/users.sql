@@ -23,6 +23,12 @@ SET is_active = $2, updated_at = NOW()
 WHERE user_id = $1
 RETURNING *;
 
+-- name: UpdateUserSeniority :one
+UPDATE users
+SET seniority = $2, updated_at = NOW()
+WHERE user_id = $1
+RETURNING *;
+
 -- name: ListUsersByTeamID :many
 SELECT * FROM users
 WHERE team_id = $1
@@ -39,7 +45,7 @@ WHERE team_id = $1 AND is_active = true AND user_id != $2
 ORDER BY username;
 
 -- name: GetUserWithTeam :one
-SELECT u.*, t.team_name
+SELECT u.*, t.team_name, t.mentorship_enabled
 FROM users u
 JOIN teams t ON u.team_id = t.id
 WHERE u.user_id = $1 LIMIT 1;
@@ -0,0 +1,14 @@
+// Package docs встраивает OpenAPI спецификацию API в бинарный файл
+package docs
+
+import "embed"
+
+// OpenAPI - спецификация REST API в формате YAML, отдается на /openapi.yaml
+//
+//go:embed openapi.yaml
+var OpenAPI []byte
+
+// SwaggerUI - ресурсы swagger-ui-dist для /docs, скачиваются через make swagger-ui
+//
+//go:embed swagger-ui
+var SwaggerUI embed.FS
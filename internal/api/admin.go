@@ -0,0 +1,121 @@
+package api
+
+import (
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/config"
+)
+
+// adminKey - ключ признака администратора в gin.Context
+const adminKey = "client_admin"
+
+// Ошибки проверки прав администратора
+var (
+	ErrAdminCredentialsRequired = errors.New("admin credentials required")
+	ErrAdminAccessDenied        = errors.New("admin access denied")
+)
+
+// AdminAuth проверяет права администратора: идентичность клиентского
+// сертификата из списка или токен в заголовке Authorization: Bearer
+type AdminAuth struct {
+	identities map[string]bool
+	token      string
+}
+
+// NewAdminAuth создает проверку прав администратора. Без идентичностей
+// и токена администратором не считается ни один клиент.
+func NewAdminAuth(cfg config.AdminConfig) *AdminAuth {
+	identities := make(map[string]bool, len(cfg.Identities))
+	for _, identity := range cfg.Identities {
+		identities[identity] = true
+	}
+	return &AdminAuth{identities: identities, token: cfg.Token}
+}
+
+// Check проверяет учетные данные клиента. identity - идентичность по
+// клиентскому сертификату (пустая без сертификата), authorization -
+// значение заголовка Authorization.
+func (a *AdminAuth) Check(identity, authorization string) error {
+	if identity != "" && a.identities[identity] {
+		return nil
+	}
+	if authorization == "" {
+		if identity == "" {
+			return ErrAdminCredentialsRequired
+		}
+		return ErrAdminAccessDenied
+	}
+
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok || a.token == "" || subtle.ConstantTimeCompare([]byte(token), []byte(a.token)) != 1 {
+		return ErrAdminAccessDenied
+	}
+	return nil
+}
+
+// AdminMiddleware отмечает запросы администратора и отклоняет остальные
+// запросы к операциям администрирования: без учетных данных - 401
+// UNAUTHORIZED, с неверными - 403 FORBIDDEN. Операции администрирования
+// берутся из встроенной спецификации; без них middleware не создается,
+// чтобы не пропускать такие операции без проверки.
+func AdminMiddleware(auth *AdminAuth) (gin.HandlerFunc, error) {
+	adminOperations, err := loadAdminOperations()
+	if err != nil {
+		return nil, err
+	}
+
+	return func(c *gin.Context) {
+		err := auth.Check(c.GetString(identityKey), c.GetHeader("Authorization"))
+		if err == nil {
+			c.Set(adminKey, true)
+			c.Next()
+			return
+		}
+		if !adminOperations[c.Request.Method+" "+c.FullPath()] {
+			c.Next()
+			return
+		}
+
+		if errors.Is(err, ErrAdminCredentialsRequired) {
+			c.Header("WWW-Authenticate", "Bearer")
+			abortWithError(c, http.StatusUnauthorized, UNAUTHORIZED, "Admin credentials required")
+			return
+		}
+		abortWithError(c, http.StatusForbidden, FORBIDDEN, "Admin access denied")
+	}, nil
+}
+
+// isAdmin сообщает, подтвердил ли клиент права администратора
+func isAdmin(c *gin.Context) bool {
+	return c.GetBool(adminKey)
+}
+
+// loadAdminOperations строит таблицу операций администрирования
+// (с требованием security) из встроенной спецификации
+func loadAdminOperations() (map[string]bool, error) {
+	swagger, err := GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load OpenAPI spec: %w", err)
+	}
+
+	operations := make(map[string]bool)
+
+	for path, item := range swagger.Paths.Map() {
+		for method, op := range item.Operations() {
+			if op.Security != nil && len(*op.Security) > 0 {
+				operations[strings.ToUpper(method)+" "+path] = true
+			}
+		}
+	}
+	if len(operations) == 0 {
+		return nil, errors.New("OpenAPI spec has no admin operations")
+	}
+
+	return operations, nil
+}
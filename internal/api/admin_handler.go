@@ -0,0 +1,121 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.uber.org/zap"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/logger"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/policy"
+)
+
+// GetAdminPolicy возвращает текущую политику назначения
+func (h *Handler) GetAdminPolicy(c *gin.Context) {
+	c.JSON(http.StatusOK, toPolicySnapshot(h.policies.Current()))
+}
+
+// PutAdminPolicy проверяет и атомарно применяет новую политику назначения
+func (h *Handler) PutAdminPolicy(c *gin.Context) {
+	var req PutAdminPolicyJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		if !abortIfTooLarge(c, err) {
+			invalidPolicy(c, "Invalid request body: "+err.Error())
+		}
+		return
+	}
+
+	reviewSLA, err := time.ParseDuration(req.ReviewSla)
+	if err != nil {
+		invalidPolicy(c, "review_sla: "+err.Error())
+		return
+	}
+
+	snap, err := h.policies.Update(policy.Policy{
+		DefaultReviewerCount:   req.DefaultReviewerCount,
+		Strategy:               string(req.Strategy),
+		ReviewSLA:              reviewSLA,
+		MaxOpenReviews:         req.MaxOpenReviews,
+		FallbackOverCapacity:   req.FallbackOverCapacity,
+		FallbackRemoveInactive: req.FallbackRemoveInactive,
+		WorkloadWeight:         req.WorkloadWeight,
+		SkillWeight:            req.SkillWeight,
+	}, policy.SourceAdmin)
+	if err != nil {
+		// Update возвращает только ошибки проверки, текущая политика не меняется
+		invalidPolicy(c, err.Error())
+		return
+	}
+
+	logger.FromContext(c.Request.Context()).Info("Assignment policy updated",
+		zap.Int64("version", snap.Version),
+		zap.String("source", snap.Source),
+		zap.String("client", ClientIdentity(c)),
+	)
+	c.JSON(http.StatusOK, toPolicySnapshot(snap))
+}
+
+// PostAdminWorkloadReconcile сверяет учтенную нагрузку ревьюеров с назначениями
+func (h *Handler) PostAdminWorkloadReconcile(c *gin.Context) {
+	drift, err := h.services.Workload.Reconcile(c.Request.Context())
+	if err != nil {
+		_ = c.Error(err)
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: struct {
+				Code    ErrorResponseErrorCode `json:"code"`
+				Message string                 `json:"message"`
+			}{
+				Code:    NOTFOUND,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	resp := WorkloadReconcileResult{Drift: make([]WorkloadDrift, 0, len(drift))}
+	for _, d := range drift {
+		resp.Drift = append(resp.Drift, WorkloadDrift{
+			UserId:   d.UserID,
+			Recorded: d.Recorded,
+			Actual:   d.Actual,
+		})
+	}
+
+	logger.FromContext(c.Request.Context()).Info("Reviewer workload reconciled",
+		zap.Int("drift", len(drift)),
+		zap.String("client", ClientIdentity(c)),
+	)
+	c.JSON(http.StatusOK, resp)
+}
+
+func invalidPolicy(c *gin.Context, message string) {
+	c.JSON(http.StatusBadRequest, ErrorResponse{
+		Error: struct {
+			Code    ErrorResponseErrorCode `json:"code"`
+			Message string                 `json:"message"`
+		}{
+			Code:    INVALIDPOLICY,
+			Message: message,
+		},
+	})
+}
+
+// toPolicySnapshot преобразует снимок политики в модель API
+func toPolicySnapshot(snap *policy.Snapshot) AssignmentPolicySnapshot {
+	return AssignmentPolicySnapshot{
+		Policy: AssignmentPolicy{
+			DefaultReviewerCount:   snap.DefaultReviewerCount,
+			Strategy:               AssignmentPolicyStrategy(snap.Strategy),
+			ReviewSla:              snap.ReviewSLA.String(),
+			MaxOpenReviews:         snap.MaxOpenReviews,
+			FallbackOverCapacity:   snap.FallbackOverCapacity,
+			FallbackRemoveInactive: snap.FallbackRemoveInactive,
+			WorkloadWeight:         snap.WorkloadWeight,
+			SkillWeight:            snap.SkillWeight,
+		},
+		Version:   snap.Version,
+		UpdatedAt: snap.UpdatedAt,
+		Source:    AssignmentPolicySnapshotSource(snap.Source),
+	}
+}
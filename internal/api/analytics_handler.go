@@ -0,0 +1,101 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+)
+
+// GetAnalyticsReviewTimes возвращает перцентили времени ревью по интервалам
+func (h *Handler) GetAnalyticsReviewTimes(c *gin.Context, params GetAnalyticsReviewTimesParams) {
+	bucket := toAnalyticsBucket(params.Bucket)
+	buckets, err := h.services.Analytics.GetReviewTimes(c.Request.Context(), bucket, models.TimeWindow{From: params.From, To: params.To})
+	if err != nil {
+		statisticsError(c, err)
+		return
+	}
+
+	resp := ReviewTimeSeries{Bucket: AnalyticsBucket(bucket), Series: make([]ReviewTimeBucket, 0, len(buckets))}
+	for _, b := range buckets {
+		resp.Series = append(resp.Series, ReviewTimeBucket{
+			BucketStart:           b.BucketStart,
+			PrsOpened:             b.PRsOpened,
+			PrsMerged:             b.PRsMerged,
+			TimeToFirstAssignment: toPercentiles(b.TimeToFirstAssignment),
+			TimeToMerge:           toPercentiles(b.TimeToMerge),
+		})
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetAnalyticsReviewerThroughput возвращает назначения ревьюеров по интервалам
+func (h *Handler) GetAnalyticsReviewerThroughput(c *gin.Context, params GetAnalyticsReviewerThroughputParams) {
+	bucket := toAnalyticsBucket(params.Bucket)
+	throughput, err := h.services.Analytics.GetReviewerThroughput(c.Request.Context(), bucket, models.TimeWindow{From: params.From, To: params.To})
+	if err != nil {
+		statisticsError(c, err)
+		return
+	}
+
+	resp := ReviewerThroughputSeries{Bucket: AnalyticsBucket(bucket), Series: make([]ReviewerThroughput, 0, len(throughput))}
+	for _, t := range throughput {
+		resp.Series = append(resp.Series, ReviewerThroughput{
+			BucketStart:     t.BucketStart,
+			UserId:          t.UserID,
+			Username:        t.Username,
+			TeamName:        t.TeamName,
+			ReviewsAssigned: t.ReviewsAssigned,
+			ReviewsMerged:   t.ReviewsMerged,
+			Share:           t.Share,
+		})
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetAnalyticsTeamThroughput возвращает открытые и слитые PR команд по интервалам
+func (h *Handler) GetAnalyticsTeamThroughput(c *gin.Context, params GetAnalyticsTeamThroughputParams) {
+	bucket := toAnalyticsBucket(params.Bucket)
+	throughput, err := h.services.Analytics.GetTeamThroughput(c.Request.Context(), bucket, models.TimeWindow{From: params.From, To: params.To})
+	if err != nil {
+		statisticsError(c, err)
+		return
+	}
+
+	resp := TeamThroughputSeries{Bucket: AnalyticsBucket(bucket), Series: make([]TeamThroughput, 0, len(throughput))}
+	for _, t := range throughput {
+		resp.Series = append(resp.Series, TeamThroughput{
+			BucketStart:         t.BucketStart,
+			TeamName:            t.TeamName,
+			PrsOpened:           t.PRsOpened,
+			PrsMerged:           t.PRsMerged,
+			PrsMergedCumulative: t.PRsMergedCumulative,
+		})
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// toAnalyticsBucket возвращает шаг из запроса, по умолчанию неделю
+func toAnalyticsBucket(bucket *BucketQuery) models.AnalyticsBucket {
+	if bucket == nil {
+		return models.AnalyticsBucketWeek
+	}
+	return models.AnalyticsBucket(*bucket)
+}
+
+// toPercentiles преобразует перцентили длительности в секунды
+func toPercentiles(p *models.Percentiles) *Percentiles {
+	if p == nil {
+		return nil
+	}
+	return &Percentiles{
+		P50Seconds: int64(p.P50 / time.Second),
+		P90Seconds: int64(p.P90 / time.Second),
+		P99Seconds: int64(p.P99 / time.Second),
+	}
+}
@@ -0,0 +1,163 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/service"
+)
+
+// GetUsersDashboard возвращает персональную сводку ревьюера
+func (h *Handler) GetUsersDashboard(c *gin.Context, params GetUsersDashboardParams) {
+	d, err := h.services.Dashboard.GetDashboard(c.Request.Context(), params.UserId)
+	if err != nil {
+		unavailabilityError(c, err)
+		return
+	}
+
+	seniority := Seniority(d.User.Seniority)
+	resp := UserDashboard{
+		User: User{
+			UserId:    d.User.UserID,
+			Username:  d.User.Username,
+			TeamName:  d.User.TeamName,
+			IsActive:  d.User.IsActive,
+			Seniority: &seniority,
+		},
+		PendingReviews:       make([]PendingReview, 0, len(d.PendingReviews)),
+		AuthoredPullRequests: make([]AuthoredPullRequest, 0, len(d.AuthoredPRs)),
+		Capacity: ReviewCapacity{
+			OpenReviews:    d.Capacity.OpenReviews,
+			MaxOpenReviews: d.Capacity.MaxOpenReviews,
+			Remaining:      d.Capacity.Remaining,
+			AtCapacity:     d.Capacity.AtCapacity,
+			Unavailable:    d.Capacity.Unavailable,
+		},
+		Unavailability: toUnavailabilityList(d.Unavailability),
+		RecentStats: RecentReviewStats{
+			From:            d.RecentStats.Window.From,
+			To:              d.RecentStats.Window.To,
+			ReviewsAssigned: d.RecentStats.ReviewsAssigned,
+			ReviewsMerged:   d.RecentStats.ReviewsMerged,
+		},
+	}
+
+	for _, r := range d.PendingReviews {
+		resp.PendingReviews = append(resp.PendingReviews, PendingReview{
+			PullRequestId:   r.PullRequestID,
+			PullRequestName: r.PullRequestName,
+			AuthorId:        r.AuthorID,
+			CreatedAt:       r.CreatedAt,
+			AgeSeconds:      int64(r.Age.Seconds()),
+			DueAt:           r.DueAt,
+			Overdue:         r.Overdue,
+			Assignment:      toReviewerAssignment(d.User.UserID, &d.User.Username, r.Assignment, r.AssignedAt),
+		})
+	}
+
+	for _, pr := range d.AuthoredPRs {
+		reviewers := make([]AuthoredPullRequestReviewer, 0, len(pr.Reviewers))
+		for _, r := range pr.Reviewers {
+			reviewers = append(reviewers, AuthoredPullRequestReviewer{
+				UserId:     r.UserID,
+				Username:   r.Username,
+				IsActive:   r.IsActive,
+				Source:     AuthoredPullRequestReviewerSource(r.Source),
+				AssignedAt: r.AssignedAt,
+				DueAt:      r.DueAt,
+				Overdue:    r.Overdue,
+			})
+		}
+		resp.AuthoredPullRequests = append(resp.AuthoredPullRequests, AuthoredPullRequest{
+			PullRequestId:   pr.PullRequestID,
+			PullRequestName: pr.PullRequestName,
+			CreatedAt:       pr.CreatedAt,
+			Reviewers:       reviewers,
+		})
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// PostUsersAddUnavailability добавляет период недоступности пользователя
+func (h *Handler) PostUsersAddUnavailability(c *gin.Context) {
+	var req PostUsersAddUnavailabilityJSONRequestBody
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	period := models.Unavailability{UserID: req.UserId, StartsAt: req.StartsAt, EndsAt: req.EndsAt}
+	if req.Reason != nil {
+		period.Reason = *req.Reason
+	}
+
+	period, err := h.services.User.AddUnavailability(c.Request.Context(), period)
+	if err != nil {
+		unavailabilityError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toUnavailability(period))
+}
+
+// PostUsersDeleteUnavailability удаляет период недоступности
+func (h *Handler) PostUsersDeleteUnavailability(c *gin.Context) {
+	var req PostUsersDeleteUnavailabilityJSONRequestBody
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	period, err := h.services.User.DeleteUnavailability(c.Request.Context(), req.Id)
+	if err != nil {
+		unavailabilityError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toUnavailability(period))
+}
+
+func toUnavailability(u models.Unavailability) Unavailability {
+	return Unavailability{
+		Id:       u.ID,
+		UserId:   u.UserID,
+		StartsAt: u.StartsAt,
+		EndsAt:   u.EndsAt,
+		Reason:   u.Reason,
+	}
+}
+
+func toUnavailabilityList(periods []models.Unavailability) []Unavailability {
+	resp := make([]Unavailability, 0, len(periods))
+	for _, u := range periods {
+		resp = append(resp, toUnavailability(u))
+	}
+	return resp
+}
+
+// unavailabilityError отвечает на ошибку сводки или периодов недоступности
+func unavailabilityError(c *gin.Context, err error) {
+	status, code, message := http.StatusInternalServerError, NOTFOUND, err.Error()
+	switch {
+	case errors.Is(err, service.ErrInvalidUnavailability):
+		status, code = http.StatusBadRequest, INVALIDUNAVAILABILITY
+	case errors.Is(err, service.ErrUserNotFound):
+		status, message = http.StatusNotFound, "User not found"
+	case errors.Is(err, service.ErrUnavailabilityNotFound):
+		status, message = http.StatusNotFound, "Unavailability period not found"
+	default:
+		_ = c.Error(err)
+	}
+
+	c.JSON(status, ErrorResponse{
+		Error: struct {
+			Code    ErrorResponseErrorCode `json:"code"`
+			Message string                 `json:"message"`
+		}{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
@@ -0,0 +1,98 @@
+package api
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"io/fs"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/docs"
+)
+
+// swaggerUIBase - путь, по которому сервис сам отдает встроенные ресурсы
+// Swagger UI. Страница не обращается к CDN, поэтому подмена ресурсов на
+// стороне CDN ее не затрагивает.
+const swaggerUIBase = "/docs/assets/"
+
+// swaggerUIInit - встроенный скрипт страницы, его хеш разрешается в CSP
+const swaggerUIInit = `window.ui = SwaggerUIBundle({ url: "/openapi.yaml", dom_id: "#swagger-ui" });`
+
+// swaggerUIPage - страница Swagger UI, загружающая спецификацию с /openapi.yaml
+const swaggerUIPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>PR Reviewer Assignment Service API</title>
+  <link rel="stylesheet" href="` + swaggerUIBase + `swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="` + swaggerUIBase + `swagger-ui-bundle.js"></script>
+  <script>` + swaggerUIInit + `</script>
+</body>
+</html>
+`
+
+// swaggerUIPolicy разрешает странице только ресурсы этого сервиса и
+// собственный встроенный скрипт
+var swaggerUIPolicy = func() string {
+	sum := sha256.Sum256([]byte(swaggerUIInit))
+	return "default-src 'none'; " +
+		"script-src 'self' 'sha256-" + base64.StdEncoding.EncodeToString(sum[:]) + "'; " +
+		"style-src 'self' 'unsafe-inline'; " +
+		"img-src 'self' data:; connect-src 'self'; " +
+		"base-uri 'none'; form-action 'none'; frame-ancestors 'none'"
+}()
+
+// swaggerUIFiles - ресурсы, которые отдаются на /docs/assets/, и их типы
+var swaggerUIFiles = map[string]string{
+	"swagger-ui.css":       "text/css; charset=utf-8",
+	"swagger-ui-bundle.js": "text/javascript; charset=utf-8",
+}
+
+// swaggerUIAssets - встроенные ресурсы swagger-ui-dist (docs/swagger-ui)
+var swaggerUIAssets fs.FS = func() fs.FS {
+	assets, err := fs.Sub(docs.SwaggerUI, "swagger-ui")
+	if err != nil {
+		panic(err)
+	}
+	return assets
+}()
+
+// SpecHandler отдает встроенную OpenAPI спецификацию
+func SpecHandler(c *gin.Context) {
+	c.Data(http.StatusOK, "application/yaml", docs.OpenAPI)
+}
+
+// DocsHandler отдает Swagger UI для встроенной спецификации
+func DocsHandler(c *gin.Context) {
+	for name := range swaggerUIFiles {
+		if _, err := fs.Stat(swaggerUIAssets, name); err != nil {
+			c.String(http.StatusServiceUnavailable, "Swagger UI assets are not embedded, run make swagger-ui and rebuild")
+			return
+		}
+	}
+
+	c.Header("Content-Security-Policy", swaggerUIPolicy)
+	c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(swaggerUIPage))
+}
+
+// DocsAssetsHandler отдает встроенные ресурсы Swagger UI
+func DocsAssetsHandler(c *gin.Context) {
+	name := c.Param("name")
+	contentType, ok := swaggerUIFiles[name]
+	if !ok {
+		c.Status(http.StatusNotFound)
+		return
+	}
+
+	data, err := fs.ReadFile(swaggerUIAssets, name)
+	if err != nil {
+		c.Status(http.StatusNotFound)
+		return
+	}
+	c.Header("Cache-Control", "public, max-age=86400")
+	c.Data(http.StatusOK, contentType, data)
+}
@@ -0,0 +1,120 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-contrib/sse"
+	"github.com/gin-gonic/gin"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+)
+
+// eventKeepalive - период комментария keepalive в потоке событий, чтобы
+// прокси не закрывали соединение без событий
+const eventKeepalive = 15 * time.Second
+
+// GetEventsStream отправляет события назначения как Server-Sent Events.
+// С Last-Event-ID сначала отправляются сохраненные события после него.
+func (h *Handler) GetEventsStream(c *gin.Context, params GetEventsStreamParams) {
+	ctx := c.Request.Context()
+	filter := models.EventFilter{}
+	if params.UserId != nil {
+		filter.UserID = *params.UserId
+	}
+	if params.TeamName != nil {
+		filter.TeamName = *params.TeamName
+	}
+	if params.PullRequestId != nil {
+		filter.PullRequestID = *params.PullRequestId
+	}
+
+	// Поток живет дольше SERVER_WRITE_TIMEOUT
+	_ = http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{})
+
+	// Подписка до чтения журнала: события, записанные во время чтения, не теряются
+	sub := h.services.Events.Subscribe(filter)
+	defer sub.Close()
+
+	if params.LastEventID != nil {
+		err := sub.Replay(ctx, *params.LastEventID, func(e models.Event) error {
+			return writeEvent(c, e)
+		})
+		if err != nil {
+			_ = c.Error(err)
+			if !c.Writer.Written() {
+				c.JSON(http.StatusInternalServerError, ErrorResponse{
+					Error: struct {
+						Code    ErrorResponseErrorCode `json:"code"`
+						Message string                 `json:"message"`
+					}{
+						Code:    NOTFOUND,
+						Message: err.Error(),
+					},
+				})
+			}
+			return
+		}
+	}
+	startEventStream(c)
+	c.Writer.Flush()
+
+	keepalive := time.NewTicker(eventKeepalive)
+	defer keepalive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case e, ok := <-sub.Events():
+			if !ok {
+				// Клиент не успевал читать события и продолжит с Last-Event-ID
+				return
+			}
+			if err := writeEvent(c, e); err != nil {
+				return
+			}
+		case <-keepalive.C:
+			if _, err := c.Writer.WriteString(": keepalive\n\n"); err != nil {
+				return
+			}
+		}
+		c.Writer.Flush()
+	}
+}
+
+// startEventStream отправляет заголовки потока, если ответ еще не начат
+func startEventStream(c *gin.Context) {
+	if c.Writer.Written() {
+		return
+	}
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+	c.Status(http.StatusOK)
+	c.Writer.WriteHeaderNow()
+}
+
+func writeEvent(c *gin.Context, e models.Event) error {
+	startEventStream(c)
+	return sse.Encode(c.Writer, sse.Event{
+		Id:    strconv.FormatInt(e.ID, 10),
+		Event: string(e.Type),
+		Data:  toAssignmentEvent(e),
+	})
+}
+
+func toAssignmentEvent(e models.Event) AssignmentEvent {
+	return AssignmentEvent{
+		Id:             e.ID,
+		Type:           AssignmentEventType(e.Type),
+		PullRequestId:  e.PullRequestID,
+		AuthorId:       e.AuthorID,
+		UserId:         e.UserID,
+		PreviousUserId: e.PreviousUserID,
+		TeamName:       e.TeamName,
+		Details:        e.Details,
+		CreatedAt:      e.CreatedAt,
+	}
+}
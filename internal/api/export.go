@@ -0,0 +1,185 @@
+package api
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+)
+
+// Форматы потокового экспорта статистики
+const (
+	mimeCSV    = "text/csv"
+	mimeNDJSON = "application/x-ndjson"
+)
+
+// exportFlushRows - через сколько строк экспорт отправляется клиенту
+const exportFlushRows = 100
+
+// exportFormat выбирает формат ответа по заголовку Accept. Пустая строка
+// означает обычный JSON ответ, он же используется, если ни один формат не подошел.
+func exportFormat(c *gin.Context) string {
+	switch c.NegotiateFormat(binding.MIMEJSON, mimeCSV, mimeNDJSON) {
+	case mimeCSV:
+		return mimeCSV
+	case mimeNDJSON:
+		return mimeNDJSON
+	default:
+		return ""
+	}
+}
+
+// exportWriter построчно пишет статистику в CSV с заголовком или в NDJSON
+// с ключами columns. Ответ начинается с первой строки, поэтому ошибка до
+// нее возвращается обычным JSON с кодом ошибки.
+type exportWriter struct {
+	c       *gin.Context
+	format  string
+	columns []string
+	keys    [][]byte
+
+	started bool
+	rows    int
+	csv     *csv.Writer
+	record  []string
+	line    bytes.Buffer
+}
+
+func newExportWriter(c *gin.Context, format string, columns ...string) *exportWriter {
+	w := &exportWriter{c: c, format: format, columns: columns}
+	if format == mimeCSV {
+		w.csv = csv.NewWriter(c.Writer)
+		w.record = make([]string, len(columns))
+	} else {
+		w.keys = make([][]byte, len(columns))
+		for i, col := range columns {
+			w.keys[i], _ = json.Marshal(col)
+		}
+	}
+	return w
+}
+
+func (w *exportWriter) start() error {
+	w.started = true
+	w.c.Header("Content-Type", w.format+"; charset=utf-8")
+	w.c.Status(http.StatusOK)
+	if w.csv != nil {
+		return w.csv.Write(w.columns)
+	}
+	return nil
+}
+
+// write записывает строку, values идут в порядке columns
+func (w *exportWriter) write(values ...any) error {
+	if !w.started {
+		if err := w.start(); err != nil {
+			return err
+		}
+	}
+
+	if w.csv != nil {
+		for i, v := range values {
+			w.record[i] = csvValue(v)
+		}
+		if err := w.csv.Write(w.record); err != nil {
+			return err
+		}
+	} else {
+		w.line.Reset()
+		w.line.WriteByte('{')
+		for i, v := range values {
+			if i > 0 {
+				w.line.WriteByte(',')
+			}
+			w.line.Write(w.keys[i])
+			w.line.WriteByte(':')
+			value, err := json.Marshal(v)
+			if err != nil {
+				return err
+			}
+			w.line.Write(value)
+		}
+		w.line.WriteString("}\n")
+		if _, err := w.c.Writer.Write(w.line.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	w.rows++
+	if w.rows%exportFlushRows == 0 {
+		return w.flush()
+	}
+	return nil
+}
+
+func (w *exportWriter) flush() error {
+	if w.csv != nil {
+		w.csv.Flush()
+		if err := w.csv.Error(); err != nil {
+			return err
+		}
+	}
+	w.c.Writer.Flush()
+	return nil
+}
+
+// finish завершает экспорт. err - ошибка получения строк: если ответ еще не
+// начат, она возвращается клиенту, иначе ответ обрывается и ошибка пишется в лог.
+func (w *exportWriter) finish(err error) {
+	if err != nil && !w.started {
+		statisticsError(w.c, err)
+		return
+	}
+	if err != nil {
+		_ = w.c.Error(err)
+	}
+	if !w.started {
+		// Пустой экспорт: CSV содержит только заголовок
+		if err := w.start(); err != nil {
+			_ = w.c.Error(err)
+			return
+		}
+	}
+	if err := w.flush(); err != nil {
+		_ = w.c.Error(err)
+	}
+}
+
+// csvValue форматирует значение ячейки CSV. nil и пустые указатели дают
+// пустую ячейку, время - RFC 3339 в UTC.
+func csvValue(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case *string:
+		if v == nil {
+			return ""
+		}
+		return *v
+	case bool:
+		return strconv.FormatBool(v)
+	case int:
+		return strconv.Itoa(v)
+	case int64:
+		return strconv.FormatInt(v, 10)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	case *time.Time:
+		if v == nil {
+			return ""
+		}
+		return v.UTC().Format(time.RFC3339)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,131 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+)
+
+// exportRouter отвечает экспортом двух строк или ошибкой до первой строки
+func exportRouter(fail bool) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/export", func(c *gin.Context) {
+		format := exportFormat(c)
+		if format == "" {
+			c.JSON(http.StatusOK, gin.H{"format": "json"})
+			return
+		}
+		w := newExportWriter(c, format, "user_id", "team_name", "open_reviews", "merged_at")
+		if fail {
+			w.finish(errors.New("query failed"))
+			return
+		}
+		team := "backend"
+		merged := time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)
+		w.finish(errors.Join(
+			w.write("u1", &team, int64(2), &merged),
+			w.write("u,2", (*string)(nil), int64(0), (*time.Time)(nil)),
+		))
+	})
+	return router
+}
+
+func TestExport_Formats(t *testing.T) {
+	tests := []struct {
+		name        string
+		accept      string
+		contentType string
+		body        string
+	}{
+		{
+			name:        "без Accept",
+			contentType: "application/json; charset=utf-8",
+			body:        `{"format":"json"}`,
+		},
+		{
+			name:        "неизвестный формат",
+			accept:      "application/xml",
+			contentType: "application/json; charset=utf-8",
+			body:        `{"format":"json"}`,
+		},
+		{
+			name:        "CSV",
+			accept:      "text/csv",
+			contentType: "text/csv; charset=utf-8",
+			body: "user_id,team_name,open_reviews,merged_at\n" +
+				"u1,backend,2,2025-10-01T12:00:00Z\n" +
+				"\"u,2\",,0,\n",
+		},
+		{
+			name:        "NDJSON",
+			accept:      "application/x-ndjson",
+			contentType: "application/x-ndjson; charset=utf-8",
+			body: `{"user_id":"u1","team_name":"backend","open_reviews":2,"merged_at":"2025-10-01T12:00:00Z"}` + "\n" +
+				`{"user_id":"u,2","team_name":null,"open_reviews":0,"merged_at":null}` + "\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/export", nil)
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			rec := httptest.NewRecorder()
+			exportRouter(false).ServeHTTP(rec, req)
+
+			assert.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, tt.contentType, rec.Header().Get("Content-Type"))
+			assert.Equal(t, tt.body, rec.Body.String())
+		})
+	}
+}
+
+func TestExport_ErrorBeforeFirstRow(t *testing.T) {
+	req := httptest.NewRequest(http.MethodGet, "/export", nil)
+	req.Header.Set("Accept", "text/csv")
+	rec := httptest.NewRecorder()
+	exportRouter(true).ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	assert.Contains(t, rec.Body.String(), "query failed")
+}
+
+func TestExportFairness(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/export", func(c *gin.Context) {
+		w := newExportWriter(c, exportFormat(c), fairnessExportColumns...)
+		w.finish(exportFairness(w, []models.TeamFairness{{
+			TeamName: "backend",
+			Members: []models.ReviewerFairness{{
+				ReviewerAssignmentCount: models.ReviewerAssignmentCount{
+					UserID: "u1", Username: "Alice", IsActive: true,
+					Assignments: 5, PolicyAssignments: 4, TieBreakAssignments: 1,
+				},
+				Deviation:         1,
+				RelativeDeviation: 0.25,
+				Status:            models.FairnessOverAssigned,
+			}},
+		}}))
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/export", nil)
+	req.Header.Set("Accept", "text/csv")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t,
+		"team_name,user_id,username,is_active,assignments,policy_assignments,tie_break_assignments,deviation,relative_deviation,status\n"+
+			"backend,u1,Alice,true,5,4,1,1,0.25,over_assigned\n",
+		rec.Body.String())
+}
@@ -19,14 +19,98 @@ import (
 	"github.com/oapi-codegen/runtime"
 )
 
+const (
+	AdminTokenScopes = "adminToken.Scopes"
+)
+
+// Defines values for AnalyticsBucket.
+const (
+	Day   AnalyticsBucket = "day"
+	Month AnalyticsBucket = "month"
+	Week  AnalyticsBucket = "week"
+)
+
+// Defines values for AssignmentEventType.
+const (
+	PrMerged         AssignmentEventType = "pr.merged"
+	ReviewerAssigned AssignmentEventType = "reviewer.assigned"
+	ReviewerReplaced AssignmentEventType = "reviewer.replaced"
+	UserDeactivated  AssignmentEventType = "user.deactivated"
+)
+
+// Defines values for AssignmentPolicyStrategy.
+const (
+	AssignmentPolicyStrategyLeastLoaded AssignmentPolicyStrategy = "least_loaded"
+	AssignmentPolicyStrategyRandom      AssignmentPolicyStrategy = "random"
+)
+
+// Defines values for AssignmentPolicySnapshotSource.
+const (
+	Admin   AssignmentPolicySnapshotSource = "admin"
+	Sighup  AssignmentPolicySnapshotSource = "sighup"
+	Startup AssignmentPolicySnapshotSource = "startup"
+)
+
+// Defines values for AuditEntryAction.
+const (
+	RuleCreated    AuditEntryAction = "rule_created"
+	RuleDeleted    AuditEntryAction = "rule_deleted"
+	RuleOverridden AuditEntryAction = "rule_overridden"
+)
+
+// Defines values for AuthoredPullRequestReviewerSource.
+const (
+	AuthoredPullRequestReviewerSourceAuto                 AuthoredPullRequestReviewerSource = "auto"
+	AuthoredPullRequestReviewerSourceCodeOwner            AuthoredPullRequestReviewerSource = "code_owner"
+	AuthoredPullRequestReviewerSourceFallbackTeam         AuthoredPullRequestReviewerSource = "fallback_team"
+	AuthoredPullRequestReviewerSourceInactiveReassignment AuthoredPullRequestReviewerSource = "inactive_reassignment"
+	AuthoredPullRequestReviewerSourceManual               AuthoredPullRequestReviewerSource = "manual"
+	AuthoredPullRequestReviewerSourceSlaEscalation        AuthoredPullRequestReviewerSource = "sla_escalation"
+)
+
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	FORBIDDEN             ErrorResponseErrorCode = "FORBIDDEN"
+	IDEMPOTENCYINPROGRESS ErrorResponseErrorCode = "IDEMPOTENCY_IN_PROGRESS"
+	IDEMPOTENCYKEYREUSED  ErrorResponseErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	INVALIDPOLICY         ErrorResponseErrorCode = "INVALID_POLICY"
+	INVALIDREVIEWERS      ErrorResponseErrorCode = "INVALID_REVIEWERS"
+	INVALIDRULE           ErrorResponseErrorCode = "INVALID_RULE"
+	INVALIDSENIORITY      ErrorResponseErrorCode = "INVALID_SENIORITY"
+	INVALIDSKILL          ErrorResponseErrorCode = "INVALID_SKILL"
+	INVALIDTIMERANGE      ErrorResponseErrorCode = "INVALID_TIME_RANGE"
+	INVALIDTOLERANCE      ErrorResponseErrorCode = "INVALID_TOLERANCE"
+	INVALIDUNAVAILABILITY ErrorResponseErrorCode = "INVALID_UNAVAILABILITY"
+	NOCANDIDATE           ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED           ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND              ErrorResponseErrorCode = "NOT_FOUND"
+	PAYLOADTOOLARGE       ErrorResponseErrorCode = "PAYLOAD_TOO_LARGE"
+	PREXISTS              ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED              ErrorResponseErrorCode = "PR_MERGED"
+	RATELIMITED           ErrorResponseErrorCode = "RATE_LIMITED"
+	REVIEWERBLOCKED       ErrorResponseErrorCode = "REVIEWER_BLOCKED"
+	RULEEXISTS            ErrorResponseErrorCode = "RULE_EXISTS"
+	TEAMEXISTS            ErrorResponseErrorCode = "TEAM_EXISTS"
+	UNAUTHORIZED          ErrorResponseErrorCode = "UNAUTHORIZED"
+	VALIDATIONERROR       ErrorResponseErrorCode = "VALIDATION_ERROR"
+)
+
+// Defines values for ExcludedCandidateReason.
+const (
+	AlreadyAssigned ExcludedCandidateReason = "already_assigned"
+	AtCapacity      ExcludedCandidateReason = "at_capacity"
+	Author          ExcludedCandidateReason = "author"
+	Blocked         ExcludedCandidateReason = "blocked"
+	Excluded        ExcludedCandidateReason = "excluded"
+	Inactive        ExcludedCandidateReason = "inactive"
+	Unavailable     ExcludedCandidateReason = "unavailable"
+)
+
+// Defines values for HealthStatus.
+const (
+	Degraded HealthStatus = "degraded"
+	Down     HealthStatus = "down"
+	Ok       HealthStatus = "ok"
 )
 
 // Defines values for PullRequestStatus.
@@ -41,9 +125,208 @@ const (
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for PullRequestStatsStatus.
+const (
+	MERGED PullRequestStatsStatus = "MERGED"
+	OPEN   PullRequestStatsStatus = "OPEN"
+)
+
+// Defines values for ReviewerAssignmentSource.
+const (
+	ReviewerAssignmentSourceAuto                 ReviewerAssignmentSource = "auto"
+	ReviewerAssignmentSourceCodeOwner            ReviewerAssignmentSource = "code_owner"
+	ReviewerAssignmentSourceFallbackTeam         ReviewerAssignmentSource = "fallback_team"
+	ReviewerAssignmentSourceInactiveReassignment ReviewerAssignmentSource = "inactive_reassignment"
+	ReviewerAssignmentSourceManual               ReviewerAssignmentSource = "manual"
+	ReviewerAssignmentSourceSlaEscalation        ReviewerAssignmentSource = "sla_escalation"
+)
+
+// Defines values for ReviewerFairnessStatus.
+const (
+	Balanced      ReviewerFairnessStatus = "balanced"
+	OverAssigned  ReviewerFairnessStatus = "over_assigned"
+	UnderAssigned ReviewerFairnessStatus = "under_assigned"
+)
+
+// Defines values for ReviewerRuleEffect.
+const (
+	ReviewerRuleEffectBlock  ReviewerRuleEffect = "block"
+	ReviewerRuleEffectPrefer ReviewerRuleEffect = "prefer"
+)
+
+// Defines values for ScoreComponentName.
+const (
+	ScoreComponentNameOverCapacity ScoreComponentName = "over_capacity"
+	ScoreComponentNamePreferred    ScoreComponentName = "preferred"
+	ScoreComponentNameRandom       ScoreComponentName = "random"
+	ScoreComponentNameSkillMatch   ScoreComponentName = "skill_match"
+	ScoreComponentNameWorkload     ScoreComponentName = "workload"
+)
+
+// Defines values for Seniority.
+const (
+	Learner Seniority = "learner"
+	Regular Seniority = "regular"
+	Senior  Seniority = "senior"
+)
+
+// Defines values for UserSkillLevel.
+const (
+	Expert       UserSkillLevel = "expert"
+	Intermediate UserSkillLevel = "intermediate"
+	Novice       UserSkillLevel = "novice"
+)
+
+// Defines values for ValidationIssueLocation.
+const (
+	Body   ValidationIssueLocation = "body"
+	Cookie ValidationIssueLocation = "cookie"
+	Header ValidationIssueLocation = "header"
+	Path   ValidationIssueLocation = "path"
+	Query  ValidationIssueLocation = "query"
+)
+
+// Defines values for PostRulesAddJSONBodyEffect.
+const (
+	PostRulesAddJSONBodyEffectBlock  PostRulesAddJSONBodyEffect = "block"
+	PostRulesAddJSONBodyEffectPrefer PostRulesAddJSONBodyEffect = "prefer"
+)
+
+// AnalyticsBucket Шаг временного ряда. Интервалы выравниваются по началу дня, недели (понедельник)
+// или месяца в UTC, поэтому первый интервал может начинаться раньше from.
+// Диапазон содержит не больше 366 интервалов.
+type AnalyticsBucket string
+
+// AssignmentEvent Данные события SSE. Поле `id` совпадает с id события потока и
+// передается в Last-Event-ID для продолжения.
+type AssignmentEvent struct {
+	// AuthorId Автор PR для событий PR
+	AuthorId  *string   `json:"author_id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Details source и strategy назначения, pull_request_name для pr.merged
+	Details map[string]interface{} `json:"details"`
+	Id      int64                  `json:"id"`
+
+	// PreviousUserId Замененный ревьюер для reviewer.replaced
+	PreviousUserId *string `json:"previous_user_id"`
+	PullRequestId  *string `json:"pull_request_id"`
+
+	// TeamName Команда автора PR или деактивированного пользователя
+	TeamName *string             `json:"team_name"`
+	Type     AssignmentEventType `json:"type"`
+
+	// UserId Назначенный ревьюер или деактивированный пользователь
+	UserId *string `json:"user_id"`
+}
+
+// AssignmentEventType defines model for AssignmentEvent.Type.
+type AssignmentEventType string
+
+// AssignmentPolicy defines model for AssignmentPolicy.
+type AssignmentPolicy struct {
+	DefaultReviewerCount int `json:"default_reviewer_count"`
+
+	// FallbackOverCapacity Назначать сверх лимита, если все кандидаты его достигли
+	FallbackOverCapacity bool `json:"fallback_over_capacity"`
+
+	// FallbackRemoveInactive Снимать неактивного ревьюера, если замену найти не удалось
+	FallbackRemoveInactive bool `json:"fallback_remove_inactive"`
+
+	// MaxOpenReviews Лимит открытых ревью на ревьюера, 0 - без ограничения
+	MaxOpenReviews int `json:"max_open_reviews"`
+
+	// ReviewSla Длительность в формате Go, например 48h
+	ReviewSla string `json:"review_sla"`
+
+	// SkillWeight Вес совпадения навыков кандидата с тегами PR
+	SkillWeight float64                  `json:"skill_weight"`
+	Strategy    AssignmentPolicyStrategy `json:"strategy"`
+
+	// WorkloadWeight Вес количества открытых ревью в оценке кандидата
+	WorkloadWeight float64 `json:"workload_weight"`
+}
+
+// AssignmentPolicyStrategy defines model for AssignmentPolicy.Strategy.
+type AssignmentPolicyStrategy string
+
+// AssignmentPolicySnapshot defines model for AssignmentPolicySnapshot.
+type AssignmentPolicySnapshot struct {
+	Policy    AssignmentPolicy               `json:"policy"`
+	Source    AssignmentPolicySnapshotSource `json:"source"`
+	UpdatedAt time.Time                      `json:"updated_at"`
+	Version   int64                          `json:"version"`
+}
+
+// AssignmentPolicySnapshotSource defines model for AssignmentPolicySnapshot.Source.
+type AssignmentPolicySnapshotSource string
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	Action AuditEntryAction `json:"action"`
+
+	// Actor Идентичность клиента, выполнившего операцию
+	Actor         string                 `json:"actor"`
+	CreatedAt     time.Time              `json:"created_at"`
+	Details       map[string]interface{} `json:"details"`
+	Id            int64                  `json:"id"`
+	PullRequestId *string                `json:"pull_request_id,omitempty"`
+	UserId        *string                `json:"user_id,omitempty"`
+}
+
+// AuditEntryAction defines model for AuditEntry.Action.
+type AuditEntryAction string
+
+// AuthoredPullRequest defines model for AuthoredPullRequest.
+type AuthoredPullRequest struct {
+	CreatedAt       time.Time                     `json:"created_at"`
+	PullRequestId   string                        `json:"pull_request_id"`
+	PullRequestName string                        `json:"pull_request_name"`
+	Reviewers       []AuthoredPullRequestReviewer `json:"reviewers"`
+}
+
+// AuthoredPullRequestReviewer defines model for AuthoredPullRequestReviewer.
+type AuthoredPullRequestReviewer struct {
+	AssignedAt time.Time `json:"assigned_at"`
+	DueAt      time.Time `json:"due_at"`
+	IsActive   bool      `json:"is_active"`
+
+	// Overdue Срок ревью истек, а PR еще открыт
+	Overdue  bool                              `json:"overdue"`
+	Source   AuthoredPullRequestReviewerSource `json:"source"`
+	UserId   string                            `json:"user_id"`
+	Username string                            `json:"username"`
+}
+
+// AuthoredPullRequestReviewerSource defines model for AuthoredPullRequestReviewer.Source.
+type AuthoredPullRequestReviewerSource string
+
+// ComponentHealth defines model for ComponentHealth.
+type ComponentHealth struct {
+	Details *map[string]interface{} `json:"details,omitempty"`
+
+	// LatencyMs Время проверки компонента в миллисекундах
+	LatencyMs float64 `json:"latency_ms"`
+	Message   *string `json:"message,omitempty"`
+	Name      string  `json:"name"`
+
+	// Status ok - компонент работает нормально;
+	// degraded - компонент работает, но с проблемами (трафик принимается);
+	// down - компонент недоступен (трафик не принимается)
+	Status HealthStatus `json:"status"`
+}
+
+// EntityId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+type EntityId = string
+
+// EntityName Имя команды, пользователя или Pull Request, непустое и до 255 символов
+type EntityName = string
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
-	Error struct {
+	// Details Нарушения схемы запроса при коде VALIDATION_ERROR
+	Details *[]ValidationIssue `json:"details,omitempty"`
+	Error   struct {
 		Code    ErrorResponseErrorCode `json:"code"`
 		Message string                 `json:"message"`
 	} `json:"error"`
@@ -52,16 +335,77 @@ type ErrorResponse struct {
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// ExcludedCandidate defines model for ExcludedCandidate.
+type ExcludedCandidate struct {
+	Reason   ExcludedCandidateReason `json:"reason"`
+	UserId   string                  `json:"user_id"`
+	Username string                  `json:"username"`
+	Workload int                     `json:"workload"`
+}
+
+// ExcludedCandidateReason defines model for ExcludedCandidate.Reason.
+type ExcludedCandidateReason string
+
+// FairnessReport defines model for FairnessReport.
+type FairnessReport struct {
+	Teams     []TeamFairness `json:"teams"`
+	Tolerance float64        `json:"tolerance"`
+}
+
+// HealthReport defines model for HealthReport.
+type HealthReport struct {
+	Components []ComponentHealth `json:"components"`
+
+	// Status ok - компонент работает нормально;
+	// degraded - компонент работает, но с проблемами (трафик принимается);
+	// down - компонент недоступен (трафик не принимается)
+	Status    HealthStatus `json:"status"`
+	Timestamp time.Time    `json:"timestamp"`
+}
+
+// HealthStatus ok - компонент работает нормально;
+// degraded - компонент работает, но с проблемами (трафик принимается);
+// down - компонент недоступен (трафик не принимается)
+type HealthStatus string
+
+// PendingReview defines model for PendingReview.
+type PendingReview struct {
+	// AgeSeconds Сколько секунд прошло с создания PR
+	AgeSeconds int64              `json:"age_seconds"`
+	Assignment ReviewerAssignment `json:"assignment"`
+	AuthorId   string             `json:"author_id"`
+	CreatedAt  time.Time          `json:"created_at"`
+
+	// DueAt Срок ревью - момент назначения плюс review_sla политики
+	DueAt           time.Time `json:"due_at"`
+	Overdue         bool      `json:"overdue"`
+	PullRequestId   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+}
+
+// Percentiles Перцентили длительности в секундах
+type Percentiles struct {
+	P50Seconds int64 `json:"p50_seconds"`
+	P90Seconds int64 `json:"p90_seconds"`
+	P99Seconds int64 `json:"p99_seconds"`
+}
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
 	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	CreatedAt         *time.Time `json:"createdAt"`
+	MergedAt          *time.Time `json:"mergedAt"`
+	PullRequestId     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+
+	// RequiredTags Навыки, нужные для ревью PR
+	RequiredTags *[]string `json:"required_tags,omitempty"`
+
+	// ReviewerAssignments Почему был выбран каждый ревьювер
+	ReviewerAssignments *[]ReviewerAssignment `json:"reviewer_assignments,omitempty"`
+	Status              PullRequestStatus     `json:"status"`
 }
 
 // PullRequestStatus defines model for PullRequest.Status.
@@ -78,141 +422,1424 @@ type PullRequestShort struct {
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// PullRequestStats defines model for PullRequestStats.
+type PullRequestStats struct {
+	AuthorId        string                 `json:"author_id"`
+	CreatedAt       time.Time              `json:"created_at"`
+	MergedAt        *time.Time             `json:"merged_at,omitempty"`
+	PullRequestId   string                 `json:"pull_request_id"`
+	PullRequestName string                 `json:"pull_request_name"`
+	ReviewersCount  int64                  `json:"reviewers_count"`
+	Status          PullRequestStatsStatus `json:"status"`
+}
+
+// PullRequestStatsStatus defines model for PullRequestStats.Status.
+type PullRequestStatsStatus string
+
+// PullRequestStatsList defines model for PullRequestStatsList.
+type PullRequestStatsList struct {
+	Statistics []PullRequestStats `json:"statistics"`
+}
+
+// PullRequestTags defines model for PullRequestTags.
+type PullRequestTags struct {
+	// PullRequestId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	PullRequestId EntityId `json:"pull_request_id"`
+	RequiredTags  []string `json:"required_tags"`
+}
+
+// RankedCandidate defines model for RankedCandidate.
+type RankedCandidate struct {
+	Breakdown []ScoreComponent `json:"breakdown"`
+	Rank      int              `json:"rank"`
+	Score     float64          `json:"score"`
+
+	// Seniority Уровень ревьювера для наставничества
+	Seniority *Seniority `json:"seniority,omitempty"`
+	UserId    string     `json:"user_id"`
+	Username  string     `json:"username"`
+
+	// Workload Количество открытых ревью
+	Workload int `json:"workload"`
+}
+
+// RecentReviewStats defines model for RecentReviewStats.
+type RecentReviewStats struct {
+	From time.Time `json:"from"`
+
+	// ReviewsAssigned Назначения на ревью в окне
+	ReviewsAssigned int64 `json:"reviews_assigned"`
+
+	// ReviewsMerged Из них на PR, которые уже слиты
+	ReviewsMerged int64     `json:"reviews_merged"`
+	To            time.Time `json:"to"`
+}
+
+// ReviewCapacity defines model for ReviewCapacity.
+type ReviewCapacity struct {
+	// AtCapacity Лимит достигнут, автоназначение пропускает пользователя
+	AtCapacity bool `json:"at_capacity"`
+
+	// MaxOpenReviews Лимит политики, 0 - без ограничения
+	MaxOpenReviews int   `json:"max_open_reviews"`
+	OpenReviews    int64 `json:"open_reviews"`
+
+	// Remaining Сколько ревью еще можно назначить, отсутствует без лимита
+	Remaining *int64 `json:"remaining"`
+
+	// Unavailable Пользователь сейчас в периоде недоступности
+	Unavailable bool `json:"unavailable"`
+}
+
+// ReviewTimeBucket defines model for ReviewTimeBucket.
+type ReviewTimeBucket struct {
+	BucketStart time.Time `json:"bucket_start"`
+
+	// PrsMerged Из них уже слитые
+	PrsMerged int64 `json:"prs_merged"`
+
+	// PrsOpened PR, созданные в интервале
+	PrsOpened int64 `json:"prs_opened"`
+
+	// TimeToFirstAssignment Перцентили длительности в секундах
+	TimeToFirstAssignment *Percentiles `json:"time_to_first_assignment,omitempty"`
+
+	// TimeToMerge Перцентили длительности в секундах
+	TimeToMerge *Percentiles `json:"time_to_merge,omitempty"`
+}
+
+// ReviewTimeSeries defines model for ReviewTimeSeries.
+type ReviewTimeSeries struct {
+	// Bucket Шаг временного ряда. Интервалы выравниваются по началу дня, недели (понедельник)
+	// или месяца в UTC, поэтому первый интервал может начинаться раньше from.
+	// Диапазон содержит не больше 366 интервалов.
+	Bucket AnalyticsBucket    `json:"bucket"`
+	Series []ReviewTimeBucket `json:"series"`
+}
+
+// ReviewerAssignment defines model for ReviewerAssignment.
+type ReviewerAssignment struct {
+	AssignedAt time.Time `json:"assigned_at"`
+
+	// Source Каким путем ревьювер попал на PR
+	Source ReviewerAssignmentSource `json:"source"`
+
+	// Strategy Стратегия выбора, отсутствует при ручном назначении
+	Strategy *string `json:"strategy"`
+
+	// TieBreak Выбор решил порядок кандидатов: невыбранный кандидат имел ту же оценку.
+	// Отсутствует для назначений не по политике.
+	TieBreak *bool   `json:"tie_break"`
+	UserId   string  `json:"user_id"`
+	Username *string `json:"username,omitempty"`
+
+	// WorkloadAtAssignment Количество открытых ревью кандидата в момент назначения
+	WorkloadAtAssignment *int64 `json:"workload_at_assignment"`
+}
+
+// ReviewerAssignmentSource Каким путем ревьювер попал на PR
+type ReviewerAssignmentSource string
+
+// ReviewerFairness defines model for ReviewerFairness.
+type ReviewerFairness struct {
+	Assignments int64 `json:"assignments"`
+
+	// Deviation Отклонение от среднего по команде в назначениях
+	Deviation float64 `json:"deviation"`
+	IsActive  bool    `json:"is_active"`
+
+	// RelativeDeviation Отклонение в долях среднего по команде
+	RelativeDeviation float64                `json:"relative_deviation"`
+	Status            ReviewerFairnessStatus `json:"status"`
+
+	// TieBreakAssignments Назначения, решенные порядком среди кандидатов с равной оценкой
+	TieBreakAssignments int64  `json:"tie_break_assignments"`
+	UserId              string `json:"user_id"`
+	Username            string `json:"username"`
+}
+
+// ReviewerFairnessStatus defines model for ReviewerFairness.Status.
+type ReviewerFairnessStatus string
+
+// ReviewerPreview defines model for ReviewerPreview.
+type ReviewerPreview struct {
+	AuthorId string `json:"author_id"`
+
+	// Candidates Кандидаты в порядке выбора
+	Candidates []RankedCandidate   `json:"candidates"`
+	Excluded   []ExcludedCandidate `json:"excluded"`
+
+	// Mentorship Выбор учитывает пару senior и learner
+	Mentorship    bool  `json:"mentorship"`
+	PolicyVersion int64 `json:"policy_version"`
+
+	// RequiredTags Навыки, по которым оценивались кандидаты
+	RequiredTags  []string `json:"required_tags"`
+	ReviewerCount int      `json:"reviewer_count"`
+
+	// Selected Ревьюверы, которые были бы назначены
+	Selected []string `json:"selected"`
+	Strategy string   `json:"strategy"`
+	TeamName string   `json:"team_name"`
+}
+
+// ReviewerRule Постоянное правило назначения. block запрещает reviewer_id ревьюить PR автора
+// author_id или всех участников команды team_name; prefer повышает оценку
+// reviewer_id при выборе для PR автора author_id.
+type ReviewerRule struct {
+	AuthorId   *string            `json:"author_id,omitempty"`
+	CreatedAt  time.Time          `json:"created_at"`
+	CreatedBy  string             `json:"created_by"`
+	Effect     ReviewerRuleEffect `json:"effect"`
+	Id         int64              `json:"id"`
+	Reason     string             `json:"reason"`
+	ReviewerId string             `json:"reviewer_id"`
+	TeamName   *string            `json:"team_name,omitempty"`
+}
+
+// ReviewerRuleEffect defines model for ReviewerRule.Effect.
+type ReviewerRuleEffect string
+
+// ReviewerThroughput defines model for ReviewerThroughput.
+type ReviewerThroughput struct {
+	BucketStart     time.Time `json:"bucket_start"`
+	ReviewsAssigned int64     `json:"reviews_assigned"`
+
+	// ReviewsMerged Назначения на PR, которые уже слиты
+	ReviewsMerged int64 `json:"reviews_merged"`
+
+	// Share Доля ревьюера среди всех назначений интервала
+	Share    float64 `json:"share"`
+	TeamName string  `json:"team_name"`
+	UserId   string  `json:"user_id"`
+	Username string  `json:"username"`
+}
+
+// ReviewerThroughputSeries defines model for ReviewerThroughputSeries.
+type ReviewerThroughputSeries struct {
+	// Bucket Шаг временного ряда. Интервалы выравниваются по началу дня, недели (понедельник)
+	// или месяца в UTC, поэтому первый интервал может начинаться раньше from.
+	// Диапазон содержит не больше 366 интервалов.
+	Bucket AnalyticsBucket      `json:"bucket"`
+	Series []ReviewerThroughput `json:"series"`
+}
+
+// ScoreComponent defines model for ScoreComponent.
+type ScoreComponent struct {
+	// Name workload - минус количество открытых ревью с весом workload_weight (least_loaded),
+	// over_capacity - штраф за превышение лимита, random - случайная оценка (random),
+	// skill_match - средний уровень навыков по тегам PR с весом skill_weight,
+	// preferred - надбавка 1 за правило prefer для автора
+	Name  ScoreComponentName `json:"name"`
+	Value float64            `json:"value"`
+}
+
+// ScoreComponentName workload - минус количество открытых ревью с весом workload_weight (least_loaded),
+// over_capacity - штраф за превышение лимита, random - случайная оценка (random),
+// skill_match - средний уровень навыков по тегам PR с весом skill_weight,
+// preferred - надбавка 1 за правило prefer для автора
+type ScoreComponentName string
+
+// Seniority Уровень ревьювера для наставничества
+type Seniority string
+
 // Team defines model for Team.
 type Team struct {
-	Members  []TeamMember `json:"members"`
-	TeamName string       `json:"team_name"`
+	Members []TeamMember `json:"members"`
+
+	// MentorshipEnabled На каждый PR назначается senior и, если возможно, learner
+	MentorshipEnabled *bool `json:"mentorship_enabled,omitempty"`
+
+	// TeamName Имя команды, пользователя или Pull Request, непустое и до 255 символов
+	TeamName EntityName `json:"team_name"`
+}
+
+// TeamFairness defines model for TeamFairness.
+type TeamFairness struct {
+	// Gini Коэффициент Джини назначений, 0 - поровну, 1 - все у одного
+	Gini float64 `json:"gini"`
+
+	// GiniWithoutTieBreaks Коэффициент Джини без назначений, решенных равенством оценок
+	GiniWithoutTieBreaks float64 `json:"gini_without_tie_breaks"`
+
+	// MaxMinRatio Отношение наибольшего числа назначений к наименьшему, отсутствует, если у кого-то их нет
+	MaxMinRatio *float64           `json:"max_min_ratio"`
+	Mean        float64            `json:"mean"`
+	Members     []ReviewerFairness `json:"members"`
+
+	// PolicyAssignments Назначения, выбранные политикой после появления учета равенства оценок
+	PolicyAssignments int64 `json:"policy_assignments"`
+
+	// Reviewers Активные участники и неактивные, получившие назначения в окне
+	Reviewers           int    `json:"reviewers"`
+	TeamName            string `json:"team_name"`
+	TieBreakAssignments int64  `json:"tie_break_assignments"`
+
+	// TieBreakShare Доля решенных равенством оценок среди назначений по политике
+	TieBreakShare    float64 `json:"tie_break_share"`
+	TotalAssignments int64   `json:"total_assignments"`
 }
 
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
-	IsActive *bool  `json:"is_active,omitempty"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	IsActive *bool `json:"is_active,omitempty"`
+
+	// Seniority Уровень ревьювера для наставничества
+	Seniority *Seniority `json:"seniority,omitempty"`
+
+	// UserId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	UserId EntityId `json:"user_id"`
+
+	// Username Имя команды, пользователя или Pull Request, непустое и до 255 символов
+	Username EntityName `json:"username"`
+}
+
+// TeamStats defines model for TeamStats.
+type TeamStats struct {
+	ActiveMembers int64 `json:"active_members"`
+
+	// PrsAuthored PR участников команды, созданные в окне
+	PrsAuthored int64 `json:"prs_authored"`
+
+	// PrsMerged Из них слитые
+	PrsMerged int64 `json:"prs_merged"`
+
+	// PrsReviewed Различные PR, на которые участники команды назначены в окне
+	PrsReviewed int64 `json:"prs_reviewed"`
+
+	// ReviewAssignments Все назначения участников команды, сделанные в окне
+	ReviewAssignments int64  `json:"review_assignments"`
+	TeamName          string `json:"team_name"`
+	TotalMembers      int64  `json:"total_members"`
+}
+
+// TeamStatsList defines model for TeamStatsList.
+type TeamStatsList struct {
+	Statistics []TeamStats `json:"statistics"`
+}
+
+// TeamThroughput defines model for TeamThroughput.
+type TeamThroughput struct {
+	BucketStart time.Time `json:"bucket_start"`
+	PrsMerged   int64     `json:"prs_merged"`
+
+	// PrsMergedCumulative Слитые PR с начала диапазона по этот интервал включительно
+	PrsMergedCumulative int64  `json:"prs_merged_cumulative"`
+	PrsOpened           int64  `json:"prs_opened"`
+	TeamName            string `json:"team_name"`
+}
+
+// TeamThroughputSeries defines model for TeamThroughputSeries.
+type TeamThroughputSeries struct {
+	// Bucket Шаг временного ряда. Интервалы выравниваются по началу дня, недели (понедельник)
+	// или месяца в UTC, поэтому первый интервал может начинаться раньше from.
+	// Диапазон содержит не больше 366 интервалов.
+	Bucket AnalyticsBucket  `json:"bucket"`
+	Series []TeamThroughput `json:"series"`
+}
+
+// Unavailability Период [starts_at, ends_at), когда пользователь не может ревьюить.
+// Показывается в сводке и не влияет на автоназначение.
+type Unavailability struct {
+	EndsAt   time.Time `json:"ends_at"`
+	Id       int64     `json:"id"`
+	Reason   string    `json:"reason"`
+	StartsAt time.Time `json:"starts_at"`
+	UserId   string    `json:"user_id"`
 }
 
 // User defines model for User.
 type User struct {
-	IsActive bool   `json:"is_active"`
-	TeamName string `json:"team_name"`
+	IsActive bool `json:"is_active"`
+
+	// Seniority Уровень ревьювера для наставничества
+	Seniority *Seniority `json:"seniority,omitempty"`
+	TeamName  string     `json:"team_name"`
+	UserId    string     `json:"user_id"`
+	Username  string     `json:"username"`
+}
+
+// UserDashboard defines model for UserDashboard.
+type UserDashboard struct {
+	// AuthoredPullRequests Открытые PR пользователя
+	AuthoredPullRequests []AuthoredPullRequest `json:"authored_pull_requests"`
+	Capacity             ReviewCapacity        `json:"capacity"`
+
+	// PendingReviews Открытые PR, ожидающие ревью пользователя, самые старые первыми
+	PendingReviews []PendingReview   `json:"pending_reviews"`
+	RecentStats    RecentReviewStats `json:"recent_stats"`
+
+	// Unavailability Текущие и будущие периоды недоступности
+	Unavailability []Unavailability `json:"unavailability"`
+	User           User             `json:"user"`
+}
+
+// UserSkill defines model for UserSkill.
+type UserSkill struct {
+	Level UserSkillLevel `json:"level"`
+
+	// Tag Тег навыка в нижнем регистре, например go или frontend/react
+	Tag string `json:"tag"`
+}
+
+// UserSkillLevel defines model for UserSkill.Level.
+type UserSkillLevel string
+
+// UserSkills defines model for UserSkills.
+type UserSkills struct {
+	Skills []UserSkill `json:"skills"`
+
+	// UserId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	UserId EntityId `json:"user_id"`
+}
+
+// ValidationIssue defines model for ValidationIssue.
+type ValidationIssue struct {
+	// Field Имя параметра или JSON Pointer поля тела, например /members/0/user_id
+	Field    string                  `json:"field"`
+	Location ValidationIssueLocation `json:"location"`
+	Message  string                  `json:"message"`
+}
+
+// ValidationIssueLocation defines model for ValidationIssue.Location.
+type ValidationIssueLocation string
+
+// WorkloadDrift defines model for WorkloadDrift.
+type WorkloadDrift struct {
+	// Actual Фактическое количество открытых PR, где пользователь назначен ревьюером
+	Actual int64 `json:"actual"`
+
+	// Recorded Учтенное количество открытых ревью до исправления
+	Recorded int64  `json:"recorded"`
 	UserId   string `json:"user_id"`
-	Username string `json:"username"`
 }
 
-// TeamNameQuery defines model for TeamNameQuery.
-type TeamNameQuery = string
+// WorkloadReconcileResult defines model for WorkloadReconcileResult.
+type WorkloadReconcileResult struct {
+	// Drift Исправленные расхождения, пустой массив - расхождений не было
+	Drift []WorkloadDrift `json:"drift"`
+}
 
-// UserIdQuery defines model for UserIdQuery.
-type UserIdQuery = string
+// BucketQuery Шаг временного ряда. Интервалы выравниваются по началу дня, недели (понедельник)
+// или месяца в UTC, поэтому первый интервал может начинаться раньше from.
+// Диапазон содержит не больше 366 интервалов.
+type BucketQuery = AnalyticsBucket
 
-// PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
-type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
-	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
+// PullRequestIdQuery Идентификатор пользователя или Pull Request, непустой и до 255 символов
+type PullRequestIdQuery = EntityId
+
+// RangeFromQuery defines model for RangeFromQuery.
+type RangeFromQuery = time.Time
+
+// RangeToQuery defines model for RangeToQuery.
+type RangeToQuery = time.Time
+
+// TeamNameQuery Имя команды, пользователя или Pull Request, непустое и до 255 символов
+type TeamNameQuery = EntityName
+
+// UserIdQuery Идентификатор пользователя или Pull Request, непустой и до 255 символов
+type UserIdQuery = EntityId
+
+// WindowFromQuery defines model for WindowFromQuery.
+type WindowFromQuery = time.Time
+
+// WindowToQuery defines model for WindowToQuery.
+type WindowToQuery = time.Time
+
+// Forbidden defines model for Forbidden.
+type Forbidden = ErrorResponse
+
+// PayloadTooLarge defines model for PayloadTooLarge.
+type PayloadTooLarge = ErrorResponse
+
+// TooManyRequests defines model for TooManyRequests.
+type TooManyRequests = ErrorResponse
+
+// Unauthorized defines model for Unauthorized.
+type Unauthorized = ErrorResponse
+
+// GetAnalyticsReviewTimesParams defines parameters for GetAnalyticsReviewTimes.
+type GetAnalyticsReviewTimesParams struct {
+	// From Начало диапазона включительно (RFC 3339)
+	From RangeFromQuery `form:"from" json:"from"`
+
+	// To Конец диапазона, не включается (RFC 3339)
+	To RangeToQuery `form:"to" json:"to"`
+
+	// Bucket Шаг временного ряда, по умолчанию week
+	Bucket *BucketQuery `form:"bucket,omitempty" json:"bucket,omitempty"`
 }
 
-// PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
-type PostPullRequestMergeJSONBody struct {
-	PullRequestId string `json:"pull_request_id"`
+// GetAnalyticsReviewerThroughputParams defines parameters for GetAnalyticsReviewerThroughput.
+type GetAnalyticsReviewerThroughputParams struct {
+	// From Начало диапазона включительно (RFC 3339)
+	From RangeFromQuery `form:"from" json:"from"`
+
+	// To Конец диапазона, не включается (RFC 3339)
+	To RangeToQuery `form:"to" json:"to"`
+
+	// Bucket Шаг временного ряда, по умолчанию week
+	Bucket *BucketQuery `form:"bucket,omitempty" json:"bucket,omitempty"`
 }
 
-// PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
-type PostPullRequestReassignJSONBody struct {
-	OldUserId     string `json:"old_user_id"`
-	PullRequestId string `json:"pull_request_id"`
+// GetAnalyticsTeamThroughputParams defines parameters for GetAnalyticsTeamThroughput.
+type GetAnalyticsTeamThroughputParams struct {
+	// From Начало диапазона включительно (RFC 3339)
+	From RangeFromQuery `form:"from" json:"from"`
+
+	// To Конец диапазона, не включается (RFC 3339)
+	To RangeToQuery `form:"to" json:"to"`
+
+	// Bucket Шаг временного ряда, по умолчанию week
+	Bucket *BucketQuery `form:"bucket,omitempty" json:"bucket,omitempty"`
 }
 
-// PostTeamDeactivateJSONBody defines parameters for PostTeamDeactivate.
-type PostTeamDeactivateJSONBody struct {
-	// TeamName Имя команды для деактивации
-	TeamName string `json:"team_name"`
+// GetAuditListParams defines parameters for GetAuditList.
+type GetAuditListParams struct {
+	PullRequestId *string `form:"pull_request_id,omitempty" json:"pull_request_id,omitempty"`
+	Limit         *int    `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
-// GetTeamGetParams defines parameters for GetTeamGet.
-type GetTeamGetParams struct {
-	// TeamName Уникальное имя команды
-	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+// GetEventsStreamParams defines parameters for GetEventsStream.
+type GetEventsStreamParams struct {
+	UserId        *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+	TeamName      *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+	PullRequestId *string `form:"pull_request_id,omitempty" json:"pull_request_id,omitempty"`
+
+	// LastEventID id последнего полученного события
+	LastEventID *int64 `json:"Last-Event-ID,omitempty"`
 }
 
-// GetUsersGetReviewParams defines parameters for GetUsersGetReview.
-type GetUsersGetReviewParams struct {
-	// UserId Идентификатор пользователя
-	UserId UserIdQuery `form:"user_id" json:"user_id"`
+// PostPullRequestAssignJSONBody defines parameters for PostPullRequestAssign.
+type PostPullRequestAssignJSONBody struct {
+	Override       *bool   `json:"override,omitempty"`
+	OverrideReason *string `json:"override_reason,omitempty"`
+
+	// PullRequestId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	PullRequestId EntityId `json:"pull_request_id"`
+
+	// UserId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	UserId EntityId `json:"user_id"`
 }
 
-// PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
-type PostUsersSetIsActiveJSONBody struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
+// PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
+type PostPullRequestCreateJSONBody struct {
+	// AuthorId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	AuthorId EntityId `json:"author_id"`
+
+	// ExcludedReviewers Пользователи, которых нельзя назначать
+	ExcludedReviewers *[]EntityId `json:"excluded_reviewers,omitempty"`
+
+	// PreferredReviewers Активные участники команды автора, назначаются первыми
+	PreferredReviewers *[]EntityId `json:"preferred_reviewers,omitempty"`
+
+	// PullRequestId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	PullRequestId EntityId `json:"pull_request_id"`
+
+	// PullRequestName Имя команды, пользователя или Pull Request, непустое и до 255 символов
+	PullRequestName EntityName `json:"pull_request_name"`
+
+	// RequiredTags Навыки, нужные для ревью; кандидаты с ними получают более высокую оценку
+	RequiredTags *[]string `json:"required_tags,omitempty"`
+
+	// ReviewerCount Общее количество ревьюверов, по умолчанию - из политики назначения
+	ReviewerCount *int `json:"reviewer_count,omitempty"`
+}
+
+// PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
+type PostPullRequestMergeJSONBody struct {
+	// PullRequestId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	PullRequestId EntityId `json:"pull_request_id"`
+}
+
+// PostPullRequestPreviewReviewersJSONBody defines parameters for PostPullRequestPreviewReviewers.
+type PostPullRequestPreviewReviewersJSONBody struct {
+	// AuthorId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	AuthorId          EntityId    `json:"author_id"`
+	ExcludedReviewers *[]EntityId `json:"excluded_reviewers,omitempty"`
+
+	// PullRequestId Существующий PR, чьи ревьюверы учитываются как уже назначенные
+	PullRequestId *string `json:"pull_request_id,omitempty"`
+
+	// RequiredTags Навыки, нужные для ревью. По умолчанию - теги pull_request_id
+	RequiredTags  *[]string `json:"required_tags,omitempty"`
+	ReviewerCount *int      `json:"reviewer_count,omitempty"`
+}
+
+// PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
+type PostPullRequestReassignJSONBody struct {
+	// NewUserId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	NewUserId *EntityId `json:"new_user_id,omitempty"`
+
+	// OldUserId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	OldUserId      EntityId `json:"old_user_id"`
+	Override       *bool    `json:"override,omitempty"`
+	OverrideReason *string  `json:"override_reason,omitempty"`
+
+	// PullRequestId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	PullRequestId EntityId `json:"pull_request_id"`
+}
+
+// GetPullRequestRequiredTagsParams defines parameters for GetPullRequestRequiredTags.
+type GetPullRequestRequiredTagsParams struct {
+	// PullRequestId Идентификатор Pull Request
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
+}
+
+// GetPullRequestReviewersParams defines parameters for GetPullRequestReviewers.
+type GetPullRequestReviewersParams struct {
+	// PullRequestId Идентификатор Pull Request
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
+}
+
+// PostRulesAddJSONBody defines parameters for PostRulesAdd.
+type PostRulesAddJSONBody struct {
+	// AuthorId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	AuthorId *EntityId                  `json:"author_id,omitempty"`
+	Effect   PostRulesAddJSONBodyEffect `json:"effect"`
+	Reason   *string                    `json:"reason,omitempty"`
+
+	// ReviewerId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	ReviewerId EntityId `json:"reviewer_id"`
+
+	// TeamName Имя команды, пользователя или Pull Request, непустое и до 255 символов
+	TeamName *EntityName `json:"team_name,omitempty"`
+}
+
+// PostRulesAddJSONBodyEffect defines parameters for PostRulesAdd.
+type PostRulesAddJSONBodyEffect string
+
+// PostRulesDeleteJSONBody defines parameters for PostRulesDelete.
+type PostRulesDeleteJSONBody struct {
+	RuleId int64 `json:"rule_id"`
+}
+
+// GetRulesListParams defines parameters for GetRulesList.
+type GetRulesListParams struct {
+	// UserId Только правила, где пользователь является ревьювером или автором
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// GetStatisticsFairnessParams defines parameters for GetStatisticsFairness.
+type GetStatisticsFairnessParams struct {
+	// TeamName Только указанная команда
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// From Начало окна статистики включительно (RFC 3339)
+	From *WindowFromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна статистики, не включается (RFC 3339)
+	To        *WindowToQuery `form:"to,omitempty" json:"to,omitempty"`
+	Tolerance *float64       `form:"tolerance,omitempty" json:"tolerance,omitempty"`
+}
+
+// GetStatisticsPullRequestsParams defines parameters for GetStatisticsPullRequests.
+type GetStatisticsPullRequestsParams struct {
+	// From Начало окна статистики включительно (RFC 3339)
+	From *WindowFromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна статистики, не включается (RFC 3339)
+	To *WindowToQuery `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetStatisticsTeamsParams defines parameters for GetStatisticsTeams.
+type GetStatisticsTeamsParams struct {
+	// From Начало окна статистики включительно (RFC 3339)
+	From *WindowFromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна статистики, не включается (RFC 3339)
+	To *WindowToQuery `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// PostTeamDeactivateJSONBody defines parameters for PostTeamDeactivate.
+type PostTeamDeactivateJSONBody struct {
+	// TeamName Имя команды, пользователя или Pull Request, непустое и до 255 символов
+	TeamName EntityName `json:"team_name"`
+}
+
+// GetTeamGetParams defines parameters for GetTeamGet.
+type GetTeamGetParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostTeamSetMentorshipJSONBody defines parameters for PostTeamSetMentorship.
+type PostTeamSetMentorshipJSONBody struct {
+	Enabled bool `json:"enabled"`
+
+	// TeamName Имя команды, пользователя или Pull Request, непустое и до 255 символов
+	TeamName EntityName `json:"team_name"`
+}
+
+// PostUsersAddUnavailabilityJSONBody defines parameters for PostUsersAddUnavailability.
+type PostUsersAddUnavailabilityJSONBody struct {
+	EndsAt   time.Time `json:"ends_at"`
+	Reason   *string   `json:"reason,omitempty"`
+	StartsAt time.Time `json:"starts_at"`
+
+	// UserId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	UserId EntityId `json:"user_id"`
+}
+
+// GetUsersDashboardParams defines parameters for GetUsersDashboard.
+type GetUsersDashboardParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// PostUsersDeleteUnavailabilityJSONBody defines parameters for PostUsersDeleteUnavailability.
+type PostUsersDeleteUnavailabilityJSONBody struct {
+	Id int64 `json:"id"`
+}
+
+// GetUsersGetReviewParams defines parameters for GetUsersGetReview.
+type GetUsersGetReviewParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
+type PostUsersSetIsActiveJSONBody struct {
+	IsActive bool `json:"is_active"`
+
+	// UserId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	UserId EntityId `json:"user_id"`
+}
+
+// PostUsersSetSeniorityJSONBody defines parameters for PostUsersSetSeniority.
+type PostUsersSetSeniorityJSONBody struct {
+	// Seniority Уровень ревьювера для наставничества
+	Seniority Seniority `json:"seniority"`
+
+	// UserId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	UserId EntityId `json:"user_id"`
+}
+
+// GetUsersSkillsParams defines parameters for GetUsersSkills.
+type GetUsersSkillsParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// PutAdminPolicyJSONRequestBody defines body for PutAdminPolicy for application/json ContentType.
+type PutAdminPolicyJSONRequestBody = AssignmentPolicy
+
+// PostPullRequestAssignJSONRequestBody defines body for PostPullRequestAssign for application/json ContentType.
+type PostPullRequestAssignJSONRequestBody PostPullRequestAssignJSONBody
+
+// PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
+type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
+
+// PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
+type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
+
+// PostPullRequestPreviewReviewersJSONRequestBody defines body for PostPullRequestPreviewReviewers for application/json ContentType.
+type PostPullRequestPreviewReviewersJSONRequestBody PostPullRequestPreviewReviewersJSONBody
+
+// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
+type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
+
+// PostPullRequestSetRequiredTagsJSONRequestBody defines body for PostPullRequestSetRequiredTags for application/json ContentType.
+type PostPullRequestSetRequiredTagsJSONRequestBody = PullRequestTags
+
+// PostRulesAddJSONRequestBody defines body for PostRulesAdd for application/json ContentType.
+type PostRulesAddJSONRequestBody PostRulesAddJSONBody
+
+// PostRulesDeleteJSONRequestBody defines body for PostRulesDelete for application/json ContentType.
+type PostRulesDeleteJSONRequestBody PostRulesDeleteJSONBody
+
+// PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
+type PostTeamAddJSONRequestBody = Team
+
+// PostTeamDeactivateJSONRequestBody defines body for PostTeamDeactivate for application/json ContentType.
+type PostTeamDeactivateJSONRequestBody PostTeamDeactivateJSONBody
+
+// PostTeamSetMentorshipJSONRequestBody defines body for PostTeamSetMentorship for application/json ContentType.
+type PostTeamSetMentorshipJSONRequestBody PostTeamSetMentorshipJSONBody
+
+// PostUsersAddUnavailabilityJSONRequestBody defines body for PostUsersAddUnavailability for application/json ContentType.
+type PostUsersAddUnavailabilityJSONRequestBody PostUsersAddUnavailabilityJSONBody
+
+// PostUsersDeleteUnavailabilityJSONRequestBody defines body for PostUsersDeleteUnavailability for application/json ContentType.
+type PostUsersDeleteUnavailabilityJSONRequestBody PostUsersDeleteUnavailabilityJSONBody
+
+// PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
+type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+
+// PostUsersSetSeniorityJSONRequestBody defines body for PostUsersSetSeniority for application/json ContentType.
+type PostUsersSetSeniorityJSONRequestBody PostUsersSetSeniorityJSONBody
+
+// PostUsersSetSkillsJSONRequestBody defines body for PostUsersSetSkills for application/json ContentType.
+type PostUsersSetSkillsJSONRequestBody = UserSkills
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Получить текущую политику назначения
+	// (GET /admin/policy)
+	GetAdminPolicy(c *gin.Context)
+	// Заменить политику назначения
+	// (PUT /admin/policy)
+	PutAdminPolicy(c *gin.Context)
+	// Сверить нагрузку ревьюеров
+	// (POST /admin/workload/reconcile)
+	PostAdminWorkloadReconcile(c *gin.Context)
+	// Перцентили времени ревью по интервалам
+	// (GET /analytics/reviewTimes)
+	GetAnalyticsReviewTimes(c *gin.Context, params GetAnalyticsReviewTimesParams)
+	// Назначения ревьюеров по интервалам
+	// (GET /analytics/reviewerThroughput)
+	GetAnalyticsReviewerThroughput(c *gin.Context, params GetAnalyticsReviewerThroughputParams)
+	// Пропускная способность команд по интервалам
+	// (GET /analytics/teamThroughput)
+	GetAnalyticsTeamThroughput(c *gin.Context, params GetAnalyticsTeamThroughputParams)
+	// Журнал аудита правил назначения
+	// (GET /audit/list)
+	GetAuditList(c *gin.Context, params GetAuditListParams)
+	// Поток событий назначения (Server-Sent Events)
+	// (GET /events/stream)
+	GetEventsStream(c *gin.Context, params GetEventsStreamParams)
+	// Health check endpoint
+	// (GET /health)
+	GetHealth(c *gin.Context)
+	// Liveness probe
+	// (GET /health/live)
+	GetHealthLive(c *gin.Context)
+	// Readiness probe
+	// (GET /health/ready)
+	GetHealthReady(c *gin.Context)
+	// Вручную назначить ревьювера
+	// (POST /pullRequest/assign)
+	PostPullRequestAssign(c *gin.Context)
+	// Создать PR и автоматически назначить ревьюверов из команды автора
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(c *gin.Context)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	PostPullRequestMerge(c *gin.Context)
+	// Предпросмотр назначения ревьюверов без записи
+	// (POST /pullRequest/previewReviewers)
+	PostPullRequestPreviewReviewers(c *gin.Context)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	PostPullRequestReassign(c *gin.Context)
+	// Получить навыки, нужные для ревью PR
+	// (GET /pullRequest/requiredTags)
+	GetPullRequestRequiredTags(c *gin.Context, params GetPullRequestRequiredTagsParams)
+	// Получить ревьюверов PR с причинами назначения
+	// (GET /pullRequest/reviewers)
+	GetPullRequestReviewers(c *gin.Context, params GetPullRequestReviewersParams)
+	// Заменить навыки, нужные для ревью PR
+	// (POST /pullRequest/setRequiredTags)
+	PostPullRequestSetRequiredTags(c *gin.Context)
+	// Создать правило назначения
+	// (POST /rules/add)
+	PostRulesAdd(c *gin.Context)
+	// Удалить правило назначения
+	// (POST /rules/delete)
+	PostRulesDelete(c *gin.Context)
+	// Получить правила назначения
+	// (GET /rules/list)
+	GetRulesList(c *gin.Context, params GetRulesListParams)
+	// Получить статистику назначений по пользователям
+	// (GET /statistics/assignments)
+	GetStatisticsAssignments(c *gin.Context)
+	// Справедливость распределения ревью
+	// (GET /statistics/fairness)
+	GetStatisticsFairness(c *gin.Context, params GetStatisticsFairnessParams)
+	// Получить статистику по Pull Request'ам
+	// (GET /statistics/pullRequests)
+	GetStatisticsPullRequests(c *gin.Context, params GetStatisticsPullRequestsParams)
+	// Получить статистику по командам
+	// (GET /statistics/teams)
+	GetStatisticsTeams(c *gin.Context, params GetStatisticsTeamsParams)
+	// Получить рабочую нагрузку активных пользователей
+	// (GET /statistics/workload)
+	GetStatisticsWorkload(c *gin.Context)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	PostTeamAdd(c *gin.Context)
+	// Массовая деактивация пользователей команды с переназначением PR
+	// (POST /team/deactivate)
+	PostTeamDeactivate(c *gin.Context)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeamGet(c *gin.Context, params GetTeamGetParams)
+	// Включить или выключить наставничество в команде
+	// (POST /team/setMentorship)
+	PostTeamSetMentorship(c *gin.Context)
+	// Добавить период недоступности пользователя
+	// (POST /users/addUnavailability)
+	PostUsersAddUnavailability(c *gin.Context)
+	// Персональная сводка ревьюера
+	// (GET /users/dashboard)
+	GetUsersDashboard(c *gin.Context, params GetUsersDashboardParams)
+	// Удалить период недоступности
+	// (POST /users/deleteUnavailability)
+	PostUsersDeleteUnavailability(c *gin.Context)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUsersGetReview(c *gin.Context, params GetUsersGetReviewParams)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	PostUsersSetIsActive(c *gin.Context)
+	// Установить уровень пользователя для наставничества
+	// (POST /users/setSeniority)
+	PostUsersSetSeniority(c *gin.Context)
+	// Заменить навыки пользователя
+	// (POST /users/setSkills)
+	PostUsersSetSkills(c *gin.Context)
+	// Получить навыки пользователя
+	// (GET /users/skills)
+	GetUsersSkills(c *gin.Context, params GetUsersSkillsParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandler       func(*gin.Context, error, int)
+}
+
+type MiddlewareFunc func(c *gin.Context)
+
+// GetAdminPolicy operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminPolicy(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAdminPolicy(c)
+}
+
+// PutAdminPolicy operation middleware
+func (siw *ServerInterfaceWrapper) PutAdminPolicy(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PutAdminPolicy(c)
+}
+
+// PostAdminWorkloadReconcile operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminWorkloadReconcile(c *gin.Context) {
+
+	c.Set(AdminTokenScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostAdminWorkloadReconcile(c)
+}
+
+// GetAnalyticsReviewTimes operation middleware
+func (siw *ServerInterfaceWrapper) GetAnalyticsReviewTimes(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAnalyticsReviewTimesParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument from is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := c.Query("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument to is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "bucket" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bucket", c.Request.URL.Query(), &params.Bucket)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter bucket: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAnalyticsReviewTimes(c, params)
+}
+
+// GetAnalyticsReviewerThroughput operation middleware
+func (siw *ServerInterfaceWrapper) GetAnalyticsReviewerThroughput(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAnalyticsReviewerThroughputParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument from is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := c.Query("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument to is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "bucket" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bucket", c.Request.URL.Query(), &params.Bucket)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter bucket: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAnalyticsReviewerThroughput(c, params)
+}
+
+// GetAnalyticsTeamThroughput operation middleware
+func (siw *ServerInterfaceWrapper) GetAnalyticsTeamThroughput(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAnalyticsTeamThroughputParams
+
+	// ------------- Required query parameter "from" -------------
+
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument from is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Required query parameter "to" -------------
+
+	if paramValue := c.Query("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument to is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "bucket" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "bucket", c.Request.URL.Query(), &params.Bucket)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter bucket: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAnalyticsTeamThroughput(c, params)
+}
+
+// GetAuditList operation middleware
+func (siw *ServerInterfaceWrapper) GetAuditList(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditListParams
+
+	// ------------- Optional query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pull_request_id", c.Request.URL.Query(), &params.PullRequestId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pull_request_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetAuditList(c, params)
+}
+
+// GetEventsStream operation middleware
+func (siw *ServerInterfaceWrapper) GetEventsStream(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEventsStreamParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pull_request_id", c.Request.URL.Query(), &params.PullRequestId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pull_request_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "Last-Event-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("Last-Event-ID")]; found {
+		var LastEventID int64
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for Last-Event-ID, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "Last-Event-ID", valueList[0], &LastEventID, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter Last-Event-ID: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.LastEventID = &LastEventID
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetEventsStream(c, params)
+}
+
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetHealth(c)
+}
+
+// GetHealthLive operation middleware
+func (siw *ServerInterfaceWrapper) GetHealthLive(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetHealthLive(c)
+}
+
+// GetHealthReady operation middleware
+func (siw *ServerInterfaceWrapper) GetHealthReady(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetHealthReady(c)
+}
+
+// PostPullRequestAssign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestAssign(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestAssign(c)
+}
+
+// PostPullRequestCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestCreate(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestCreate(c)
+}
+
+// PostPullRequestMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestMerge(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestMerge(c)
+}
+
+// PostPullRequestPreviewReviewers operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestPreviewReviewers(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestPreviewReviewers(c)
+}
+
+// PostPullRequestReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReassign(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestReassign(c)
+}
+
+// GetPullRequestRequiredTags operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestRequiredTags(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestRequiredTagsParams
+
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	if paramValue := c.Query("pull_request_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument pull_request_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", c.Request.URL.Query(), &params.PullRequestId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pull_request_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPullRequestRequiredTags(c, params)
+}
+
+// GetPullRequestReviewers operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestReviewers(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestReviewersParams
+
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	if paramValue := c.Query("pull_request_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument pull_request_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", c.Request.URL.Query(), &params.PullRequestId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pull_request_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetPullRequestReviewers(c, params)
+}
+
+// PostPullRequestSetRequiredTags operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestSetRequiredTags(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestSetRequiredTags(c)
 }
 
-// PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
-type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
+// PostRulesAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostRulesAdd(c *gin.Context) {
 
-// PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
-type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
+	c.Set(AdminTokenScopes, []string{})
 
-// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
-type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-// PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
-type PostTeamAddJSONRequestBody = Team
+	siw.Handler.PostRulesAdd(c)
+}
 
-// PostTeamDeactivateJSONRequestBody defines body for PostTeamDeactivate for application/json ContentType.
-type PostTeamDeactivateJSONRequestBody PostTeamDeactivateJSONBody
+// PostRulesDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostRulesDelete(c *gin.Context) {
 
-// PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
-type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+	c.Set(AdminTokenScopes, []string{})
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Health check endpoint
-	// (GET /health)
-	GetHealth(c *gin.Context)
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-	// (POST /pullRequest/create)
-	PostPullRequestCreate(c *gin.Context)
-	// Пометить PR как MERGED (идемпотентная операция)
-	// (POST /pullRequest/merge)
-	PostPullRequestMerge(c *gin.Context)
-	// Переназначить конкретного ревьювера на другого из его команды
-	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(c *gin.Context)
-	// Получить статистику назначений по пользователям
-	// (GET /statistics/assignments)
-	GetStatisticsAssignments(c *gin.Context)
-	// Получить рабочую нагрузку активных пользователей
-	// (GET /statistics/workload)
-	GetStatisticsWorkload(c *gin.Context)
-	// Создать команду с участниками (создаёт/обновляет пользователей)
-	// (POST /team/add)
-	PostTeamAdd(c *gin.Context)
-	// Массовая деактивация пользователей команды с переназначением PR
-	// (POST /team/deactivate)
-	PostTeamDeactivate(c *gin.Context)
-	// Получить команду с участниками
-	// (GET /team/get)
-	GetTeamGet(c *gin.Context, params GetTeamGetParams)
-	// Получить PR'ы, где пользователь назначен ревьювером
-	// (GET /users/getReview)
-	GetUsersGetReview(c *gin.Context, params GetUsersGetReviewParams)
-	// Установить флаг активности пользователя
-	// (POST /users/setIsActive)
-	PostUsersSetIsActive(c *gin.Context)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandler       func(*gin.Context, error, int)
+	siw.Handler.PostRulesDelete(c)
 }
 
-type MiddlewareFunc func(c *gin.Context)
+// GetRulesList operation middleware
+func (siw *ServerInterfaceWrapper) GetRulesList(c *gin.Context) {
 
-// GetHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetHealth(c *gin.Context) {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRulesListParams
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -221,11 +1848,11 @@ func (siw *ServerInterfaceWrapper) GetHealth(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetHealth(c)
+	siw.Handler.GetRulesList(c, params)
 }
 
-// PostPullRequestCreate operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestCreate(c *gin.Context) {
+// GetStatisticsAssignments operation middleware
+func (siw *ServerInterfaceWrapper) GetStatisticsAssignments(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -234,11 +1861,48 @@ func (siw *ServerInterfaceWrapper) PostPullRequestCreate(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostPullRequestCreate(c)
+	siw.Handler.GetStatisticsAssignments(c)
 }
 
-// PostPullRequestMerge operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestMerge(c *gin.Context) {
+// GetStatisticsFairness operation middleware
+func (siw *ServerInterfaceWrapper) GetStatisticsFairness(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatisticsFairnessParams
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tolerance" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tolerance", c.Request.URL.Query(), &params.Tolerance)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tolerance: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -247,11 +1911,32 @@ func (siw *ServerInterfaceWrapper) PostPullRequestMerge(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostPullRequestMerge(c)
+	siw.Handler.GetStatisticsFairness(c, params)
 }
 
-// PostPullRequestReassign operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestReassign(c *gin.Context) {
+// GetStatisticsPullRequests operation middleware
+func (siw *ServerInterfaceWrapper) GetStatisticsPullRequests(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatisticsPullRequestsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -260,11 +1945,32 @@ func (siw *ServerInterfaceWrapper) PostPullRequestReassign(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostPullRequestReassign(c)
+	siw.Handler.GetStatisticsPullRequests(c, params)
 }
 
-// GetStatisticsAssignments operation middleware
-func (siw *ServerInterfaceWrapper) GetStatisticsAssignments(c *gin.Context) {
+// GetStatisticsTeams operation middleware
+func (siw *ServerInterfaceWrapper) GetStatisticsTeams(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatisticsTeamsParams
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", c.Request.URL.Query(), &params.From)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", c.Request.URL.Query(), &params.To)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -273,7 +1979,7 @@ func (siw *ServerInterfaceWrapper) GetStatisticsAssignments(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetStatisticsAssignments(c)
+	siw.Handler.GetStatisticsTeams(c, params)
 }
 
 // GetStatisticsWorkload operation middleware
@@ -348,6 +2054,78 @@ func (siw *ServerInterfaceWrapper) GetTeamGet(c *gin.Context) {
 	siw.Handler.GetTeamGet(c, params)
 }
 
+// PostTeamSetMentorship operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetMentorship(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamSetMentorship(c)
+}
+
+// PostUsersAddUnavailability operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersAddUnavailability(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersAddUnavailability(c)
+}
+
+// GetUsersDashboard operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersDashboard(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersDashboardParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := c.Query("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersDashboard(c, params)
+}
+
+// PostUsersDeleteUnavailability operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersDeleteUnavailability(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersDeleteUnavailability(c)
+}
+
 // GetUsersGetReview operation middleware
 func (siw *ServerInterfaceWrapper) GetUsersGetReview(c *gin.Context) {
 
@@ -394,6 +2172,65 @@ func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(c *gin.Context) {
 	siw.Handler.PostUsersSetIsActive(c)
 }
 
+// PostUsersSetSeniority operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetSeniority(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersSetSeniority(c)
+}
+
+// PostUsersSetSkills operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetSkills(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersSetSkills(c)
+}
+
+// GetUsersSkills operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersSkills(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersSkillsParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := c.Query("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersSkills(c, params)
+}
+
 // GinServerOptions provides options for the Gin server.
 type GinServerOptions struct {
 	BaseURL      string
@@ -421,73 +2258,306 @@ func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options
 		ErrorHandler:       errorHandler,
 	}
 
+	router.GET(options.BaseURL+"/admin/policy", wrapper.GetAdminPolicy)
+	router.PUT(options.BaseURL+"/admin/policy", wrapper.PutAdminPolicy)
+	router.POST(options.BaseURL+"/admin/workload/reconcile", wrapper.PostAdminWorkloadReconcile)
+	router.GET(options.BaseURL+"/analytics/reviewTimes", wrapper.GetAnalyticsReviewTimes)
+	router.GET(options.BaseURL+"/analytics/reviewerThroughput", wrapper.GetAnalyticsReviewerThroughput)
+	router.GET(options.BaseURL+"/analytics/teamThroughput", wrapper.GetAnalyticsTeamThroughput)
+	router.GET(options.BaseURL+"/audit/list", wrapper.GetAuditList)
+	router.GET(options.BaseURL+"/events/stream", wrapper.GetEventsStream)
 	router.GET(options.BaseURL+"/health", wrapper.GetHealth)
+	router.GET(options.BaseURL+"/health/live", wrapper.GetHealthLive)
+	router.GET(options.BaseURL+"/health/ready", wrapper.GetHealthReady)
+	router.POST(options.BaseURL+"/pullRequest/assign", wrapper.PostPullRequestAssign)
 	router.POST(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
 	router.POST(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
+	router.POST(options.BaseURL+"/pullRequest/previewReviewers", wrapper.PostPullRequestPreviewReviewers)
 	router.POST(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	router.GET(options.BaseURL+"/pullRequest/requiredTags", wrapper.GetPullRequestRequiredTags)
+	router.GET(options.BaseURL+"/pullRequest/reviewers", wrapper.GetPullRequestReviewers)
+	router.POST(options.BaseURL+"/pullRequest/setRequiredTags", wrapper.PostPullRequestSetRequiredTags)
+	router.POST(options.BaseURL+"/rules/add", wrapper.PostRulesAdd)
+	router.POST(options.BaseURL+"/rules/delete", wrapper.PostRulesDelete)
+	router.GET(options.BaseURL+"/rules/list", wrapper.GetRulesList)
 	router.GET(options.BaseURL+"/statistics/assignments", wrapper.GetStatisticsAssignments)
+	router.GET(options.BaseURL+"/statistics/fairness", wrapper.GetStatisticsFairness)
+	router.GET(options.BaseURL+"/statistics/pullRequests", wrapper.GetStatisticsPullRequests)
+	router.GET(options.BaseURL+"/statistics/teams", wrapper.GetStatisticsTeams)
 	router.GET(options.BaseURL+"/statistics/workload", wrapper.GetStatisticsWorkload)
 	router.POST(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
 	router.POST(options.BaseURL+"/team/deactivate", wrapper.PostTeamDeactivate)
 	router.GET(options.BaseURL+"/team/get", wrapper.GetTeamGet)
+	router.POST(options.BaseURL+"/team/setMentorship", wrapper.PostTeamSetMentorship)
+	router.POST(options.BaseURL+"/users/addUnavailability", wrapper.PostUsersAddUnavailability)
+	router.GET(options.BaseURL+"/users/dashboard", wrapper.GetUsersDashboard)
+	router.POST(options.BaseURL+"/users/deleteUnavailability", wrapper.PostUsersDeleteUnavailability)
 	router.GET(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
 	router.POST(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	router.POST(options.BaseURL+"/users/setSeniority", wrapper.PostUsersSetSeniority)
+	router.POST(options.BaseURL+"/users/setSkills", wrapper.PostUsersSetSkills)
+	router.GET(options.BaseURL+"/users/skills", wrapper.GetUsersSkills)
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xb724bxxF/lcW2QByAFinZalJ+Y2LFFVA7LKWgRV2BOPHW0sXkHXO3dGIYAkwpiZPK",
-	"iJqiH4qgiRvkBShajGj9fYXZNypmd4/3/3gSZSXol4S629uZnZ35zcxv109py+l0HZvZ3KPVp7RruEaH",
-	"cebKv1aZ0blvdNifesx9gg9M5rVcq8stx6ZVCj/BKYzhCAZwLF7AKZzBiMAYTsQegSM4gxMYwCkciF1a",
-	"ohZ+8YmcqERto8NolXJmdJryd4m67JOe5TKTVrnbYyXqtTZZx0Ch/EkXB3vctewNurVVoh95zF02s7T6",
-	"NxzACE7FNozF50o/sQ1n4hmBcziTqh7CGQzl4xEci70M9Xoec5uWeSHltvyX0oBLruu4DeZ1Hdtj+IB9",
-	"ZnS6bfUT3+GPlmPiFPc/XG1+8OFH9+/QEu0wzzM28KnLPKfnthixHU4eOj3blBbouk6XudxiXmSq6GM1",
-	"8VPK7F6HVh/Q1aXavebSX5ZXVldoidYbkd/3lhp3l1A26lFbWVm+e1//2Xy/dv/O8p3a6pJ+q7RcK8UX",
-	"H9I7bdcCIz5QqgXjg7mc9Y9ZiyfGqxUmh5VovdduN9gnPebxpAUMz7M2bGY2XfbYYp9qt476i95lAqcw",
-	"gEP8r3iO/gOnYld8QcQzGMFQvBDfwBBG4hl6DrlRmZtbeBvdhrOOl7LciaKG6xpP8G+jxzcd6U5po1su",
-	"Mzgza3INDx23Y3BapabB2U1uyfiwe+22sY6uo1wwxfbuxmwzdHvtdtNVtsxSNDJGxUnKKI8bvOeFfe/D",
-	"+tJ9WqLay5K+E9vvuCppgsM2nYgspe35FL9Z2XTcNOfJ3bH/B2Ol2QUxP2mLDuus6+iZuPxvXfaQVulv",
-	"ykEKKWvsK+Ms9+Q3abEQ4P5UoAinCF+JLLW1wITyltc0Wtx6zFTwPzR6bR4LgnXHaTPDxpl81E/bKnxX",
-	"TO8gd0y+SVMbM9kUhZMa5pnvDepfimxGoGMKeG9hQn3oSDEWR8ih9QZp6IAkNRmiHWZzssLcx1aLkRur",
-	"zONk1fAelcgHRrtNFioLi4iwj5nrKaCen6vMVXAVTpfZRteiVXprrjJ3C/3d4JvScuVNZrT5Jv7cYDyl",
-	"OHipEFwi+REMEN4HsA9nskLoY4Eg+nAG+1jNiD7WEET05eghjEUfBlTKdw2ccNmkVXqX8T8ooWhBleql",
-	"LguVisrCNme21MXodttWS35a/thDhZ6GSomoD4RQwa8ZqPOIpsA2wrvHjU43E/mTW52yYTFD/RgsOmyk",
-	"AYzENpHWeSbrO135oSKLlVszLHhSxOQB5MQUavTsS4NTGMGB2muxA+eY+eU0Xq/TMbC+pGp3SWuTtR4R",
-	"Zptdx7I5ijY2PAwVvftr+FW5G6SVskrqcqWOqk6inlN3PB5KQ++r4SoOmcffc8wnBewZKilDGYv25mlK",
-	"kqJd9+Z8pTKfmiOqtGaaxGOG29qkW6XMnbqexDhjkktHpWghv5WI2PmLGbzrZlWZD2hvAcHzFipylfsS",
-	"hIMqE7ZyNkqpl5enw9VzoeCpN4gEyEM4wOYON/N25faFwj5Pn2jDlBa8/4Ch6ubK4RYTBjKSVRH/WjeA",
-	"u0q7319sT+N9WbhPCvqyeoNYJjHaLjPMJ4R9Znnci+3FTOtEO+/AzzAioi92xNcwktloKHYQgGMIBT/6",
-	"OyK2xQtSbxAYExgoS6GJZC/8HOeAI3wVbnXG8hvEQLKQ3u3AGA5jDf1kdkwNISwM+ZOXgoiyRykMiPfk",
-	"6BnwMDvM8oJmKn5NQabLIU/lepAn6BIpVlg35ys3F26vzi9Ub92uLv7ur1eGTbp3uX50gqEEKBktZ2JP",
-	"MlRj4qtzzWiF+sRhKR67L2VcjWSI+tF7BAM40kqTGzCWX57I6nRbc1sYvHsEzrBkwSAUX8JY7L1dPBZd",
-	"pryncDg2/A9miEinHfiq9sqFXJ/L8R+cK6/ZmTmQSxERv3xYY6fTW3zjBQWuods2WsxsrqOH9hbp1UVx",
-	"bPIcMg67tDN4BWfJpDSgUykRl0YlrRVpDF7K2UcJJnCMITwUu4o/li2DbnmuHU3GcIwJPJ3IfpGGNhcs",
-	"gTTdg1lCqh4A1fdKBhwi7pxIGNpTtYPqmo9hRCYs8mOj3csqpyaDgnKqZdi2w4mPScSxidKB1BvKFLbz",
-	"vmGblqk7qqhe2JKGmzhF3UoYxeJwrEojtFWeajGqO9DOdojiOoh2KUldtHx9iGUTzoyOryiv6fiNKfoy",
-	"d9P2xS4cJ1jotIrsJH8REfo+fJKg2RfLk4cJPsgQ7hC+aXna0ldXwsL3MBDPxI74KgiiA5XsJuw6nEtu",
-	"YYh+TXQqS4k/sZfMmsmhupLFQvUUjvC1zJNZICJtTeAAdcQhcpiqdUfqd/wMKyezIoZaHrdaXtmYsFte",
-	"Ng/1T1mxD2Xm/tpnVY4kvOhKHat9VCIJRq+VZ/8srSkVzTjXIj53cwj7MIahFPBaDidSAPYLO6IPAzjB",
-	"7U+htlYm66qFljVrmgusRasPfLhpdjHPzS8ogk/9dSvCdtJ1o/WI2ZiZucONdjNi6vnFEPmp0mLAd9Ja",
-	"22oxulWKSnsnLGzxIsIWIsIWosLec9bp1lpO1gwbIESpx0n3QNFJ7WLZnG0oWj3QPO1tPkucsqS0SS5P",
-	"Jieo7ughQDGiTrkojBUTKwnbtHhIK6mPxc4EEnxfDyYSOxmBdZ4TThJ4fQwIAiOJAJ867qO2Y5hXEf5Y",
-	"8R+JZ2JXbEvExCLgQAZ3HAQGcCQXN5xgXs55d06g/9nXftYoD8zwIHKmoU5epPeqpOQ1W04Pp8wM9yJx",
-	"XUjCYgEJFw3m8HZnhPKUE500TS8e079ssP5XnxE8V40pRtcrmVoP0VGnhqh/xPBc7IhvYp9jtIa8W1cO",
-	"ab4NI3idF6RowLJhmvlN7yozOjXTnKXRnRyYprn+pbx5moNGHbtrPFGwXrieW51UsFdMknN9ovxLm8SP",
-	"9TxOyte1gKGKxMR3EYY6TJxjRGBnVpmNnI5e6QmK/cm63yBFHV/dZenqSJm9I2vWHYkifVm+qwtmJzAm",
-	"NwIDim/Fdtk/p4WhzM4yh2aiQpgZwx2MIILJpGPFTutiy/0XjEIoNJbgJGUO5QlxHiQl2PMxgX0YyYHn",
-	"uFJcRnr/o2uDMc4fqQVgROqNub/Z8EOUAowwFcosoi8fE7EtYfYUDuVCvpTUqF9NoDFH8gh8FLRdupsS",
-	"fV06+dyjfzaunVlCstZF2ucE+yi0kbKE7rSUoDiRonRWreBIshbHMBBfkPlKBU5E3/9O9PX7U20M2Bd/",
-	"F9/CiTqjiCqSqHB8WL8T7PQM6J4KK/moMknbiduKyeuSE1MdRFxuoHZsKvkViLsWzjIIHkWWqk7K7Cnr",
-	"N7EcevcdFDKhNXWjl2OxlDmfpsFPolw+iAfpxAGLlA2JgiuyimQVLz3yJNOpI+Q8RsuQyMg4lnr3YYSl",
-	"jYJP8UWq/LjRihkhi0c51R1EiqjLpLMdjRZfKfTKNv3g+o+Jv8s/G04WpP+RCaevvSI99vBxYYxH5DrP",
-	"JpR9xiUzJ+nGUf8v0a/h+LuMy8tPwZ3tB+l2C4aUo3e6sUaaLfp/NVXdxevcmMv8gAlFxuR2nPr79btv",
-	"vJ8qWlRleKDEXHRBdWcvzxE/wqF3JyMv6o/hu/yze2P4rEuJf6NHZWsxby14raD4PdrELeWU27TZbX/m",
-	"nc6oMmvFLs2dy5x1Bkek3nhLFV1Z/NIU56w33hK7JQKv0JtzD7MKnYX4Diw9MeLAHuPLXm1CvGR3/PLT",
-	"ldDoGYrDEKA9NNoeK+4jU1iiS2z0tFu6V1wK9vR15qQJLkW65ZjKl5QXPPJydbHK5odQJ/mtqpQ0G5zm",
-	"mdefD14WP++Nht5PivvWi9Nc2+fYYcGrGGXst3TZpHEi0FAWcx/7UB9V+o9Oy2iTWn2ZqDG4wW6bVukm",
-	"512vWi63ccCm4/Hqu5V3KyqzKwlP/X+CpXISlgn6gRIdehA5iws9D1F/oaf6ru7W2tb/AgAA//8CJ2h+",
-	"+jYAAA==",
+	"H4sIAAAAAAAC/+y9e3PbVpYg/lVu4ffbGnsLkinJdmL1X4qtZLRRbA2lJNNjumhYhCWMSYADgE60LldZ",
+	"Vucx40w8SWV2uno3SWd6tnb/W1qWbFoP+isA32jrnHMvcC9wAYIS/dju/OGySOJx7rnnnvfjnrHudbqe",
+	"a7thYMzfM7qWb3Xs0Pbx03u99Tt2+Dc929+Cjy07WPedbuh4rjFvRP876kdPWbQbP4j2o6NoPzqOjqNh",
+	"9DQasvhB/Djai/omi17Cx53oKBpGh/FXUT86jgbxt+wz275jmIYDT/oHfIFpuFbHNuaNW/hWwzSC9U27",
+	"Y8Gb/3/fvm3MG//fuRTac/RrcG7BtdpbobMeELTG/fumsdJrt+v2P/TsIFxqFcH/+2gPgI4fRoP4d9Eg",
+	"Ooj68cNoGD9gcDvj9xcA2e21202fLmk6LcM04IPj2y1jPvR7dlXoF93QCbeWWgh23XI37Pd9r1ME8o9R",
+	"H3F4GA1ZtBcNon70MupHz6NhdBz1WbQbHUSH8bfxV9EgfhjtR4fxN7An7Ez9/ctsbm7u0tmC5dz2vU7p",
+	"Gm57fscKjXmjZYX2VOh0bMM0wq0u3ByEvuNupCtY84rg/wMCuh9/qYHeZPCTvIZ+tB8/jLfjx6PhD71J",
+	"QL9mW52rVscuAv9P0THRiUAswDuIjuLHLDqIhtERkvde/KgIStvqNPHv05ELwIgAfxzY/kkIHE4lrgFw",
+	"vxv1ObE8LoC7F9j+xIj8U8dteZ9VpvJhdIC0HW/HDxHQAf4FqxmcnuDHJhGCvhKFl0F+emIfE/L7sHdB",
+	"13MDG1n7+55/y2m1bBc+rHtuaLsh/Gl1u21n3YLlnPv7wMOf7c+tTrdt45++7/l0Swue//61+ntLV64s",
+	"XjVMo2MHgbUB3y60Oo7LrPV1OwhYy3Ydm7a+IrXAO+ocWAI9h+TDaECkTXiMH0e7QL8JDqN+tBcdRQOU",
+	"Ntvxw/iBoH04pgaICGur7VmtNc9btvwN+3RoWFn47fK1hSvNtWvXmssL9Q8WFXRwScJuea0tZn++btut",
+	"gM3Uzr974Z2L7NZWaAcTxc6/R/t0dJ4Dg4UVx9sgHJ7QmY+/BsLbi4bRy3iHU+RRIreRHx9F+/AXoGnN",
+	"8z6y3C2+hOB0aKovrC02l5c+WlpbvKJiyApt1nY6TsjxY7dM5tuhv8Ucl81OFj8/o8KyGz8CTETHDInp",
+	"CBiIirJhtAtsPSE1wOEBAyYfP4i/jh/EO/HDeMcwjU3banGNqQ4wTy3cDm1fwxz+F+J1P3rO4m0UGMCu",
+	"DgDv29F+dBDvgPRQgGDRk3gH2Hj8kG8OPIAAV5gAP++OG9obtg/rBvHgWr1w0/Od/2q3aBtOunMfX134",
+	"eO2vr9WX/m7xiuaor/t2y3ZDx2oHLBERk9yzHwkDO/FX8Ed0HD+KvwAiBnnLPxSf+L4ECu5SVmU8kW47",
+	"zaLfI1nAnu6iTvCIIVnBPu0CHPB1/K1gSaAJg0Ag2RbvAPzH8WMSBSiqD6MBOwPXpd+gPBtEB2cbbjTA",
+	"C/B0bseP4y9R42Mfr10mNTv+Z1hvdARPfklQxY+iF6ChKGAyPO7PEKMcngH+/zD+BiHFBRxzTgGCcrrh",
+	"Rj+o+hrDA7KHT30WDQQjVpjM3MWLuZfDqZpuuIZp2G6vY8xfN1oWiDZuD3Q8N9w0buTkl2ksBIGz4XZs",
+	"N1y8a7u6PftBUEO0T9A9iR+h1H3MVlcXp1n0M8AW7bObTusmXbGLC9oj4cvibea0srciZh+iMO+zaNBw",
+	"OW73xW20ubts2QrCKYRtaukK7O0h3o0SZw/f/AzpaBA/5ghIjxsdU1Cx5o3ejGEa675thXariXJ9tjZ7",
+	"YWqmNjV7fm1mdn7u/PyFi39nwBEJLaeNBB14PX8d8GX1SDkIfSu0N7aMeaNtW0HYBFEHR9I04B3nZ02j",
+	"69t3Ha8XNIVyN2/0Zg0zZ9jMG11/aqZWA6hS/XXeuGWt37HdVqpqwPPsz2x/2re7bWvdhp+kZ8/By7u+",
+	"17X90LGDzKpzm/kv0a4wxuoCmfLORC/YSt0wDbfXblu32rZQSHN0I2Oymq6kYNZqtRyAyWqvSLDTu1SQ",
+	"aQ9YNGAC+3i6ouf8jPG9N5mCYcCmWF/Xn+7Y/oYtIdW79ff2eij2TYLfccOL5w0zx/d1G5tD7r9Ffc7U",
+	"9vmJecG4UPwm/hbIW4Ck29SRKM+R0L3R90ikpdWpuWkFZ7AvSCPqI3Fwtgjssh8dIHHsRgM8d7tRX+Lc",
+	"JVbPaPjwi3sJ10oQYyFfssk4yiNL3lPYkOmWba2Hzl2gSS2fK961HzPEpN+30djA2wpw8c1oXNyXrcDr",
+	"hpNQa3pulFN3Q0PMKTNf8drO+lZG+2jZt61eO2wKhDbXvR6w/FnTuG2128B6mt5d+N7qWutOuCVATX71",
+	"7Y531246LiI7WUrH+rzpdW2XPzkw5i+IbWsGbcuYN86/u2mYRnDHabebn9nOxmZozM8UM1TT+Mzz78CH",
+	"9Oocnytazj2j47hOBwiqpjvJRWstpQwU48Aqd4EeQDFK1Fv0sezH20Qhu6B0suiAH6wBHK74Iegw+3Ra",
+	"9kABRQJ6CnekzOaW57VtyzXulyE8B+QvqMgccQCjY4VAU+VKpmYV4OeCa8U7xFlfwM3cAAQlGdWLeDv+",
+	"Rgtqfu9zIP731BAAmX8QPwBhg+plChgjoz4HaI1NgfoD2j0shpSoaJByfsMcsd8yIWq0m0PVx4FK7jeg",
+	"ecS/Q254RKeYfeCZhB/QPQZkzDGi6xy3UQk999LvAfuqqsQXQ28ABfNAGEoKHaHfgyG8T3HfBiSvUwHs",
+	"9YDHaHHi9jq3CCXpuUtZb+YA+pbb8jpaXpo7mwULJFuM9gpJfjfql5PALvz8JSLjQHOKoj7qd+vtXuDc",
+	"tT8SK+RMahwUZLhtASuREKWQkYbqC9lKyWHOYzJDOVV4/KprdYNND3dB5Y/dRAaU+vkzz0Py4HpvShxB",
+	"aPlhrwsAOhub+IcFJqpe1nZbY+uGd20/cDxXuaFIGctsHl9n+gwFgmQ1WmT2Wk646Ib+Vh59sEmeKyPB",
+	"77XtJhfBhkkfW3bblj7C3vvkANRhxloPPX+ES/krmQspfhIT7WBSMcgORo/FU/Lnkv3Uj7+EQJDxelX2",
+	"U6jVeaW2THeroDTxfRPIHkd9QsPJbklhrjxZnASLVRaZM1+0VwkGhaA4od0JRp7v/KLq/CGpAm5Yvm9t",
+	"5U9WLhqXh1LBqgxgRQwnwOQPIDcBxiPYnj3W9U7QTFWrvHYD57nV0+pdqPsfKOKLIhH70YHJuBG1H/9j",
+	"tK/IPK0SlWe43OPQsdye1ZaFCFh0gHSvZTe9z1wbKDxoW007WLfaFid9IWKavm0l/H2UXaT9rYASM4SS",
+	"RrKSe2TUJgs0lU1NdivFs45oLgui/mvbaoebeUI5KZNqW6Htrm81Ozql9XvyUabOJlT8KTxGQUnhUOQ+",
+	"7F2GftJD1Hgkx3PUj7/Qamg5rSxx/2r2opAjBKEV9kZyAULdKl2b3T2+X/xJCl50+5GEHScRGhWWtZwb",
+	"wF23Io4yRGcrGk5s9sIF0JtB/d6FB8LDSB1btt0NII7ZCxdMo2uFoe0DSI3Gqu7YSwFf3SryoWfzdCvY",
+	"n/wKFOd+eZjh6rW15vvXPr6qxhh8m/vWXC9kt72eS7GFwrOlCSdDsObrxHaJt+Mv8Mw8ygXJ4E9+cPai",
+	"ffbJwvLSlYW1pWtXm4v1+jWwYCoJs0+sttNCJrcUBD07L8DMdO0Zse21FP66trjwUXPxb5dW1wC7K3Xl",
+	"748W6x9gQAYQt7C6uvTBVf6xeXnh6hUAfdEwFbQuXcU1NVeuLS9d/q1hZoNyulCmuKe++MnS4qeL9VXp",
+	"u9UPl5aX5c+LV5eu1ZfW8NH8+uZ7y9cuf7gov77+8TI8Gf5LVyR+XFv6aLFZX7iqvHzt2vJifeHqZfm7",
+	"j68ufLKwtLzw3tIyvXHpyuJHK9fWFq9e/m3zw8XfNuuLH68uXsn8sHS1uVK/9kF9cRVeqtniTLQrDXLr",
+	"BFMxM8xwL9zZ9Po8x8pcTwSiZWxgWbbs1mXLbQGZ2XkyAnmqGgXkbZdkLghB17prOeTtMw0rlK1Bq+3b",
+	"VmurKTk4bf5ewzRutb31O6NdmOOI6tTK1AY0Kwjy5H5TrF+Hvfctx3ftIKjbXc/XKM6guFRXWyFnSDxR",
+	"d8xDr237lktK00jZmlllerPJwdItiGRmuhyJwcrZfdfvKYrEzPSskNageVq3rEASrvOGd8e4b6o6y7qA",
+	"bPY8CgIQARn1pDZdm5F5d9fz2iywwp6P3JBduvif0kSW1q0m/C6/tWVv+DxUpfJ0xwfX522rHdhAiF17",
+	"PURIJIt8NgfLu8mrOs4GQRBk13hD93bTAD08CK1ONwnAzUzN1CAAV6vN12p/lw9nqZmUlYgnqzFq6Ock",
+	"apMCfeXUJpnwEhUrfZApL7CYDFcTeFUx7N1hUyRXFYWUgs1P0PDoi5A092YKT+dvGq7YmGrPQK0GsiqE",
+	"SvwE4r7REXdEnuHZAaj4cZEvnNMionsWXup95upfyOPz5P3YAY9GdJx9LIbD9c9W4t/eHTT9E8KDt2rZ",
+	"6ortthx3g+xQjRW6YTcDe91zW4HW+16cb0Lqz9eYNCTcvc95cgWoS6rjtthFIllwI8hV2NKpTw/vl+PB",
+	"k/EKJUb2aKN4ivIijtItzsVuQbWGbL1tlvpYubqNvnlM7TPMisBJJnve0J6UJ+ZEjpJ0IzJOE5nGNEax",
+	"QgI6FrFi++u2GzptW0ejP2PE6kthmIlApibuAT/sMo3xmnEsX6jJR6KKl+/S+HdcGuuO7IZIIKqvVx+t",
+	"xWaZ+y/xXyieOBXjXInK0/pxJuDBfQoQ6DlTm56ePSvbQQWx8lSEVTrXC8XHemRwniLsp3nCJB2ftLnN",
+	"0NoosEZ52AyTgOOd6BnPWRJZLilLWqmPheckKJSeQv0xG+I2Y6LYk/gRZIQBRE8oZEnBrGfRXja9AGmg",
+	"qgGs5/DFyo2QhtdWMJeYG7Y3zInzs0Sz0RyQEYdsdVNrL5ST9+Qo680haxReQisMxsXLScQ5nfM3HdFI",
+	"0zcqiIc3SeFZiEfGlbJ7uuzoJAs83wkgfbaynZN98MiAjvSOEYCucTYrGb1lKYwZ9nzd2MB8yX9oGzdy",
+	"Bp2GdqpVuWikQFUuPnL31SfrkFO33DulvqFbvm3dQTOj6v6trnu+nRirWtljuXd0bhvTCODeSs4P0whs",
+	"1/F8nuRUClBy4aT8TZqcQzUjZFiWETJa0UMEmSP9VoQtU9oj7RbboEaTjC3gvljgVJlN8uyQ1Nk3Ov1Q",
+	"JAHl8mKw7Gm/msko3kucXRvieM7wZV/Qy1bqJhrkFK2hXG9QoTDlGy2F+FG1V4feCX0jvHSMlxxm8JZb",
+	"kn774JLLUkJfRnKGZdl+UpaanKJ3DPUoZpIem7dfhT+CF/5EB8LhUpwWe9osuoxpXC1JLr9V2RdWIqyO",
+	"5biwfSN9IRL58ig41Ucc83qNBItAXN+YyAbibcA2cYZ4h9DIlyWlW+oIscAUkUCXnfJa7V2Xt0vG8AtM",
+	"AN3Gc0hpNgMeyMp5rBJLWrPNGYLP5I9pUsrUyIG8gGLyX3M6dlp/k5FQ+H0Tk7nG0PX8aowkxzCqcit4",
+	"Aaxc9wJgTIrnjBt1u/kSmIpvg2U2Q6952/GDsFnduSY7WaTHIGbGujdDBsqmKMhQUF++46u2L7QrzY6P",
+	"XetvGkHywDHsUon2RmlgaTcCelHx8hRjV9VIlQyhonqeqkU8sKV2ExWEJCCiFNrIWo9x2fK9tqRjNC2V",
+	"lvLp6idKZ0oTg3KKVB9Yf3TEUOwAuzrKeRVIUryk6jQS85KH/HWlGMnJxjl/sSgohDRGVH3QZUJlKMUy",
+	"gdIJMP0A0yWjI1WkkGAeVKpASTddkwPEYWG8PHQQHVLlGhUrgqs7l6M8jHbnSS5I3h9RHpK5mFEieXTI",
+	"QHww5J9pBnS8AxWCP+lRwAvh8qt+IWIkSWlOoinsU4VcAU4kdeTUqn/2OJzWENAlwke7o0MLJ1EVCkPi",
+	"2iS2MsaVBLAL3MiJK7GC2GrZdx1LZCRnkPkT4A1TiY5TnXSIpZe8pPJY5AkjVUiZTVyWalBXNWttRAal",
+	"bwPLuGs3x17ALpXTHwIolVZSDd686wgT9iVTo+e21C9uWW3IFtCnZSQMZIR/+Edd0WJadi4Um5S74NrS",
+	"hQ+0rAYji6IyGjPlEvYBH6upRK8pCVTGTxHeZELXEk+p91QcvBU/ieaWlgMLZw6lcUgOnOv3RIaD5ES4",
+	"a7V7tjE/NQPJDeSZmUkcMVMz46gLqCBUf+Nc+sbZ9I1zppGpMpbe+J53S3kfPiLJNILXiVymNIVJetqM",
+	"+rSFtrOuuFQgK0R6hJz8lD7kvPqQK9Zd9Rk1gInKN5pJusmMFHFJCxQDu81TU65z5M4aN0qVOU1RdXmp",
+	"dN6XLpGHVgHLFPjtqqd3X1FmKkd4Mm5GXYpjsosVtfN8YpvmqXD+PD/YdLrlmtAON9kfodnFPR2YCsrI",
+	"zwiprm3b8klx1MTgMxte0fEwTvxPyIbEnXWU8sUBNxchWf8bTaHmyWKDSfAiD3pKujmo/6jq65hmrHrh",
+	"KJIIzP9J/Cgnp8eEVtbEy0vFyzm9HBZJb1Oq5TKbnMNUdkcV+pNwppxCifTLuH+9V+TioWzs+DGvXuee",
+	"OxCeA+rto9GDphkmZaYpzeDNIsJP1gT9LVLbCx1aWP4h1dQ33ARrSTU51gqj54QcTNCCBRV1Xvop5Z+z",
+	"BM+/YV3fvm37pNxTvx1+DiXLoeHKwIn865QfJXHxDJgsgZJshVcdexT33NITpX37NmyupKzhZiBggASt",
+	"Rla5+CzN5i0MSxatdYzTgseEL0R9cAKBgoeR8URB52ubvtfb2Oz2Jubq08UrJhFuKAxyTC7uEGxavq0t",
+	"8B5m8j+oTlJRrMVB1JvTWUdjv5qhUUYik9K5M85DrQqutkccFVgRmKxGeG+H31E5CpPwPGYCs7nl6Tuq",
+	"CMWWch4HGDrSVsGXuzrgHlAHttH+y5SIszOynnvWbLhKuTmbYvHXImEWRRbxfqkxGxnXSvcKqvWHe7ej",
+	"QxJG0Qs8BY9le7LPztCV8FoqVO9Y4fom3piY53Bk4h1RMgcNr3JNDbB5bdLDAESQuma5CN5suMTqfUpT",
+	"PsZ2CU/ggQjSjLTKVJALEcm9ZIocllygkqmVrdqnlSYV+bjQROz4Bb4Abq+NX5HAjyfdr6VIOYSf7Z+q",
+	"IDuXXtiXnYXUNZP6qMl9GSSkpLq7b2/02pZviAwC7ZqhUiN/Qjo2rHO8eo+P7IR1FtomTdsF312BkFGT",
+	"7FbqRC5JD5ekr1hiqCidWzDKlAQpzVIjRmHuY7SWlTddZswCX7q9V2phcpjecFxH72CN/zn+HaTNQ0sA",
+	"4SP9AdrKweZrZR2PJL/kHT13gYWZbIZNcRHJwEk9jPZEY5lqghAgbH7mhJteL2wmbp/gREDzaLAWdtWZ",
+	"Rky1z88FZ7yyITiMDqrBD5HZjuM2sdKlwHt5jKn+CXsF+AZy1z5qw/MVlgYfRn26IqdpHIg7yadNdx7B",
+	"HugjIRL5ws4c0K5MAa9jIrljP36oXWaBG1xaNpB6tQSjcU97zkGuOfPcgjyBUzUXeNnPBkKGvFUX4o5+",
+	"pV63aS2r6InZz1NRv5CGRqnI2nTx6F/Sfk1cA86YgwPGGzJFffXSpCoZ7qB+IAn55fRtJYkoD2G5ulro",
+	"5a4UcRf3VlHSxzvBihqvPVO6OFhFBd4LrfbY6y1h8ikR6B7OTxzxS6OYbWqPRrE/PYv90bKGy+GcpFEi",
+	"PbxlUnEM8dRJh1WTQmVr6YSSWGM3FSGnKCubguESI6yY+GLxlii61JcqjqHC9JixEgYr5/icKLmH073e",
+	"AwpHlswjgh1dAseky6l+gTxXVF1kee/oSTMnR0id71EX0nLZqjtGfYlPsWMj2DVyl3GosYRtqQ8zs7Se",
+	"oWOFmDL7r8Vv6UmbWK588sTTJsnDgybveVMP4Fgntrne6/QoTqrNykwOrDC0k2bZZBrmxp2geY5dr+OH",
+	"Oe9X4XCIk+T6nZLOy51hMgkXZdUV4XH0xr9pz1eGDCfh9fpYJJg6bb2j4ec095VdRzQHTSs0me224I+z",
+	"pjBB9jgZaVNqKT0pbZaejZxA0tPP1Bk8ep6GGUVDcOzBOhQR1kEy8QPI/HHSfb00YTvXKJwvQO1JUKuJ",
+	"ngQUUphJAwbGXWtdSkfww8zdtTnlbiVKnwtCJy+v3LNsAvENCeqqrx2vDZ+UNpW8ykzWWtpJBMbvjNA+",
+	"J6Vuvg4f/UinfLqyImxcsYLNW57lt4rKAe1WU65pCopyrITHmYRB2byiE/YW1FnyctHFaK9AUr8BB4V6",
+	"M5RUReRXBb6S6BmP538b/yNaxHImYcGiUS3rQ/cqjD7hcKEHwnkgxj4cRYOqyFH7SmhzByAnHMRVWMFh",
+	"kq1Mkisaivj1v1MhP8cBJRHsRHvpN1IxA+nPxcUMldackSCaRff42S59SlCQhmnkKcIsOgAS2eUwlcF9",
+	"0albBc9//sS17bt2Ww5Ju95dyo4C1ut37JZjhbZB7XR8fU50aG0U7NdTOVrS58mZA3RJJyneT5NZLPua",
+	"ntQbnkgwuO3jZJrWOd+21kO1y9zF86MKsgBGk6+2FEXZQtGAf3k9wZVABV+4seFhIyLxawZvdA0Uj0J+",
+	"WLnwDBIAqhFosq0FtDmW76E4U5ig0mEt28IuX2Ho2O1WcVdCzLWilu0U5xOb/V9Wr11lKx7iUjC5xxRm",
+	"O4z6Gjo5xw24c7VzKeQ5Sm1761a2EXLXCiEWJuan0aAmrBjw7jhYZum1tk7X1S15rckRUtbhzTQ+5YG8",
+	"K75zO9R6Z6DGIY/U/xAeVYqFofq6XzFki6LmKaVQl+i6ivaZTUOAqWkV/RLrnt/SulD+FH+F70tymsYN",
+	"OGNfSoxMiCDqYVn+/Dj5w4UnJFmPKTanbFvr0K5l3WnbdTvotTUb3BL7nj00uUVxF/sDdNJ8garCnhQ6",
+	"UJqOHuFF0Kxzl01p70mqLShNb1hVUKr0Osp4o9VpOxsG9noPlNlVeDAndmjMvubdsV2tiAHDCiixbLIX",
+	"w2dMh/CQaRZ9z4djPaS4PX+CsPH2ECV7VJoqNSzH4zSIXjRczK55oHaFRU/EQN/3HHspR885FA7OQIOd",
+	"JrsNcYjav235cnh2Mwy7NObMcW97ekcmj6w9TjRDuVNp/IidWbm2umaylY/XzmbajMHEMbr6qejbigGI",
+	"m0stu9P1sD/e1If21k021XCxe5I005Sqf3gYPjv8jrwp+DO+bkjXDtnM7Luko+H8ER4SWVi9vLSUayHL",
+	"K4WiXckCVqaVSctk8XbyVl5jEA3jL/h8smTaoykyDHkHX7WlqxhDcYRVSw1XeSC3ydNm9cIyz7SpZ2nc",
+	"fRe//cdkZlgKkTSVJxomS4y3CaJ0M9DJ2nDT/Qin6jAxaMtuzTOIUtycZimO4kfswuefE6Tq+sVoORMW",
+	"2BfRQmn1DTe/ND6LjtIsJbQJCjsmeuHj0g5Kt4ew95wiXzAUETCg7kbD5SJnR+Q1sPO1S+xmQT/Wm9iQ",
+	"PEsD0v4p9EB9/hputAdZ3aBtwlWocPCha0emXHbIFRCuasD+T7Hzs7Pspr5tLGzDH4QHseFKiCcs3nTS",
+	"AzXt27CRjufepBF+uqHXs+cZOd2j/lmkKHFu+3ik9qNncHwarp7dUBxBOpHTDbfh4jCvlC3IPcBTCpFd",
+	"pZD1tI17+qXE5iCJgTd+xiFraUfFg2gwzeS3MCSsY7WrMun8aV/lM5mu2FIzN5T/+9G+tsO0ymqV1ty8",
+	"VzUujTSIhqvpcIA/7ycZDKnEzCUmcNb6IqernmUaoq013JvZXsGcXHf59TBbkPcsvZmDRN6N3SQuo3am",
+	"BuZYNL5VN3Yw3mY3L9NQz6m1ra49z7JzPW8SkfwkszPYibxMlWeEQYDozM1zKNbO/WdY5UBIJLWXBE9k",
+	"Kwjkw6Hhpa/kn9BdBWvg00hszv7OMtW6jx9lKB9prUQq4xF6zm5m5TKcTY3gZ1q531lbXj2b8IxtWaEg",
+	"/i3pHjeRCLJsfp/dXOADWHFH5tl7qAnczBwoniykjjcFtibPN81T5Ay7KXeqvmnyoElyRsgPBEyuNsdu",
+	"Jm2sb5J6Ejph26bwrUhzYWkFO1u1ffAWsDNrdhCyNSu4Y7L3rXabge/4rDTFZt6Yma5N10SjDqvrGPPG",
+	"3HRtGqqfwAJDZY8TUzrrZ4OCD6Ack5XZMuaND+wQJ8ryET+ZodWztVqFMbbVZs4WjicqGqlM/inMNCXu",
+	"up3MB1Xaf943jfO1maLXJ+s5pwznxZvmRt+Uju2GO2Yvjb4jO8BZ1sXR8yFr4ddvYDvkXqdj+VtptxFR",
+	"SfUNiU7EBLDPzOLjHe0JJ0cJNttaoFFM2HMtLGpukuCyn5FmKdv7kobl8srTVJJynTlKx4DTSFxk78fR",
+	"UD13WMWUTs17hAJFSECuCMkCZI9sjugJv5xP90WtLHHEkrxLKASastBxUwl9pZcjdNyg98Ah8apo3Liv",
+	"GmzAbO+/LWcst/fZTejTKZkceBVGhWdhSpo6U7/kfmbmCiRAxg9LmAXen8pSkC19nFj4GrnGTIU7stPx",
+	"Xw+3SefSErc5KXu5bwpxI/Lmz/nCMUMD54KwMGoM/oNc4egwE73JhGm0gHHOpLiruHkJHFW4woCRkj4N",
+	"/bDineg50VGmNpTzFayLA1UtHSyueuriR9Ms+veoT9aSmAMqG3ID7gQRjsRBsZlIsyWRas98eq3+IU4G",
+	"qS9evnb18tLyYnPp6tpi/ZOF5bNaDucFxOJyvrGTiXV5Piw50a6n/tJZ2fGYLTe/f6PyJPoiP56OOfwi",
+	"o1ZCoMys/lz0ALHWgRjeqhBr3lm8W3QqRTrJOT9pxBRI2mB+6ClWfuZzB0Ej3k2qKLgakO2zZep9Nt0L",
+	"tXPdS/DvkjrifyCakWQa4JNSwN0glLrecHVHPl+8J4xqno/4WJTqRr9XgY0fCSsAwt0ZqNOjvk0tow95",
+	"054jtEzIagCmhfg4iga68wiqtcB+XUI+6Oi+1bFDTMC7rqej9BKo+9+w3/e9zt9gVOW+We2ONa/y9ZRq",
+	"xC+/cVpmIfKcjM9s+06aVzR/XfwkMuDSvlxqSo2c8XZJTQybmS1v06Y0oJ/JtJefzTSPv1jTdWtTH3H+",
+	"fK2Wecy7F/l30qNmL1yardXu3zeL1/hO4Rpr6hprY7DPXJ83Hd/8Xjpx5EOmThTV1buSCWLaEVLpOBrH",
+	"vQthTQZoZp85MOJjnr0zN8Na1hYjVAXM/nzdtlss3LRZ2+k4IfNus7mLF4llTkiz/BGUREwZBmQckNEf",
+	"vcilOSb+B6idf3o6bi+bdZpJCyonzGk4ueLi6Ehm8oK1FDD6bBH4hl252Za+AClh/Lqx4fq2i8iM0+Hm",
+	"FHY+eXH1dAUWqyz7V047gtPmq/ov5mv2zycl9LXpC/r2OaPaDY3N0TSV5L9ytreSs/04WilLKrtPzNLC",
+	"XGa9lp2V1MUI5vWiUpHMQCln0TM3k6cY93n0/2GSVAi8C91gT6XHYFoIBLxHZdljDCyBUMpXJj475FX7",
+	"e5SbKEUYo6HsCqFxI7A4UdiNTSNLWWgmc/wvhn12PDfcHM0/a5emajOFWtzMO4WlFzPvqBrebK2oC1kh",
+	"4y558exs4YvnMurzbAH/HoM/a2sdfuXNb6fWqTSfp64dGIoextvC20sRPIUrnoxZ91pOeK7tBMXsOfpF",
+	"NvM5n93Bz4cFUc60lW9xPBONd2KKB9FAeQDATDkLaL2LnB50EQ4y2dt67RJWhZVmOWbowJJEoiM/T/np",
+	"ISkh5fLf9I9AUlRuTOppZ8Ds7FifOx1Itpyp4UfH5R81hXs3ThlqyFajhGPVISHuFt3Q3xqZxiYerUlk",
+	"y5+pf5O38Bk2lDkmAp3Uuflv6UMhorVDKWyZXjKjfOPQa04cDvsuYSb0eUsU/fmAFKSc5xpPKvjBkdiF",
+	"cTctVHYz/cqHnKZ1+KrrT5M0wJQAUManWzZWklih3eLn+4i874wi8PF2kqtAGX/RMR6WA/zyxTSL/gNW",
+	"HX8Dv4LzDKD6J7Lk5NyohovG5APqxvH73zAx8w4Xsossby/J5dJkvZp0SNPcB4yqay7kPLPhSgrSEJsG",
+	"TbPoF20OGFu2gnBqEXZjaukKgKToYkMV/9KSdEln+9m9kfpGxDtUpIY38JYozGnR2kRyVMKWAF75SZrU",
+	"p8VPFq+urTbri2uLVyEdZppRgTTopjMXMvNFh3k6wodwJn8ksjowNvGi4d6x7a7Vdu7a0yz6V9H0Rkrb",
+	"4LlwO5TGlARpeJ1nnwLVCi4omkIJHIzT0QO1Ys9suLnXUGwPXQXRM7oUiETZtQIlFn8OVumEVWLWUlL+",
+	"uExaaW057s1jCgmVRzgticwyTa954sC+RHLqrmAhijGfpuVziBT8KvCMLg4fLWNC+/OQOOBUygAT3c5w",
+	"WvPs/GzDxSvm89ys4cKw7Hl2r2E4rYYxf37WbCAYDWO+YeQvN8xGFsV4JR9Jhr8n3Srxlx59yemBvpqj",
+	"58DjvV7QVH6bxd8SGsAvuSaNv/CMtIYxf6/BW8PjNTBWAS8Q3VDxW7lrW8O4bzakJo94gW56RcO433CV",
+	"naqWFoCbXBR558dVphlonpSk2sE+0K97yIOfYWJ37uGT8pjq4dHrgGcgecr2p1YhkYpYwVlJFtM3XBhv",
+	"0ujvQin8s5xgILr8UGrmUKc9k7eA9GTQSvo69sTnjU9UIZP61SenCYdba3r0jD+dfLQi9ku66CpTxQGQ",
+	"C7W5Uyw4sQLLRnUmqKCrT7803ezxDLXS7rL1TXv9DtS8d6HYSqI/+l2hv3NtXrBcQoQQMIAyE5GaSn6d",
+	"Y3Q8pTnDfTVzQqla4PlXRJiQriUoVkqsyeYuTxfT7zI1cn8lNPyGp+1XMj0yuwK1xLsZUgAUuXYQsK7v",
+	"3bJH0IBvW62tSpxIlEm85A0OKEk+MZoPWPRd9ANXneW0CMnCf4lx7D7xU663py3ypLSpb6lJ6dMkffkF",
+	"qmo8MyUxpXfxgB8kOTC/cF0QWpuK8fpJFYU0kV/hloyyccm/cIyvf8D19aKgOiGyjqh7hbl04jVdz6/A",
+	"I55ycbWbrQ2iJE5quwp2Fjvj3RHeHoGlsydhjaeC/X8CuPFj3jt+SMQg7IOXYtpK/LAK84OdcEaTfDdt",
+	"BXCOjNeSzLBM+3tT6bEef5eW/SjNXaMj6shuFnX3xC1K5hBuMzUNHooB/pBECtLSBtXnnh66xJeUb0US",
+	"PStwIRQlbkmNEkihGjtDteI8XmUSyX2zkDEL1CgeKD78LN9jQ1zdLGkscorpvqetv86bXOKJerb/+jJ2",
+	"dWPbNef1j9nxbRkl+A1l6hb08JGaUWJ9Gm/cngU6cXo/zvopFIfOCXP0audfHzZW6mItI3obHWP/7L10",
+	"yy6dLg5SX/xkafHTxXrzveVrlz9cvKJEQYRtzJyA+KLdYre2mMX8XtueZ705+AR/s3cmGfRYqYsNj7e5",
+	"k/GZst25WYQZ3p7j6m8mizq1Rb9PghBYg5EbUavprC1JQYm1BxpZSNZ+sSzE1mxJ4CQ5Ink8Zvsc9qXy",
+	"t7Q38wude3cgO/52M8PTMPMSlU+lxyzk7WjGjpmsAGBecpXFFFUoj5KJlwlJp5CJuRFfYmBMU2rAe93o",
+	"XTBuSL3cMz/iZKky6ar8JiZktVossC1/fbN8HL5mulWJfFYGrlSVo7o1Vxt4jA3RpbabvIM0XZgdN4la",
+	"b9WGBzJ8uU7Pun04UZfkTD9QKaXCLDw1J2ruVLqck2tAGsKq3tN23CFZx8i/CZm8NUF6bn+jmYzF6yBx",
+	"cIRSOEmtEZ6QA5FX/iNzOCBWKs0lOuV0rWwoLXqCzpKCpic6NmSyojLyKWo6ka08LIoAJjHZ2shmqnnF",
+	"NL/RpnTWT6auzoxpPvjKVOIsCzTBcrhh5jnqqRijcAQZ11YWrxr3Szhfd2SbMKXrXBV/nyqyjieaoiJU",
+	"tFVthkqio3kIS5AGQFhvFhU3L9xk6ZwTy20xwcUnmqlCri1io0NRRi9cPS+pJSfvS4yxzgON0pN2fEDZ",
+	"kE982RfDXQavXUWP/kWw/HOKetPPa+bxo7F1c2rwSjo2/JmMe5mowj6bKOxztET7c4e3dCx84Uq9ufi3",
+	"S6trKvmt1JnTYlYbHZCMP+b+/VdkBZDjWp1SLcwl7liBqMpXGKVMGgejg+f/PWMhSXJKZv0NEn2DPIJy",
+	"KWAla4K36XhepsZUNzjSShdub5Rq3h/h1a/EGVXG40+qKY2Qr6/O3TMB+UkpOwtKvr4U852YhP1osf7B",
+	"4pU3IGOpR7M8axPSjQQ4b8Bno/XJvEHe8TMNrSc+yLkHKNsHHEnsDG/BcsTTayiRR8xjU1poPT5bnSV0",
+	"iUbrspFV4Jn/Pte6S7SDf6bMNTYZ8vMhT6ZXEz+xQSIZFqAMiMVk2i1CAktmCCm46L/XVJgWDUBXZ56n",
+	"jStQucE2N4N4Ww2nISZ5zjyXQ9UGsWJ3cng4k5rmobITDcQgPcqLi3cokQ6uYFPkmxmQg+x5ZtoYL7zl",
+	"uVp93O5qLpOV7J5O0nmic2O8OQfGqzDMs8E+VYdJKj2wVPqr+Bu9P04diZ16FuhMF7jFSVNWe/LOXriA",
+	"rX1C2wdwGo1V/ZDWiVn51I5ObwwLsmZ56/U0FvwYZvNpDeLJx2/4aSsM3qhnXhn//tpDNz8W2GbZrmzx",
+	"o9culFMrTVgIb6WYFkYLbxZ3hNH0B2xUhwJJm+fTCqVyhOrS2rdJtyyR0t/h4137M5G5KCdV95W2Jrmo",
+	"m8ZIibepg8p39PvoPHiQhf8HZ4kOM7nQWqvuFcX3G66IS1OAn0r23kxUvy427RRi2Gu3mplC2xNZVxJd",
+	"jCM1ldePcdvbk0kw0vsqL/HN24rQz48CUq/U12oaInkah+3DKydnGmYenuVUCXvibXCz3QWkcGppRl/X",
+	"N9Q3Vcvn46xAMwko32Fo+Gsigt7ZqVSDD3nf4hM47sxX5DZV8xzuC0dLDnQpmil6gYumaLysh7sqzFJ3",
+	"a3JRCuG65bpeyITgZp7LCAa2UieQXO+y5bZg/oOdhws0xkx/1oLoexloV681Ly9cvQL9dNVqWddjNGWI",
+	"8QOE7UjXBTzMcRkUOwhAeYJaDoE/lxIctsEvnXqwK809KFnEWnNhdXXpg6slRAC4FiyVhR4LN52AY3qi",
+	"tb2ZhsyM+mJJQ2pVPellEbeJH795x1MetEFaGAwB2gfUKbeQRfPW8qIzOF1GHg1eoqR4S8ZRd4nNr3Gj",
+	"tqiPraJsSbeM28ZAes5S61S9BqpRmvQ+hLeoH+5TtIbeHj/phPydcrfb6Li6hwJwMQYNSe6iSgSUes3e",
+	"FPVUTOiVVUZJk7RKwghUk2bMGx3L7Vnt0e17zHEfDKVuhpkUuhnzSplbNg1ZeeFly/cAJNFJtGmpTd5m",
+	"7t8o007zpoHGTTau71CXpTuipD1vW6SvraSb/jGf3UdtdKiNBg25LBIlf64MQutM4cNrJcc+NWUcVaE/",
+	"gmcEtipHir0tfyrx4xakae4zad5MOlSEuHyR2/gljy5Q7a/wQmsbqMWP4y+mR/kkVu2spHwVTbG18u31",
+	"eWjHEa/ZOn/hAX3z3tkXnDp+DZPq2lZPUm0AQzE4Z7VaJQf+R3qD4twU/GnI3Z4i45oPrBIOHHBBJqXj",
+	"EGWRjWCe6EW2Mhh13+kcm3xNSlxUe9SxBclCqzXZvOnbt0FkzZMHwJBGH3ctx4casA3f6nQcd4N1LT90",
+	"ReiRh3ukeY2TjQxysNJZhAI+wql26GCJj1EBuDoYytjgqvm52W44tBQVhleX+TleoAtoqjCBMCVkJeQ/",
+	"fCu4KK/pk8F8nT3DX2sYrdAXMxDNXSaWATnJBNSUfgTfFhO0KDaJIfXCpMK3fNiCmhioEuI4zaRIQLXs",
+	"th2OSOPDu67QhSfX7VTmDG9vVpwzn+Fr4ta3JWZfyMr+RD3pKA75F8U2sjSpsoVo+JYfMb5xyTST0x2x",
+	"TDPDnL8I79A3B9TMVk20Nxmq/uhBwbnqWJ3LPGHsauWseeLGV5NtHYj4HNvbQudzhJ+FHl25e0eC+GLf",
+	"yavwa74c/Wo9JUKo1gmwCXLqBCsZ4FGQHHmyLu8FNPmYiYY/zyGJhDfXe8HLp7bTNiBYEYL2Vu7srCbr",
+	"WpCWdVo/aYotPIW8I27XD2hyBLTBpU9zRX3MQy+02k0F1TMXFHfljOquXGg76zZ6SOW3vSO/7MI4L5sd",
+	"s5d6vp2OQIB02tSLZEDvaYZ2p5DrflUsnHyaX35J98aaCy6v997o3k0a7pAfhS1TzedTbitHOca9TPu3",
+	"tCNc0uENN3pE57fc6htIPo0EpQ0gvYa0A3DBbLaXm2bV0ENvPbirQs1BNgWcZgKZmYPEFCCY0svd3oyJ",
+	"6zL5KsyZC+acOTM7AiBNMxw69TTcNB3elmMxr8wxvJ0FQDu9THCpIs6mtDhOeVSeGd+2HN+1gxJO/COv",
+	"icMeGCPY7i62FnhIM6KLm9WjX4jhUFRwLF2/7Xsdk4XeWb4onKdB7ee3uRYwwKChmZ/yr/S4j7+AhL0/",
+	"yh1WmZjKJqfAiQg2jaJ6JrVnlev+UHxkrzAZCB+6CGHBpA9l+BmNguI5eXJjy0xDAl5QHH8DoXeTGs5G",
+	"RyL+jD99y0KvbfuWuw4+Nu2MgiIfe7aN/ovs27OzT36TTPeW+tbmrtLNr5LHJ8uT8tNsqKPCpv0pcb4v",
+	"SHEcLTTToLafdoflToEC3bFy59MRYdJPsSn7WMMC6BZ5WoAWQLHv+h7atelZU+oY5/Vu4Xi8tKu2tnzb",
+	"7XVunaKjtqShAP5oczYc1wF4Zi5efMfET83PnHDT64XN0LGbt3zbuhMgEB3r82bHcZtIADjGqmNbLg5m",
+	"6dgAmBz65UL3InDou45Fez9rGk7QpCQfsq9hHTQloCldhwNekuxASMVMR8OYRgKWKt/nKqhH0DYDJ5Jm",
+	"tJ0ZJRpbrJUVvjn9JZlRM/vO7DsFuhXAkRIIXFs5H0icsqSpWkW1olhZqK5wJHvX4JvXMFQd45LZkDCh",
+	"/jaLHWn5FnP9I7/3Ddr8Bt99BEDZ/3zH2apaSqqU5PSVZGGmrKloV2ImoJp56E0OtSvUmESvQXRdMmfN",
+	"ORPWpy5pXCXnJxz6J/owZhimaFQ1qckW15YX6wtXL+sHWwj1IxVz8+mfbGb6AqTBeb0wcFo2u14z2cyN",
+	"Vz3XgvxkQjcR+sBeOoDi9buz/lDu5Z7cwIBfEgMfVJdD3kRhW4QE+3wirVJroHhyKmqdXTlcOZYPQDfS",
+	"k082oi3bl9RJE/MpNF4DXR/+3WnGy02y9UPRMH288CYOqa0oIoC0oOF0uXqjhGjHzcY6vZpxY8I+iVw8",
+	"Ne32XZxXxU029ZILa7VL0kSg03Wl4jI4KdzUlItXnxEkp5iEVkg+0soSc1Tz9txqSFom6ynu8C7JNloU",
+	"fptZPBeZlZqwp6Z89jJpc04uOTOYMHMrN5NlcgloZhZjpsswU0hdjk4zRRpITMKJOWvqFmtqlzYJNwGa",
+	"p1JfYbXt1puaFgXMkNGaZ5TpWyLB/JZ92/NtSC/Xzuh6ndL29TpVQPWBE874Y/4qMxmqTIBx82ccySWP",
+	"sCt3jgyUDoWJAMYuYPr6fa30m5cnyqQdw3ayQ+pTf0F2kmt2nDUK3zQDaUp7V1EZ5elk6yjnwRpuyJ+B",
+	"WEUzopnYw3M0+I74I47nq6lT8/hIPs4v+Whp+pSxGi+Vu++TV54fc4reCUTjKM8zhwWmpJiNDE646Sdj",
+	"pYFoaUh4aQBiGgpm0ENtNjS4gV8und4qVEA3VaBNGVwzhdOUITQ1oCWW4HnwZ9fMd8yZWXPm0mT82hLf",
+	"+VU+vZXyKWeaVxNPouZgEvHVrMN9pS6SmrJRVkVolQZey3n5pwL60/LWFA0wOivrOMRAkhgSze2FuQpz",
+	"oQsDp5XeMJnJ02ogVN7uglipBNs9XbW6BtLxg6Z/ptFQjdsyjy8QS68g/pm829S8Mh/2RPDmxpYOf+RD",
+	"j76iAApocBT4eg5n/BUWwfDXpv3Ik9fGOwpD4WWoOnYCsa0yvgjIzObB53MMQZM5ZYq5HMTI8YITMZBR",
+	"PEHlJV1ri3S9sdS3V9J6N+Tj8d40SpLB0CXcU8BaAVFVsrNUZ61iQ/UnomWtLS58pOuFmqw73w/VfEWu",
+	"6LcuibkwS1k2sXdEHyDFkKZSuzPphkHRyjkxIE+aZFvMheROhWSQShwoHVxbUpDzgzJ0ZIDMcJ8abcGw",
+	"qTIWqHMjYHcm7KfYx9lVQ33xPlf/sAJPUfewxSEY7z+pLRmVpiJy6sOudipDojACMvdp+qrcphBL83Fm",
+	"1rbUCzIZ8ZY4+im946foJXwP2xU9T+Zs9UXaxGEGvPQ1u0yJXvTjL9hMrRYdxdviPilrg5ABs4Hj77B3",
+	"3G4WEG2pEmz7lXSnTyFN9IP1S7nYaQt20me8lp5B6YmgZkWUYtjqEUqboMa++w4VN/GabZ4BWYIGzTPv",
+	"6XhYzszZy568hKqq6B45RVlZhab5KHrMCilV6YDKZ5kcYSgS4JZmJAMRa9+fRVo1JBR19jjmlp++NmJs",
+	"mcgHMMdfR8flqO+/rbHWNyjY/gdKrW1OhY+z6Es4dGVBEW8Xb/wAM9KUUtOcYOMOhqLqArj+Azsc2zcL",
+	"9wGXmpSj9a1RRcdXzjUzQf6JHFSZvfwzTk3IlSJU1OjKKDeww49sN/T8YNPplmhl1A052lUaLB9Tvcox",
+	"f2Uf3V4yP91P1YlBQfdM1YFGnjWaGY9zxnBYdPwNC2zX8XwYXipnn+7m0kn3RarGNiY5PKHSbay6b9uW",
+	"79q+mTZp5t/kSrdx417IqnzDFYmhRZ01BSYyvGNgqrmkctdKzdVMDCnO2v0Fw7Ym06+iTJNbVQjkFMqc",
+	"7Vq32kJxMsfV7ZK7da67ySp+ZvKy11HdmK3nEKhuVl9xeTtFeWGax1cqt/pJsgC/K8gyTsbCbfM2uAdR",
+	"P8+QhtHurwpNfiphwlY5Z+cpd9QIQ/mlmNkO8/ywgPOjVQD+wI9d667ltK1bThuLMsu8gx/DTQu5e07F",
+	"EVqBnAg1MzVTk8JaUjuKuxY9hRqe+mHmttqccpuilJTyFP7+aoPHS3tMSGBVfdppJ+FKtZ/Jy81kUW+6",
+	"yUSGTHTH8gcU0EDIh4nQeiHE4wCk9ERDsh9fXfhkYWl54b2l5aW132rDsj0FaNa1fcdrzbMEv6zTCyAc",
+	"KyKyAtkTnd6VLp9Rvm06Y0mMGUDHEnqMoEfWIcjwt6gxxdvWYyilM1HCK6M4OxVd8roVh24FV0WmqHDV",
+	"lhVs3vIsvyTw/FP0jDd6pdETsqJm6tyPo6p3leok0PhNue6pHz9uuGpkCUvPhIdRHtQFQuSQHIsgY3Jz",
+	"Ek0FefGjUvQNGm4+rB/1JYh5FVzSlZfcjtE+m6sx/Hs/elGQ94SYv5Ige1zTGm5/LS1B4UUpmNq8FLRU",
+	"9qKDBN8aknvLT/ek+taiU+dYjOtBLTPelvCTJfbyo4jtUsbWca7obptUz5WTtVt5CzqtVJDnSq+VAln+",
+	"eklYZfNvlVDK9jepJJJKyX3D5r1uy1yReNsHyZVvgG0W9MItKKmY5HCEG9VNgwxkFbudyMUSm1hamB+W",
+	"VJwgVKjeq8BUMth/4Tb4MDpgK/W/IldbmWx5Be7JlfpfxY9GN8Wp2ke+mPADO1wKFpLUrhHsfVW6+hTG",
+	"q+QK5wNQqtLWiDy0yZmF6YteSywTXqzHzImy/UowKN40Su+qGJrLebeE7NIS7K+GVrFM454pQibPcPsd",
+	"jpp+qinoOKmJFdjhKoYDqulzq/LlpzjxQfpO/vcYvqZABriMHFJQJ+siSh77xhVJOpiTOYYTcg2tLl5d",
+	"ulYv8goluJtnDaNtW62GMfGce8jj2eWuXFo4zicb8p4zv3KdMbmOgrxi/4lIlir2qvdHsqI7Trtd1lJe",
+	"DGTZk6fmbUsKWpL8wsvcuF9EROnEZF7hzxkI/PM22Ri+RDdhNMw+Ot7hNtljKYdOvVsb+UsYJy3u1XST",
+	"h5fwF7wBHiS/uXi46qn6yFfhPB8uLS/ruQ7AN8/Ex7Z9126zhrHR83sNg932fBZaG2zDe9XlP1LH+tQN",
+	"/StrOnV3+xPqPgm3KbXwk3P7FntFKx7Av2iXaPFMpbHIBx5r+3f1zcSWvXWrzRZWlhhdA1qt3zbmjc0w",
+	"7Abz58614YJNLwjn3629W6OsLnrDPdGqi6LL983kC3q19IXS+0P6njqk3jezUEXfo6iK/xkj2bBWmPL+",
+	"EJP1cjEFjo09QH/8AMMrvBMcxdCVkhosKgT5+hTFLiAPvl9YX7e74XzDvSkqlG5CKnzuUtGj9znDOAl6",
+	"LEj2HkZP4i/horOQ5nNTV5x1s+GeoZRqkbx2jBUSvA78IN45O82iX8RH0WFPtKQ5THN2+DqOSLmgCf1J",
+	"8ixC9130wzSL/pVypRpuNIy/jgbRE4oz0GR6ysk4VGIwLMmIP4z6GfRhAGhIqV0APKzkgVpGT+EavrlS",
+	"IZK05Quu1d7Kfrl4l9ftJN/8tW21w03lRmgYDXWA/3cAzt9uwntFAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
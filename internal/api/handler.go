@@ -2,10 +2,13 @@ package api
 
 import (
 	"database/sql"
+	"errors"
 	"net/http"
 	"time"
 
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/health"
 	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/policy"
 	"github.com/AtoyanMikhail/PRAssignmentService/internal/service"
 	"github.com/gin-gonic/gin"
 )
@@ -13,12 +16,16 @@ import (
 // Handler реализует ServerInterface для обработки HTTP запросов
 type Handler struct {
 	services *service.Services
+	health   *health.Checker
+	policies *policy.Store
 }
 
 // NewHandler создает новый HTTP handler
-func NewHandler(services *service.Services) *Handler {
+func NewHandler(services *service.Services, checker *health.Checker, policies *policy.Store) *Handler {
 	return &Handler{
 		services: services,
+		health:   checker,
+		policies: policies,
 	}
 }
 
@@ -33,23 +40,28 @@ func (h *Handler) GetHealth(c *gin.Context) {
 // PostPullRequestCreate создает PR и автоматически назначает ревьюеров
 func (h *Handler) PostPullRequestCreate(c *gin.Context) {
 	var req PostPullRequestCreateJSONRequestBody
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: struct {
-				Code    ErrorResponseErrorCode `json:"code"`
-				Message string                 `json:"message"`
-			}{
-				Code:    NOTFOUND,
-				Message: "Invalid request body: " + err.Error(),
-			},
-		})
+	if !bindJSON(c, &req) {
 		return
 	}
 
-	pr, err := h.services.PullRequest.CreatePR(c.Request.Context(), req.PullRequestId, req.PullRequestName, req.AuthorId)
+	var opts service.ReviewerOptions
+	if req.ReviewerCount != nil {
+		opts.Count = *req.ReviewerCount
+	}
+	if req.PreferredReviewers != nil {
+		opts.Preferred = *req.PreferredReviewers
+	}
+	if req.ExcludedReviewers != nil {
+		opts.Excluded = *req.ExcludedReviewers
+	}
+	if req.RequiredTags != nil {
+		opts.RequiredTags = *req.RequiredTags
+	}
+
+	pr, reviewers, err := h.services.PullRequest.CreateWithReviewers(c.Request.Context(), req.PullRequestId, req.PullRequestName, req.AuthorId, opts)
 	if err != nil {
-		switch err {
-		case service.ErrPullRequestAlreadyExists:
+		switch {
+		case errors.Is(err, service.ErrPullRequestAlreadyExists):
 			c.JSON(http.StatusConflict, ErrorResponse{
 				Error: struct {
 					Code    ErrorResponseErrorCode `json:"code"`
@@ -59,7 +71,7 @@ func (h *Handler) PostPullRequestCreate(c *gin.Context) {
 					Message: "Pull request already exists",
 				},
 			})
-		case service.ErrUserNotFound:
+		case errors.Is(err, service.ErrUserNotFound):
 			c.JSON(http.StatusNotFound, ErrorResponse{
 				Error: struct {
 					Code    ErrorResponseErrorCode `json:"code"`
@@ -69,7 +81,38 @@ func (h *Handler) PostPullRequestCreate(c *gin.Context) {
 					Message: "Author not found",
 				},
 			})
+		case errors.Is(err, service.ErrInvalidReviewerOptions):
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: struct {
+					Code    ErrorResponseErrorCode `json:"code"`
+					Message string                 `json:"message"`
+				}{
+					Code:    INVALIDREVIEWERS,
+					Message: err.Error(),
+				},
+			})
+		case errors.Is(err, service.ErrInvalidSkill):
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: struct {
+					Code    ErrorResponseErrorCode `json:"code"`
+					Message string                 `json:"message"`
+				}{
+					Code:    INVALIDSKILL,
+					Message: err.Error(),
+				},
+			})
+		case errors.Is(err, service.ErrReviewerBlocked):
+			c.JSON(http.StatusConflict, ErrorResponse{
+				Error: struct {
+					Code    ErrorResponseErrorCode `json:"code"`
+					Message string                 `json:"message"`
+				}{
+					Code:    REVIEWERBLOCKED,
+					Message: err.Error(),
+				},
+			})
 		default:
+			_ = c.Error(err)
 			c.JSON(http.StatusInternalServerError, ErrorResponse{
 				Error: struct {
 					Code    ErrorResponseErrorCode `json:"code"`
@@ -83,23 +126,23 @@ func (h *Handler) PostPullRequestCreate(c *gin.Context) {
 		return
 	}
 
-	reviewers, err := h.services.Reviewer.AutoAssignReviewers(c.Request.Context(), pr.PullRequestID, 2)
-	if err != nil && err != service.ErrNoActiveReviewers {
-		c.Error(err)
-	}
-
 	assignedReviewerIDs := make([]string, 0, len(reviewers))
 	for _, r := range reviewers {
 		assignedReviewerIDs = append(assignedReviewerIDs, r.UserID)
 	}
+	assignments := reviewerAssignments(reviewers)
 
 	response := PullRequest{
-		PullRequestId:     pr.PullRequestID,
-		PullRequestName:   pr.PullRequestName,
-		AuthorId:          pr.AuthorID,
-		Status:            PullRequestStatus(pr.Status),
-		AssignedReviewers: assignedReviewerIDs,
-		CreatedAt:         &pr.CreatedAt,
+		PullRequestId:       pr.PullRequestID,
+		PullRequestName:     pr.PullRequestName,
+		AuthorId:            pr.AuthorID,
+		Status:              PullRequestStatus(pr.Status),
+		AssignedReviewers:   assignedReviewerIDs,
+		ReviewerAssignments: &assignments,
+		CreatedAt:           &pr.CreatedAt,
+	}
+	if len(pr.RequiredTags) > 0 {
+		response.RequiredTags = &pr.RequiredTags
 	}
 
 	c.JSON(http.StatusCreated, response)
@@ -108,16 +151,7 @@ func (h *Handler) PostPullRequestCreate(c *gin.Context) {
 // PostPullRequestMerge помечает PR как MERGED
 func (h *Handler) PostPullRequestMerge(c *gin.Context) {
 	var req PostPullRequestMergeJSONRequestBody
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: struct {
-				Code    ErrorResponseErrorCode `json:"code"`
-				Message string                 `json:"message"`
-			}{
-				Code:    NOTFOUND,
-				Message: "Invalid request body: " + err.Error(),
-			},
-		})
+	if !bindJSON(c, &req) {
 		return
 	}
 
@@ -153,15 +187,26 @@ func (h *Handler) PostPullRequestMerge(c *gin.Context) {
 	for _, r := range reviewers {
 		assignedReviewerIDs = append(assignedReviewerIDs, r.UserID)
 	}
+	assignments := reviewerInfoAssignments(reviewers)
 
 	response := PullRequest{
-		PullRequestId:     pr.PullRequestID,
-		PullRequestName:   pr.PullRequestName,
-		AuthorId:          pr.AuthorID,
-		Status:            PullRequestStatus(pr.Status),
-		AssignedReviewers: assignedReviewerIDs,
-		CreatedAt:         &pr.CreatedAt,
-		MergedAt:          pr.MergedAt,
+		PullRequestId:       pr.PullRequestID,
+		PullRequestName:     pr.PullRequestName,
+		AuthorId:            pr.AuthorID,
+		Status:              PullRequestStatus(pr.Status),
+		AssignedReviewers:   assignedReviewerIDs,
+		ReviewerAssignments: &assignments,
+		CreatedAt:           &pr.CreatedAt,
+		MergedAt:            pr.MergedAt,
+	}
+	tags, err := h.services.Skill.GetRequiredTags(c.Request.Context(), pr.PullRequestID)
+	if err != nil {
+		_ = c.Error(err)
+		abortWithError(c, http.StatusInternalServerError, NOTFOUND, err.Error())
+		return
+	}
+	if len(tags) > 0 {
+		response.RequiredTags = &tags
 	}
 
 	c.JSON(http.StatusOK, response)
@@ -170,23 +215,24 @@ func (h *Handler) PostPullRequestMerge(c *gin.Context) {
 // PostPullRequestReassign переназначает ревьюера
 func (h *Handler) PostPullRequestReassign(c *gin.Context) {
 	var req PostPullRequestReassignJSONRequestBody
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: struct {
-				Code    ErrorResponseErrorCode `json:"code"`
-				Message string                 `json:"message"`
-			}{
-				Code:    NOTFOUND,
-				Message: "Invalid request body: " + err.Error(),
-			},
-		})
+	if !bindJSON(c, &req) {
 		return
 	}
 
-	err := h.services.Reviewer.ReplaceReviewer(c.Request.Context(), req.PullRequestId, req.OldUserId, "")
+	var newUserID string
+	if req.NewUserId != nil {
+		newUserID = *req.NewUserId
+	}
+
+	override := toOverride(req.Override, req.OverrideReason)
+	if override.Enabled && !isAdmin(c) {
+		abortWithError(c, http.StatusForbidden, FORBIDDEN, "Override requires admin access")
+		return
+	}
+	err := h.services.Reviewer.ReplaceReviewer(actorContext(c), req.PullRequestId, req.OldUserId, newUserID, override)
 	if err != nil {
-		switch err {
-		case service.ErrPullRequestNotFound:
+		switch {
+		case errors.Is(err, service.ErrPullRequestNotFound):
 			c.JSON(http.StatusNotFound, ErrorResponse{
 				Error: struct {
 					Code    ErrorResponseErrorCode `json:"code"`
@@ -196,7 +242,7 @@ func (h *Handler) PostPullRequestReassign(c *gin.Context) {
 					Message: "Pull request not found",
 				},
 			})
-		case service.ErrReviewerNotAssigned:
+		case errors.Is(err, service.ErrReviewerNotAssigned):
 			c.JSON(http.StatusBadRequest, ErrorResponse{
 				Error: struct {
 					Code    ErrorResponseErrorCode `json:"code"`
@@ -206,7 +252,7 @@ func (h *Handler) PostPullRequestReassign(c *gin.Context) {
 					Message: "Reviewer not assigned to this PR",
 				},
 			})
-		case service.ErrNoActiveReviewers:
+		case errors.Is(err, service.ErrNoActiveReviewers):
 			c.JSON(http.StatusNotFound, ErrorResponse{
 				Error: struct {
 					Code    ErrorResponseErrorCode `json:"code"`
@@ -217,15 +263,7 @@ func (h *Handler) PostPullRequestReassign(c *gin.Context) {
 				},
 			})
 		default:
-			c.JSON(http.StatusInternalServerError, ErrorResponse{
-				Error: struct {
-					Code    ErrorResponseErrorCode `json:"code"`
-					Message string                 `json:"message"`
-				}{
-					Code:    NOTFOUND,
-					Message: err.Error(),
-				},
-			})
+			manualAssignmentError(c, err)
 		}
 		return
 	}
@@ -236,16 +274,7 @@ func (h *Handler) PostPullRequestReassign(c *gin.Context) {
 // PostTeamAdd создает команду с участниками
 func (h *Handler) PostTeamAdd(c *gin.Context) {
 	var req PostTeamAddJSONRequestBody
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: struct {
-				Code    ErrorResponseErrorCode `json:"code"`
-				Message string                 `json:"message"`
-			}{
-				Code:    NOTFOUND,
-				Message: "Invalid request body: " + err.Error(),
-			},
-		})
+	if !bindJSON(c, &req) {
 		return
 	}
 
@@ -335,16 +364,7 @@ func (h *Handler) PostTeamAdd(c *gin.Context) {
 // PostTeamDeactivate массово деактивирует всех пользователей команды и переназначает их PR
 func (h *Handler) PostTeamDeactivate(c *gin.Context) {
 	var req PostTeamDeactivateJSONRequestBody
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: struct {
-				Code    ErrorResponseErrorCode `json:"code"`
-				Message string                 `json:"message"`
-			}{
-				Code:    NOTFOUND,
-				Message: "Invalid request body: " + err.Error(),
-			},
-		})
+	if !bindJSON(c, &req) {
 		return
 	}
 
@@ -444,16 +464,19 @@ func (h *Handler) GetTeamGet(c *gin.Context, params GetTeamGetParams) {
 	members := make([]TeamMember, 0, len(users))
 	for _, user := range users {
 		isActive := user.IsActive
+		seniority := Seniority(user.Seniority)
 		members = append(members, TeamMember{
-			UserId:   user.UserID,
-			Username: user.Username,
-			IsActive: &isActive,
+			UserId:    user.UserID,
+			Username:  user.Username,
+			IsActive:  &isActive,
+			Seniority: &seniority,
 		})
 	}
 
 	response := Team{
-		TeamName: team.TeamName,
-		Members:  members,
+		TeamName:          team.TeamName,
+		MentorshipEnabled: &team.MentorshipEnabled,
+		Members:           members,
 	}
 
 	c.JSON(http.StatusOK, response)
@@ -503,16 +526,7 @@ func (h *Handler) GetUsersGetReview(c *gin.Context, params GetUsersGetReviewPara
 // PostUsersSetIsActive устанавливает флаг активности пользователя
 func (h *Handler) PostUsersSetIsActive(c *gin.Context) {
 	var req PostUsersSetIsActiveJSONRequestBody
-	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, ErrorResponse{
-			Error: struct {
-				Code    ErrorResponseErrorCode `json:"code"`
-				Message string                 `json:"message"`
-			}{
-				Code:    NOTFOUND,
-				Message: "Invalid request body: " + err.Error(),
-			},
-		})
+	if !bindJSON(c, &req) {
 		return
 	}
 
@@ -568,10 +582,12 @@ func (h *Handler) PostUsersSetIsActive(c *gin.Context) {
 		}
 	}
 
+	seniority := Seniority(updatedUser.Seniority)
 	response := User{
-		UserId:   updatedUser.UserID,
-		Username: updatedUser.Username,
-		IsActive: updatedUser.IsActive,
+		UserId:    updatedUser.UserID,
+		Username:  updatedUser.Username,
+		IsActive:  updatedUser.IsActive,
+		Seniority: &seniority,
 	}
 
 	c.JSON(http.StatusOK, response)
@@ -579,6 +595,15 @@ func (h *Handler) PostUsersSetIsActive(c *gin.Context) {
 
 // GetStatisticsAssignments возвращает статистику назначений по пользователям
 func (h *Handler) GetStatisticsAssignments(c *gin.Context) {
+	if format := exportFormat(c); format != "" {
+		w := newExportWriter(c, format,
+			"user_id", "username", "team_name", "total_assignments", "open_prs", "merged_prs")
+		w.finish(h.services.Statistics.ForEachAssignmentStats(c.Request.Context(), func(s models.AssignmentStats) error {
+			return w.write(s.UserID, s.Username, s.TeamName, s.TotalAssignments, s.OpenPRs, s.MergedPRs)
+		}))
+		return
+	}
+
 	stats, err := h.services.Statistics.GetAssignmentStats(c.Request.Context())
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, ErrorResponse{
@@ -600,6 +625,15 @@ func (h *Handler) GetStatisticsAssignments(c *gin.Context) {
 
 // GetStatisticsWorkload возвращает рабочую нагрузку активных пользователей
 func (h *Handler) GetStatisticsWorkload(c *gin.Context) {
+	if format := exportFormat(c); format != "" {
+		w := newExportWriter(c, format,
+			"user_id", "username", "team_name", "is_active", "open_reviews_count")
+		w.finish(h.services.Statistics.ForEachUserWorkload(c.Request.Context(), func(s models.UserWorkload) error {
+			return w.write(s.UserID, s.Username, s.TeamName, s.IsActive, s.OpenReviewsCount)
+		}))
+		return
+	}
+
 	workload, err := h.services.Statistics.GetUserWorkload(c.Request.Context())
 	if err != nil {
 		c.JSON(http.StatusInternalServerError, ErrorResponse{
@@ -0,0 +1,57 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/health"
+)
+
+// GetHealthLive отвечает, что процесс жив, не проверяя зависимости
+func (h *Handler) GetHealthLive(c *gin.Context) {
+	c.JSON(http.StatusOK, gin.H{
+		"status":    Ok,
+		"timestamp": time.Now().UTC().Format(time.RFC3339),
+	})
+}
+
+// GetHealthReady проверяет готовность сервиса принимать трафик
+func (h *Handler) GetHealthReady(c *gin.Context) {
+	report := h.health.Check(c.Request.Context())
+
+	status := http.StatusOK
+	if report.Status == health.StatusDown {
+		status = http.StatusServiceUnavailable
+	}
+
+	c.JSON(status, toHealthReport(report))
+}
+
+// toHealthReport преобразует результат проверок в модель API
+func toHealthReport(report health.Report) HealthReport {
+	components := make([]ComponentHealth, 0, len(report.Components))
+	for _, comp := range report.Components {
+		item := ComponentHealth{
+			Name:      comp.Name,
+			Status:    HealthStatus(comp.Status),
+			LatencyMs: float64(comp.Latency.Microseconds()) / 1000,
+		}
+		if comp.Message != "" {
+			msg := comp.Message
+			item.Message = &msg
+		}
+		if len(comp.Details) > 0 {
+			details := comp.Details
+			item.Details = &details
+		}
+		components = append(components, item)
+	}
+
+	return HealthReport{
+		Status:     HealthStatus(report.Status),
+		Timestamp:  report.Timestamp,
+		Components: components,
+	}
+}
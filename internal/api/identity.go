@@ -0,0 +1,49 @@
+package api
+
+import (
+	"crypto/tls"
+
+	"github.com/gin-gonic/gin"
+)
+
+// identityKey - ключ идентичности клиента в gin.Context
+const identityKey = "client_identity"
+
+// SetClientIdentity сохраняет идентичность клиента, определенную аутентификацией
+func SetClientIdentity(c *gin.Context, identity string) {
+	c.Set(identityKey, identity)
+}
+
+// ClientIdentity возвращает идентичность клиента.
+// Если аутентификация ее не установила, используется IP адрес клиента.
+func ClientIdentity(c *gin.Context) string {
+	if identity := c.GetString(identityKey); identity != "" {
+		return identity
+	}
+	return c.ClientIP()
+}
+
+// ClientCertIdentityMiddleware определяет идентичность клиента по CN проверенного
+// клиентского сертификата. CN, отсутствующий в identities, используется как есть.
+func ClientCertIdentityMiddleware(identities map[string]string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if identity := CertIdentity(c.Request.TLS, identities); identity != "" {
+			SetClientIdentity(c, identity)
+		}
+		c.Next()
+	}
+}
+
+// CertIdentity возвращает идентичность по CN проверенного клиентского
+// сертификата или пустую строку, если сертификата нет
+func CertIdentity(state *tls.ConnectionState, identities map[string]string) string {
+	if state == nil || len(state.VerifiedChains) == 0 {
+		return ""
+	}
+
+	cn := state.VerifiedChains[0][0].Subject.CommonName
+	if identity, ok := identities[cn]; ok {
+		return identity
+	}
+	return cn
+}
@@ -0,0 +1,106 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/service"
+)
+
+// PostUsersSetSeniority задает уровень пользователя для наставничества
+func (h *Handler) PostUsersSetSeniority(c *gin.Context) {
+	var req PostUsersSetSeniorityJSONRequestBody
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	user, err := h.services.User.SetSeniority(c.Request.Context(), req.UserId, models.Seniority(req.Seniority))
+	if err != nil {
+		status, code, message := http.StatusInternalServerError, NOTFOUND, err.Error()
+		switch {
+		case errors.Is(err, service.ErrInvalidSeniority):
+			status, code = http.StatusBadRequest, INVALIDSENIORITY
+		case errors.Is(err, service.ErrUserNotFound):
+			status, message = http.StatusNotFound, "User not found"
+		default:
+			_ = c.Error(err)
+		}
+		c.JSON(status, ErrorResponse{
+			Error: struct {
+				Code    ErrorResponseErrorCode `json:"code"`
+				Message string                 `json:"message"`
+			}{
+				Code:    code,
+				Message: message,
+			},
+		})
+		return
+	}
+
+	userWithTeam, err := h.services.User.GetUserWithTeam(c.Request.Context(), user.UserID)
+	if err != nil {
+		_ = c.Error(err)
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: struct {
+				Code    ErrorResponseErrorCode `json:"code"`
+				Message string                 `json:"message"`
+			}{
+				Code:    NOTFOUND,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	seniority := Seniority(userWithTeam.Seniority)
+	c.JSON(http.StatusOK, User{
+		UserId:    userWithTeam.UserID,
+		Username:  userWithTeam.Username,
+		TeamName:  userWithTeam.TeamName,
+		IsActive:  userWithTeam.IsActive,
+		Seniority: &seniority,
+	})
+}
+
+// PostTeamSetMentorship включает или выключает наставничество в команде
+func (h *Handler) PostTeamSetMentorship(c *gin.Context) {
+	var req PostTeamSetMentorshipJSONRequestBody
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	team, err := h.services.Team.SetMentorship(c.Request.Context(), req.TeamName, req.Enabled)
+	if err != nil {
+		if errors.Is(err, service.ErrTeamNotFound) {
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error: struct {
+					Code    ErrorResponseErrorCode `json:"code"`
+					Message string                 `json:"message"`
+				}{
+					Code:    NOTFOUND,
+					Message: "Team not found",
+				},
+			})
+			return
+		}
+		_ = c.Error(err)
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: struct {
+				Code    ErrorResponseErrorCode `json:"code"`
+				Message string                 `json:"message"`
+			}{
+				Code:    NOTFOUND,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"team_name":          team.TeamName,
+		"mentorship_enabled": team.MentorshipEnabled,
+	})
+}
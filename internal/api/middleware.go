@@ -1,16 +1,49 @@
 package api
 
 import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+	"unicode"
+
 	"github.com/AtoyanMikhail/PRAssignmentService/internal/logger"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/metrics"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/policy"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/ratelimit"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/service"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
 )
 
+// RequestIDHeader - заголовок с идентификатором запроса
+const RequestIDHeader = "X-Request-ID"
+
+// IdempotencyKeyHeader - заголовок с ключом идемпотентности изменяющего запроса
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotentReplayedHeader отмечает ответ, сохраненный для первого запроса с ключом
+const IdempotentReplayedHeader = "Idempotent-Replayed"
+
+// maxRequestIDLength ограничивает длину принимаемого от клиента идентификатора
+const maxRequestIDLength = 128
+
 // CORSMiddleware добавляет CORS заголовки для работы с браузерными клиентами
 func CORSMiddleware() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.Writer.Header().Set("Access-Control-Allow-Origin", "*")
 		c.Writer.Header().Set("Access-Control-Allow-Credentials", "true")
-		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With")
+		c.Writer.Header().Set("Access-Control-Allow-Headers", "Content-Type, Content-Length, Accept-Encoding, X-CSRF-Token, Authorization, accept, origin, Cache-Control, X-Requested-With, X-Request-ID, Idempotency-Key")
+		c.Writer.Header().Set("Access-Control-Expose-Headers", RequestIDHeader+", "+IdempotentReplayedHeader)
 		c.Writer.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS, GET, PUT, DELETE, PATCH")
 
 		if c.Request.Method == "OPTIONS" {
@@ -22,10 +55,301 @@ func CORSMiddleware() gin.HandlerFunc {
 	}
 }
 
-// LoggingMiddleware логирует входящие запросы
+// LoggingMiddleware назначает запросу X-Request-ID, кладет логгер запроса
+// в context.Context и после обработки логирует результат и ошибки из c.Errors
 func LoggingMiddleware(log *logger.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		log.Infof("Request: %s %s", c.Request.Method, c.Request.URL.Path)
+		start := time.Now()
+
+		requestID := c.GetHeader(RequestIDHeader)
+		if !ValidRequestID(requestID) {
+			requestID = uuid.NewString()
+		}
+		c.Header(RequestIDHeader, requestID)
+
+		fields := []zap.Field{zap.String("request_id", requestID)}
+		if sc := trace.SpanContextFromContext(c.Request.Context()); sc.HasTraceID() {
+			fields = append(fields, zap.String("trace_id", sc.TraceID().String()))
+		}
+		reqLog := log.With(fields...)
+		c.Request = c.Request.WithContext(logger.WithContext(c.Request.Context(), reqLog))
+
 		c.Next()
+
+		status := c.Writer.Status()
+		fields = []zap.Field{
+			zap.String("method", c.Request.Method),
+			zap.String("path", c.Request.URL.Path),
+			zap.String("route", c.FullPath()),
+			zap.Int("status", status),
+			zap.Duration("latency", time.Since(start)),
+			zap.Int("size", c.Writer.Size()),
+			zap.String("client", ClientIdentity(c)),
+		}
+		if len(c.Errors) > 0 {
+			fields = append(fields, zap.Strings("errors", c.Errors.Errors()))
+		}
+
+		switch {
+		case status >= 500 || len(c.Errors) > 0:
+			reqLog.Error("Request completed", fields...)
+		case status >= 400:
+			reqLog.Warn("Request completed", fields...)
+		default:
+			reqLog.Info("Request completed", fields...)
+		}
+	}
+}
+
+// RecoveryMiddleware перехватывает панику в обработчике и логирует ее логгером запроса
+func RecoveryMiddleware() gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(io.Discard, func(c *gin.Context, recovered any) {
+		logger.FromContext(c.Request.Context()).Error("Panic recovered",
+			zap.Any("panic", recovered),
+			zap.String("method", c.Request.Method),
+			zap.String("path", c.Request.URL.Path),
+			zap.Stack("stack"),
+		)
+		c.AbortWithStatus(http.StatusInternalServerError)
+	})
+}
+
+// PolicyMiddleware фиксирует политику назначения на время запроса:
+// обновление политики не влияет на уже начатые запросы
+func PolicyMiddleware(policies *policy.Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := policy.WithSnapshot(c.Request.Context(), policies.Current())
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// ValidRequestID проверяет идентификатор запроса, пришедший от клиента:
+// непустая строка печатных ASCII символов без пробелов до 128 символов
+func ValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for _, r := range id {
+		if r > unicode.MaxASCII || !unicode.IsPrint(r) || r == ' ' {
+			return false
+		}
+	}
+	return true
+}
+
+// MetricsMiddleware собирает RED метрики по операциям OpenAPI
+func MetricsMiddleware(m *metrics.Metrics) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		m.HTTPRequestStarted()
+
+		c.Next()
+
+		operation := OperationName(c.Request.Method, c.FullPath())
+		m.HTTPRequestFinished(operation, c.Request.Method, c.Writer.Status(), time.Since(start))
+	}
+}
+
+// RateLimitMiddleware ограничивает частоту запросов клиента к маршруту.
+// При превышении отвечает 429 с заголовком Retry-After. Маршруты из exempt
+// (пробы и метрики) не ограничиваются.
+func RateLimitMiddleware(l *ratelimit.Limiter, m *metrics.Metrics, exempt ...string) gin.HandlerFunc {
+	skip := make(map[string]bool, len(exempt))
+	for _, route := range exempt {
+		skip[route] = true
+	}
+
+	return func(c *gin.Context) {
+		route := c.FullPath()
+		if skip[route] {
+			c.Next()
+			return
+		}
+
+		ok, retryAfter := l.Allow(ClientIdentity(c), route)
+		if ok {
+			c.Next()
+			return
+		}
+
+		if route == "" {
+			route = unknownOperation
+		}
+		m.RateLimited(route)
+
+		seconds := int(math.Ceil(retryAfter.Seconds()))
+		if seconds < 1 {
+			seconds = 1
+		}
+		c.Header("Retry-After", strconv.Itoa(seconds))
+		c.AbortWithStatusJSON(http.StatusTooManyRequests, ErrorResponse{
+			Error: struct {
+				Code    ErrorResponseErrorCode `json:"code"`
+				Message string                 `json:"message"`
+			}{
+				Code:    RATELIMITED,
+				Message: "Rate limit exceeded, retry in " + strconv.Itoa(seconds) + "s",
+			},
+		})
 	}
 }
+
+// BodySizeMiddleware ограничивает размер тела запроса. Запрос с заявленной
+// длиной больше лимита сразу получает 413, а тело без длины обрезается
+// http.MaxBytesReader, и его разбор завершается ошибкой.
+func BodySizeMiddleware(limit int64) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.Request.ContentLength > limit {
+			c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, ErrorResponse{
+				Error: struct {
+					Code    ErrorResponseErrorCode `json:"code"`
+					Message string                 `json:"message"`
+				}{
+					Code:    PAYLOADTOOLARGE,
+					Message: "Request body exceeds " + strconv.FormatInt(limit, 10) + " bytes",
+				},
+			})
+			return
+		}
+
+		if c.Request.Body != nil {
+			c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, limit)
+		}
+		c.Next()
+	}
+}
+
+// IdempotencyMiddleware выполняет изменяющий запрос с заголовком
+// Idempotency-Key один раз для клиента: повтор с тем же ключом получает
+// сохраненный ответ. Ответы 5xx не сохраняются, и ключ освобождается.
+func IdempotencyMiddleware(svc service.IdempotencyService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(IdempotencyKeyHeader)
+		if key == "" || (c.Request.Method != http.MethodPost && c.Request.Method != http.MethodPut) {
+			c.Next()
+			return
+		}
+		if !ValidRequestID(key) {
+			abortWithError(c, http.StatusBadRequest, NOTFOUND, "Invalid Idempotency-Key header")
+			return
+		}
+
+		body, err := io.ReadAll(c.Request.Body)
+		if err != nil {
+			var tooLarge *http.MaxBytesError
+			if errors.As(err, &tooLarge) {
+				abortWithError(c, http.StatusRequestEntityTooLarge, PAYLOADTOOLARGE,
+					"Request body exceeds "+strconv.FormatInt(tooLarge.Limit, 10)+" bytes")
+				return
+			}
+			abortWithError(c, http.StatusBadRequest, NOTFOUND, "Invalid request body: "+err.Error())
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+		ctx := c.Request.Context()
+		client := ClientIdentity(c)
+		stored, err := svc.Begin(ctx, client, key, requestHash(c.Request, body))
+		switch {
+		case errors.Is(err, service.ErrIdempotencyKeyReused):
+			abortWithError(c, http.StatusUnprocessableEntity, IDEMPOTENCYKEYREUSED,
+				"Idempotency key was used for a different request")
+			return
+		case errors.Is(err, service.ErrIdempotencyInProgress):
+			abortWithError(c, http.StatusConflict, IDEMPOTENCYINPROGRESS,
+				"Request with this idempotency key is in progress")
+			return
+		case err != nil:
+			_ = c.Error(err)
+			abortWithError(c, http.StatusInternalServerError, NOTFOUND, "Internal server error")
+			return
+		case stored != nil:
+			c.Header(IdempotentReplayedHeader, "true")
+			c.Data(stored.StatusCode, stored.ContentType, stored.Body)
+			c.Abort()
+			return
+		}
+
+		recorder := &responseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+		c.Next()
+
+		// Ответ сохраняется, даже если клиент уже отключился
+		ctx = context.WithoutCancel(ctx)
+		status := recorder.Status()
+		if status >= http.StatusInternalServerError {
+			err = svc.Release(ctx, client, key)
+		} else {
+			err = svc.Complete(ctx, client, key, models.IdempotentResponse{
+				StatusCode:  status,
+				ContentType: recorder.Header().Get("Content-Type"),
+				Body:        recorder.body.Bytes(),
+			})
+		}
+		if err != nil {
+			_ = c.Error(err)
+		}
+	}
+}
+
+// requestHash связывает ключ идемпотентности с методом, адресом и телом запроса
+func requestHash(r *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(r.Method + " " + r.URL.RequestURI() + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// responseRecorder копирует тело ответа для сохранения
+type responseRecorder struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *responseRecorder) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *responseRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
+
+// bindJSON разбирает тело запроса в req. При ошибке отвечает 413, если тело
+// превысило лимит BodySizeMiddleware, иначе 400, и возвращает false.
+func bindJSON(c *gin.Context, req any) bool {
+	err := c.ShouldBindJSON(req)
+	if err == nil {
+		return true
+	}
+	if !abortIfTooLarge(c, err) {
+		abortWithError(c, http.StatusBadRequest, NOTFOUND, "Invalid request body: "+err.Error())
+	}
+	return false
+}
+
+// abortIfTooLarge отвечает 413, если чтение тела прервал лимит BodySizeMiddleware
+func abortIfTooLarge(c *gin.Context, err error) bool {
+	var maxBytesErr *http.MaxBytesError
+	if !errors.As(err, &maxBytesErr) {
+		return false
+	}
+	abortWithError(c, http.StatusRequestEntityTooLarge, PAYLOADTOOLARGE,
+		"Request body exceeds "+strconv.FormatInt(maxBytesErr.Limit, 10)+" bytes")
+	return true
+}
+
+// abortWithError прерывает обработку запроса ответом ErrorResponse
+func abortWithError(c *gin.Context, status int, code ErrorResponseErrorCode, message string) {
+	c.AbortWithStatusJSON(status, ErrorResponse{
+		Error: struct {
+			Code    ErrorResponseErrorCode `json:"code"`
+			Message string                 `json:"message"`
+		}{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
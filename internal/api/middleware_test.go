@@ -0,0 +1,401 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/config"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/logger"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/ratelimit"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/service"
+)
+
+func newLoggingRouter(buf *bytes.Buffer, handler gin.HandlerFunc) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(LoggingMiddleware(logger.New(buf, "debug", "json")))
+	router.Use(RecoveryMiddleware())
+	router.GET("/test", handler)
+	return router
+}
+
+// decodeLogLines разбирает JSON логи построчно
+func decodeLogLines(t *testing.T, buf *bytes.Buffer) []map[string]any {
+	t.Helper()
+	var lines []map[string]any
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var line map[string]any
+		require.NoError(t, dec.Decode(&line))
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func TestLoggingMiddleware_PropagatesRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	router := newLoggingRouter(&buf, func(c *gin.Context) {
+		logger.FromContext(c.Request.Context()).Info("handler called")
+		c.Status(http.StatusNoContent)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/test", nil)
+	req.Header.Set(RequestIDHeader, "req-42")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, "req-42", w.Header().Get(RequestIDHeader))
+
+	lines := decodeLogLines(t, &buf)
+	require.Len(t, lines, 2)
+	assert.Equal(t, "handler called", lines[0]["msg"])
+	assert.Equal(t, "req-42", lines[0]["request_id"])
+	assert.Equal(t, "Request completed", lines[1]["msg"])
+	assert.Equal(t, "req-42", lines[1]["request_id"])
+	assert.EqualValues(t, http.StatusNoContent, lines[1]["status"])
+	assert.Equal(t, "/test", lines[1]["route"])
+}
+
+func TestLoggingMiddleware_GeneratesRequestID(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+	}{
+		{"заголовок отсутствует", ""},
+		{"недопустимые символы", "bad id\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			router := newLoggingRouter(&buf, func(c *gin.Context) {
+				c.Status(http.StatusOK)
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/test", nil)
+			if tt.header != "" {
+				req.Header.Set(RequestIDHeader, tt.header)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			id := w.Header().Get(RequestIDHeader)
+			assert.Len(t, id, 36)
+			assert.NotEqual(t, tt.header, id)
+		})
+	}
+}
+
+func TestLoggingMiddleware_LogsContextErrors(t *testing.T) {
+	var buf bytes.Buffer
+	router := newLoggingRouter(&buf, func(c *gin.Context) {
+		_ = c.Error(assert.AnError)
+		c.Status(http.StatusOK)
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/test", nil))
+
+	lines := decodeLogLines(t, &buf)
+	require.Len(t, lines, 1)
+	assert.Equal(t, "ERROR", lines[0]["level"])
+	assert.Equal(t, []any{assert.AnError.Error()}, lines[0]["errors"])
+}
+
+func TestRecoveryMiddleware_LogsPanic(t *testing.T) {
+	var buf bytes.Buffer
+	router := newLoggingRouter(&buf, func(c *gin.Context) {
+		panic("boom")
+	})
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/test", nil))
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+
+	lines := decodeLogLines(t, &buf)
+	require.Len(t, lines, 2)
+	assert.Equal(t, "Panic recovered", lines[0]["msg"])
+	assert.Equal(t, lines[0]["request_id"], lines[1]["request_id"])
+}
+
+func TestRateLimitMiddleware(t *testing.T) {
+	limiter, err := ratelimit.New(config.RateLimitConfig{RPS: 0.5, Burst: 1, IdleTimeout: time.Minute})
+	require.NoError(t, err)
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(RateLimitMiddleware(limiter, nil, "/health/live"))
+	router.POST("/pullRequest/create", func(c *gin.Context) { c.Status(http.StatusCreated) })
+	router.GET("/health/live", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	do := func(method, path string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(method, path, nil))
+		return w
+	}
+
+	assert.Equal(t, http.StatusCreated, do(http.MethodPost, "/pullRequest/create").Code)
+
+	w := do(http.MethodPost, "/pullRequest/create")
+	assert.Equal(t, http.StatusTooManyRequests, w.Code)
+	assert.Equal(t, "2", w.Header().Get("Retry-After"))
+
+	var resp ErrorResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, RATELIMITED, resp.Error.Code)
+
+	// Пробы не ограничиваются
+	for range 3 {
+		assert.Equal(t, http.StatusOK, do(http.MethodGet, "/health/live").Code)
+	}
+}
+
+func TestAdminMiddleware(t *testing.T) {
+	const token = "admin-token-0123456789"
+	auth := NewAdminAuth(config.AdminConfig{Identities: []string{"ops"}, Token: token})
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		if identity := c.GetHeader("X-Test-Identity"); identity != "" {
+			SetClientIdentity(c, identity)
+		}
+	})
+	adminMiddleware, err := AdminMiddleware(auth)
+	require.NoError(t, err)
+	router.Use(adminMiddleware)
+	handler := func(c *gin.Context) { c.JSON(http.StatusOK, gin.H{"admin": isAdmin(c)}) }
+	router.PUT("/admin/policy", handler)
+	router.POST("/rules/add", handler)
+	router.GET("/rules/list", handler)
+
+	tests := []struct {
+		name          string
+		method, path  string
+		identity      string
+		authorization string
+		status        int
+		code          ErrorResponseErrorCode
+	}{
+		{"no credentials", http.MethodPut, "/admin/policy", "", "", http.StatusUnauthorized, UNAUTHORIZED},
+		{"wrong token", http.MethodPut, "/admin/policy", "", "Bearer wrong-token", http.StatusForbidden, FORBIDDEN},
+		{"not a bearer token", http.MethodPost, "/rules/add", "", token, http.StatusForbidden, FORBIDDEN},
+		{"identity not in list", http.MethodPost, "/rules/add", "ci", "", http.StatusForbidden, FORBIDDEN},
+		{"token", http.MethodPut, "/admin/policy", "", "Bearer " + token, http.StatusOK, ""},
+		{"identity in list", http.MethodPost, "/rules/add", "ops", "", http.StatusOK, ""},
+		{"public route", http.MethodGet, "/rules/list", "", "", http.StatusOK, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			if tt.identity != "" {
+				req.Header.Set("X-Test-Identity", tt.identity)
+			}
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.status, w.Code)
+			if tt.code != "" {
+				var resp ErrorResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+				assert.Equal(t, tt.code, resp.Error.Code)
+			}
+		})
+	}
+
+	// Без идентичностей и токена администраторов нет
+	closed := NewAdminAuth(config.AdminConfig{})
+	assert.ErrorIs(t, closed.Check("", "Bearer "), ErrAdminAccessDenied)
+	assert.ErrorIs(t, closed.Check("", ""), ErrAdminCredentialsRequired)
+}
+
+func TestBodySizeMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(BodySizeMiddleware(16))
+	router.POST("/test", func(c *gin.Context) {
+		var body map[string]any
+		if !bindJSON(c, &body) {
+			return
+		}
+		c.Status(http.StatusOK)
+	})
+
+	t.Run("в пределах лимита", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/test", strings.NewReader(`{"a":1}`)))
+		assert.Equal(t, http.StatusOK, w.Code)
+	})
+
+	t.Run("заявленная длина больше лимита", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/test", strings.NewReader(`{"a":"0123456789abcdef"}`)))
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	})
+
+	t.Run("тело без длины обрезается", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/test", strings.NewReader(`{"a":"0123456789abcdef"}`))
+		req.ContentLength = -1
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+		assert.Contains(t, w.Body.String(), "PAYLOAD_TOO_LARGE")
+	})
+
+	t.Run("некорректный JSON", func(t *testing.T) {
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/test", strings.NewReader(`{"a":`)))
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+	})
+}
+
+func TestClientIdentity_TrustedProxies(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	identity := func(trusted []string) string {
+		router := gin.New()
+		require.NoError(t, router.SetTrustedProxies(trusted))
+		var got string
+		router.GET("/test", func(c *gin.Context) { got = ClientIdentity(c) })
+
+		req := httptest.NewRequest(http.MethodGet, "/test", nil)
+		req.RemoteAddr = "10.0.0.5:4321"
+		req.Header.Set("X-Forwarded-For", "203.0.113.7")
+		router.ServeHTTP(httptest.NewRecorder(), req)
+		return got
+	}
+
+	// Без доверенных прокси подмена X-Forwarded-For не меняет идентичность
+	assert.Equal(t, "10.0.0.5", identity([]string{}))
+	assert.Equal(t, "203.0.113.7", identity([]string{"10.0.0.0/8"}))
+}
+
+// fakeIdempotencyRepository хранит ключи идемпотентности в памяти
+type fakeIdempotencyRepository struct {
+	records    map[string]models.IdempotencyRecord
+	reservedAt map[string]time.Time
+}
+
+func (r *fakeIdempotencyRepository) ReserveIdempotencyKey(_ context.Context, client, key, requestHash string, lease time.Duration) (bool, error) {
+	if record, ok := r.records[client+"/"+key]; ok {
+		stale := record.Response == nil && record.RequestHash == requestHash &&
+			time.Since(r.reservedAt[client+"/"+key]) > lease
+		if !stale {
+			return false, nil
+		}
+	}
+	r.records[client+"/"+key] = models.IdempotencyRecord{RequestHash: requestHash}
+	r.reservedAt[client+"/"+key] = time.Now()
+	return true, nil
+}
+
+func (r *fakeIdempotencyRepository) GetIdempotencyKey(_ context.Context, client, key string) (models.IdempotencyRecord, error) {
+	return r.records[client+"/"+key], nil
+}
+
+func (r *fakeIdempotencyRepository) CompleteIdempotencyKey(_ context.Context, client, key string, resp models.IdempotentResponse) error {
+	record := r.records[client+"/"+key]
+	record.Response = &resp
+	r.records[client+"/"+key] = record
+	return nil
+}
+
+func (r *fakeIdempotencyRepository) ReleaseIdempotencyKey(_ context.Context, client, key string) error {
+	delete(r.records, client+"/"+key)
+	return nil
+}
+
+func (r *fakeIdempotencyRepository) DeleteIdempotencyKeysBefore(context.Context, time.Time) (int64, error) {
+	return 0, nil
+}
+
+func TestIdempotencyMiddleware(t *testing.T) {
+	repo := &fakeIdempotencyRepository{
+		records:    map[string]models.IdempotencyRecord{},
+		reservedAt: map[string]time.Time{},
+	}
+	calls := 0
+	status := http.StatusCreated
+
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(IdempotencyMiddleware(service.NewIdempotencyService(repo, time.Minute)))
+	router.POST("/test", func(c *gin.Context) {
+		calls++
+		c.JSON(status, gin.H{"call": calls})
+	})
+
+	do := func(key, body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/test", strings.NewReader(body))
+		if key != "" {
+			req.Header.Set(IdempotencyKeyHeader, key)
+		}
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+		return w
+	}
+
+	first := do("key-1", `{"a":1}`)
+	assert.Equal(t, http.StatusCreated, first.Code)
+
+	// Повтор получает сохраненный ответ без повторного выполнения
+	replay := do("key-1", `{"a":1}`)
+	assert.Equal(t, http.StatusCreated, replay.Code)
+	assert.Equal(t, first.Body.String(), replay.Body.String())
+	assert.Equal(t, "true", replay.Header().Get(IdempotentReplayedHeader))
+	assert.Equal(t, 1, calls)
+
+	// Тот же ключ с другим телом
+	reused := do("key-1", `{"a":2}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, reused.Code)
+	assert.Contains(t, reused.Body.String(), "IDEMPOTENCY_KEY_REUSED")
+
+	// Без ключа запрос выполняется каждый раз
+	do("", `{"a":1}`)
+	do("", `{"a":1}`)
+	assert.Equal(t, 3, calls)
+
+	// Ответ 5xx не сохраняется, повтор выполняет запрос заново
+	status = http.StatusInternalServerError
+	assert.Equal(t, http.StatusInternalServerError, do("key-2", `{}`).Code)
+	status = http.StatusOK
+	assert.Equal(t, http.StatusOK, do("key-2", `{}`).Code)
+	assert.Equal(t, 5, calls)
+
+	// Незавершенный запрос с тем же ключом
+	pending := requestHash(httptest.NewRequest(http.MethodPost, "/test", nil), []byte(`{}`))
+	repo.records["192.0.2.1/key-3"] = models.IdempotencyRecord{RequestHash: pending}
+	repo.reservedAt["192.0.2.1/key-3"] = time.Now()
+	assert.Equal(t, http.StatusConflict, do("key-3", `{}`).Code)
+
+	// Аренда незавершенного запроса истекла (процесс упал): повтор занимает
+	// ключ и выполняет запрос заново
+	repo.reservedAt["192.0.2.1/key-3"] = time.Now().Add(-2 * time.Minute)
+	assert.Equal(t, http.StatusOK, do("key-3", `{}`).Code)
+	assert.Equal(t, 6, calls)
+	assert.Equal(t, http.StatusOK, do("key-3", `{}`).Code)
+	assert.Equal(t, 6, calls, "ответ занявшего ключ запроса сохранен")
+
+	// Просроченный ключ с другим телом не занимается
+	repo.records["192.0.2.1/key-4"] = models.IdempotencyRecord{RequestHash: pending}
+	repo.reservedAt["192.0.2.1/key-4"] = time.Now().Add(-2 * time.Minute)
+	assert.Equal(t, http.StatusUnprocessableEntity, do("key-4", `{"a":1}`).Code)
+
+	assert.Equal(t, http.StatusBadRequest, do("bad key", `{}`).Code)
+}
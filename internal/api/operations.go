@@ -0,0 +1,67 @@
+package api
+
+import (
+	"strings"
+	"unicode"
+)
+
+// unknownOperation используется для маршрутов вне OpenAPI спецификации
+const unknownOperation = "unknown"
+
+// operationNames сопоставляет "METHOD /path" с именем операции OpenAPI
+var operationNames = loadOperationNames()
+
+// OperationName возвращает имя операции OpenAPI для метода и шаблона маршрута.
+// Имя совпадает с именем метода ServerInterface, например PostPullRequestCreate.
+func OperationName(method, path string) string {
+	if name, ok := operationNames[method+" "+path]; ok {
+		return name
+	}
+	return unknownOperation
+}
+
+// loadOperationNames строит таблицу операций из встроенной спецификации
+func loadOperationNames() map[string]string {
+	names := make(map[string]string)
+
+	swagger, err := GetSwagger()
+	if err != nil {
+		return names
+	}
+
+	for path, item := range swagger.Paths.Map() {
+		for method, op := range item.Operations() {
+			name := op.OperationID
+			if name == "" {
+				name = defaultOperationName(method, path)
+			}
+			names[strings.ToUpper(method)+" "+path] = name
+		}
+	}
+
+	return names
+}
+
+// defaultOperationName повторяет правило именования oapi-codegen
+// для операций без operationId: метод + сегменты пути в CamelCase
+func defaultOperationName(method, path string) string {
+	var b strings.Builder
+	b.WriteString(upperFirst(strings.ToLower(method)))
+
+	for _, segment := range strings.FieldsFunc(path, func(r rune) bool {
+		return r == '/' || r == '-' || r == '_' || r == '{' || r == '}'
+	}) {
+		b.WriteString(upperFirst(segment))
+	}
+
+	return b.String()
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	r := []rune(s)
+	r[0] = unicode.ToUpper(r[0])
+	return string(r)
+}
@@ -0,0 +1,143 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/service"
+)
+
+// PostPullRequestPreviewReviewers показывает, кого назначит сервис, ничего не записывая
+func (h *Handler) PostPullRequestPreviewReviewers(c *gin.Context) {
+	var req PostPullRequestPreviewReviewersJSONRequestBody
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	previewReq := service.PreviewRequest{AuthorID: req.AuthorId}
+	if req.PullRequestId != nil {
+		previewReq.PullRequestID = *req.PullRequestId
+	}
+	if req.ReviewerCount != nil {
+		previewReq.Count = *req.ReviewerCount
+	}
+	if req.ExcludedReviewers != nil {
+		previewReq.Excluded = *req.ExcludedReviewers
+	}
+	if req.RequiredTags != nil {
+		previewReq.RequiredTags = *req.RequiredTags
+	}
+
+	preview, err := h.services.Reviewer.PreviewReviewers(c.Request.Context(), previewReq)
+	if err != nil {
+		switch {
+		case errors.Is(err, service.ErrUserNotFound):
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error: struct {
+					Code    ErrorResponseErrorCode `json:"code"`
+					Message string                 `json:"message"`
+				}{
+					Code:    NOTFOUND,
+					Message: "Author not found",
+				},
+			})
+		case errors.Is(err, service.ErrPullRequestNotFound):
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error: struct {
+					Code    ErrorResponseErrorCode `json:"code"`
+					Message string                 `json:"message"`
+				}{
+					Code:    NOTFOUND,
+					Message: "Pull request not found",
+				},
+			})
+		case errors.Is(err, service.ErrInvalidReviewerOptions):
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: struct {
+					Code    ErrorResponseErrorCode `json:"code"`
+					Message string                 `json:"message"`
+				}{
+					Code:    INVALIDREVIEWERS,
+					Message: err.Error(),
+				},
+			})
+		case errors.Is(err, service.ErrInvalidSkill):
+			c.JSON(http.StatusBadRequest, ErrorResponse{
+				Error: struct {
+					Code    ErrorResponseErrorCode `json:"code"`
+					Message string                 `json:"message"`
+				}{
+					Code:    INVALIDSKILL,
+					Message: err.Error(),
+				},
+			})
+		default:
+			_ = c.Error(err)
+			c.JSON(http.StatusInternalServerError, ErrorResponse{
+				Error: struct {
+					Code    ErrorResponseErrorCode `json:"code"`
+					Message string                 `json:"message"`
+				}{
+					Code:    NOTFOUND,
+					Message: err.Error(),
+				},
+			})
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toReviewerPreview(preview))
+}
+
+// toReviewerPreview преобразует результат предпросмотра в модель API
+func toReviewerPreview(preview service.Preview) ReviewerPreview {
+	resp := ReviewerPreview{
+		AuthorId:      preview.Author.UserID,
+		TeamName:      preview.Author.TeamName,
+		Strategy:      preview.Strategy,
+		PolicyVersion: preview.PolicyVersion,
+		ReviewerCount: preview.Count,
+		RequiredTags:  preview.RequiredTags,
+		Mentorship:    preview.Mentorship,
+		Selected:      make([]string, 0, len(preview.Selected)),
+		Candidates:    make([]RankedCandidate, 0, len(preview.Ranked)),
+		Excluded:      make([]ExcludedCandidate, 0, len(preview.Excluded)),
+	}
+
+	for _, user := range preview.Selected {
+		resp.Selected = append(resp.Selected, user.UserID)
+	}
+
+	for i, candidate := range preview.Ranked {
+		breakdown := make([]ScoreComponent, 0, len(candidate.Breakdown))
+		for _, component := range candidate.Breakdown {
+			breakdown = append(breakdown, ScoreComponent{
+				Name:  ScoreComponentName(component.Name),
+				Value: component.Value,
+			})
+		}
+		seniority := Seniority(candidate.User.Seniority)
+		resp.Candidates = append(resp.Candidates, RankedCandidate{
+			Rank:      i + 1,
+			UserId:    candidate.User.UserID,
+			Username:  candidate.User.Username,
+			Workload:  int(candidate.Workload),
+			Seniority: &seniority,
+			Score:     candidate.Score,
+			Breakdown: breakdown,
+		})
+	}
+
+	for _, excluded := range preview.Excluded {
+		resp.Excluded = append(resp.Excluded, ExcludedCandidate{
+			UserId:   excluded.User.UserID,
+			Username: excluded.User.Username,
+			Workload: int(excluded.Workload),
+			Reason:   ExcludedCandidateReason(excluded.Reason),
+		})
+	}
+
+	return resp
+}
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/service"
+)
+
+// GetPullRequestReviewers возвращает ревьюеров PR с причинами назначения
+func (h *Handler) GetPullRequestReviewers(c *gin.Context, params GetPullRequestReviewersParams) {
+	reviewers, err := h.services.Reviewer.GetPRReviewers(c.Request.Context(), params.PullRequestId)
+	if err != nil {
+		if errors.Is(err, service.ErrPullRequestNotFound) {
+			c.JSON(http.StatusNotFound, ErrorResponse{
+				Error: struct {
+					Code    ErrorResponseErrorCode `json:"code"`
+					Message string                 `json:"message"`
+				}{
+					Code:    NOTFOUND,
+					Message: "Pull request not found",
+				},
+			})
+			return
+		}
+		_ = c.Error(err)
+		c.JSON(http.StatusInternalServerError, ErrorResponse{
+			Error: struct {
+				Code    ErrorResponseErrorCode `json:"code"`
+				Message string                 `json:"message"`
+			}{
+				Code:    NOTFOUND,
+				Message: err.Error(),
+			},
+		})
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"pull_request_id": params.PullRequestId,
+		"reviewers":       reviewerInfoAssignments(reviewers),
+	})
+}
+
+// toReviewerAssignment преобразует причину назначения в модель API
+func toReviewerAssignment(userID string, username *string, assignment models.Assignment, assignedAt time.Time) ReviewerAssignment {
+	return ReviewerAssignment{
+		UserId:               userID,
+		Username:             username,
+		Source:               ReviewerAssignmentSource(assignment.Source),
+		Strategy:             assignment.StrategyToDB(),
+		WorkloadAtAssignment: assignment.WorkloadAtAssignment,
+		TieBreak:             assignment.TieBreak,
+		AssignedAt:           assignedAt,
+	}
+}
+
+// reviewerAssignments описывает только что назначенных ревьюеров
+func reviewerAssignments(reviewers []models.PRReviewer) []ReviewerAssignment {
+	assignments := make([]ReviewerAssignment, 0, len(reviewers))
+	for _, r := range reviewers {
+		assignments = append(assignments, toReviewerAssignment(r.UserID, nil, r.Assignment, r.AssignedAt))
+	}
+	return assignments
+}
+
+// reviewerInfoAssignments описывает ревьюеров PR вместе с их именами
+func reviewerInfoAssignments(reviewers []models.ReviewerInfo) []ReviewerAssignment {
+	assignments := make([]ReviewerAssignment, 0, len(reviewers))
+	for _, r := range reviewers {
+		username := r.Username
+		assignments = append(assignments, toReviewerAssignment(r.UserID, &username, r.Assignment, r.AssignedAt))
+	}
+	return assignments
+}
@@ -0,0 +1,218 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/service"
+)
+
+// actorContext возвращает контекст запроса с инициатором операции для журнала аудита
+func actorContext(c *gin.Context) context.Context {
+	return service.WithActor(c.Request.Context(), ClientIdentity(c))
+}
+
+// PostPullRequestAssign вручную назначает ревьюера на PR
+func (h *Handler) PostPullRequestAssign(c *gin.Context) {
+	var req PostPullRequestAssignJSONRequestBody
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	override := toOverride(req.Override, req.OverrideReason)
+	if override.Enabled && !isAdmin(c) {
+		abortWithError(c, http.StatusForbidden, FORBIDDEN, "Override requires admin access")
+		return
+	}
+	reviewer, err := h.services.Reviewer.AssignReviewer(actorContext(c), req.PullRequestId, req.UserId, override)
+	if err != nil {
+		manualAssignmentError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toReviewerAssignment(reviewer.UserID, nil, reviewer.Assignment, reviewer.AssignedAt))
+}
+
+// GetRulesList возвращает правила назначения
+func (h *Handler) GetRulesList(c *gin.Context, params GetRulesListParams) {
+	var userID string
+	if params.UserId != nil {
+		userID = *params.UserId
+	}
+
+	rules, err := h.services.Rule.ListRules(c.Request.Context(), userID)
+	if err != nil {
+		ruleError(c, err)
+		return
+	}
+
+	resp := make([]ReviewerRule, 0, len(rules))
+	for _, rule := range rules {
+		resp = append(resp, toReviewerRule(rule))
+	}
+	c.JSON(http.StatusOK, gin.H{"rules": resp})
+}
+
+// PostRulesAdd создает правило назначения
+func (h *Handler) PostRulesAdd(c *gin.Context) {
+	var req PostRulesAddJSONRequestBody
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	rule := models.ReviewerRule{
+		Effect:     models.ReviewerRuleEffect(req.Effect),
+		ReviewerID: req.ReviewerId,
+		AuthorID:   req.AuthorId,
+		TeamName:   req.TeamName,
+	}
+	if req.Reason != nil {
+		rule.Reason = *req.Reason
+	}
+
+	created, err := h.services.Rule.CreateRule(actorContext(c), rule)
+	if err != nil {
+		ruleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toReviewerRule(created))
+}
+
+// PostRulesDelete удаляет правило назначения
+func (h *Handler) PostRulesDelete(c *gin.Context) {
+	var req PostRulesDeleteJSONRequestBody
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	deleted, err := h.services.Rule.DeleteRule(actorContext(c), req.RuleId)
+	if err != nil {
+		ruleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toReviewerRule(deleted))
+}
+
+// GetAuditList возвращает журнал аудита
+func (h *Handler) GetAuditList(c *gin.Context, params GetAuditListParams) {
+	var pullRequestID string
+	if params.PullRequestId != nil {
+		pullRequestID = *params.PullRequestId
+	}
+	var limit int
+	if params.Limit != nil {
+		limit = *params.Limit
+	}
+
+	entries, err := h.services.Audit.ListEntries(c.Request.Context(), pullRequestID, limit)
+	if err != nil {
+		ruleError(c, err)
+		return
+	}
+
+	resp := make([]AuditEntry, 0, len(entries))
+	for _, entry := range entries {
+		resp = append(resp, AuditEntry{
+			Id:            entry.ID,
+			Action:        AuditEntryAction(entry.Action),
+			Actor:         entry.Actor,
+			PullRequestId: entry.PullRequestID,
+			UserId:        entry.UserID,
+			Details:       entry.Details,
+			CreatedAt:     entry.CreatedAt,
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{"entries": resp})
+}
+
+// toOverride преобразует необязательные поля запроса в переопределение правил
+func toOverride(enabled *bool, reason *string) service.Override {
+	var override service.Override
+	if enabled != nil {
+		override.Enabled = *enabled
+	}
+	if reason != nil {
+		override.Reason = *reason
+	}
+	return override
+}
+
+// toReviewerRule преобразует правило назначения в модель API
+func toReviewerRule(rule models.ReviewerRule) ReviewerRule {
+	return ReviewerRule{
+		Id:         rule.ID,
+		Effect:     ReviewerRuleEffect(rule.Effect),
+		ReviewerId: rule.ReviewerID,
+		AuthorId:   rule.AuthorID,
+		TeamName:   rule.TeamName,
+		Reason:     rule.Reason,
+		CreatedBy:  rule.CreatedBy,
+		CreatedAt:  rule.CreatedAt,
+	}
+}
+
+// manualAssignmentError отвечает на ошибку ручного назначения ревьюера
+func manualAssignmentError(c *gin.Context, err error) {
+	status, code, message := http.StatusInternalServerError, NOTFOUND, err.Error()
+	switch {
+	case errors.Is(err, service.ErrPullRequestNotFound):
+		status, message = http.StatusNotFound, "Pull request not found"
+	case errors.Is(err, service.ErrUserNotFound):
+		status, message = http.StatusNotFound, "User not found"
+	case errors.Is(err, service.ErrReviewerBlocked):
+		status, code = http.StatusConflict, REVIEWERBLOCKED
+	case errors.Is(err, service.ErrInvalidStatus):
+		status, code = http.StatusConflict, PRMERGED
+	case errors.Is(err, service.ErrUserInactive),
+		errors.Is(err, service.ErrReviewerAlreadyAssigned),
+		errors.Is(err, service.ErrCannotAssignAuthor):
+		status, code = http.StatusBadRequest, INVALIDREVIEWERS
+	default:
+		_ = c.Error(err)
+	}
+
+	c.JSON(status, ErrorResponse{
+		Error: struct {
+			Code    ErrorResponseErrorCode `json:"code"`
+			Message string                 `json:"message"`
+		}{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
+
+// ruleError отвечает на ошибку RuleService и AuditService
+func ruleError(c *gin.Context, err error) {
+	status, code, message := http.StatusInternalServerError, NOTFOUND, err.Error()
+	switch {
+	case errors.Is(err, service.ErrInvalidRule):
+		status, code = http.StatusBadRequest, INVALIDRULE
+	case errors.Is(err, service.ErrRuleAlreadyExists):
+		status, code = http.StatusConflict, RULEEXISTS
+	case errors.Is(err, service.ErrRuleNotFound):
+		status, message = http.StatusNotFound, "Rule not found"
+	case errors.Is(err, service.ErrUserNotFound):
+		status, message = http.StatusNotFound, "User not found"
+	case errors.Is(err, service.ErrTeamNotFound):
+		status, message = http.StatusNotFound, "Team not found"
+	default:
+		_ = c.Error(err)
+	}
+
+	c.JSON(status, ErrorResponse{
+		Error: struct {
+			Code    ErrorResponseErrorCode `json:"code"`
+			Message string                 `json:"message"`
+		}{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
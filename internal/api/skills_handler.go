@@ -0,0 +1,104 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/service"
+)
+
+// GetUsersSkills возвращает навыки пользователя
+func (h *Handler) GetUsersSkills(c *gin.Context, params GetUsersSkillsParams) {
+	skills, err := h.services.Skill.GetUserSkills(c.Request.Context(), params.UserId)
+	if err != nil {
+		skillError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toUserSkills(params.UserId, skills))
+}
+
+// PostUsersSetSkills заменяет навыки пользователя
+func (h *Handler) PostUsersSetSkills(c *gin.Context) {
+	var req PostUsersSetSkillsJSONRequestBody
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	skills := make([]models.UserSkill, 0, len(req.Skills))
+	for _, skill := range req.Skills {
+		skills = append(skills, models.UserSkill{Tag: skill.Tag, Level: models.SkillLevel(skill.Level)})
+	}
+
+	saved, err := h.services.Skill.SetUserSkills(c.Request.Context(), req.UserId, skills)
+	if err != nil {
+		skillError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toUserSkills(req.UserId, saved))
+}
+
+// GetPullRequestRequiredTags возвращает навыки, нужные для ревью PR
+func (h *Handler) GetPullRequestRequiredTags(c *gin.Context, params GetPullRequestRequiredTagsParams) {
+	tags, err := h.services.Skill.GetRequiredTags(c.Request.Context(), params.PullRequestId)
+	if err != nil {
+		skillError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, PullRequestTags{PullRequestId: params.PullRequestId, RequiredTags: tags})
+}
+
+// PostPullRequestSetRequiredTags заменяет навыки, нужные для ревью PR
+func (h *Handler) PostPullRequestSetRequiredTags(c *gin.Context) {
+	var req PostPullRequestSetRequiredTagsJSONRequestBody
+	if !bindJSON(c, &req) {
+		return
+	}
+
+	tags, err := h.services.Skill.SetRequiredTags(c.Request.Context(), req.PullRequestId, req.RequiredTags)
+	if err != nil {
+		skillError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, PullRequestTags{PullRequestId: req.PullRequestId, RequiredTags: tags})
+}
+
+// skillError отвечает на ошибку SkillService
+func skillError(c *gin.Context, err error) {
+	status, code, message := http.StatusInternalServerError, NOTFOUND, err.Error()
+	switch {
+	case errors.Is(err, service.ErrInvalidSkill):
+		status, code = http.StatusBadRequest, INVALIDSKILL
+	case errors.Is(err, service.ErrUserNotFound):
+		status, message = http.StatusNotFound, "User not found"
+	case errors.Is(err, service.ErrPullRequestNotFound):
+		status, message = http.StatusNotFound, "Pull request not found"
+	default:
+		_ = c.Error(err)
+	}
+
+	c.JSON(status, ErrorResponse{
+		Error: struct {
+			Code    ErrorResponseErrorCode `json:"code"`
+			Message string                 `json:"message"`
+		}{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
+
+// toUserSkills преобразует навыки пользователя в модель API
+func toUserSkills(userID string, skills []models.UserSkill) UserSkills {
+	resp := UserSkills{UserId: userID, Skills: make([]UserSkill, 0, len(skills))}
+	for _, skill := range skills {
+		resp.Skills = append(resp.Skills, UserSkill{Tag: skill.Tag, Level: UserSkillLevel(skill.Level)})
+	}
+	return resp
+}
@@ -0,0 +1,193 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/service"
+)
+
+// GetStatisticsPullRequests возвращает статистику по PR, созданным в окне
+func (h *Handler) GetStatisticsPullRequests(c *gin.Context, params GetStatisticsPullRequestsParams) {
+	window := toTimeWindow(params.From, params.To)
+	if format := exportFormat(c); format != "" {
+		w := newExportWriter(c, format,
+			"pull_request_id", "pull_request_name", "author_id", "status", "reviewers_count", "created_at", "merged_at")
+		w.finish(h.services.Statistics.ForEachPRStats(c.Request.Context(), window, func(s models.PRStats) error {
+			return w.write(s.PullRequestID, s.PullRequestName, s.AuthorID, string(s.Status), s.ReviewersCount, s.CreatedAt, s.MergedAt)
+		}))
+		return
+	}
+
+	stats, err := h.services.Statistics.GetPRStats(c.Request.Context(), window)
+	if err != nil {
+		statisticsError(c, err)
+		return
+	}
+
+	resp := PullRequestStatsList{Statistics: make([]PullRequestStats, 0, len(stats))}
+	for _, s := range stats {
+		resp.Statistics = append(resp.Statistics, PullRequestStats{
+			PullRequestId:   s.PullRequestID,
+			PullRequestName: s.PullRequestName,
+			AuthorId:        s.AuthorID,
+			Status:          PullRequestStatsStatus(s.Status),
+			ReviewersCount:  s.ReviewersCount,
+			CreatedAt:       s.CreatedAt,
+			MergedAt:        s.MergedAt,
+		})
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetStatisticsTeams возвращает статистику по командам за окно
+func (h *Handler) GetStatisticsTeams(c *gin.Context, params GetStatisticsTeamsParams) {
+	window := toTimeWindow(params.From, params.To)
+	if format := exportFormat(c); format != "" {
+		w := newExportWriter(c, format,
+			"team_name", "total_members", "active_members", "prs_authored", "prs_merged", "prs_reviewed", "review_assignments")
+		w.finish(h.services.Statistics.ForEachTeamStats(c.Request.Context(), window, func(s models.TeamStats) error {
+			return w.write(s.TeamName, s.TotalMembers, s.ActiveMembers, s.PRsAuthored, s.PRsMerged, s.PRsReviewed, s.ReviewAssignments)
+		}))
+		return
+	}
+
+	stats, err := h.services.Statistics.GetTeamStats(c.Request.Context(), window)
+	if err != nil {
+		statisticsError(c, err)
+		return
+	}
+
+	resp := TeamStatsList{Statistics: make([]TeamStats, 0, len(stats))}
+	for _, s := range stats {
+		resp.Statistics = append(resp.Statistics, TeamStats{
+			TeamName:          s.TeamName,
+			TotalMembers:      s.TotalMembers,
+			ActiveMembers:     s.ActiveMembers,
+			PrsAuthored:       s.PRsAuthored,
+			PrsMerged:         s.PRsMerged,
+			PrsReviewed:       s.PRsReviewed,
+			ReviewAssignments: s.ReviewAssignments,
+		})
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// GetStatisticsFairness возвращает неравенство назначений внутри команд
+func (h *Handler) GetStatisticsFairness(c *gin.Context, params GetStatisticsFairnessParams) {
+	teamName := ""
+	if params.TeamName != nil {
+		teamName = *params.TeamName
+	}
+	tolerance := service.DefaultFairnessTolerance
+	if params.Tolerance != nil {
+		tolerance = *params.Tolerance
+	}
+
+	teams, err := h.services.Statistics.GetFairness(c.Request.Context(), teamName, toTimeWindow(params.From, params.To), tolerance)
+	if err != nil {
+		statisticsError(c, err)
+		return
+	}
+
+	// Отчет считается целиком, в экспорт попадает строка на каждого ревьюера
+	if format := exportFormat(c); format != "" {
+		w := newExportWriter(c, format, fairnessExportColumns...)
+		w.finish(exportFairness(w, teams))
+		return
+	}
+
+	resp := FairnessReport{Tolerance: tolerance, Teams: make([]TeamFairness, 0, len(teams))}
+	for _, team := range teams {
+		members := make([]ReviewerFairness, 0, len(team.Members))
+		for _, m := range team.Members {
+			members = append(members, ReviewerFairness{
+				UserId:              m.UserID,
+				Username:            m.Username,
+				IsActive:            m.IsActive,
+				Assignments:         m.Assignments,
+				TieBreakAssignments: m.TieBreakAssignments,
+				Deviation:           m.Deviation,
+				RelativeDeviation:   m.RelativeDeviation,
+				Status:              ReviewerFairnessStatus(m.Status),
+			})
+		}
+		resp.Teams = append(resp.Teams, TeamFairness{
+			TeamName:             team.TeamName,
+			Reviewers:            team.Reviewers,
+			TotalAssignments:     team.TotalAssignments,
+			Mean:                 team.Mean,
+			Gini:                 team.Gini,
+			GiniWithoutTieBreaks: team.GiniWithoutTieBreaks,
+			MaxMinRatio:          team.MaxMinRatio,
+			PolicyAssignments:    team.PolicyAssignments,
+			TieBreakAssignments:  team.TieBreakAssignments,
+			TieBreakShare:        team.TieBreakShare,
+			Members:              members,
+		})
+	}
+
+	c.JSON(http.StatusOK, resp)
+}
+
+// fairnessExportColumns - столбцы экспорта отчета о справедливости
+var fairnessExportColumns = []string{
+	"team_name", "user_id", "username", "is_active", "assignments", "policy_assignments", "tie_break_assignments",
+	"deviation", "relative_deviation", "status",
+}
+
+func exportFairness(w *exportWriter, teams []models.TeamFairness) error {
+	for _, team := range teams {
+		for _, m := range team.Members {
+			err := w.write(team.TeamName, m.UserID, m.Username, m.IsActive, m.Assignments, m.PolicyAssignments, m.TieBreakAssignments,
+				m.Deviation, m.RelativeDeviation, string(m.Status))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// toTimeWindow преобразует необязательные границы запроса в окно
+func toTimeWindow(from, to *time.Time) models.TimeWindow {
+	var window models.TimeWindow
+	if from != nil {
+		window.From = *from
+	}
+	if to != nil {
+		window.To = *to
+	}
+	return window
+}
+
+// statisticsError отвечает на ошибку StatisticsService или AnalyticsService
+func statisticsError(c *gin.Context, err error) {
+	status, code, message := http.StatusInternalServerError, NOTFOUND, err.Error()
+	switch {
+	case errors.Is(err, service.ErrInvalidTimeWindow):
+		status, code = http.StatusBadRequest, INVALIDTIMERANGE
+	case errors.Is(err, service.ErrInvalidFairnessTolerance):
+		status, code = http.StatusBadRequest, INVALIDTOLERANCE
+	case errors.Is(err, service.ErrTeamNotFound):
+		status, message = http.StatusNotFound, "Team not found"
+	default:
+		_ = c.Error(err)
+	}
+
+	c.JSON(status, ErrorResponse{
+		Error: struct {
+			Code    ErrorResponseErrorCode `json:"code"`
+			Message string                 `json:"message"`
+		}{
+			Code:    code,
+			Message: message,
+		},
+	})
+}
@@ -0,0 +1,193 @@
+package api
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/gin-gonic/gin"
+)
+
+// ValidationMiddleware проверяет запросы по встроенной OpenAPI спецификации.
+// Запрос с нарушением схемы получает 400 VALIDATION_ERROR со списком
+// нарушений в details, маршруты вне спецификации не проверяются. Если
+// validateResponses включен, JSON ответы тоже проверяются, а нарушения
+// попадают в c.Errors и логируются.
+func ValidationMiddleware(validateResponses bool) (gin.HandlerFunc, error) {
+	swagger, err := GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("load OpenAPI spec: %w", err)
+	}
+	routes := specRoutes(swagger)
+
+	options := &openapi3filter.Options{
+		MultiError:          true,
+		SkipSettingDefaults: true,
+		AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+	}
+
+	return func(c *gin.Context) {
+		route, ok := routes[c.Request.Method+" "+c.FullPath()]
+		if !ok {
+			c.Next()
+			return
+		}
+
+		input := &openapi3filter.RequestValidationInput{
+			Request: c.Request,
+			Route:   route,
+			Options: options,
+		}
+		if err := openapi3filter.ValidateRequest(c.Request.Context(), input); err != nil {
+			if !abortIfTooLarge(c, err) {
+				abortWithValidationError(c, validationIssues(err))
+			}
+			return
+		}
+
+		if !validateResponses {
+			c.Next()
+			return
+		}
+
+		recorder := &jsonResponseRecorder{ResponseWriter: c.Writer}
+		c.Writer = recorder
+		c.Next()
+
+		if !recorder.json {
+			return
+		}
+		err := openapi3filter.ValidateResponse(c.Request.Context(), &openapi3filter.ResponseValidationInput{
+			RequestValidationInput: input,
+			Status:                 recorder.Status(),
+			Header:                 recorder.Header(),
+			Body:                   io.NopCloser(&recorder.body),
+			Options:                &openapi3filter.Options{MultiError: true},
+		})
+		if err != nil {
+			_ = c.Error(fmt.Errorf("response does not match OpenAPI spec: %w", err))
+		}
+	}, nil
+}
+
+// specRoutes сопоставляет "METHOD /path" с операцией спецификации. Пути
+// в спецификации без параметров, поэтому совпадают с шаблонами маршрутов gin.
+func specRoutes(swagger *openapi3.T) map[string]*routers.Route {
+	routes := make(map[string]*routers.Route)
+	for path, item := range swagger.Paths.Map() {
+		for method, op := range item.Operations() {
+			routes[strings.ToUpper(method)+" "+path] = &routers.Route{
+				Spec:      swagger,
+				Path:      path,
+				PathItem:  item,
+				Method:    strings.ToUpper(method),
+				Operation: op,
+			}
+		}
+	}
+	return routes
+}
+
+// abortWithValidationError прерывает обработку ответом 400 VALIDATION_ERROR.
+// В сообщение выносится первое нарушение, в details - все.
+func abortWithValidationError(c *gin.Context, issues []ValidationIssue) {
+	message := "Request validation failed"
+	if len(issues) > 0 {
+		message += ": " + issues[0].Message
+	}
+
+	resp := ErrorResponse{Details: &issues}
+	resp.Error.Code = VALIDATIONERROR
+	resp.Error.Message = message
+	c.AbortWithStatusJSON(http.StatusBadRequest, resp)
+}
+
+// validationIssues раскладывает ошибку openapi3filter на отдельные нарушения
+func validationIssues(err error) []ValidationIssue {
+	return collectIssues(Body, "", err)
+}
+
+func collectIssues(location ValidationIssueLocation, field string, err error) []ValidationIssue {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		var issues []ValidationIssue
+		for _, item := range e {
+			issues = append(issues, collectIssues(location, field, item)...)
+		}
+		return issues
+	case *openapi3filter.RequestError:
+		if p := e.Parameter; p != nil {
+			location, field = ValidationIssueLocation(p.In), p.Name
+		}
+		switch e.Err.(type) {
+		case openapi3.MultiError, *openapi3.SchemaError, *openapi3filter.ParseError:
+			return collectIssues(location, field, e.Err)
+		}
+		message := e.Reason
+		if e.Err != nil {
+			if message != "" {
+				message += ": "
+			}
+			message += e.Err.Error()
+		}
+		return []ValidationIssue{{Location: location, Field: field, Message: message}}
+	case *openapi3.SchemaError:
+		return []ValidationIssue{{Location: location, Field: field + jsonPointer(e.JSONPointer()), Message: e.Reason}}
+	case *openapi3filter.ParseError:
+		var path []string
+		for _, segment := range e.Path() {
+			path = append(path, fmt.Sprint(segment))
+		}
+		message := e.Reason
+		if cause := e.RootCause(); cause != nil {
+			message = cause.Error()
+		}
+		return []ValidationIssue{{Location: location, Field: field + jsonPointer(path), Message: message}}
+	default:
+		return []ValidationIssue{{Location: location, Field: field, Message: err.Error()}}
+	}
+}
+
+// jsonPointer собирает JSON Pointer (RFC 6901) из сегментов пути
+func jsonPointer(path []string) string {
+	var b strings.Builder
+	for _, segment := range path {
+		b.WriteByte('/')
+		b.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(segment))
+	}
+	return b.String()
+}
+
+// jsonResponseRecorder копирует тело JSON ответа для проверки. Потоковые
+// ответы (SSE, CSV, NDJSON) не копируются.
+type jsonResponseRecorder struct {
+	gin.ResponseWriter
+	body    bytes.Buffer
+	json    bool
+	checked bool
+}
+
+func (w *jsonResponseRecorder) Write(data []byte) (int, error) {
+	w.record(data)
+	return w.ResponseWriter.Write(data)
+}
+
+func (w *jsonResponseRecorder) WriteString(s string) (int, error) {
+	w.record([]byte(s))
+	return w.ResponseWriter.WriteString(s)
+}
+
+func (w *jsonResponseRecorder) record(data []byte) {
+	if !w.checked {
+		w.checked = true
+		w.json = strings.HasPrefix(w.Header().Get("Content-Type"), "application/json")
+	}
+	if w.json {
+		w.body.Write(data)
+	}
+}
@@ -0,0 +1,238 @@
+package api
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newValidationRouter поднимает /team/add и /team/get из спецификации
+// и /other вне ее, обработчики отвечают response
+func newValidationRouter(t *testing.T, validateResponses bool, response any, errs *[]error) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(func(c *gin.Context) {
+		c.Next()
+		for _, e := range c.Errors {
+			*errs = append(*errs, e.Err)
+		}
+	})
+	router.Use(BodySizeMiddleware(1024))
+
+	validation, err := ValidationMiddleware(validateResponses)
+	require.NoError(t, err)
+	router.Use(validation)
+
+	respond := func(c *gin.Context) { c.JSON(http.StatusOK, response) }
+	router.POST("/team/add", func(c *gin.Context) { c.JSON(http.StatusCreated, response) })
+	router.GET("/team/get", respond)
+	router.GET("/other", respond)
+	return router
+}
+
+func TestValidationMiddleware_Requests(t *testing.T) {
+	tests := []struct {
+		name    string
+		method  string
+		target  string
+		body    string
+		json    bool
+		status  int
+		details []ValidationIssue
+	}{
+		{
+			name:   "valid body",
+			method: http.MethodPost,
+			target: "/team/add",
+			body:   `{"team_name":"backend","members":[{"user_id":"u1","username":"Alice"}]}`,
+			json:   true,
+			status: http.StatusCreated,
+		},
+		{
+			name:   "blank and oversized fields",
+			method: http.MethodPost,
+			target: "/team/add",
+			body:   `{"team_name":"  ","members":[{"user_id":"","username":"` + strings.Repeat("a", 256) + `"}]}`,
+			json:   true,
+			status: http.StatusBadRequest,
+			details: []ValidationIssue{
+				{Location: Body, Field: "/members/0/user_id"},
+				{Location: Body, Field: "/members/0/username"},
+				{Location: Body, Field: "/team_name"},
+			},
+		},
+		{
+			name:    "missing field",
+			method:  http.MethodPost,
+			target:  "/team/add",
+			body:    `{"team_name":"backend"}`,
+			json:    true,
+			status:  http.StatusBadRequest,
+			details: []ValidationIssue{{Location: Body, Field: "/members"}},
+		},
+		{
+			name:    "without content type",
+			method:  http.MethodPost,
+			target:  "/team/add",
+			body:    `{"team_name":"backend","members":[]}`,
+			status:  http.StatusBadRequest,
+			details: []ValidationIssue{{Location: Body, Field: ""}},
+		},
+		{
+			name:    "missing query parameter",
+			method:  http.MethodGet,
+			target:  "/team/get",
+			status:  http.StatusBadRequest,
+			details: []ValidationIssue{{Location: Query, Field: "team_name"}},
+		},
+		{
+			name:   "route outside spec",
+			method: http.MethodGet,
+			target: "/other",
+			status: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var errs []error
+			router := newValidationRouter(t, false, gin.H{}, &errs)
+
+			req := httptest.NewRequest(tt.method, tt.target, strings.NewReader(tt.body))
+			if tt.json {
+				req.Header.Set("Content-Type", "application/json")
+			}
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, req)
+
+			require.Equal(t, tt.status, w.Code, w.Body.String())
+			if tt.details == nil {
+				return
+			}
+
+			var resp ErrorResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+			assert.Equal(t, VALIDATIONERROR, resp.Error.Code)
+			require.NotNil(t, resp.Details)
+			require.Len(t, *resp.Details, len(tt.details))
+			for i, issue := range *resp.Details {
+				assert.Equal(t, tt.details[i].Location, issue.Location)
+				assert.Equal(t, tt.details[i].Field, issue.Field)
+				assert.NotEmpty(t, issue.Message)
+			}
+			assert.Contains(t, resp.Error.Message, (*resp.Details)[0].Message)
+		})
+	}
+}
+
+func TestValidationMiddleware_BodyTooLarge(t *testing.T) {
+	var errs []error
+	router := newValidationRouter(t, false, gin.H{}, &errs)
+
+	// Тело без заявленной длины обрезается BodySizeMiddleware при чтении
+	body := `{"team_name":"` + strings.Repeat("a", 2000) + `","members":[]}`
+	req := httptest.NewRequest(http.MethodPost, "/team/add", io.MultiReader(strings.NewReader(body)))
+	req.Header.Set("Content-Type", "application/json")
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.Contains(t, w.Body.String(), "PAYLOAD_TOO_LARGE")
+}
+
+func TestValidationMiddleware_Responses(t *testing.T) {
+	tests := []struct {
+		name     string
+		response any
+		valid    bool
+	}{
+		{"valid", Team{TeamName: "backend", Members: []TeamMember{{UserId: "u1", Username: "Alice"}}}, true},
+		{"missing members", gin.H{"team_name": "backend"}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var errs []error
+			router := newValidationRouter(t, true, tt.response, &errs)
+
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/team/get?team_name=backend", nil))
+
+			// Ответ отправляется клиенту без изменений
+			assert.Equal(t, http.StatusOK, w.Code)
+			if tt.valid {
+				assert.Empty(t, errs)
+			} else {
+				require.Len(t, errs, 1)
+				assert.Contains(t, errs[0].Error(), "response does not match OpenAPI spec")
+			}
+		})
+	}
+}
+
+func TestSpecHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/openapi.yaml", SpecHandler)
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/openapi.yaml", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/yaml", w.Header().Get("Content-Type"))
+	assert.True(t, strings.HasPrefix(w.Body.String(), "openapi: 3.0.3"))
+}
+
+func TestDocsHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.GET("/docs", DocsHandler)
+	router.GET("/docs/assets/:name", DocsAssetsHandler)
+
+	assets := swaggerUIAssets
+	t.Cleanup(func() { swaggerUIAssets = assets })
+	swaggerUIAssets = fstest.MapFS{
+		"README.md":            {Data: []byte("readme")},
+		"swagger-ui.css":       {Data: []byte("body {}")},
+		"swagger-ui-bundle.js": {Data: []byte("var SwaggerUIBundle;")},
+	}
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/docs", nil))
+	require.Equal(t, http.StatusOK, w.Code)
+
+	// Ресурсы отдает сам сервис, внешние источники не используются
+	assert.NotContains(t, w.Body.String(), "https://")
+	assert.Contains(t, w.Body.String(), `src="/docs/assets/swagger-ui-bundle.js"`)
+
+	csp := w.Header().Get("Content-Security-Policy")
+	assert.Contains(t, csp, "default-src 'none'")
+	assert.Contains(t, csp, "script-src 'self' 'sha256-")
+	assert.NotContains(t, csp, "https:")
+	assert.NotContains(t, csp, "unsafe-eval")
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/docs/assets/swagger-ui-bundle.js", nil))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "text/javascript; charset=utf-8", w.Header().Get("Content-Type"))
+	assert.Equal(t, "var SwaggerUIBundle;", w.Body.String())
+
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/docs/assets/README.md", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+
+	// Без встроенных ресурсов страница не отдается
+	swaggerUIAssets = fstest.MapFS{"README.md": {Data: []byte("readme")}}
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/docs", nil))
+	assert.Equal(t, http.StatusServiceUnavailable, w.Code)
+}
@@ -2,81 +2,309 @@ package config
 
 import (
 	"fmt"
-	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
-// Config содержит всю конфигурацию приложения
+// Config содержит всю конфигурацию приложения.
+//
+// Каждое поле описывается тегами:
+//   - config - ключ в файле конфигурации и имя флага (секция.ключ)
+//   - env - переменная окружения
+//   - secret - значение скрывается в --print-config
 type Config struct {
-	Database DatabaseConfig
-	Server   ServerConfig
-	Logger   LoggerConfig
+	Database    DatabaseConfig    `config:"database"`
+	Server      ServerConfig      `config:"server"`
+	Logger      LoggerConfig      `config:"logger"`
+	Tracing     TracingConfig     `config:"tracing"`
+	Health      HealthConfig      `config:"health"`
+	Assignment  AssignmentConfig  `config:"assignment"`
+	RateLimit   RateLimitConfig   `config:"rate_limit"`
+	Workload    WorkloadConfig    `config:"workload"`
+	Events      EventsConfig      `config:"events"`
+	Idempotency IdempotencyConfig `config:"idempotency"`
+	Validation  ValidationConfig  `config:"validation"`
+	Admin       AdminConfig       `config:"admin"`
 }
 
 // DatabaseConfig содержит настройки базы данных
 type DatabaseConfig struct {
-	Host              string
-	Port              string
-	User              string
-	Password          string
-	DBName            string
-	SSLMode           string
-	MaxConns          int32
-	MinConns          int32
-	MaxConnLifetime   time.Duration
-	MaxConnIdleTime   time.Duration
-	HealthCheckPeriod time.Duration
+	Host              string        `config:"host" env:"DB_HOST"`
+	Port              string        `config:"port" env:"DB_PORT"`
+	User              string        `config:"user" env:"DB_USER"`
+	Password          string        `config:"password" env:"DB_PASSWORD" secret:"true"`
+	DBName            string        `config:"name" env:"DB_NAME"`
+	SSLMode           string        `config:"ssl_mode" env:"DB_SSL_MODE"`
+	MaxConns          int32         `config:"max_conns" env:"DB_MAX_CONNS"`
+	MinConns          int32         `config:"min_conns" env:"DB_MIN_CONNS"`
+	MaxConnLifetime   time.Duration `config:"max_conn_lifetime" env:"DB_MAX_CONN_LIFETIME"`
+	MaxConnIdleTime   time.Duration `config:"max_conn_idle_time" env:"DB_MAX_CONN_IDLE_TIME"`
+	HealthCheckPeriod time.Duration `config:"health_check_period" env:"DB_HEALTH_CHECK_PERIOD"`
 }
 
 // ServerConfig содержит настройки HTTP сервера
 type ServerConfig struct {
-	Host            string
-	Port            string
-	ReadTimeout     time.Duration
-	WriteTimeout    time.Duration
-	ShutdownTimeout time.Duration
-	TLSEnabled      bool
-	TLSCertFile     string
-	TLSKeyFile      string
+	Host              string        `config:"host" env:"SERVER_HOST"`
+	Port              string        `config:"port" env:"SERVER_PORT"`
+	GRPCEnabled       bool          `config:"grpc_enabled" env:"SERVER_GRPC_ENABLED"` // gRPC API на отдельном порту с тем же TLS
+	GRPCPort          string        `config:"grpc_port" env:"SERVER_GRPC_PORT"`
+	GRPCInsecure      bool          `config:"grpc_insecure" env:"SERVER_GRPC_INSECURE"` // разрешить gRPC без TLS
+	ReadTimeout       time.Duration `config:"read_timeout" env:"SERVER_READ_TIMEOUT"`
+	ReadHeaderTimeout time.Duration `config:"read_header_timeout" env:"SERVER_READ_HEADER_TIMEOUT"`
+	WriteTimeout      time.Duration `config:"write_timeout" env:"SERVER_WRITE_TIMEOUT"`
+	IdleTimeout       time.Duration `config:"idle_timeout" env:"SERVER_IDLE_TIMEOUT"`
+	ShutdownTimeout   time.Duration `config:"shutdown_timeout" env:"SERVER_SHUTDOWN_TIMEOUT"`
+	TLSEnabled        bool          `config:"tls_enabled" env:"SERVER_TLS_ENABLED"`
+	TLSCertFile       string        `config:"tls_cert_file" env:"SERVER_TLS_CERT_FILE"`
+	TLSKeyFile        string        `config:"tls_key_file" env:"SERVER_TLS_KEY_FILE"`
+	TLSReloadInterval time.Duration `config:"tls_reload_interval" env:"SERVER_TLS_RELOAD_INTERVAL"` // период проверки изменений сертификатов, 0 - без перезагрузки
+	TLSClientAuth     string        `config:"tls_client_auth" env:"SERVER_TLS_CLIENT_AUTH"`         // none, optional или require
+	TLSClientCAFile   string        `config:"tls_client_ca_file" env:"SERVER_TLS_CLIENT_CA_FILE"`
+	// TLSClientIdentities сопоставляет CN клиентского сертификата идентичности API
+	TLSClientIdentities map[string]string `config:"tls_client_identities" env:"SERVER_TLS_CLIENT_IDENTITIES"`
+	MaxBodyBytes        int64             `config:"max_body_bytes" env:"SERVER_MAX_BODY_BYTES"` // максимальный размер тела запроса
+	// TrustedProxies - IP и CIDR прокси, чьим X-Forwarded-For можно верить.
+	// Пустой список - адрес клиента берется только из соединения.
+	TrustedProxies []string `config:"trusted_proxies" env:"SERVER_TRUSTED_PROXIES"`
 }
 
 // LoggerConfig содержит настройки логгера
 type LoggerConfig struct {
-	Level  string
-	Format string // json или console
+	Level  string `config:"level" env:"LOG_LEVEL"`
+	Format string `config:"format" env:"LOG_FORMAT"` // json или console
 }
 
-// Load загружает конфигурацию из переменных окружения
-func Load() (*Config, error) {
-	cfg := &Config{
+// TracingConfig содержит настройки трассировки OpenTelemetry
+type TracingConfig struct {
+	Enabled       bool          `config:"enabled" env:"TRACING_ENABLED"`
+	Exporter      string        `config:"exporter" env:"TRACING_EXPORTER"`      // otlp или stdout
+	Protocol      string        `config:"protocol" env:"TRACING_OTLP_PROTOCOL"` // grpc или http, для экспортера otlp
+	Endpoint      string        `config:"endpoint" env:"TRACING_OTLP_ENDPOINT"`
+	Insecure      bool          `config:"insecure" env:"TRACING_OTLP_INSECURE"`
+	ServiceName   string        `config:"service_name" env:"TRACING_SERVICE_NAME"`
+	SampleRatio   float64       `config:"sample_ratio" env:"TRACING_SAMPLE_RATIO"`
+	ExportTimeout time.Duration `config:"export_timeout" env:"TRACING_EXPORT_TIMEOUT"`
+}
+
+// HealthConfig содержит настройки проверок готовности
+type HealthConfig struct {
+	CheckTimeout            time.Duration `config:"check_timeout" env:"HEALTH_CHECK_TIMEOUT"`
+	PoolSaturationThreshold float64       `config:"pool_saturation_threshold" env:"HEALTH_POOL_SATURATION_THRESHOLD"` // доля занятых соединений, начиная с которой пул считается перегруженным
+}
+
+// Стратегии выбора ревьюеров
+const (
+	StrategyLeastLoaded = "least_loaded"
+	StrategyRandom      = "random"
+)
+
+// AssignmentConfig содержит доменные настройки назначения ревьюеров.
+// Во время работы сервиса используется как политика назначения и может
+// быть перезагружена без перезапуска.
+type AssignmentConfig struct {
+	DefaultReviewerCount int           `config:"default_reviewer_count" env:"ASSIGNMENT_DEFAULT_REVIEWER_COUNT"`
+	Strategy             string        `config:"strategy" env:"ASSIGNMENT_STRATEGY"` // least_loaded или random
+	ReviewSLA            time.Duration `config:"review_sla" env:"ASSIGNMENT_REVIEW_SLA"`
+	// MaxOpenReviews - максимальное количество открытых ревью на ревьюера, 0 - без ограничения
+	MaxOpenReviews int `config:"max_open_reviews" env:"ASSIGNMENT_MAX_OPEN_REVIEWS"`
+	// FallbackOverCapacity разрешает назначать наименее загруженных сверх лимита,
+	// если все кандидаты его достигли
+	FallbackOverCapacity bool `config:"fallback_over_capacity" env:"ASSIGNMENT_FALLBACK_OVER_CAPACITY"`
+	// FallbackRemoveInactive снимает неактивного ревьюера, если замену найти не удалось
+	FallbackRemoveInactive bool `config:"fallback_remove_inactive" env:"ASSIGNMENT_FALLBACK_REMOVE_INACTIVE"`
+	// WorkloadWeight - вес количества открытых ревью в оценке кандидата
+	WorkloadWeight float64 `config:"workload_weight" env:"ASSIGNMENT_WORKLOAD_WEIGHT"`
+	// SkillWeight - вес совпадения навыков кандидата с тегами PR
+	SkillWeight float64 `config:"skill_weight" env:"ASSIGNMENT_SKILL_WEIGHT"`
+}
+
+// RateLimitConfig содержит ограничения частоты запросов. Лимит действует
+// отдельно для каждой пары идентичность клиента - маршрут.
+type RateLimitConfig struct {
+	Enabled bool    `config:"enabled" env:"RATE_LIMIT_ENABLED"`
+	RPS     float64 `config:"rps" env:"RATE_LIMIT_RPS"` // запросов в секунду по умолчанию
+	Burst   int     `config:"burst" env:"RATE_LIMIT_BURST"`
+	// Routes переопределяет лимит для маршрутов: путь OpenAPI или полное имя
+	// метода gRPC -> "rps:burst"
+	Routes map[string]string `config:"routes" env:"RATE_LIMIT_ROUTES"`
+	// IdleTimeout - время, после которого лимит неактивного клиента удаляется из памяти
+	IdleTimeout time.Duration `config:"idle_timeout" env:"RATE_LIMIT_IDLE_TIMEOUT"`
+}
+
+// WorkloadConfig содержит настройки учета нагрузки ревьюеров
+type WorkloadConfig struct {
+	// ReconcileInterval - период сверки учтенной нагрузки с фактической, 0 отключает сверку
+	ReconcileInterval time.Duration `config:"reconcile_interval" env:"WORKLOAD_RECONCILE_INTERVAL"`
+}
+
+// EventsConfig содержит настройки журнала событий назначения
+type EventsConfig struct {
+	// Retention - срок хранения событий для возобновления потока, 0 - хранить всегда
+	Retention time.Duration `config:"retention" env:"EVENTS_RETENTION"`
+}
+
+// IdempotencyConfig содержит настройки повторов запросов с заголовком Idempotency-Key
+type IdempotencyConfig struct {
+	Enabled bool `config:"enabled" env:"IDEMPOTENCY_ENABLED"`
+	// Retention - срок хранения ответов, после него ключ можно использовать заново
+	Retention time.Duration `config:"retention" env:"IDEMPOTENCY_RETENTION"`
+	// Lease - время, после которого незавершенный запрос считается прерванным,
+	// и повтор с тем же ключом выполняет его заново
+	Lease time.Duration `config:"lease" env:"IDEMPOTENCY_LEASE"`
+}
+
+// ValidationConfig содержит настройки проверки запросов по OpenAPI спецификации
+type ValidationConfig struct {
+	Requests bool `config:"requests" env:"VALIDATION_REQUESTS"`
+	// Responses - проверка ответов для тестовых окружений, нарушения логируются
+	Responses bool `config:"responses" env:"VALIDATION_RESPONSES"`
+}
+
+// AdminConfig содержит доступ к эндпоинтам администрирования: /admin/*,
+// изменение правил назначения и ручные назначения с override. Без
+// идентичностей и токена эти операции запрещены.
+type AdminConfig struct {
+	// Identities - идентичности клиентских сертификатов с правами администратора
+	Identities []string `config:"identities" env:"ADMIN_IDENTITIES"`
+	// Token - токен администратора в заголовке Authorization: Bearer
+	Token string `config:"token" env:"ADMIN_TOKEN" secret:"true"`
+}
+
+// RouteLimit - параметры token bucket
+type RouteLimit struct {
+	RPS   float64
+	Burst int
+}
+
+// RouteLimits разбирает лимиты маршрутов
+func (c RateLimitConfig) RouteLimits() (map[string]RouteLimit, error) {
+	limits := make(map[string]RouteLimit, len(c.Routes))
+	for route, raw := range c.Routes {
+		rpsRaw, burstRaw, ok := strings.Cut(raw, ":")
+		if !ok {
+			return nil, fmt.Errorf("route %s: expected rps:burst, got %q", route, raw)
+		}
+		rps, err := strconv.ParseFloat(rpsRaw, 64)
+		if err != nil || rps <= 0 {
+			return nil, fmt.Errorf("route %s: rps must be a positive number, got %q", route, rpsRaw)
+		}
+		burst, err := strconv.Atoi(burstRaw)
+		if err != nil || burst <= 0 {
+			return nil, fmt.Errorf("route %s: burst must be a positive integer, got %q", route, burstRaw)
+		}
+		limits[route] = RouteLimit{RPS: rps, Burst: burst}
+	}
+	return limits, nil
+}
+
+// Default возвращает конфигурацию со значениями по умолчанию
+func Default() *Config {
+	return &Config{
 		Database: DatabaseConfig{
-			Host:              getEnv("DB_HOST", "localhost"),
-			Port:              getEnv("DB_PORT", "5432"),
-			User:              getEnv("DB_USER", "postgres"),
-			Password:          getEnv("DB_PASSWORD", "postgres"),
-			DBName:            getEnv("DB_NAME", "pr_assignment"),
-			SSLMode:           getEnv("DB_SSL_MODE", "disable"),
-			MaxConns:          getEnvAsInt32("DB_MAX_CONNS", 25),
-			MinConns:          getEnvAsInt32("DB_MIN_CONNS", 5),
-			MaxConnLifetime:   getEnvAsDuration("DB_MAX_CONN_LIFETIME", time.Hour),
-			MaxConnIdleTime:   getEnvAsDuration("DB_MAX_CONN_IDLE_TIME", 30*time.Minute),
-			HealthCheckPeriod: getEnvAsDuration("DB_HEALTH_CHECK_PERIOD", time.Minute),
+			Host:              "localhost",
+			Port:              "5432",
+			User:              "postgres",
+			Password:          "postgres",
+			DBName:            "pr_assignment",
+			SSLMode:           "disable",
+			MaxConns:          25,
+			MinConns:          5,
+			MaxConnLifetime:   time.Hour,
+			MaxConnIdleTime:   30 * time.Minute,
+			HealthCheckPeriod: time.Minute,
 		},
 		Server: ServerConfig{
-			Host:            getEnv("SERVER_HOST", "0.0.0.0"),
-			Port:            getEnv("SERVER_PORT", "8443"),
-			ReadTimeout:     getEnvAsDuration("SERVER_READ_TIMEOUT", 10*time.Second),
-			WriteTimeout:    getEnvAsDuration("SERVER_WRITE_TIMEOUT", 10*time.Second),
-			ShutdownTimeout: getEnvAsDuration("SERVER_SHUTDOWN_TIMEOUT", 5*time.Second),
-			TLSEnabled:      getEnvAsBool("SERVER_TLS_ENABLED", true),
-			TLSCertFile:     getEnv("SERVER_TLS_CERT_FILE", "certs/server.crt"),
-			TLSKeyFile:      getEnv("SERVER_TLS_KEY_FILE", "certs/server.key"),
+			Host:                "0.0.0.0",
+			Port:                "8443",
+			GRPCEnabled:         false,
+			GRPCPort:            "9443",
+			ReadTimeout:         10 * time.Second,
+			ReadHeaderTimeout:   5 * time.Second,
+			WriteTimeout:        10 * time.Second,
+			IdleTimeout:         60 * time.Second,
+			ShutdownTimeout:     5 * time.Second,
+			TLSEnabled:          true,
+			TLSCertFile:         "certs/server.crt",
+			TLSKeyFile:          "certs/server.key",
+			TLSReloadInterval:   30 * time.Second,
+			TLSClientAuth:       "none",
+			TLSClientIdentities: map[string]string{},
+			MaxBodyBytes:        1 << 20,
+			TrustedProxies:      []string{},
 		},
 		Logger: LoggerConfig{
-			Level:  getEnv("LOG_LEVEL", "info"),
-			Format: getEnv("LOG_FORMAT", "console"),
+			Level:  "info",
+			Format: "console",
+		},
+		Tracing: TracingConfig{
+			Enabled:       false,
+			Exporter:      "otlp",
+			Protocol:      "grpc",
+			Endpoint:      "localhost:4317",
+			Insecure:      true,
+			ServiceName:   "pr-assignment-service",
+			SampleRatio:   1.0,
+			ExportTimeout: 10 * time.Second,
 		},
+		Health: HealthConfig{
+			CheckTimeout:            2 * time.Second,
+			PoolSaturationThreshold: 0.9,
+		},
+		Assignment: AssignmentConfig{
+			DefaultReviewerCount:   2,
+			Strategy:               StrategyLeastLoaded,
+			ReviewSLA:              48 * time.Hour,
+			MaxOpenReviews:         0,
+			FallbackOverCapacity:   true,
+			FallbackRemoveInactive: true,
+			WorkloadWeight:         1,
+			SkillWeight:            1,
+		},
+		RateLimit: RateLimitConfig{
+			Enabled:     true,
+			RPS:         20,
+			Burst:       40,
+			Routes:      map[string]string{},
+			IdleTimeout: 10 * time.Minute,
+		},
+		Workload: WorkloadConfig{
+			ReconcileInterval: 5 * time.Minute,
+		},
+		Events: EventsConfig{
+			Retention: 7 * 24 * time.Hour,
+		},
+		Idempotency: IdempotencyConfig{
+			Enabled:   true,
+			Retention: 24 * time.Hour,
+			Lease:     time.Minute,
+		},
+		Validation: ValidationConfig{
+			Requests: true,
+		},
+		Admin: AdminConfig{
+			Identities: []string{},
+		},
+	}
+}
+
+// Load собирает конфигурацию по слоям: значения по умолчанию, файл,
+// переменные окружения, флаги. Возвращает все найденные ошибки сразу.
+func Load(params Params) (*Config, error) {
+	cfg := Default()
+	fields := fieldsOf(cfg)
+
+	var errs []error
+	if params.File != "" {
+		errs = append(errs, applyFile(fields, params.File)...)
+	}
+	errs = append(errs, applyEnv(fields)...)
+	errs = append(errs, applyOverrides(fields, params.Overrides)...)
+	// Поле с ошибкой разбора сохраняет значение предыдущего слоя,
+	// поэтому проверка остальных полей остается корректной
+	errs = append(errs, cfg.validate()...)
+	if len(errs) > 0 {
+		return nil, &Error{Errors: errs}
 	}
 
 	return cfg, nil
@@ -95,50 +323,7 @@ func (c *ServerConfig) Address() string {
 	return fmt.Sprintf("%s:%s", c.Host, c.Port)
 }
 
-// getEnv возвращает значение переменной окружения или значение по умолчанию
-func getEnv(key, defaultValue string) string {
-	value := os.Getenv(key)
-	if value == "" {
-		return defaultValue
-	}
-	return value
-}
-
-// getEnvAsInt32 возвращает значение переменной окружения как int32
-func getEnvAsInt32(key string, defaultValue int32) int32 {
-	valueStr := os.Getenv(key)
-	if valueStr == "" {
-		return defaultValue
-	}
-	value, err := strconv.ParseInt(valueStr, 10, 32)
-	if err != nil {
-		return defaultValue
-	}
-	return int32(value)
-}
-
-// getEnvAsDuration возвращает значение переменной окружения как time.Duration
-func getEnvAsDuration(key string, defaultValue time.Duration) time.Duration {
-	valueStr := os.Getenv(key)
-	if valueStr == "" {
-		return defaultValue
-	}
-	value, err := time.ParseDuration(valueStr)
-	if err != nil {
-		return defaultValue
-	}
-	return value
-}
-
-// getEnvAsBool возвращает значение переменной окружения как bool
-func getEnvAsBool(key string, defaultValue bool) bool {
-	valueStr := os.Getenv(key)
-	if valueStr == "" {
-		return defaultValue
-	}
-	value, err := strconv.ParseBool(valueStr)
-	if err != nil {
-		return defaultValue
-	}
-	return value
+// GRPCAddress возвращает адрес gRPC сервера в формате host:port
+func (c *ServerConfig) GRPCAddress() string {
+	return fmt.Sprintf("%s:%s", c.Host, c.GRPCPort)
 }
@@ -0,0 +1,209 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// clearEnv сбрасывает переменные окружения всех полей, чтобы тесты не
+// зависели от окружения, в котором запущены
+func clearEnv(t *testing.T) {
+	t.Helper()
+	for _, f := range fieldsOf(Default()) {
+		t.Setenv(f.env, "")
+	}
+}
+
+func writeConfig(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoad_Defaults(t *testing.T) {
+	clearEnv(t)
+
+	cfg, err := Load(Params{})
+	require.NoError(t, err)
+	assert.Equal(t, Default(), cfg)
+}
+
+func TestLoad_LayerPrecedence(t *testing.T) {
+	clearEnv(t)
+	path := writeConfig(t, "config.yaml", `
+database:
+  host: db.internal
+  max_conns: 50
+server:
+  port: "9000"
+  read_timeout: 30s
+  tls_client_identities:
+    ci-runner: ci
+assignment:
+  strategy: random
+admin:
+  identities: [ops]
+`)
+	t.Setenv("DB_MAX_CONNS", "60")
+	t.Setenv("ADMIN_IDENTITIES", "ops, ci")
+	t.Setenv("SERVER_PORT", "9100")
+
+	cfg, err := Load(Params{
+		File:      path,
+		Overrides: map[string]string{"server.port": "9200"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "db.internal", cfg.Database.Host, "значение из файла")
+	assert.Equal(t, int32(60), cfg.Database.MaxConns, "env важнее файла")
+	assert.Equal(t, "9200", cfg.Server.Port, "флаг важнее env")
+	assert.Equal(t, 30*time.Second, cfg.Server.ReadTimeout)
+	assert.Equal(t, map[string]string{"ci-runner": "ci"}, cfg.Server.TLSClientIdentities)
+	assert.Equal(t, StrategyRandom, cfg.Assignment.Strategy)
+	assert.Equal(t, []string{"ops", "ci"}, cfg.Admin.Identities, "список из env")
+	assert.Equal(t, "localhost:4317", cfg.Tracing.Endpoint, "значение по умолчанию")
+}
+
+func TestLoad_TOML(t *testing.T) {
+	clearEnv(t)
+	path := writeConfig(t, "config.toml", `
+[database]
+max_conns = 40
+
+[assignment]
+default_reviewer_count = 3
+review_sla = "24h"
+`)
+
+	cfg, err := Load(Params{File: path})
+	require.NoError(t, err)
+	assert.Equal(t, int32(40), cfg.Database.MaxConns)
+	assert.Equal(t, 3, cfg.Assignment.DefaultReviewerCount)
+	assert.Equal(t, 24*time.Hour, cfg.Assignment.ReviewSLA)
+}
+
+func TestLoad_ReportsAllErrors(t *testing.T) {
+	clearEnv(t)
+	path := writeConfig(t, "config.yaml", `
+database:
+  max_conn: 10
+logger:
+  level: loud
+unknown_section:
+  key: value
+`)
+	t.Setenv("DB_MAX_CONNS", "abc")
+	t.Setenv("SERVER_READ_TIMEOUT", "10")
+
+	_, err := Load(Params{
+		File:      path,
+		Overrides: map[string]string{"assignment.strategy": "round_robin"},
+	})
+	require.Error(t, err)
+
+	var cfgErr *Error
+	require.True(t, errors.As(err, &cfgErr))
+
+	msg := err.Error()
+	assert.Contains(t, msg, "database.max_conn: unknown key")
+	assert.Contains(t, msg, "unknown_section: unknown key")
+	assert.Contains(t, msg, `database.max_conns: invalid value "abc" in env DB_MAX_CONNS`)
+	assert.Contains(t, msg, `server.read_timeout: invalid value "10" in env SERVER_READ_TIMEOUT`)
+	assert.Contains(t, msg, `logger.level: must be one of`)
+	assert.Contains(t, msg, `assignment.strategy: must be one of`)
+	assert.Len(t, cfgErr.Errors, 6)
+}
+
+func TestLoad_Validation(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("DB_MIN_CONNS", "30")
+	t.Setenv("SERVER_TLS_CLIENT_AUTH", "require")
+	t.Setenv("TRACING_SAMPLE_RATIO", "1.5")
+	t.Setenv("RATE_LIMIT_ROUTES", "/team/deactivate=0.5:2,/pullRequest/create=fast")
+	t.Setenv("WORKLOAD_RECONCILE_INTERVAL", "-1m")
+	t.Setenv("EVENTS_RETENTION", "-1h")
+	t.Setenv("IDEMPOTENCY_RETENTION", "0s")
+	t.Setenv("IDEMPOTENCY_LEASE", "5s")
+	t.Setenv("SERVER_GRPC_ENABLED", "true")
+	t.Setenv("SERVER_GRPC_PORT", "8443")
+	t.Setenv("ADMIN_TOKEN", "short")
+	t.Setenv("SERVER_TRUSTED_PROXIES", "10.0.0.0/8,proxy.local")
+	t.Setenv("ASSIGNMENT_WORKLOAD_WEIGHT", "0")
+	t.Setenv("ASSIGNMENT_SKILL_WEIGHT", "0")
+
+	_, err := Load(Params{})
+	require.Error(t, err)
+	msg := err.Error()
+	assert.Contains(t, msg, "database.min_conns")
+	assert.Contains(t, msg, "server.tls_client_ca_file: required")
+	assert.Contains(t, msg, "tracing.sample_ratio")
+	assert.Contains(t, msg, "rate_limit.routes: route /pullRequest/create: expected rps:burst")
+	assert.Contains(t, msg, "workload.reconcile_interval")
+	assert.Contains(t, msg, "events.retention")
+	assert.Contains(t, msg, "idempotency.retention")
+	assert.Contains(t, msg, "idempotency.lease: must be greater than server.write_timeout 10s, got 5s")
+	assert.Contains(t, msg, "server.grpc_port: must differ")
+	assert.Contains(t, msg, "admin.token: must be at least 16 characters")
+	assert.Contains(t, msg, `server.trusted_proxies: invalid IP or CIDR "proxy.local"`)
+	assert.NotContains(t, msg, `"10.0.0.0/8"`)
+	assert.Contains(t, msg, "assignment.workload_weight: must be positive, got 0")
+	assert.NotContains(t, msg, "assignment.skill_weight", "нулевой вес навыков допустим")
+}
+
+func TestLoad_GRPCRequiresTLS(t *testing.T) {
+	clearEnv(t)
+	t.Setenv("SERVER_TLS_ENABLED", "false")
+	t.Setenv("SERVER_GRPC_ENABLED", "true")
+
+	_, err := Load(Params{})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "server.grpc_enabled: requires tls_enabled or explicit grpc_insecure")
+
+	t.Setenv("SERVER_GRPC_INSECURE", "true")
+	cfg, err := Load(Params{})
+	require.NoError(t, err)
+	assert.True(t, cfg.Server.GRPCEnabled)
+}
+
+func TestParseFlags(t *testing.T) {
+	clearEnv(t)
+	t.Setenv(ConfigFileEnv, "from-env.yaml")
+
+	var out bytes.Buffer
+	params, err := ParseFlags("api", []string{"--print-config", "-server.port=8080"}, &out)
+	require.NoError(t, err)
+	assert.True(t, params.PrintConfig)
+	assert.Equal(t, "from-env.yaml", params.File)
+	assert.Equal(t, map[string]string{"server.port": "8080"}, params.Overrides)
+
+	_, err = ParseFlags("api", []string{"-server.nope=1"}, &out)
+	assert.Error(t, err)
+}
+
+func TestPrint_RedactsSecrets(t *testing.T) {
+	cfg := Default()
+	cfg.Database.Password = "s3cr3t"
+	cfg.Admin.Identities = []string{"ops", "ci"}
+
+	var out bytes.Buffer
+	require.NoError(t, cfg.Print(&out))
+	assert.NotContains(t, out.String(), "s3cr3t")
+	assert.Contains(t, out.String(), "password: "+redacted)
+
+	// Вывод пригоден для повторной загрузки
+	clearEnv(t)
+	path := writeConfig(t, "printed.yaml", out.String())
+	loaded, err := Load(Params{File: path})
+	require.NoError(t, err)
+	assert.Equal(t, cfg.Server, loaded.Server)
+	assert.Equal(t, cfg.Assignment, loaded.Assignment)
+	assert.Equal(t, cfg.Admin, loaded.Admin)
+}
@@ -0,0 +1,54 @@
+package config
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+)
+
+// ConfigFileEnv - переменная окружения с путем к файлу конфигурации
+const ConfigFileEnv = "CONFIG_FILE"
+
+// Params - параметры запуска, полученные из командной строки
+type Params struct {
+	File        string            // путь к файлу YAML или TOML
+	PrintConfig bool              // вывести итоговую конфигурацию и завершиться
+	Overrides   map[string]string // значения полей из флагов, ключ - секция.ключ
+}
+
+// ParseFlags разбирает аргументы командной строки. Для каждого поля
+// конфигурации доступен флаг с именем его ключа, например -server.port=8080.
+// Ошибки разбора вместе со справкой выводятся в output.
+func ParseFlags(name string, args []string, output io.Writer) (Params, error) {
+	params := Params{
+		File:      os.Getenv(ConfigFileEnv),
+		Overrides: make(map[string]string),
+	}
+
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(output)
+	fs.StringVar(&params.File, "config", params.File, "путь к файлу конфигурации (.yaml, .yml или .toml), также $"+ConfigFileEnv)
+	fs.BoolVar(&params.PrintConfig, "print-config", false, "вывести итоговую конфигурацию без секретов и завершиться")
+
+	for _, f := range fieldsOf(Default()) {
+		key := f.key
+		usage := fmt.Sprintf("переопределяет $%s", f.env)
+		fs.Func(key, usage, func(v string) error {
+			params.Overrides[key] = v
+			return nil
+		})
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return Params{}, err
+	}
+	if fs.NArg() > 0 {
+		err := fmt.Errorf("unexpected arguments: %v", fs.Args())
+		fmt.Fprintln(output, err)
+		fs.Usage()
+		return Params{}, err
+	}
+
+	return params, nil
+}
@@ -0,0 +1,289 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// Error содержит все ошибки, найденные при загрузке конфигурации
+type Error struct {
+	Errors []error
+}
+
+// Error реализует error
+func (e *Error) Error() string {
+	var b strings.Builder
+	b.WriteString("invalid configuration:")
+	for _, err := range e.Errors {
+		b.WriteString("\n  - ")
+		b.WriteString(err.Error())
+	}
+	return b.String()
+}
+
+// Unwrap позволяет проверять отдельные ошибки через errors.Is/As
+func (e *Error) Unwrap() []error {
+	return e.Errors
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// field - одно настраиваемое поле конфигурации
+type field struct {
+	key    string // секция.ключ
+	env    string
+	secret bool
+	value  reflect.Value
+}
+
+// fieldsOf перечисляет поля конфигурации в порядке объявления
+func fieldsOf(cfg *Config) []field {
+	var fields []field
+
+	root := reflect.ValueOf(cfg).Elem()
+	for i := 0; i < root.NumField(); i++ {
+		section := root.Type().Field(i)
+		sectionValue := root.Field(i)
+		prefix := section.Tag.Get("config")
+
+		for j := 0; j < sectionValue.NumField(); j++ {
+			f := sectionValue.Type().Field(j)
+			fields = append(fields, field{
+				key:    prefix + "." + f.Tag.Get("config"),
+				env:    f.Tag.Get("env"),
+				secret: f.Tag.Get("secret") == "true",
+				value:  sectionValue.Field(j),
+			})
+		}
+	}
+
+	return fields
+}
+
+// index возвращает поля по ключу
+func index(fields []field) map[string]field {
+	m := make(map[string]field, len(fields))
+	for _, f := range fields {
+		m[f.key] = f
+	}
+	return m
+}
+
+// set разбирает строковое значение и записывает его в поле
+func (f field) set(raw string) error {
+	v := f.value
+	switch {
+	case v.Type() == durationType:
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+	case v.Kind() == reflect.String:
+		v.SetString(raw)
+	case v.Kind() == reflect.Bool:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case v.Kind() == reflect.Int, v.Kind() == reflect.Int32, v.Kind() == reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetInt(n)
+	case v.Kind() == reflect.Float64:
+		x, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		v.SetFloat(x)
+	case v.Kind() == reflect.Map:
+		m, err := parseMap(raw)
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(m))
+	case v.Kind() == reflect.Slice:
+		v.Set(reflect.ValueOf(parseList(raw)))
+	default:
+		return fmt.Errorf("unsupported field type %s", v.Type())
+	}
+	return nil
+}
+
+// setFromFile записывает значение, прочитанное из YAML или TOML
+func (f field) setFromFile(raw any) error {
+	if f.value.Kind() == reflect.Map {
+		if nested, ok := raw.(map[string]any); ok {
+			m := make(map[string]string, len(nested))
+			for k, v := range nested {
+				m[k] = fmt.Sprint(v)
+			}
+			f.value.Set(reflect.ValueOf(m))
+			return nil
+		}
+	}
+
+	if f.value.Kind() == reflect.Slice {
+		if items, ok := raw.([]any); ok {
+			list := make([]string, 0, len(items))
+			for _, item := range items {
+				list = append(list, fmt.Sprint(item))
+			}
+			f.value.Set(reflect.ValueOf(list))
+			return nil
+		}
+	}
+
+	switch raw.(type) {
+	case map[string]any, []any:
+		return fmt.Errorf("expected a scalar value")
+	}
+	return f.set(fmt.Sprint(raw))
+}
+
+// parseList разбирает значение вида "a,b,c", пустые элементы пропускаются
+func parseList(raw string) []string {
+	list := []string{}
+	for _, item := range strings.Split(raw, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// parseMap разбирает значение вида "key1=value1,key2=value2"
+func parseMap(raw string) (map[string]string, error) {
+	m := make(map[string]string)
+	for _, pair := range strings.Split(raw, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		k, v, ok := strings.Cut(pair, "=")
+		k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+		if !ok || k == "" || v == "" {
+			return nil, fmt.Errorf("expected key=value, got %q", pair)
+		}
+		m[k] = v
+	}
+	return m, nil
+}
+
+// applyFile применяет значения из файла YAML или TOML
+func applyFile(fields []field, path string) []error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return []error{fmt.Errorf("config file: %w", err)}
+	}
+
+	raw := make(map[string]any)
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		dec := yaml.NewDecoder(bytes.NewReader(data))
+		if err := dec.Decode(&raw); err != nil && !errors.Is(err, io.EOF) {
+			return []error{fmt.Errorf("config file %s: %w", path, err)}
+		}
+	case ".toml":
+		if err := toml.Unmarshal(data, &raw); err != nil {
+			return []error{fmt.Errorf("config file %s: %w", path, err)}
+		}
+	default:
+		return []error{fmt.Errorf("config file %s: unsupported format, use .yaml, .yml or .toml", path)}
+	}
+
+	byKey := index(fields)
+	sections := make(map[string]bool)
+	for _, f := range fields {
+		section, _, _ := strings.Cut(f.key, ".")
+		sections[section] = true
+	}
+
+	var errs []error
+	var walk func(prefix string, values map[string]any)
+	walk = func(prefix string, values map[string]any) {
+		keys := make([]string, 0, len(values))
+		for k := range values {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			key := k
+			if prefix != "" {
+				key = prefix + "." + k
+			}
+			v := values[k]
+
+			if f, ok := byKey[key]; ok {
+				if err := f.setFromFile(v); err != nil {
+					errs = append(errs, fmt.Errorf("%s: invalid value %v in %s: %w", key, v, path, err))
+				}
+				continue
+			}
+			if nested, ok := v.(map[string]any); ok && prefix == "" && sections[key] {
+				walk(key, nested)
+				continue
+			}
+			errs = append(errs, fmt.Errorf("%s: unknown key in %s", key, path))
+		}
+	}
+	walk("", raw)
+
+	return errs
+}
+
+// applyEnv применяет значения из переменных окружения. Пустая переменная не учитывается.
+func applyEnv(fields []field) []error {
+	var errs []error
+	for _, f := range fields {
+		raw, ok := os.LookupEnv(f.env)
+		if !ok || raw == "" {
+			continue
+		}
+		if err := f.set(raw); err != nil {
+			errs = append(errs, fmt.Errorf("%s: invalid value %q in env %s: %w", f.key, raw, f.env, err))
+		}
+	}
+	return errs
+}
+
+// applyOverrides применяет значения из флагов командной строки
+func applyOverrides(fields []field, overrides map[string]string) []error {
+	byKey := index(fields)
+
+	keys := make([]string, 0, len(overrides))
+	for k := range overrides {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var errs []error
+	for _, key := range keys {
+		raw := overrides[key]
+		f, ok := byKey[key]
+		if !ok {
+			errs = append(errs, fmt.Errorf("%s: unknown flag", key))
+			continue
+		}
+		if err := f.set(raw); err != nil {
+			errs = append(errs, fmt.Errorf("%s: invalid value %q in flag -%s: %w", key, raw, key, err))
+		}
+	}
+	return errs
+}
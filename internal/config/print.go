@@ -0,0 +1,83 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// redacted заменяет значения секретных полей при выводе
+const redacted = "<redacted>"
+
+// Print выводит конфигурацию в YAML, скрывая секреты.
+// Вывод можно использовать как файл конфигурации.
+func (c *Config) Print(w io.Writer) error {
+	root := &yaml.Node{Kind: yaml.MappingNode}
+	sections := make(map[string]*yaml.Node)
+
+	for _, f := range fieldsOf(c) {
+		sectionKey, key, _ := strings.Cut(f.key, ".")
+		section, ok := sections[sectionKey]
+		if !ok {
+			section = &yaml.Node{Kind: yaml.MappingNode}
+			sections[sectionKey] = section
+			root.Content = append(root.Content, scalar(sectionKey), section)
+		}
+		section.Content = append(section.Content, scalar(key), f.node())
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(root); err != nil {
+		return fmt.Errorf("failed to print config: %w", err)
+	}
+	return enc.Close()
+}
+
+// node возвращает значение поля в виде узла YAML
+func (f field) node() *yaml.Node {
+	if f.secret {
+		if f.value.String() == "" {
+			return scalar("")
+		}
+		return scalar(redacted)
+	}
+
+	switch v := f.value.Interface().(type) {
+	case time.Duration:
+		return scalar(v.String())
+	case map[string]string:
+		keys := make([]string, 0, len(v))
+		for k := range v {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		n := &yaml.Node{Kind: yaml.MappingNode, Style: yaml.FlowStyle}
+		for _, k := range keys {
+			n.Content = append(n.Content, scalar(k), scalar(v[k]))
+		}
+		return n
+	case []string:
+		n := &yaml.Node{Kind: yaml.SequenceNode, Style: yaml.FlowStyle}
+		for _, item := range v {
+			n.Content = append(n.Content, scalar(item))
+		}
+		return n
+	}
+
+	n := &yaml.Node{}
+	_ = n.Encode(f.value.Interface())
+	if f.value.Kind() == reflect.String {
+		n.Style = yaml.DoubleQuotedStyle
+	}
+	return n
+}
+
+func scalar(v string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: v}
+}
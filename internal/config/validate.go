@@ -0,0 +1,202 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+)
+
+// minAdminTokenLength - минимальная длина токена администратора
+const minAdminTokenLength = 16
+
+// validate проверяет значения конфигурации и возвращает все найденные ошибки
+func (c *Config) validate() []error {
+	var errs []error
+	add := func(key, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s: "+format, append([]any{key}, args...)...))
+	}
+
+	// База данных
+	if c.Database.Host == "" {
+		add("database.host", "must not be empty")
+	}
+	if !validPort(c.Database.Port) {
+		add("database.port", "invalid port %q", c.Database.Port)
+	}
+	if c.Database.DBName == "" {
+		add("database.name", "must not be empty")
+	}
+	if c.Database.MaxConns <= 0 {
+		add("database.max_conns", "must be positive, got %d", c.Database.MaxConns)
+	}
+	if c.Database.MinConns < 0 || c.Database.MinConns > c.Database.MaxConns {
+		add("database.min_conns", "must be between 0 and max_conns (%d), got %d", c.Database.MaxConns, c.Database.MinConns)
+	}
+	oneOf(add, "database.ssl_mode", c.Database.SSLMode, "disable", "allow", "prefer", "require", "verify-ca", "verify-full")
+
+	// Сервер
+	if !validPort(c.Server.Port) {
+		add("server.port", "invalid port %q", c.Server.Port)
+	}
+	if c.Server.GRPCEnabled {
+		if !validPort(c.Server.GRPCPort) {
+			add("server.grpc_port", "invalid port %q", c.Server.GRPCPort)
+		} else if c.Server.GRPCPort == c.Server.Port {
+			add("server.grpc_port", "must differ from server.port")
+		}
+		if !c.Server.TLSEnabled && !c.Server.GRPCInsecure {
+			add("server.grpc_enabled", "requires tls_enabled or explicit grpc_insecure")
+		}
+	}
+	positive(add, "server.read_timeout", c.Server.ReadTimeout)
+	positive(add, "server.read_header_timeout", c.Server.ReadHeaderTimeout)
+	positive(add, "server.write_timeout", c.Server.WriteTimeout)
+	positive(add, "server.idle_timeout", c.Server.IdleTimeout)
+	positive(add, "server.shutdown_timeout", c.Server.ShutdownTimeout)
+	positive(add, "server.max_body_bytes", c.Server.MaxBodyBytes)
+	for _, proxy := range c.Server.TrustedProxies {
+		if net.ParseIP(proxy) == nil {
+			if _, _, err := net.ParseCIDR(proxy); err != nil {
+				add("server.trusted_proxies", "invalid IP or CIDR %q", proxy)
+			}
+		}
+	}
+	if c.Server.TLSReloadInterval < 0 {
+		add("server.tls_reload_interval", "must not be negative")
+	}
+	if c.Server.TLSEnabled {
+		if c.Server.TLSCertFile == "" {
+			add("server.tls_cert_file", "required when TLS is enabled")
+		}
+		if c.Server.TLSKeyFile == "" {
+			add("server.tls_key_file", "required when TLS is enabled")
+		}
+		oneOf(add, "server.tls_client_auth", c.Server.TLSClientAuth, "none", "optional", "require")
+		if c.Server.TLSClientAuth != "none" && c.Server.TLSClientCAFile == "" {
+			add("server.tls_client_ca_file", "required when tls_client_auth is %q", c.Server.TLSClientAuth)
+		}
+	}
+
+	// Логирование
+	oneOf(add, "logger.level", c.Logger.Level, "debug", "info", "warn", "warning", "error", "fatal")
+	oneOf(add, "logger.format", c.Logger.Format, "json", "console")
+
+	// Трассировка
+	if c.Tracing.Enabled {
+		oneOf(add, "tracing.exporter", c.Tracing.Exporter, "otlp", "stdout")
+		if c.Tracing.Exporter == "otlp" {
+			oneOf(add, "tracing.protocol", c.Tracing.Protocol, "grpc", "http")
+			if c.Tracing.Endpoint == "" {
+				add("tracing.endpoint", "required for otlp exporter")
+			}
+		}
+		positive(add, "tracing.export_timeout", c.Tracing.ExportTimeout)
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		add("tracing.sample_ratio", "must be between 0 and 1, got %g", c.Tracing.SampleRatio)
+	}
+
+	// Проверки состояния
+	positive(add, "health.check_timeout", c.Health.CheckTimeout)
+	if c.Health.PoolSaturationThreshold <= 0 || c.Health.PoolSaturationThreshold > 1 {
+		add("health.pool_saturation_threshold", "must be in (0, 1], got %g", c.Health.PoolSaturationThreshold)
+	}
+
+	// Назначение ревьюеров
+	for _, err := range c.Assignment.Validate() {
+		errs = append(errs, fmt.Errorf("assignment.%w", err))
+	}
+
+	// Ограничение частоты запросов
+	if c.RateLimit.Enabled {
+		if c.RateLimit.RPS <= 0 {
+			add("rate_limit.rps", "must be positive, got %g", c.RateLimit.RPS)
+		}
+		if c.RateLimit.Burst <= 0 {
+			add("rate_limit.burst", "must be positive, got %d", c.RateLimit.Burst)
+		}
+		positive(add, "rate_limit.idle_timeout", c.RateLimit.IdleTimeout)
+		if _, err := c.RateLimit.RouteLimits(); err != nil {
+			add("rate_limit.routes", "%v", err)
+		}
+	}
+
+	// Учет нагрузки
+	if c.Workload.ReconcileInterval < 0 {
+		add("workload.reconcile_interval", "must not be negative, got %s", c.Workload.ReconcileInterval)
+	}
+
+	// Журнал событий
+	if c.Events.Retention < 0 {
+		add("events.retention", "must not be negative, got %s", c.Events.Retention)
+	}
+
+	// Ключи идемпотентности
+	if c.Idempotency.Enabled && c.Idempotency.Retention <= 0 {
+		add("idempotency.retention", "must be positive, got %s", c.Idempotency.Retention)
+	}
+	// Аренда короче записи ответа позволила бы повтору выполнить еще идущий запрос
+	if c.Idempotency.Enabled && c.Idempotency.Lease <= c.Server.WriteTimeout {
+		add("idempotency.lease", "must be greater than server.write_timeout %s, got %s", c.Server.WriteTimeout, c.Idempotency.Lease)
+	}
+
+	// Администрирование
+	if token := c.Admin.Token; token != "" && len(token) < minAdminTokenLength {
+		add("admin.token", "must be at least %d characters", minAdminTokenLength)
+	}
+
+	return errs
+}
+
+// Validate проверяет политику назначения. Используется и при загрузке
+// конфигурации, и при обновлении политики во время работы.
+// Ошибки начинаются с ключа поля без имени секции.
+func (a AssignmentConfig) Validate() []error {
+	var errs []error
+	add := func(key, format string, args ...any) {
+		errs = append(errs, fmt.Errorf("%s: "+format, append([]any{key}, args...)...))
+	}
+
+	if a.DefaultReviewerCount < 0 {
+		add("default_reviewer_count", "must not be negative, got %d", a.DefaultReviewerCount)
+	}
+	oneOf(add, "strategy", a.Strategy, StrategyLeastLoaded, StrategyRandom)
+	positive(add, "review_sla", a.ReviewSLA)
+	if a.MaxOpenReviews < 0 {
+		add("max_open_reviews", "must not be negative, got %d", a.MaxOpenReviews)
+	}
+	// Без веса нагрузки оценка перестает распределять ревью по команде
+	if a.WorkloadWeight <= 0 {
+		add("workload_weight", "must be positive, got %g", a.WorkloadWeight)
+	}
+	if a.SkillWeight < 0 {
+		add("skill_weight", "must not be negative, got %g", a.SkillWeight)
+	}
+
+	return errs
+}
+
+type addFunc func(key, format string, args ...any)
+
+// oneOf проверяет, что значение входит в список допустимых
+func oneOf(add addFunc, key, value string, allowed ...string) {
+	for _, a := range allowed {
+		if value == a {
+			return
+		}
+	}
+	add(key, "must be one of %v, got %q", allowed, value)
+}
+
+// positive проверяет, что длительность больше нуля
+func positive[T ~int64](add addFunc, key string, value T) {
+	if value <= 0 {
+		add(key, "must be positive")
+	}
+}
+
+// validPort проверяет номер TCP порта
+func validPort(port string) bool {
+	n, err := strconv.Atoi(port)
+	return err == nil && n > 0 && n <= 65535
+}
@@ -0,0 +1,236 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: analytics.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const getReviewTimeAnalytics = `-- name: GetReviewTimeAnalytics :many
+WITH buckets AS (
+    SELECT s::timestamp as bucket_start
+    FROM generate_series(
+        date_trunc($1::text, $2::timestamp),
+        $3::timestamp,
+        ('1 ' || $1::text)::interval
+    ) s
+    WHERE s < $3::timestamp
+), first_assignment AS (
+    -- Из журнала: замена ревьюера перезаписывает assigned_at в pr_reviewers
+    SELECT l.pull_request_id, MIN(l.assigned_at) as assigned_at
+    FROM reviewer_assignment_log l
+    GROUP BY l.pull_request_id
+), prs AS (
+    SELECT
+        date_trunc($1::text, p.created_at) as bucket_start,
+        EXTRACT(EPOCH FROM fa.assigned_at - p.created_at)::float8 as to_first_assignment,
+        EXTRACT(EPOCH FROM p.merged_at - p.created_at)::float8 as to_merge
+    FROM pull_requests p
+    LEFT JOIN first_assignment fa ON fa.pull_request_id = p.pull_request_id
+    WHERE p.created_at >= $2::timestamp
+      AND p.created_at < $3::timestamp
+)
+SELECT
+    b.bucket_start,
+    COUNT(prs.bucket_start) as prs_opened,
+    COUNT(prs.to_merge) as prs_merged,
+    -- p50, p90 и p99 в секундах, NULL для интервала без подходящих PR
+    (percentile_cont(ARRAY[0.5, 0.9, 0.99]::float8[]) WITHIN GROUP (ORDER BY prs.to_first_assignment))::float8[] as first_assignment_percentiles,
+    (percentile_cont(ARRAY[0.5, 0.9, 0.99]::float8[]) WITHIN GROUP (ORDER BY prs.to_merge))::float8[] as merge_percentiles
+FROM buckets b
+LEFT JOIN prs ON prs.bucket_start = b.bucket_start
+GROUP BY b.bucket_start
+ORDER BY b.bucket_start
+`
+
+type GetReviewTimeAnalyticsParams struct {
+	Bucket     string           `json:"bucket"`
+	RangeStart pgtype.Timestamp `json:"range_start"`
+	RangeEnd   pgtype.Timestamp `json:"range_end"`
+}
+
+type GetReviewTimeAnalyticsRow struct {
+	BucketStart                pgtype.Timestamp `json:"bucket_start"`
+	PrsOpened                  int64            `json:"prs_opened"`
+	PrsMerged                  int64            `json:"prs_merged"`
+	FirstAssignmentPercentiles []float64        `json:"first_assignment_percentiles"`
+	MergePercentiles           []float64        `json:"merge_percentiles"`
+}
+
+// Перцентили времени до первого назначения и до слияния для PR, созданных
+// в каждом интервале [range_start, range_end). Пустые интервалы тоже
+// возвращаются, чтобы ряд был непрерывным.
+func (q *Queries) GetReviewTimeAnalytics(ctx context.Context, arg GetReviewTimeAnalyticsParams) ([]GetReviewTimeAnalyticsRow, error) {
+	rows, err := q.db.Query(ctx, getReviewTimeAnalytics, arg.Bucket, arg.RangeStart, arg.RangeEnd)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetReviewTimeAnalyticsRow{}
+	for rows.Next() {
+		var i GetReviewTimeAnalyticsRow
+		if err := rows.Scan(
+			&i.BucketStart,
+			&i.PrsOpened,
+			&i.PrsMerged,
+			&i.FirstAssignmentPercentiles,
+			&i.MergePercentiles,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReviewerThroughput = `-- name: GetReviewerThroughput :many
+SELECT
+    date_trunc($1::text, r.assigned_at)::timestamp as bucket_start,
+    u.user_id,
+    u.username,
+    t.team_name,
+    COUNT(*) as reviews_assigned,
+    COUNT(*) FILTER (WHERE p.status = 'MERGED') as reviews_merged,
+    (COUNT(*)::float8 / SUM(COUNT(*)) OVER (PARTITION BY date_trunc($1::text, r.assigned_at)))::float8 as share
+FROM pr_reviewers r
+JOIN users u ON u.user_id = r.user_id
+JOIN teams t ON t.id = u.team_id
+JOIN pull_requests p ON p.pull_request_id = r.pull_request_id
+WHERE r.assigned_at >= $2::timestamp
+  AND r.assigned_at < $3::timestamp
+GROUP BY date_trunc($1::text, r.assigned_at), u.user_id, u.username, t.team_name
+ORDER BY bucket_start, reviews_assigned DESC, u.user_id
+`
+
+type GetReviewerThroughputParams struct {
+	Bucket     string           `json:"bucket"`
+	RangeStart pgtype.Timestamp `json:"range_start"`
+	RangeEnd   pgtype.Timestamp `json:"range_end"`
+}
+
+type GetReviewerThroughputRow struct {
+	BucketStart     pgtype.Timestamp `json:"bucket_start"`
+	UserID          string           `json:"user_id"`
+	Username        string           `json:"username"`
+	TeamName        string           `json:"team_name"`
+	ReviewsAssigned int64            `json:"reviews_assigned"`
+	ReviewsMerged   int64            `json:"reviews_merged"`
+	Share           float64          `json:"share"`
+}
+
+// Количество назначений каждого ревьюера по интервалам и его доля среди
+// всех назначений интервала. Интервалы без назначений ревьюера опускаются.
+func (q *Queries) GetReviewerThroughput(ctx context.Context, arg GetReviewerThroughputParams) ([]GetReviewerThroughputRow, error) {
+	rows, err := q.db.Query(ctx, getReviewerThroughput, arg.Bucket, arg.RangeStart, arg.RangeEnd)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetReviewerThroughputRow{}
+	for rows.Next() {
+		var i GetReviewerThroughputRow
+		if err := rows.Scan(
+			&i.BucketStart,
+			&i.UserID,
+			&i.Username,
+			&i.TeamName,
+			&i.ReviewsAssigned,
+			&i.ReviewsMerged,
+			&i.Share,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamThroughput = `-- name: GetTeamThroughput :many
+WITH buckets AS (
+    SELECT s::timestamp as bucket_start
+    FROM generate_series(
+        date_trunc($1::text, $2::timestamp),
+        $3::timestamp,
+        ('1 ' || $1::text)::interval
+    ) s
+    WHERE s < $3::timestamp
+), opened AS (
+    SELECT u.team_id, date_trunc($1::text, p.created_at) as bucket_start, COUNT(*) as prs_opened
+    FROM pull_requests p
+    JOIN users u ON u.user_id = p.author_id
+    WHERE p.created_at >= $2::timestamp
+      AND p.created_at < $3::timestamp
+    GROUP BY u.team_id, date_trunc($1::text, p.created_at)
+), merged AS (
+    SELECT u.team_id, date_trunc($1::text, p.merged_at) as bucket_start, COUNT(*) as prs_merged
+    FROM pull_requests p
+    JOIN users u ON u.user_id = p.author_id
+    WHERE p.merged_at >= $2::timestamp
+      AND p.merged_at < $3::timestamp
+    GROUP BY u.team_id, date_trunc($1::text, p.merged_at)
+)
+SELECT
+    b.bucket_start,
+    t.team_name,
+    COALESCE(o.prs_opened, 0)::bigint as prs_opened,
+    COALESCE(m.prs_merged, 0)::bigint as prs_merged,
+    (SUM(COALESCE(m.prs_merged, 0)) OVER (PARTITION BY t.id ORDER BY b.bucket_start))::bigint as prs_merged_cumulative
+FROM buckets b
+CROSS JOIN teams t
+LEFT JOIN opened o ON o.team_id = t.id AND o.bucket_start = b.bucket_start
+LEFT JOIN merged m ON m.team_id = t.id AND m.bucket_start = b.bucket_start
+ORDER BY t.team_name, b.bucket_start
+`
+
+type GetTeamThroughputParams struct {
+	Bucket     string           `json:"bucket"`
+	RangeStart pgtype.Timestamp `json:"range_start"`
+	RangeEnd   pgtype.Timestamp `json:"range_end"`
+}
+
+type GetTeamThroughputRow struct {
+	BucketStart         pgtype.Timestamp `json:"bucket_start"`
+	TeamName            string           `json:"team_name"`
+	PrsOpened           int64            `json:"prs_opened"`
+	PrsMerged           int64            `json:"prs_merged"`
+	PrsMergedCumulative int64            `json:"prs_merged_cumulative"`
+}
+
+// Открытые и слитые PR каждой команды по интервалам с нарастающим итогом
+// слитых PR с начала диапазона. Команда автора определяется текущей.
+func (q *Queries) GetTeamThroughput(ctx context.Context, arg GetTeamThroughputParams) ([]GetTeamThroughputRow, error) {
+	rows, err := q.db.Query(ctx, getTeamThroughput, arg.Bucket, arg.RangeStart, arg.RangeEnd)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTeamThroughputRow{}
+	for rows.Next() {
+		var i GetTeamThroughputRow
+		if err := rows.Scan(
+			&i.BucketStart,
+			&i.TeamName,
+			&i.PrsOpened,
+			&i.PrsMerged,
+			&i.PrsMergedCumulative,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -0,0 +1,85 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: audit.sql
+
+package db
+
+import (
+	"context"
+)
+
+const addAuditEntry = `-- name: AddAuditEntry :one
+INSERT INTO audit_log (action, actor, pull_request_id, user_id, details)
+VALUES ($1, $2, $3, $4, $5)
+RETURNING id, action, actor, pull_request_id, user_id, details, created_at
+`
+
+type AddAuditEntryParams struct {
+	Action        string  `json:"action"`
+	Actor         string  `json:"actor"`
+	PullRequestID *string `json:"pull_request_id"`
+	UserID        *string `json:"user_id"`
+	Details       []byte  `json:"details"`
+}
+
+func (q *Queries) AddAuditEntry(ctx context.Context, arg AddAuditEntryParams) (AuditLog, error) {
+	row := q.db.QueryRow(ctx, addAuditEntry,
+		arg.Action,
+		arg.Actor,
+		arg.PullRequestID,
+		arg.UserID,
+		arg.Details,
+	)
+	var i AuditLog
+	err := row.Scan(
+		&i.ID,
+		&i.Action,
+		&i.Actor,
+		&i.PullRequestID,
+		&i.UserID,
+		&i.Details,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listAuditEntries = `-- name: ListAuditEntries :many
+SELECT id, action, actor, pull_request_id, user_id, details, created_at FROM audit_log
+WHERE $1::text = '' OR pull_request_id = $1
+ORDER BY id DESC
+LIMIT $2
+`
+
+type ListAuditEntriesParams struct {
+	PullRequestID string `json:"pull_request_id"`
+	MaxEntries    int32  `json:"max_entries"`
+}
+
+func (q *Queries) ListAuditEntries(ctx context.Context, arg ListAuditEntriesParams) ([]AuditLog, error) {
+	rows, err := q.db.Query(ctx, listAuditEntries, arg.PullRequestID, arg.MaxEntries)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AuditLog{}
+	for rows.Next() {
+		var i AuditLog
+		if err := rows.Scan(
+			&i.ID,
+			&i.Action,
+			&i.Actor,
+			&i.PullRequestID,
+			&i.UserID,
+			&i.Details,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
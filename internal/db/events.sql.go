@@ -0,0 +1,129 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: events.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const deleteEventsBefore = `-- name: DeleteEventsBefore :execrows
+DELETE FROM assignment_events
+WHERE created_at < $1
+`
+
+func (q *Queries) DeleteEventsBefore(ctx context.Context, before pgtype.Timestamp) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteEventsBefore, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getLatestEventID = `-- name: GetLatestEventID :one
+SELECT COALESCE(MAX(id), 0)::bigint FROM assignment_events
+`
+
+func (q *Queries) GetLatestEventID(ctx context.Context) (int64, error) {
+	row := q.db.QueryRow(ctx, getLatestEventID)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const listEventsAfter = `-- name: ListEventsAfter :many
+SELECT id, event_type, pull_request_id, author_id, user_id, previous_user_id, team_name, details, created_at FROM assignment_events
+WHERE id > $1
+  AND ($2::text = '' OR pull_request_id = $2)
+  AND ($3::text = '' OR team_name = $3)
+  AND ($4::text = '' OR $4 IN (user_id, previous_user_id, author_id))
+ORDER BY id
+LIMIT $5
+`
+
+type ListEventsAfterParams struct {
+	AfterID       int64  `json:"after_id"`
+	PullRequestID string `json:"pull_request_id"`
+	TeamName      string `json:"team_name"`
+	UserID        string `json:"user_id"`
+	MaxEvents     int32  `json:"max_events"`
+}
+
+// События после after_id по возрастанию. Пустой фильтр не ограничивает выборку,
+// user_id совпадает с ревьюером, замененным ревьюером или автором PR.
+func (q *Queries) ListEventsAfter(ctx context.Context, arg ListEventsAfterParams) ([]AssignmentEvent, error) {
+	rows, err := q.db.Query(ctx, listEventsAfter,
+		arg.AfterID,
+		arg.PullRequestID,
+		arg.TeamName,
+		arg.UserID,
+		arg.MaxEvents,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AssignmentEvent{}
+	for rows.Next() {
+		var i AssignmentEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventType,
+			&i.PullRequestID,
+			&i.AuthorID,
+			&i.UserID,
+			&i.PreviousUserID,
+			&i.TeamName,
+			&i.Details,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listEventsByIDs = `-- name: ListEventsByIDs :many
+SELECT id, event_type, pull_request_id, author_id, user_id, previous_user_id, team_name, details, created_at FROM assignment_events
+WHERE id = ANY($1::bigint[])
+ORDER BY id
+`
+
+// События с указанными id: перечитывание id, пропущенных при рассылке
+func (q *Queries) ListEventsByIDs(ctx context.Context, ids []int64) ([]AssignmentEvent, error) {
+	rows, err := q.db.Query(ctx, listEventsByIDs, ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []AssignmentEvent{}
+	for rows.Next() {
+		var i AssignmentEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.EventType,
+			&i.PullRequestID,
+			&i.AuthorID,
+			&i.UserID,
+			&i.PreviousUserID,
+			&i.TeamName,
+			&i.Details,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
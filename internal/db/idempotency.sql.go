@@ -0,0 +1,125 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: idempotency.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const completeIdempotencyKey = `-- name: CompleteIdempotencyKey :exec
+UPDATE idempotency_keys
+SET status_code = $3, content_type = $4, response_body = $5
+WHERE client = $1 AND idempotency_key = $2
+`
+
+type CompleteIdempotencyKeyParams struct {
+	Client         string `json:"client"`
+	IdempotencyKey string `json:"idempotency_key"`
+	StatusCode     *int32 `json:"status_code"`
+	ContentType    string `json:"content_type"`
+	ResponseBody   []byte `json:"response_body"`
+}
+
+func (q *Queries) CompleteIdempotencyKey(ctx context.Context, arg CompleteIdempotencyKeyParams) error {
+	_, err := q.db.Exec(ctx, completeIdempotencyKey,
+		arg.Client,
+		arg.IdempotencyKey,
+		arg.StatusCode,
+		arg.ContentType,
+		arg.ResponseBody,
+	)
+	return err
+}
+
+const deleteIdempotencyKeysBefore = `-- name: DeleteIdempotencyKeysBefore :execrows
+DELETE FROM idempotency_keys
+WHERE created_at < $1
+`
+
+func (q *Queries) DeleteIdempotencyKeysBefore(ctx context.Context, before pgtype.Timestamp) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteIdempotencyKeysBefore, before)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getIdempotencyKey = `-- name: GetIdempotencyKey :one
+SELECT client, idempotency_key, request_hash, status_code, content_type, response_body, created_at, reserved_at FROM idempotency_keys
+WHERE client = $1 AND idempotency_key = $2
+`
+
+type GetIdempotencyKeyParams struct {
+	Client         string `json:"client"`
+	IdempotencyKey string `json:"idempotency_key"`
+}
+
+func (q *Queries) GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error) {
+	row := q.db.QueryRow(ctx, getIdempotencyKey, arg.Client, arg.IdempotencyKey)
+	var i IdempotencyKey
+	err := row.Scan(
+		&i.Client,
+		&i.IdempotencyKey,
+		&i.RequestHash,
+		&i.StatusCode,
+		&i.ContentType,
+		&i.ResponseBody,
+		&i.CreatedAt,
+		&i.ReservedAt,
+	)
+	return i, err
+}
+
+const releaseIdempotencyKey = `-- name: ReleaseIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE client = $1 AND idempotency_key = $2 AND status_code IS NULL
+`
+
+type ReleaseIdempotencyKeyParams struct {
+	Client         string `json:"client"`
+	IdempotencyKey string `json:"idempotency_key"`
+}
+
+// Освобождает ключ незавершенного запроса, чтобы его можно было повторить
+func (q *Queries) ReleaseIdempotencyKey(ctx context.Context, arg ReleaseIdempotencyKeyParams) error {
+	_, err := q.db.Exec(ctx, releaseIdempotencyKey, arg.Client, arg.IdempotencyKey)
+	return err
+}
+
+const reserveIdempotencyKey = `-- name: ReserveIdempotencyKey :execrows
+INSERT INTO idempotency_keys (client, idempotency_key, request_hash)
+VALUES ($1, $2, $3)
+ON CONFLICT (client, idempotency_key) DO UPDATE
+SET reserved_at = NOW()
+WHERE idempotency_keys.status_code IS NULL
+  AND idempotency_keys.request_hash = EXCLUDED.request_hash
+  AND idempotency_keys.reserved_at < NOW() - make_interval(secs => $4::float8)
+`
+
+type ReserveIdempotencyKeyParams struct {
+	Client         string  `json:"client"`
+	IdempotencyKey string  `json:"idempotency_key"`
+	RequestHash    string  `json:"request_hash"`
+	LeaseSeconds   float64 `json:"lease_seconds"`
+}
+
+// Занимает ключ для нового запроса. Незавершенный запрос с тем же телом,
+// чья аренда истекла, считается прерванным, и ключ занимается заново.
+// 0 строк - ключ уже использован.
+func (q *Queries) ReserveIdempotencyKey(ctx context.Context, arg ReserveIdempotencyKeyParams) (int64, error) {
+	result, err := q.db.Exec(ctx, reserveIdempotencyKey,
+		arg.Client,
+		arg.IdempotencyKey,
+		arg.RequestHash,
+		arg.LeaseSeconds,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
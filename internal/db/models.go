@@ -8,11 +8,54 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-type PrReviewer struct {
+type AssignmentEvent struct {
+	ID             int64            `json:"id"`
+	EventType      string           `json:"event_type"`
+	PullRequestID  *string          `json:"pull_request_id"`
+	AuthorID       *string          `json:"author_id"`
+	UserID         *string          `json:"user_id"`
+	PreviousUserID *string          `json:"previous_user_id"`
+	TeamName       *string          `json:"team_name"`
+	Details        []byte           `json:"details"`
+	CreatedAt      pgtype.Timestamp `json:"created_at"`
+}
+
+type AuditLog struct {
 	ID            int64            `json:"id"`
-	PullRequestID string           `json:"pull_request_id"`
-	UserID        string           `json:"user_id"`
-	AssignedAt    pgtype.Timestamp `json:"assigned_at"`
+	Action        string           `json:"action"`
+	Actor         string           `json:"actor"`
+	PullRequestID *string          `json:"pull_request_id"`
+	UserID        *string          `json:"user_id"`
+	Details       []byte           `json:"details"`
+	CreatedAt     pgtype.Timestamp `json:"created_at"`
+}
+
+type IdempotencyKey struct {
+	Client         string           `json:"client"`
+	IdempotencyKey string           `json:"idempotency_key"`
+	RequestHash    string           `json:"request_hash"`
+	StatusCode     *int32           `json:"status_code"`
+	ContentType    string           `json:"content_type"`
+	ResponseBody   []byte           `json:"response_body"`
+	CreatedAt      pgtype.Timestamp `json:"created_at"`
+	ReservedAt     pgtype.Timestamp `json:"reserved_at"`
+}
+
+type PrRequiredTag struct {
+	PullRequestID string `json:"pull_request_id"`
+	Tag           string `json:"tag"`
+}
+
+type PrReviewer struct {
+	ID                   int64            `json:"id"`
+	PullRequestID        string           `json:"pull_request_id"`
+	UserID               string           `json:"user_id"`
+	AssignedAt           pgtype.Timestamp `json:"assigned_at"`
+	Source               string           `json:"source"`
+	Strategy             *string          `json:"strategy"`
+	WorkloadAtAssignment *int64           `json:"workload_at_assignment"`
+	// TRUE if an unselected candidate had the same score; NULL for assignments not selected by policy
+	TieBreak *bool `json:"tie_break"`
 }
 
 type PullRequest struct {
@@ -25,10 +68,38 @@ type PullRequest struct {
 	MergedAt        pgtype.Timestamp `json:"merged_at"`
 }
 
+type ReviewerAssignmentLog struct {
+	ID            int64            `json:"id"`
+	PullRequestID string           `json:"pull_request_id"`
+	UserID        string           `json:"user_id"`
+	Source        string           `json:"source"`
+	Strategy      *string          `json:"strategy"`
+	TieBreak      *bool            `json:"tie_break"`
+	AssignedAt    pgtype.Timestamp `json:"assigned_at"`
+}
+
+type ReviewerRule struct {
+	ID         int64            `json:"id"`
+	Effect     string           `json:"effect"`
+	ReviewerID string           `json:"reviewer_id"`
+	AuthorID   *string          `json:"author_id"`
+	TeamID     *int64           `json:"team_id"`
+	Reason     string           `json:"reason"`
+	CreatedBy  string           `json:"created_by"`
+	CreatedAt  pgtype.Timestamp `json:"created_at"`
+}
+
+type ReviewerWorkload struct {
+	UserID      string           `json:"user_id"`
+	OpenReviews int64            `json:"open_reviews"`
+	UpdatedAt   pgtype.Timestamp `json:"updated_at"`
+}
+
 type Team struct {
-	ID        int64            `json:"id"`
-	TeamName  string           `json:"team_name"`
-	CreatedAt pgtype.Timestamp `json:"created_at"`
+	ID                int64            `json:"id"`
+	TeamName          string           `json:"team_name"`
+	CreatedAt         pgtype.Timestamp `json:"created_at"`
+	MentorshipEnabled bool             `json:"mentorship_enabled"`
 }
 
 type User struct {
@@ -39,4 +110,20 @@ type User struct {
 	IsActive  bool             `json:"is_active"`
 	CreatedAt pgtype.Timestamp `json:"created_at"`
 	UpdatedAt pgtype.Timestamp `json:"updated_at"`
+	Seniority string           `json:"seniority"`
+}
+
+type UserSkill struct {
+	UserID string `json:"user_id"`
+	Tag    string `json:"tag"`
+	Level  string `json:"level"`
+}
+
+type UserUnavailability struct {
+	ID        int64            `json:"id"`
+	UserID    string           `json:"user_id"`
+	StartsAt  pgtype.Timestamp `json:"starts_at"`
+	EndsAt    pgtype.Timestamp `json:"ends_at"`
+	Reason    string           `json:"reason"`
+	CreatedAt pgtype.Timestamp `json:"created_at"`
 }
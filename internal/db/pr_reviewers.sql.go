@@ -12,24 +12,39 @@ import (
 )
 
 const addReviewer = `-- name: AddReviewer :one
-INSERT INTO pr_reviewers (pull_request_id, user_id)
-VALUES ($1, $2)
-RETURNING id, pull_request_id, user_id, assigned_at
+INSERT INTO pr_reviewers (pull_request_id, user_id, source, strategy, workload_at_assignment, tie_break)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, pull_request_id, user_id, assigned_at, source, strategy, workload_at_assignment, tie_break
 `
 
 type AddReviewerParams struct {
-	PullRequestID string `json:"pull_request_id"`
-	UserID        string `json:"user_id"`
+	PullRequestID        string  `json:"pull_request_id"`
+	UserID               string  `json:"user_id"`
+	Source               string  `json:"source"`
+	Strategy             *string `json:"strategy"`
+	WorkloadAtAssignment *int64  `json:"workload_at_assignment"`
+	TieBreak             *bool   `json:"tie_break"`
 }
 
 func (q *Queries) AddReviewer(ctx context.Context, arg AddReviewerParams) (PrReviewer, error) {
-	row := q.db.QueryRow(ctx, addReviewer, arg.PullRequestID, arg.UserID)
+	row := q.db.QueryRow(ctx, addReviewer,
+		arg.PullRequestID,
+		arg.UserID,
+		arg.Source,
+		arg.Strategy,
+		arg.WorkloadAtAssignment,
+		arg.TieBreak,
+	)
 	var i PrReviewer
 	err := row.Scan(
 		&i.ID,
 		&i.PullRequestID,
 		&i.UserID,
 		&i.AssignedAt,
+		&i.Source,
+		&i.Strategy,
+		&i.WorkloadAtAssignment,
+		&i.TieBreak,
 	)
 	return i, err
 }
@@ -47,10 +62,12 @@ func (q *Queries) CountReviewersByPRID(ctx context.Context, pullRequestID string
 }
 
 const getOpenPRsWithInactiveReviewers = `-- name: GetOpenPRsWithInactiveReviewers :many
-SELECT DISTINCT p.pull_request_id, p.author_id, pr.user_id as inactive_reviewer_id, u.team_id
+SELECT DISTINCT p.pull_request_id, p.author_id, pr.user_id as inactive_reviewer_id, u.team_id,
+    a.team_id AS author_team_id
 FROM pull_requests p
 JOIN pr_reviewers pr ON p.pull_request_id = pr.pull_request_id
 JOIN users u ON pr.user_id = u.user_id
+JOIN users a ON p.author_id = a.user_id
 WHERE p.status = 'OPEN' AND u.is_active = false
 ORDER BY p.pull_request_id
 `
@@ -60,6 +77,7 @@ type GetOpenPRsWithInactiveReviewersRow struct {
 	AuthorID           string `json:"author_id"`
 	InactiveReviewerID string `json:"inactive_reviewer_id"`
 	TeamID             int64  `json:"team_id"`
+	AuthorTeamID       int64  `json:"author_team_id"`
 }
 
 func (q *Queries) GetOpenPRsWithInactiveReviewers(ctx context.Context) ([]GetOpenPRsWithInactiveReviewersRow, error) {
@@ -76,6 +94,7 @@ func (q *Queries) GetOpenPRsWithInactiveReviewers(ctx context.Context) ([]GetOpe
 			&i.AuthorID,
 			&i.InactiveReviewerID,
 			&i.TeamID,
+			&i.AuthorTeamID,
 		); err != nil {
 			return nil, err
 		}
@@ -88,7 +107,7 @@ func (q *Queries) GetOpenPRsWithInactiveReviewers(ctx context.Context) ([]GetOpe
 }
 
 const getPullRequestsByReviewerUserID = `-- name: GetPullRequestsByReviewerUserID :many
-SELECT pr.id, pr.pull_request_id, pr.user_id, pr.assigned_at, p.pull_request_name, p.author_id, p.status, p.created_at, p.merged_at
+SELECT pr.id, pr.pull_request_id, pr.user_id, pr.assigned_at, pr.source, pr.strategy, pr.workload_at_assignment, pr.tie_break, p.pull_request_name, p.author_id, p.status, p.created_at, p.merged_at
 FROM pr_reviewers pr
 JOIN pull_requests p ON pr.pull_request_id = p.pull_request_id
 WHERE pr.user_id = $1
@@ -96,15 +115,19 @@ ORDER BY p.created_at DESC
 `
 
 type GetPullRequestsByReviewerUserIDRow struct {
-	ID              int64            `json:"id"`
-	PullRequestID   string           `json:"pull_request_id"`
-	UserID          string           `json:"user_id"`
-	AssignedAt      pgtype.Timestamp `json:"assigned_at"`
-	PullRequestName string           `json:"pull_request_name"`
-	AuthorID        string           `json:"author_id"`
-	Status          string           `json:"status"`
-	CreatedAt       pgtype.Timestamp `json:"created_at"`
-	MergedAt        pgtype.Timestamp `json:"merged_at"`
+	ID                   int64            `json:"id"`
+	PullRequestID        string           `json:"pull_request_id"`
+	UserID               string           `json:"user_id"`
+	AssignedAt           pgtype.Timestamp `json:"assigned_at"`
+	Source               string           `json:"source"`
+	Strategy             *string          `json:"strategy"`
+	WorkloadAtAssignment *int64           `json:"workload_at_assignment"`
+	TieBreak             *bool            `json:"tie_break"`
+	PullRequestName      string           `json:"pull_request_name"`
+	AuthorID             string           `json:"author_id"`
+	Status               string           `json:"status"`
+	CreatedAt            pgtype.Timestamp `json:"created_at"`
+	MergedAt             pgtype.Timestamp `json:"merged_at"`
 }
 
 func (q *Queries) GetPullRequestsByReviewerUserID(ctx context.Context, userID string) ([]GetPullRequestsByReviewerUserIDRow, error) {
@@ -121,6 +144,10 @@ func (q *Queries) GetPullRequestsByReviewerUserID(ctx context.Context, userID st
 			&i.PullRequestID,
 			&i.UserID,
 			&i.AssignedAt,
+			&i.Source,
+			&i.Strategy,
+			&i.WorkloadAtAssignment,
+			&i.TieBreak,
 			&i.PullRequestName,
 			&i.AuthorID,
 			&i.Status,
@@ -138,7 +165,7 @@ func (q *Queries) GetPullRequestsByReviewerUserID(ctx context.Context, userID st
 }
 
 const getReviewersByPRID = `-- name: GetReviewersByPRID :many
-SELECT pr.id, pr.pull_request_id, pr.user_id, pr.assigned_at, u.username, u.team_id, u.is_active
+SELECT pr.id, pr.pull_request_id, pr.user_id, pr.assigned_at, pr.source, pr.strategy, pr.workload_at_assignment, pr.tie_break, u.username, u.team_id, u.is_active, u.seniority
 FROM pr_reviewers pr
 JOIN users u ON pr.user_id = u.user_id
 WHERE pr.pull_request_id = $1
@@ -146,13 +173,18 @@ ORDER BY pr.assigned_at
 `
 
 type GetReviewersByPRIDRow struct {
-	ID            int64            `json:"id"`
-	PullRequestID string           `json:"pull_request_id"`
-	UserID        string           `json:"user_id"`
-	AssignedAt    pgtype.Timestamp `json:"assigned_at"`
-	Username      string           `json:"username"`
-	TeamID        int64            `json:"team_id"`
-	IsActive      bool             `json:"is_active"`
+	ID                   int64            `json:"id"`
+	PullRequestID        string           `json:"pull_request_id"`
+	UserID               string           `json:"user_id"`
+	AssignedAt           pgtype.Timestamp `json:"assigned_at"`
+	Source               string           `json:"source"`
+	Strategy             *string          `json:"strategy"`
+	WorkloadAtAssignment *int64           `json:"workload_at_assignment"`
+	TieBreak             *bool            `json:"tie_break"`
+	Username             string           `json:"username"`
+	TeamID               int64            `json:"team_id"`
+	IsActive             bool             `json:"is_active"`
+	Seniority            string           `json:"seniority"`
 }
 
 func (q *Queries) GetReviewersByPRID(ctx context.Context, pullRequestID string) ([]GetReviewersByPRIDRow, error) {
@@ -169,9 +201,71 @@ func (q *Queries) GetReviewersByPRID(ctx context.Context, pullRequestID string)
 			&i.PullRequestID,
 			&i.UserID,
 			&i.AssignedAt,
+			&i.Source,
+			&i.Strategy,
+			&i.WorkloadAtAssignment,
+			&i.TieBreak,
+			&i.Username,
+			&i.TeamID,
+			&i.IsActive,
+			&i.Seniority,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReviewersByPRIDs = `-- name: GetReviewersByPRIDs :many
+SELECT pr.id, pr.pull_request_id, pr.user_id, pr.assigned_at, pr.source, pr.strategy, pr.workload_at_assignment, pr.tie_break, u.username, u.team_id, u.is_active, u.seniority
+FROM pr_reviewers pr
+JOIN users u ON pr.user_id = u.user_id
+WHERE pr.pull_request_id = ANY($1::text[])
+ORDER BY pr.pull_request_id, pr.assigned_at
+`
+
+type GetReviewersByPRIDsRow struct {
+	ID                   int64            `json:"id"`
+	PullRequestID        string           `json:"pull_request_id"`
+	UserID               string           `json:"user_id"`
+	AssignedAt           pgtype.Timestamp `json:"assigned_at"`
+	Source               string           `json:"source"`
+	Strategy             *string          `json:"strategy"`
+	WorkloadAtAssignment *int64           `json:"workload_at_assignment"`
+	TieBreak             *bool            `json:"tie_break"`
+	Username             string           `json:"username"`
+	TeamID               int64            `json:"team_id"`
+	IsActive             bool             `json:"is_active"`
+	Seniority            string           `json:"seniority"`
+}
+
+// Ревьюеры нескольких PR, столбцы совпадают с GetReviewersByPRID
+func (q *Queries) GetReviewersByPRIDs(ctx context.Context, pullRequestIds []string) ([]GetReviewersByPRIDsRow, error) {
+	rows, err := q.db.Query(ctx, getReviewersByPRIDs, pullRequestIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetReviewersByPRIDsRow{}
+	for rows.Next() {
+		var i GetReviewersByPRIDsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PullRequestID,
+			&i.UserID,
+			&i.AssignedAt,
+			&i.Source,
+			&i.Strategy,
+			&i.WorkloadAtAssignment,
+			&i.TieBreak,
 			&i.Username,
 			&i.TeamID,
 			&i.IsActive,
+			&i.Seniority,
 		); err != nil {
 			return nil, err
 		}
@@ -234,17 +328,29 @@ func (q *Queries) RemoveReviewer(ctx context.Context, arg RemoveReviewerParams)
 
 const replaceReviewer = `-- name: ReplaceReviewer :exec
 UPDATE pr_reviewers
-SET user_id = $3, assigned_at = NOW()
+SET user_id = $3, assigned_at = NOW(), source = $4, strategy = $5, workload_at_assignment = $6, tie_break = $7
 WHERE pull_request_id = $1 AND user_id = $2
 `
 
 type ReplaceReviewerParams struct {
-	PullRequestID string `json:"pull_request_id"`
-	UserID        string `json:"user_id"`
-	UserID_2      string `json:"user_id_2"`
+	PullRequestID        string  `json:"pull_request_id"`
+	UserID               string  `json:"user_id"`
+	UserID_2             string  `json:"user_id_2"`
+	Source               string  `json:"source"`
+	Strategy             *string `json:"strategy"`
+	WorkloadAtAssignment *int64  `json:"workload_at_assignment"`
+	TieBreak             *bool   `json:"tie_break"`
 }
 
 func (q *Queries) ReplaceReviewer(ctx context.Context, arg ReplaceReviewerParams) error {
-	_, err := q.db.Exec(ctx, replaceReviewer, arg.PullRequestID, arg.UserID, arg.UserID_2)
+	_, err := q.db.Exec(ctx, replaceReviewer,
+		arg.PullRequestID,
+		arg.UserID,
+		arg.UserID_2,
+		arg.Source,
+		arg.Strategy,
+		arg.WorkloadAtAssignment,
+		arg.TieBreak,
+	)
 	return err
 }
@@ -82,6 +82,40 @@ func (q *Queries) GetPullRequestByPRID(ctx context.Context, pullRequestID string
 	return i, err
 }
 
+const listOpenPullRequestsByAuthorID = `-- name: ListOpenPullRequestsByAuthorID :many
+SELECT id, pull_request_id, pull_request_name, author_id, status, created_at, merged_at FROM pull_requests
+WHERE author_id = $1 AND status = 'OPEN'
+ORDER BY created_at
+`
+
+func (q *Queries) ListOpenPullRequestsByAuthorID(ctx context.Context, authorID string) ([]PullRequest, error) {
+	rows, err := q.db.Query(ctx, listOpenPullRequestsByAuthorID, authorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []PullRequest{}
+	for rows.Next() {
+		var i PullRequest
+		if err := rows.Scan(
+			&i.ID,
+			&i.PullRequestID,
+			&i.PullRequestName,
+			&i.AuthorID,
+			&i.Status,
+			&i.CreatedAt,
+			&i.MergedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const listPullRequests = `-- name: ListPullRequests :many
 SELECT id, pull_request_id, pull_request_name, author_id, status, created_at, merged_at FROM pull_requests
 ORDER BY created_at DESC
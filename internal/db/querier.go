@@ -6,49 +6,123 @@ package db
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 type Querier interface {
+	AddAuditEntry(ctx context.Context, arg AddAuditEntryParams) (AuditLog, error)
+	AddPRRequiredTags(ctx context.Context, arg AddPRRequiredTagsParams) error
 	AddReviewer(ctx context.Context, arg AddReviewerParams) (PrReviewer, error)
+	AddUnavailability(ctx context.Context, arg AddUnavailabilityParams) (UserUnavailability, error)
+	AddUserSkills(ctx context.Context, arg AddUserSkillsParams) error
+	CompleteIdempotencyKey(ctx context.Context, arg CompleteIdempotencyKeyParams) error
 	CountReviewersByPRID(ctx context.Context, pullRequestID string) (int64, error)
 	CreatePullRequest(ctx context.Context, arg CreatePullRequestParams) (PullRequest, error)
+	CreateReviewerRule(ctx context.Context, arg CreateReviewerRuleParams) (ReviewerRule, error)
 	CreateTeam(ctx context.Context, teamName string) (Team, error)
 	CreateUser(ctx context.Context, arg CreateUserParams) (User, error)
 	DeactivateTeamUsers(ctx context.Context, teamID int64) ([]User, error)
+	DeleteEventsBefore(ctx context.Context, before pgtype.Timestamp) (int64, error)
+	DeleteIdempotencyKeysBefore(ctx context.Context, before pgtype.Timestamp) (int64, error)
+	DeletePRRequiredTags(ctx context.Context, pullRequestID string) error
+	DeleteReviewerRule(ctx context.Context, id int64) (ReviewerRule, error)
+	DeleteUnavailability(ctx context.Context, id int64) (UserUnavailability, error)
+	DeleteUserSkills(ctx context.Context, userID string) error
 	// Статистика назначений по пользователям
 	GetAssignmentStats(ctx context.Context) ([]GetAssignmentStatsRow, error)
+	GetIdempotencyKey(ctx context.Context, arg GetIdempotencyKeyParams) (IdempotencyKey, error)
+	GetLatestEventID(ctx context.Context) (int64, error)
 	GetOpenPRsWithInactiveReviewers(ctx context.Context) ([]GetOpenPRsWithInactiveReviewersRow, error)
-	// Статистика по Pull Request'ам
-	GetPRStats(ctx context.Context) ([]GetPRStatsRow, error)
+	// Статистика по Pull Request'ам, созданным в окне [window_start, window_end).
+	// NULL-граница окна не ограничивает выборку.
+	GetPRStats(ctx context.Context, arg GetPRStatsParams) ([]GetPRStatsRow, error)
 	GetPullRequestByID(ctx context.Context, id int64) (PullRequest, error)
 	GetPullRequestByPRID(ctx context.Context, pullRequestID string) (PullRequest, error)
 	GetPullRequestsByReviewerUserID(ctx context.Context, userID string) ([]GetPullRequestsByReviewerUserIDRow, error)
+	// Перцентили времени до первого назначения и до слияния для PR, созданных
+	// в каждом интервале [range_start, range_end). Пустые интервалы тоже
+	// возвращаются, чтобы ряд был непрерывным.
+	GetReviewTimeAnalytics(ctx context.Context, arg GetReviewTimeAnalyticsParams) ([]GetReviewTimeAnalyticsRow, error)
+	// Назначения каждого участника команды за окно [window_start, window_end)
+	// по журналу назначений: замененные и снятые ревьюеры тоже учитываются.
+	// Учитываются активные участники и неактивные, получившие назначения в окне.
+	// Пустое имя команды - все команды.
+	GetReviewerAssignmentCounts(ctx context.Context, arg GetReviewerAssignmentCountsParams) ([]GetReviewerAssignmentCountsRow, error)
+	// Количество назначений каждого ревьюера по интервалам и его доля среди
+	// всех назначений интервала. Интервалы без назначений ревьюера опускаются.
+	GetReviewerThroughput(ctx context.Context, arg GetReviewerThroughputParams) ([]GetReviewerThroughputRow, error)
 	GetReviewersByPRID(ctx context.Context, pullRequestID string) ([]GetReviewersByPRIDRow, error)
+	// Ревьюеры нескольких PR, столбцы совпадают с GetReviewersByPRID
+	GetReviewersByPRIDs(ctx context.Context, pullRequestIds []string) ([]GetReviewersByPRIDsRow, error)
 	GetTeamByID(ctx context.Context, id int64) (Team, error)
 	GetTeamByName(ctx context.Context, teamName string) (Team, error)
-	// Статистика по командам
-	GetTeamStats(ctx context.Context) ([]GetTeamStatsRow, error)
+	// Доступные ревьюеры и открытые ревью по командам
+	GetTeamReviewLoad(ctx context.Context) ([]GetTeamReviewLoadRow, error)
+	// Статистика по командам. PR учитываются по времени создания, ревью - по
+	// времени назначения в окне [window_start, window_end). Каждая величина
+	// агрегируется отдельно, чтобы соединения не умножали строки друг друга.
+	GetTeamStats(ctx context.Context, arg GetTeamStatsParams) ([]GetTeamStatsRow, error)
+	// Открытые и слитые PR каждой команды по интервалам с нарастающим итогом
+	// слитых PR с начала диапазона. Команда автора определяется текущей.
+	GetTeamThroughput(ctx context.Context, arg GetTeamThroughputParams) ([]GetTeamThroughputRow, error)
+	// Открытые ревью участников команды. Таблица reviewer_workload обновляется
+	// триггерами на pr_reviewers и pull_requests.
+	GetTeamWorkload(ctx context.Context, teamID int64) ([]GetTeamWorkloadRow, error)
 	GetUserByID(ctx context.Context, id int64) (User, error)
 	GetUserByUserID(ctx context.Context, userID string) (User, error)
 	GetUserWithTeam(ctx context.Context, userID string) (GetUserWithTeamRow, error)
-	// Рабочая нагрузка пользователей (только открытые PR)
+	// Рабочая нагрузка активных пользователей по таблице reviewer_workload
 	GetUserWorkload(ctx context.Context) ([]GetUserWorkloadRow, error)
 	IsUserAssignedToPR(ctx context.Context, arg IsUserAssignedToPRParams) (bool, error)
 	ListActiveUsersByTeamID(ctx context.Context, teamID int64) ([]User, error)
 	ListActiveUsersByTeamIDExcludingUser(ctx context.Context, arg ListActiveUsersByTeamIDExcludingUserParams) ([]User, error)
+	ListAuditEntries(ctx context.Context, arg ListAuditEntriesParams) ([]AuditLog, error)
+	// События после after_id по возрастанию. Пустой фильтр не ограничивает выборку,
+	// user_id совпадает с ревьюером, замененным ревьюером или автором PR.
+	ListEventsAfter(ctx context.Context, arg ListEventsAfterParams) ([]AssignmentEvent, error)
+	// События с указанными id: перечитывание id, пропущенных при рассылке
+	ListEventsByIDs(ctx context.Context, ids []int64) ([]AssignmentEvent, error)
+	ListOpenPullRequestsByAuthorID(ctx context.Context, authorID string) ([]PullRequest, error)
+	ListPRRequiredTags(ctx context.Context, pullRequestID string) ([]string, error)
 	ListPullRequests(ctx context.Context) ([]PullRequest, error)
 	ListPullRequestsByStatus(ctx context.Context, status string) ([]PullRequest, error)
+	ListReviewerRules(ctx context.Context, userID string) ([]ListReviewerRulesRow, error)
+	// Правила, действующие для PR автора: заданные для него самого и для его команды
+	ListReviewerRulesForAuthor(ctx context.Context, authorID string) ([]ListReviewerRulesForAuthorRow, error)
+	ListTeamSkillsByTags(ctx context.Context, arg ListTeamSkillsByTagsParams) ([]UserSkill, error)
 	ListTeams(ctx context.Context) ([]Team, error)
+	// Участники команды, недоступные в момент at
+	ListUnavailableTeamMembers(ctx context.Context, arg ListUnavailableTeamMembersParams) ([]string, error)
+	// Текущие и будущие периоды недоступности пользователя
+	ListUpcomingUnavailability(ctx context.Context, arg ListUpcomingUnavailabilityParams) ([]UserUnavailability, error)
+	ListUserSkills(ctx context.Context, userID string) ([]UserSkill, error)
 	ListUsersByTeamID(ctx context.Context, teamID int64) ([]User, error)
+	// Блокирует изменения нагрузки до конца транзакции. Назначения, начавшиеся
+	// раньше, завершаются до получения блокировки.
+	LockReviewerWorkload(ctx context.Context) error
+	// Сериализует назначение ревьюеров внутри команды до конца транзакции
+	LockTeamAssignment(ctx context.Context, teamID int64) error
 	MergePullRequest(ctx context.Context, pullRequestID string) (PullRequest, error)
 	PullRequestExists(ctx context.Context, pullRequestID string) (bool, error)
+	// Пересчитывает нагрузку по pr_reviewers и исправляет расхождения.
+	// Возвращает исправленные записи со старым и новым значением.
+	ReconcileReviewerWorkload(ctx context.Context) ([]ReconcileReviewerWorkloadRow, error)
+	// Освобождает ключ незавершенного запроса, чтобы его можно было повторить
+	ReleaseIdempotencyKey(ctx context.Context, arg ReleaseIdempotencyKeyParams) error
 	RemoveInactiveReviewers(ctx context.Context, arg RemoveInactiveReviewersParams) error
 	RemoveReviewer(ctx context.Context, arg RemoveReviewerParams) error
 	ReplaceReviewer(ctx context.Context, arg ReplaceReviewerParams) error
+	// Занимает ключ для нового запроса. Незавершенный запрос с тем же телом,
+	// чья аренда истекла, считается прерванным, и ключ занимается заново.
+	// 0 строк - ключ уже использован.
+	ReserveIdempotencyKey(ctx context.Context, arg ReserveIdempotencyKeyParams) (int64, error)
+	SetTeamMentorship(ctx context.Context, arg SetTeamMentorshipParams) (Team, error)
 	TeamExists(ctx context.Context, teamName string) (bool, error)
 	UpdatePullRequestStatus(ctx context.Context, arg UpdatePullRequestStatusParams) (PullRequest, error)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (User, error)
 	UpdateUserIsActive(ctx context.Context, arg UpdateUserIsActiveParams) (User, error)
+	UpdateUserSeniority(ctx context.Context, arg UpdateUserSeniorityParams) (User, error)
 	UserExists(ctx context.Context, userID string) (bool, error)
 }
 
@@ -0,0 +1,158 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: reviewer_rules.sql
+
+package db
+
+import (
+	"context"
+)
+
+const createReviewerRule = `-- name: CreateReviewerRule :one
+INSERT INTO reviewer_rules (effect, reviewer_id, author_id, team_id, reason, created_by)
+VALUES ($1, $2, $3, $4, $5, $6)
+RETURNING id, effect, reviewer_id, author_id, team_id, reason, created_by, created_at
+`
+
+type CreateReviewerRuleParams struct {
+	Effect     string  `json:"effect"`
+	ReviewerID string  `json:"reviewer_id"`
+	AuthorID   *string `json:"author_id"`
+	TeamID     *int64  `json:"team_id"`
+	Reason     string  `json:"reason"`
+	CreatedBy  string  `json:"created_by"`
+}
+
+func (q *Queries) CreateReviewerRule(ctx context.Context, arg CreateReviewerRuleParams) (ReviewerRule, error) {
+	row := q.db.QueryRow(ctx, createReviewerRule,
+		arg.Effect,
+		arg.ReviewerID,
+		arg.AuthorID,
+		arg.TeamID,
+		arg.Reason,
+		arg.CreatedBy,
+	)
+	var i ReviewerRule
+	err := row.Scan(
+		&i.ID,
+		&i.Effect,
+		&i.ReviewerID,
+		&i.AuthorID,
+		&i.TeamID,
+		&i.Reason,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteReviewerRule = `-- name: DeleteReviewerRule :one
+DELETE FROM reviewer_rules
+WHERE id = $1
+RETURNING id, effect, reviewer_id, author_id, team_id, reason, created_by, created_at
+`
+
+func (q *Queries) DeleteReviewerRule(ctx context.Context, id int64) (ReviewerRule, error) {
+	row := q.db.QueryRow(ctx, deleteReviewerRule, id)
+	var i ReviewerRule
+	err := row.Scan(
+		&i.ID,
+		&i.Effect,
+		&i.ReviewerID,
+		&i.AuthorID,
+		&i.TeamID,
+		&i.Reason,
+		&i.CreatedBy,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listReviewerRules = `-- name: ListReviewerRules :many
+SELECT r.id, r.effect, r.reviewer_id, r.author_id, r.team_id, r.reason, r.created_by, r.created_at, t.team_name
+FROM reviewer_rules r
+LEFT JOIN teams t ON r.team_id = t.id
+WHERE $1::text = '' OR r.reviewer_id = $1 OR r.author_id = $1
+ORDER BY r.id
+`
+
+type ListReviewerRulesRow struct {
+	ReviewerRule ReviewerRule `json:"reviewer_rule"`
+	TeamName     *string      `json:"team_name"`
+}
+
+func (q *Queries) ListReviewerRules(ctx context.Context, userID string) ([]ListReviewerRulesRow, error) {
+	rows, err := q.db.Query(ctx, listReviewerRules, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListReviewerRulesRow{}
+	for rows.Next() {
+		var i ListReviewerRulesRow
+		if err := rows.Scan(
+			&i.ReviewerRule.ID,
+			&i.ReviewerRule.Effect,
+			&i.ReviewerRule.ReviewerID,
+			&i.ReviewerRule.AuthorID,
+			&i.ReviewerRule.TeamID,
+			&i.ReviewerRule.Reason,
+			&i.ReviewerRule.CreatedBy,
+			&i.ReviewerRule.CreatedAt,
+			&i.TeamName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listReviewerRulesForAuthor = `-- name: ListReviewerRulesForAuthor :many
+SELECT r.id, r.effect, r.reviewer_id, r.author_id, r.team_id, r.reason, r.created_by, r.created_at, t.team_name
+FROM reviewer_rules r
+LEFT JOIN teams t ON r.team_id = t.id
+WHERE r.author_id = $1::text
+   OR r.team_id = (SELECT u.team_id FROM users u WHERE u.user_id = $1)
+ORDER BY r.id
+`
+
+type ListReviewerRulesForAuthorRow struct {
+	ReviewerRule ReviewerRule `json:"reviewer_rule"`
+	TeamName     *string      `json:"team_name"`
+}
+
+// Правила, действующие для PR автора: заданные для него самого и для его команды
+func (q *Queries) ListReviewerRulesForAuthor(ctx context.Context, authorID string) ([]ListReviewerRulesForAuthorRow, error) {
+	rows, err := q.db.Query(ctx, listReviewerRulesForAuthor, authorID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ListReviewerRulesForAuthorRow{}
+	for rows.Next() {
+		var i ListReviewerRulesForAuthorRow
+		if err := rows.Scan(
+			&i.ReviewerRule.ID,
+			&i.ReviewerRule.Effect,
+			&i.ReviewerRule.ReviewerID,
+			&i.ReviewerRule.AuthorID,
+			&i.ReviewerRule.TeamID,
+			&i.ReviewerRule.Reason,
+			&i.ReviewerRule.CreatedBy,
+			&i.ReviewerRule.CreatedAt,
+			&i.TeamName,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
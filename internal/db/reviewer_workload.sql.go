@@ -0,0 +1,107 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: reviewer_workload.sql
+
+package db
+
+import (
+	"context"
+)
+
+const getTeamWorkload = `-- name: GetTeamWorkload :many
+SELECT u.user_id, COALESCE(w.open_reviews, 0)::bigint as open_reviews
+FROM users u
+LEFT JOIN reviewer_workload w ON w.user_id = u.user_id
+WHERE u.team_id = $1
+`
+
+type GetTeamWorkloadRow struct {
+	UserID      string `json:"user_id"`
+	OpenReviews int64  `json:"open_reviews"`
+}
+
+// Открытые ревью участников команды. Таблица reviewer_workload обновляется
+// триггерами на pr_reviewers и pull_requests.
+func (q *Queries) GetTeamWorkload(ctx context.Context, teamID int64) ([]GetTeamWorkloadRow, error) {
+	rows, err := q.db.Query(ctx, getTeamWorkload, teamID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTeamWorkloadRow{}
+	for rows.Next() {
+		var i GetTeamWorkloadRow
+		if err := rows.Scan(&i.UserID, &i.OpenReviews); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockReviewerWorkload = `-- name: LockReviewerWorkload :exec
+LOCK TABLE reviewer_workload IN SHARE ROW EXCLUSIVE MODE
+`
+
+// Блокирует изменения нагрузки до конца транзакции. Назначения, начавшиеся
+// раньше, завершаются до получения блокировки.
+func (q *Queries) LockReviewerWorkload(ctx context.Context) error {
+	_, err := q.db.Exec(ctx, lockReviewerWorkload)
+	return err
+}
+
+const reconcileReviewerWorkload = `-- name: ReconcileReviewerWorkload :many
+WITH actual AS (
+    SELECT r.user_id, COUNT(*) as open_reviews
+    FROM pr_reviewers r
+    JOIN pull_requests p ON p.pull_request_id = r.pull_request_id AND p.status = 'OPEN'
+    GROUP BY r.user_id
+), drift AS (
+    SELECT
+        COALESCE(a.user_id, w.user_id)::varchar as user_id,
+        COALESCE(w.open_reviews, 0)::bigint as recorded,
+        COALESCE(a.open_reviews, 0)::bigint as actual
+    FROM actual a
+    FULL JOIN reviewer_workload w ON w.user_id = a.user_id
+    WHERE COALESCE(w.open_reviews, 0) <> COALESCE(a.open_reviews, 0)
+), fixed AS (
+    INSERT INTO reviewer_workload (user_id, open_reviews, updated_at)
+    SELECT d.user_id, d.actual, NOW() FROM drift d
+    ON CONFLICT (user_id) DO UPDATE
+    SET open_reviews = EXCLUDED.open_reviews, updated_at = NOW()
+)
+SELECT user_id, recorded, actual FROM drift
+ORDER BY user_id
+`
+
+type ReconcileReviewerWorkloadRow struct {
+	UserID   string `json:"user_id"`
+	Recorded int64  `json:"recorded"`
+	Actual   int64  `json:"actual"`
+}
+
+// Пересчитывает нагрузку по pr_reviewers и исправляет расхождения.
+// Возвращает исправленные записи со старым и новым значением.
+func (q *Queries) ReconcileReviewerWorkload(ctx context.Context) ([]ReconcileReviewerWorkloadRow, error) {
+	rows, err := q.db.Query(ctx, reconcileReviewerWorkload)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []ReconcileReviewerWorkloadRow{}
+	for rows.Next() {
+		var i ReconcileReviewerWorkloadRow
+		if err := rows.Scan(&i.UserID, &i.Recorded, &i.Actual); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
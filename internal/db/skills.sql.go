@@ -0,0 +1,146 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: skills.sql
+
+package db
+
+import (
+	"context"
+)
+
+const addPRRequiredTags = `-- name: AddPRRequiredTags :exec
+INSERT INTO pr_required_tags (pull_request_id, tag)
+SELECT $1, unnest($2::text[])
+`
+
+type AddPRRequiredTagsParams struct {
+	PullRequestID string   `json:"pull_request_id"`
+	Tags          []string `json:"tags"`
+}
+
+func (q *Queries) AddPRRequiredTags(ctx context.Context, arg AddPRRequiredTagsParams) error {
+	_, err := q.db.Exec(ctx, addPRRequiredTags, arg.PullRequestID, arg.Tags)
+	return err
+}
+
+const addUserSkills = `-- name: AddUserSkills :exec
+INSERT INTO user_skills (user_id, tag, level)
+SELECT $1, unnest($2::text[]), unnest($3::text[])
+`
+
+type AddUserSkillsParams struct {
+	UserID string   `json:"user_id"`
+	Tags   []string `json:"tags"`
+	Levels []string `json:"levels"`
+}
+
+func (q *Queries) AddUserSkills(ctx context.Context, arg AddUserSkillsParams) error {
+	_, err := q.db.Exec(ctx, addUserSkills, arg.UserID, arg.Tags, arg.Levels)
+	return err
+}
+
+const deletePRRequiredTags = `-- name: DeletePRRequiredTags :exec
+DELETE FROM pr_required_tags
+WHERE pull_request_id = $1
+`
+
+func (q *Queries) DeletePRRequiredTags(ctx context.Context, pullRequestID string) error {
+	_, err := q.db.Exec(ctx, deletePRRequiredTags, pullRequestID)
+	return err
+}
+
+const deleteUserSkills = `-- name: DeleteUserSkills :exec
+DELETE FROM user_skills
+WHERE user_id = $1
+`
+
+func (q *Queries) DeleteUserSkills(ctx context.Context, userID string) error {
+	_, err := q.db.Exec(ctx, deleteUserSkills, userID)
+	return err
+}
+
+const listPRRequiredTags = `-- name: ListPRRequiredTags :many
+SELECT tag FROM pr_required_tags
+WHERE pull_request_id = $1
+ORDER BY tag
+`
+
+func (q *Queries) ListPRRequiredTags(ctx context.Context, pullRequestID string) ([]string, error) {
+	rows, err := q.db.Query(ctx, listPRRequiredTags, pullRequestID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, err
+		}
+		items = append(items, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTeamSkillsByTags = `-- name: ListTeamSkillsByTags :many
+SELECT us.user_id, us.tag, us.level
+FROM user_skills us
+JOIN users u ON us.user_id = u.user_id
+WHERE u.team_id = $1 AND us.tag = ANY($2::text[])
+ORDER BY us.user_id, us.tag
+`
+
+type ListTeamSkillsByTagsParams struct {
+	TeamID int64    `json:"team_id"`
+	Tags   []string `json:"tags"`
+}
+
+func (q *Queries) ListTeamSkillsByTags(ctx context.Context, arg ListTeamSkillsByTagsParams) ([]UserSkill, error) {
+	rows, err := q.db.Query(ctx, listTeamSkillsByTags, arg.TeamID, arg.Tags)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []UserSkill{}
+	for rows.Next() {
+		var i UserSkill
+		if err := rows.Scan(&i.UserID, &i.Tag, &i.Level); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUserSkills = `-- name: ListUserSkills :many
+SELECT user_id, tag, level FROM user_skills
+WHERE user_id = $1
+ORDER BY tag
+`
+
+func (q *Queries) ListUserSkills(ctx context.Context, userID string) ([]UserSkill, error) {
+	rows, err := q.db.Query(ctx, listUserSkills, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []UserSkill{}
+	for rows.Next() {
+		var i UserSkill
+		if err := rows.Scan(&i.UserID, &i.Tag, &i.Level); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
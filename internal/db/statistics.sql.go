@@ -75,10 +75,17 @@ SELECT
     pr.merged_at
 FROM pull_requests pr
 LEFT JOIN pr_reviewers r ON pr.pull_request_id = r.pull_request_id
+WHERE ($1::timestamp IS NULL OR pr.created_at >= $1)
+  AND ($2::timestamp IS NULL OR pr.created_at < $2)
 GROUP BY pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.created_at, pr.merged_at
 ORDER BY pr.created_at DESC
 `
 
+type GetPRStatsParams struct {
+	WindowStart pgtype.Timestamp `json:"window_start"`
+	WindowEnd   pgtype.Timestamp `json:"window_end"`
+}
+
 type GetPRStatsRow struct {
 	PullRequestID   string           `json:"pull_request_id"`
 	PullRequestName string           `json:"pull_request_name"`
@@ -89,9 +96,10 @@ type GetPRStatsRow struct {
 	MergedAt        pgtype.Timestamp `json:"merged_at"`
 }
 
-// Статистика по Pull Request'ам
-func (q *Queries) GetPRStats(ctx context.Context) ([]GetPRStatsRow, error) {
-	rows, err := q.db.Query(ctx, getPRStats)
+// Статистика по Pull Request'ам, созданным в окне [window_start, window_end).
+// NULL-граница окна не ограничивает выборку.
+func (q *Queries) GetPRStats(ctx context.Context, arg GetPRStatsParams) ([]GetPRStatsRow, error) {
+	rows, err := q.db.Query(ctx, getPRStats, arg.WindowStart, arg.WindowEnd)
 	if err != nil {
 		return nil, err
 	}
@@ -118,32 +126,177 @@ func (q *Queries) GetPRStats(ctx context.Context) ([]GetPRStatsRow, error) {
 	return items, nil
 }
 
+const getReviewerAssignmentCounts = `-- name: GetReviewerAssignmentCounts :many
+SELECT
+    t.team_name,
+    u.user_id,
+    u.username,
+    u.is_active,
+    COUNT(r.id) as assignments,
+    COUNT(r.id) FILTER (WHERE r.tie_break IS NOT NULL) as policy_assignments,
+    COUNT(r.id) FILTER (WHERE r.tie_break) as tie_break_assignments
+FROM users u
+JOIN teams t ON t.id = u.team_id
+LEFT JOIN reviewer_assignment_log r ON r.user_id = u.user_id
+    AND ($1::timestamp IS NULL OR r.assigned_at >= $1)
+    AND ($2::timestamp IS NULL OR r.assigned_at < $2)
+WHERE ($3::text = '' OR t.team_name = $3::text)
+GROUP BY t.team_name, u.user_id, u.username, u.is_active
+HAVING u.is_active OR COUNT(r.id) > 0
+ORDER BY t.team_name, u.user_id
+`
+
+type GetReviewerAssignmentCountsParams struct {
+	WindowStart pgtype.Timestamp `json:"window_start"`
+	WindowEnd   pgtype.Timestamp `json:"window_end"`
+	TeamName    string           `json:"team_name"`
+}
+
+type GetReviewerAssignmentCountsRow struct {
+	TeamName            string `json:"team_name"`
+	UserID              string `json:"user_id"`
+	Username            string `json:"username"`
+	IsActive            bool   `json:"is_active"`
+	Assignments         int64  `json:"assignments"`
+	PolicyAssignments   int64  `json:"policy_assignments"`
+	TieBreakAssignments int64  `json:"tie_break_assignments"`
+}
+
+// Назначения каждого участника команды за окно [window_start, window_end)
+// по журналу назначений: замененные и снятые ревьюеры тоже учитываются.
+// Учитываются активные участники и неактивные, получившие назначения в окне.
+// Пустое имя команды - все команды.
+func (q *Queries) GetReviewerAssignmentCounts(ctx context.Context, arg GetReviewerAssignmentCountsParams) ([]GetReviewerAssignmentCountsRow, error) {
+	rows, err := q.db.Query(ctx, getReviewerAssignmentCounts, arg.WindowStart, arg.WindowEnd, arg.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetReviewerAssignmentCountsRow{}
+	for rows.Next() {
+		var i GetReviewerAssignmentCountsRow
+		if err := rows.Scan(
+			&i.TeamName,
+			&i.UserID,
+			&i.Username,
+			&i.IsActive,
+			&i.Assignments,
+			&i.PolicyAssignments,
+			&i.TieBreakAssignments,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTeamReviewLoad = `-- name: GetTeamReviewLoad :many
+SELECT
+    t.team_name,
+    COUNT(u.user_id) FILTER (WHERE u.is_active)::bigint as active_members,
+    COALESCE(SUM(w.open_reviews), 0)::bigint as open_reviews
+FROM teams t
+LEFT JOIN users u ON u.team_id = t.id
+LEFT JOIN reviewer_workload w ON w.user_id = u.user_id
+GROUP BY t.id, t.team_name
+ORDER BY t.team_name
+`
+
+type GetTeamReviewLoadRow struct {
+	TeamName      string `json:"team_name"`
+	ActiveMembers int64  `json:"active_members"`
+	OpenReviews   int64  `json:"open_reviews"`
+}
+
+// Доступные ревьюеры и открытые ревью по командам
+func (q *Queries) GetTeamReviewLoad(ctx context.Context) ([]GetTeamReviewLoadRow, error) {
+	rows, err := q.db.Query(ctx, getTeamReviewLoad)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []GetTeamReviewLoadRow{}
+	for rows.Next() {
+		var i GetTeamReviewLoadRow
+		if err := rows.Scan(&i.TeamName, &i.ActiveMembers, &i.OpenReviews); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getTeamStats = `-- name: GetTeamStats :many
-SELECT 
+WITH members AS (
+    SELECT
+        u.team_id,
+        COUNT(*) as total_members,
+        COUNT(*) FILTER (WHERE u.is_active) as active_members
+    FROM users u
+    GROUP BY u.team_id
+), authored AS (
+    SELECT
+        u.team_id,
+        COUNT(*) as prs_authored,
+        COUNT(*) FILTER (WHERE p.status = 'MERGED') as prs_merged
+    FROM pull_requests p
+    JOIN users u ON p.author_id = u.user_id
+    WHERE ($1::timestamp IS NULL OR p.created_at >= $1)
+      AND ($2::timestamp IS NULL OR p.created_at < $2)
+    GROUP BY u.team_id
+), reviewed AS (
+    SELECT
+        u.team_id,
+        COUNT(*) as review_assignments,
+        COUNT(DISTINCT r.pull_request_id) as prs_reviewed
+    FROM pr_reviewers r
+    JOIN users u ON r.user_id = u.user_id
+    WHERE ($1::timestamp IS NULL OR r.assigned_at >= $1)
+      AND ($2::timestamp IS NULL OR r.assigned_at < $2)
+    GROUP BY u.team_id
+)
+SELECT
     t.team_name,
-    COUNT(DISTINCT u.user_id) as total_members,
-    COUNT(DISTINCT CASE WHEN u.is_active THEN u.user_id END) as active_members,
-    COUNT(DISTINCT pr.pull_request_id) as total_prs_authored,
-    COUNT(DISTINCT r.pull_request_id) as total_prs_reviewed
+    COALESCE(m.total_members, 0)::bigint as total_members,
+    COALESCE(m.active_members, 0)::bigint as active_members,
+    COALESCE(a.prs_authored, 0)::bigint as prs_authored,
+    COALESCE(a.prs_merged, 0)::bigint as prs_merged,
+    COALESCE(rv.prs_reviewed, 0)::bigint as prs_reviewed,
+    COALESCE(rv.review_assignments, 0)::bigint as review_assignments
 FROM teams t
-LEFT JOIN users u ON t.id = u.team_id
-LEFT JOIN pull_requests pr ON u.user_id = pr.author_id
-LEFT JOIN pr_reviewers r ON u.user_id = r.user_id
-GROUP BY t.team_name
+LEFT JOIN members m ON m.team_id = t.id
+LEFT JOIN authored a ON a.team_id = t.id
+LEFT JOIN reviewed rv ON rv.team_id = t.id
 ORDER BY t.team_name
 `
 
+type GetTeamStatsParams struct {
+	WindowStart pgtype.Timestamp `json:"window_start"`
+	WindowEnd   pgtype.Timestamp `json:"window_end"`
+}
+
 type GetTeamStatsRow struct {
-	TeamName         string `json:"team_name"`
-	TotalMembers     int64  `json:"total_members"`
-	ActiveMembers    int64  `json:"active_members"`
-	TotalPrsAuthored int64  `json:"total_prs_authored"`
-	TotalPrsReviewed int64  `json:"total_prs_reviewed"`
+	TeamName          string `json:"team_name"`
+	TotalMembers      int64  `json:"total_members"`
+	ActiveMembers     int64  `json:"active_members"`
+	PrsAuthored       int64  `json:"prs_authored"`
+	PrsMerged         int64  `json:"prs_merged"`
+	PrsReviewed       int64  `json:"prs_reviewed"`
+	ReviewAssignments int64  `json:"review_assignments"`
 }
 
-// Статистика по командам
-func (q *Queries) GetTeamStats(ctx context.Context) ([]GetTeamStatsRow, error) {
-	rows, err := q.db.Query(ctx, getTeamStats)
+// Статистика по командам. PR учитываются по времени создания, ревью - по
+// времени назначения в окне [window_start, window_end). Каждая величина
+// агрегируется отдельно, чтобы соединения не умножали строки друг друга.
+func (q *Queries) GetTeamStats(ctx context.Context, arg GetTeamStatsParams) ([]GetTeamStatsRow, error) {
+	rows, err := q.db.Query(ctx, getTeamStats, arg.WindowStart, arg.WindowEnd)
 	if err != nil {
 		return nil, err
 	}
@@ -155,8 +308,10 @@ func (q *Queries) GetTeamStats(ctx context.Context) ([]GetTeamStatsRow, error) {
 			&i.TeamName,
 			&i.TotalMembers,
 			&i.ActiveMembers,
-			&i.TotalPrsAuthored,
-			&i.TotalPrsReviewed,
+			&i.PrsAuthored,
+			&i.PrsMerged,
+			&i.PrsReviewed,
+			&i.ReviewAssignments,
 		); err != nil {
 			return nil, err
 		}
@@ -174,13 +329,11 @@ SELECT
     u.username,
     t.team_name,
     u.is_active,
-    COUNT(r.id) as open_reviews_count
+    COALESCE(w.open_reviews, 0)::bigint as open_reviews_count
 FROM users u
 LEFT JOIN teams t ON u.team_id = t.id
-LEFT JOIN pr_reviewers r ON u.user_id = r.user_id
-LEFT JOIN pull_requests pr ON r.pull_request_id = pr.pull_request_id AND pr.status = 'OPEN'
+LEFT JOIN reviewer_workload w ON w.user_id = u.user_id
 WHERE u.is_active = true
-GROUP BY u.user_id, u.username, t.team_name, u.is_active
 ORDER BY open_reviews_count DESC, u.username
 `
 
@@ -192,7 +345,7 @@ type GetUserWorkloadRow struct {
 	OpenReviewsCount int64   `json:"open_reviews_count"`
 }
 
-// Рабочая нагрузка пользователей (только открытые PR)
+// Рабочая нагрузка активных пользователей по таблице reviewer_workload
 func (q *Queries) GetUserWorkload(ctx context.Context) ([]GetUserWorkloadRow, error) {
 	rows, err := q.db.Query(ctx, getUserWorkload)
 	if err != nil {
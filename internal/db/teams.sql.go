@@ -12,42 +12,57 @@ import (
 const createTeam = `-- name: CreateTeam :one
 INSERT INTO teams (team_name)
 VALUES ($1)
-RETURNING id, team_name, created_at
+RETURNING id, team_name, created_at, mentorship_enabled
 `
 
 func (q *Queries) CreateTeam(ctx context.Context, teamName string) (Team, error) {
 	row := q.db.QueryRow(ctx, createTeam, teamName)
 	var i Team
-	err := row.Scan(&i.ID, &i.TeamName, &i.CreatedAt)
+	err := row.Scan(
+		&i.ID,
+		&i.TeamName,
+		&i.CreatedAt,
+		&i.MentorshipEnabled,
+	)
 	return i, err
 }
 
 const getTeamByID = `-- name: GetTeamByID :one
-SELECT id, team_name, created_at FROM teams
+SELECT id, team_name, created_at, mentorship_enabled FROM teams
 WHERE id = $1 LIMIT 1
 `
 
 func (q *Queries) GetTeamByID(ctx context.Context, id int64) (Team, error) {
 	row := q.db.QueryRow(ctx, getTeamByID, id)
 	var i Team
-	err := row.Scan(&i.ID, &i.TeamName, &i.CreatedAt)
+	err := row.Scan(
+		&i.ID,
+		&i.TeamName,
+		&i.CreatedAt,
+		&i.MentorshipEnabled,
+	)
 	return i, err
 }
 
 const getTeamByName = `-- name: GetTeamByName :one
-SELECT id, team_name, created_at FROM teams
+SELECT id, team_name, created_at, mentorship_enabled FROM teams
 WHERE team_name = $1 LIMIT 1
 `
 
 func (q *Queries) GetTeamByName(ctx context.Context, teamName string) (Team, error) {
 	row := q.db.QueryRow(ctx, getTeamByName, teamName)
 	var i Team
-	err := row.Scan(&i.ID, &i.TeamName, &i.CreatedAt)
+	err := row.Scan(
+		&i.ID,
+		&i.TeamName,
+		&i.CreatedAt,
+		&i.MentorshipEnabled,
+	)
 	return i, err
 }
 
 const listTeams = `-- name: ListTeams :many
-SELECT id, team_name, created_at FROM teams
+SELECT id, team_name, created_at, mentorship_enabled FROM teams
 ORDER BY team_name
 `
 
@@ -60,7 +75,12 @@ func (q *Queries) ListTeams(ctx context.Context) ([]Team, error) {
 	items := []Team{}
 	for rows.Next() {
 		var i Team
-		if err := rows.Scan(&i.ID, &i.TeamName, &i.CreatedAt); err != nil {
+		if err := rows.Scan(
+			&i.ID,
+			&i.TeamName,
+			&i.CreatedAt,
+			&i.MentorshipEnabled,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -71,6 +91,40 @@ func (q *Queries) ListTeams(ctx context.Context) ([]Team, error) {
 	return items, nil
 }
 
+const lockTeamAssignment = `-- name: LockTeamAssignment :exec
+SELECT pg_advisory_xact_lock(hashtextextended('team_assignment:' || $1::bigint, 0))
+`
+
+// Сериализует назначение ревьюеров внутри команды до конца транзакции
+func (q *Queries) LockTeamAssignment(ctx context.Context, teamID int64) error {
+	_, err := q.db.Exec(ctx, lockTeamAssignment, teamID)
+	return err
+}
+
+const setTeamMentorship = `-- name: SetTeamMentorship :one
+UPDATE teams
+SET mentorship_enabled = $2
+WHERE team_name = $1
+RETURNING id, team_name, created_at, mentorship_enabled
+`
+
+type SetTeamMentorshipParams struct {
+	TeamName          string `json:"team_name"`
+	MentorshipEnabled bool   `json:"mentorship_enabled"`
+}
+
+func (q *Queries) SetTeamMentorship(ctx context.Context, arg SetTeamMentorshipParams) (Team, error) {
+	row := q.db.QueryRow(ctx, setTeamMentorship, arg.TeamName, arg.MentorshipEnabled)
+	var i Team
+	err := row.Scan(
+		&i.ID,
+		&i.TeamName,
+		&i.CreatedAt,
+		&i.MentorshipEnabled,
+	)
+	return i, err
+}
+
 const teamExists = `-- name: TeamExists :one
 SELECT EXISTS(SELECT 1 FROM teams WHERE team_name = $1)
 `
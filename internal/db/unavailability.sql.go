@@ -0,0 +1,136 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.30.0
+// source: unavailability.sql
+
+package db
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+const addUnavailability = `-- name: AddUnavailability :one
+INSERT INTO user_unavailability (user_id, starts_at, ends_at, reason)
+VALUES ($1, $2, $3, $4)
+RETURNING id, user_id, starts_at, ends_at, reason, created_at
+`
+
+type AddUnavailabilityParams struct {
+	UserID   string           `json:"user_id"`
+	StartsAt pgtype.Timestamp `json:"starts_at"`
+	EndsAt   pgtype.Timestamp `json:"ends_at"`
+	Reason   string           `json:"reason"`
+}
+
+func (q *Queries) AddUnavailability(ctx context.Context, arg AddUnavailabilityParams) (UserUnavailability, error) {
+	row := q.db.QueryRow(ctx, addUnavailability,
+		arg.UserID,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.Reason,
+	)
+	var i UserUnavailability
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.Reason,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const deleteUnavailability = `-- name: DeleteUnavailability :one
+DELETE FROM user_unavailability
+WHERE id = $1
+RETURNING id, user_id, starts_at, ends_at, reason, created_at
+`
+
+func (q *Queries) DeleteUnavailability(ctx context.Context, id int64) (UserUnavailability, error) {
+	row := q.db.QueryRow(ctx, deleteUnavailability, id)
+	var i UserUnavailability
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.Reason,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listUnavailableTeamMembers = `-- name: ListUnavailableTeamMembers :many
+SELECT DISTINCT a.user_id
+FROM user_unavailability a
+JOIN users u ON u.user_id = a.user_id
+WHERE u.team_id = $1 AND a.starts_at <= $2::timestamp AND a.ends_at > $2::timestamp
+`
+
+type ListUnavailableTeamMembersParams struct {
+	TeamID int64            `json:"team_id"`
+	At     pgtype.Timestamp `json:"at"`
+}
+
+// Участники команды, недоступные в момент at
+func (q *Queries) ListUnavailableTeamMembers(ctx context.Context, arg ListUnavailableTeamMembersParams) ([]string, error) {
+	rows, err := q.db.Query(ctx, listUnavailableTeamMembers, arg.TeamID, arg.At)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var user_id string
+		if err := rows.Scan(&user_id); err != nil {
+			return nil, err
+		}
+		items = append(items, user_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUpcomingUnavailability = `-- name: ListUpcomingUnavailability :many
+SELECT id, user_id, starts_at, ends_at, reason, created_at FROM user_unavailability
+WHERE user_id = $1 AND ends_at > $2::timestamp
+ORDER BY starts_at
+`
+
+type ListUpcomingUnavailabilityParams struct {
+	UserID string           `json:"user_id"`
+	Since  pgtype.Timestamp `json:"since"`
+}
+
+// Текущие и будущие периоды недоступности пользователя
+func (q *Queries) ListUpcomingUnavailability(ctx context.Context, arg ListUpcomingUnavailabilityParams) ([]UserUnavailability, error) {
+	rows, err := q.db.Query(ctx, listUpcomingUnavailability, arg.UserID, arg.Since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []UserUnavailability{}
+	for rows.Next() {
+		var i UserUnavailability
+		if err := rows.Scan(
+			&i.ID,
+			&i.UserID,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.Reason,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -14,7 +14,7 @@ import (
 const createUser = `-- name: CreateUser :one
 INSERT INTO users (user_id, username, team_id, is_active)
 VALUES ($1, $2, $3, $4)
-RETURNING id, user_id, username, team_id, is_active, created_at, updated_at
+RETURNING id, user_id, username, team_id, is_active, created_at, updated_at, seniority
 `
 
 type CreateUserParams struct {
@@ -40,6 +40,7 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (User, e
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Seniority,
 	)
 	return i, err
 }
@@ -48,7 +49,7 @@ const deactivateTeamUsers = `-- name: DeactivateTeamUsers :many
 UPDATE users
 SET is_active = false, updated_at = NOW()
 WHERE team_id = $1 AND is_active = true
-RETURNING id, user_id, username, team_id, is_active, created_at, updated_at
+RETURNING id, user_id, username, team_id, is_active, created_at, updated_at, seniority
 `
 
 func (q *Queries) DeactivateTeamUsers(ctx context.Context, teamID int64) ([]User, error) {
@@ -68,6 +69,7 @@ func (q *Queries) DeactivateTeamUsers(ctx context.Context, teamID int64) ([]User
 			&i.IsActive,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Seniority,
 		); err != nil {
 			return nil, err
 		}
@@ -80,7 +82,7 @@ func (q *Queries) DeactivateTeamUsers(ctx context.Context, teamID int64) ([]User
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT id, user_id, username, team_id, is_active, created_at, updated_at FROM users
+SELECT id, user_id, username, team_id, is_active, created_at, updated_at, seniority FROM users
 WHERE id = $1 LIMIT 1
 `
 
@@ -95,12 +97,13 @@ func (q *Queries) GetUserByID(ctx context.Context, id int64) (User, error) {
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Seniority,
 	)
 	return i, err
 }
 
 const getUserByUserID = `-- name: GetUserByUserID :one
-SELECT id, user_id, username, team_id, is_active, created_at, updated_at FROM users
+SELECT id, user_id, username, team_id, is_active, created_at, updated_at, seniority FROM users
 WHERE user_id = $1 LIMIT 1
 `
 
@@ -115,26 +118,29 @@ func (q *Queries) GetUserByUserID(ctx context.Context, userID string) (User, err
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Seniority,
 	)
 	return i, err
 }
 
 const getUserWithTeam = `-- name: GetUserWithTeam :one
-SELECT u.id, u.user_id, u.username, u.team_id, u.is_active, u.created_at, u.updated_at, t.team_name
+SELECT u.id, u.user_id, u.username, u.team_id, u.is_active, u.created_at, u.updated_at, u.seniority, t.team_name, t.mentorship_enabled
 FROM users u
 JOIN teams t ON u.team_id = t.id
 WHERE u.user_id = $1 LIMIT 1
 `
 
 type GetUserWithTeamRow struct {
-	ID        int64            `json:"id"`
-	UserID    string           `json:"user_id"`
-	Username  string           `json:"username"`
-	TeamID    int64            `json:"team_id"`
-	IsActive  bool             `json:"is_active"`
-	CreatedAt pgtype.Timestamp `json:"created_at"`
-	UpdatedAt pgtype.Timestamp `json:"updated_at"`
-	TeamName  string           `json:"team_name"`
+	ID                int64            `json:"id"`
+	UserID            string           `json:"user_id"`
+	Username          string           `json:"username"`
+	TeamID            int64            `json:"team_id"`
+	IsActive          bool             `json:"is_active"`
+	CreatedAt         pgtype.Timestamp `json:"created_at"`
+	UpdatedAt         pgtype.Timestamp `json:"updated_at"`
+	Seniority         string           `json:"seniority"`
+	TeamName          string           `json:"team_name"`
+	MentorshipEnabled bool             `json:"mentorship_enabled"`
 }
 
 func (q *Queries) GetUserWithTeam(ctx context.Context, userID string) (GetUserWithTeamRow, error) {
@@ -148,13 +154,15 @@ func (q *Queries) GetUserWithTeam(ctx context.Context, userID string) (GetUserWi
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Seniority,
 		&i.TeamName,
+		&i.MentorshipEnabled,
 	)
 	return i, err
 }
 
 const listActiveUsersByTeamID = `-- name: ListActiveUsersByTeamID :many
-SELECT id, user_id, username, team_id, is_active, created_at, updated_at FROM users
+SELECT id, user_id, username, team_id, is_active, created_at, updated_at, seniority FROM users
 WHERE team_id = $1 AND is_active = true
 ORDER BY username
 `
@@ -176,6 +184,7 @@ func (q *Queries) ListActiveUsersByTeamID(ctx context.Context, teamID int64) ([]
 			&i.IsActive,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Seniority,
 		); err != nil {
 			return nil, err
 		}
@@ -188,7 +197,7 @@ func (q *Queries) ListActiveUsersByTeamID(ctx context.Context, teamID int64) ([]
 }
 
 const listActiveUsersByTeamIDExcludingUser = `-- name: ListActiveUsersByTeamIDExcludingUser :many
-SELECT id, user_id, username, team_id, is_active, created_at, updated_at FROM users
+SELECT id, user_id, username, team_id, is_active, created_at, updated_at, seniority FROM users
 WHERE team_id = $1 AND is_active = true AND user_id != $2
 ORDER BY username
 `
@@ -215,6 +224,7 @@ func (q *Queries) ListActiveUsersByTeamIDExcludingUser(ctx context.Context, arg
 			&i.IsActive,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Seniority,
 		); err != nil {
 			return nil, err
 		}
@@ -227,7 +237,7 @@ func (q *Queries) ListActiveUsersByTeamIDExcludingUser(ctx context.Context, arg
 }
 
 const listUsersByTeamID = `-- name: ListUsersByTeamID :many
-SELECT id, user_id, username, team_id, is_active, created_at, updated_at FROM users
+SELECT id, user_id, username, team_id, is_active, created_at, updated_at, seniority FROM users
 WHERE team_id = $1
 ORDER BY username
 `
@@ -249,6 +259,7 @@ func (q *Queries) ListUsersByTeamID(ctx context.Context, teamID int64) ([]User,
 			&i.IsActive,
 			&i.CreatedAt,
 			&i.UpdatedAt,
+			&i.Seniority,
 		); err != nil {
 			return nil, err
 		}
@@ -264,7 +275,7 @@ const updateUser = `-- name: UpdateUser :one
 UPDATE users
 SET username = $2, team_id = $3, is_active = $4, updated_at = NOW()
 WHERE user_id = $1
-RETURNING id, user_id, username, team_id, is_active, created_at, updated_at
+RETURNING id, user_id, username, team_id, is_active, created_at, updated_at, seniority
 `
 
 type UpdateUserParams struct {
@@ -290,6 +301,7 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (User, e
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Seniority,
 	)
 	return i, err
 }
@@ -298,7 +310,7 @@ const updateUserIsActive = `-- name: UpdateUserIsActive :one
 UPDATE users
 SET is_active = $2, updated_at = NOW()
 WHERE user_id = $1
-RETURNING id, user_id, username, team_id, is_active, created_at, updated_at
+RETURNING id, user_id, username, team_id, is_active, created_at, updated_at, seniority
 `
 
 type UpdateUserIsActiveParams struct {
@@ -317,6 +329,35 @@ func (q *Queries) UpdateUserIsActive(ctx context.Context, arg UpdateUserIsActive
 		&i.IsActive,
 		&i.CreatedAt,
 		&i.UpdatedAt,
+		&i.Seniority,
+	)
+	return i, err
+}
+
+const updateUserSeniority = `-- name: UpdateUserSeniority :one
+UPDATE users
+SET seniority = $2, updated_at = NOW()
+WHERE user_id = $1
+RETURNING id, user_id, username, team_id, is_active, created_at, updated_at, seniority
+`
+
+type UpdateUserSeniorityParams struct {
+	UserID    string `json:"user_id"`
+	Seniority string `json:"seniority"`
+}
+
+func (q *Queries) UpdateUserSeniority(ctx context.Context, arg UpdateUserSeniorityParams) (User, error) {
+	row := q.db.QueryRow(ctx, updateUserSeniority, arg.UserID, arg.Seniority)
+	var i User
+	err := row.Scan(
+		&i.ID,
+		&i.UserID,
+		&i.Username,
+		&i.TeamID,
+		&i.IsActive,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.Seniority,
 	)
 	return i, err
 }
@@ -0,0 +1,125 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/api"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/service"
+)
+
+// ErrorDomain - домен google.rpc.ErrorInfo в ошибках API
+const ErrorDomain = "prassignment"
+
+// serviceError - соответствие ошибки сервисного слоя коду gRPC и коду
+// ошибки REST API
+type serviceError struct {
+	err     error
+	code    codes.Code
+	reason  api.ErrorResponseErrorCode
+	message string
+}
+
+// serviceErrors повторяет ответы обработчиков REST API: 400 -
+// InvalidArgument, 404 - NotFound, 409 - AlreadyExists для существующих
+// сущностей и FailedPrecondition для конфликтов состояния
+var serviceErrors = []serviceError{
+	{service.ErrTeamNotFound, codes.NotFound, api.NOTFOUND, "Team not found"},
+	{service.ErrUserNotFound, codes.NotFound, api.NOTFOUND, "User not found"},
+	{service.ErrPullRequestNotFound, codes.NotFound, api.NOTFOUND, "Pull request not found"},
+	{service.ErrRuleNotFound, codes.NotFound, api.NOTFOUND, "Rule not found"},
+	{service.ErrUnavailabilityNotFound, codes.NotFound, api.NOTFOUND, "Unavailability period not found"},
+	{service.ErrNoActiveReviewers, codes.NotFound, api.NOCANDIDATE, "No active reviewers available for reassignment"},
+	{service.ErrTeamAlreadyExists, codes.AlreadyExists, api.TEAMEXISTS, "Team already exists"},
+	{service.ErrPullRequestAlreadyExists, codes.AlreadyExists, api.PREXISTS, "Pull request already exists"},
+	{service.ErrRuleAlreadyExists, codes.AlreadyExists, api.RULEEXISTS, ""},
+	{service.ErrInvalidStatus, codes.FailedPrecondition, api.PRMERGED, ""},
+	{service.ErrReviewerBlocked, codes.FailedPrecondition, api.REVIEWERBLOCKED, ""},
+	{service.ErrReviewerNotAssigned, codes.InvalidArgument, api.NOTASSIGNED, "Reviewer not assigned to this PR"},
+	{service.ErrInvalidReviewerOptions, codes.InvalidArgument, api.INVALIDREVIEWERS, ""},
+	{service.ErrUserInactive, codes.InvalidArgument, api.INVALIDREVIEWERS, ""},
+	{service.ErrReviewerAlreadyAssigned, codes.InvalidArgument, api.INVALIDREVIEWERS, ""},
+	{service.ErrCannotAssignAuthor, codes.InvalidArgument, api.INVALIDREVIEWERS, ""},
+	{service.ErrInvalidSkill, codes.InvalidArgument, api.INVALIDSKILL, ""},
+	{service.ErrInvalidSeniority, codes.InvalidArgument, api.INVALIDSENIORITY, ""},
+	{service.ErrInvalidRule, codes.InvalidArgument, api.INVALIDRULE, ""},
+	{service.ErrInvalidTimeWindow, codes.InvalidArgument, api.INVALIDTIMERANGE, ""},
+	{service.ErrInvalidFairnessTolerance, codes.InvalidArgument, api.INVALIDTOLERANCE, ""},
+	{service.ErrInvalidUnavailability, codes.InvalidArgument, api.INVALIDUNAVAILABILITY, ""},
+}
+
+// toStatus преобразует ошибку сервисного слоя в статус gRPC с
+// google.rpc.ErrorInfo. Неизвестные ошибки возвращаются как Internal и
+// логируются перехватчиком.
+func toStatus(err error) error {
+	for _, e := range serviceErrors {
+		if errors.Is(err, e.err) {
+			message := e.message
+			if message == "" {
+				message = err.Error()
+			}
+			return newStatus(e.code, e.reason, message)
+		}
+	}
+
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return status.FromContextError(err).Err()
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
+
+// newStatus собирает статус gRPC с кодом ошибки REST API в ErrorInfo
+func newStatus(code codes.Code, reason api.ErrorResponseErrorCode, message string) error {
+	st := status.New(code, message)
+	withDetails, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: string(reason),
+		Domain: ErrorDomain,
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
+
+// maxFieldLength - максимальная длина идентификаторов и имен, как maxLength
+// EntityId и EntityName в OpenAPI спецификации
+const maxFieldLength = 255
+
+// requireFields проверяет обязательные поля запроса, как валидация тела
+// запроса в REST API. Аргументы - пары имя поля, значение.
+func requireFields(fields ...string) error {
+	for i := 0; i+1 < len(fields); i += 2 {
+		name, value := fields[i], fields[i+1]
+		if strings.TrimSpace(value) == "" {
+			return newStatus(codes.InvalidArgument, api.VALIDATIONERROR, "Invalid request: "+name+" is required")
+		}
+		if utf8.RuneCountInString(value) > maxFieldLength {
+			return newStatus(codes.InvalidArgument, api.VALIDATIONERROR,
+				fmt.Sprintf("Invalid request: %s exceeds %d characters", name, maxFieldLength))
+		}
+	}
+	return nil
+}
+
+// rateLimited отвечает на превышение лимита запросов, как 429 с Retry-After
+func rateLimited(retryAfter time.Duration, message string) error {
+	st := status.New(codes.ResourceExhausted, message)
+	withDetails, err := st.WithDetails(
+		&errdetails.ErrorInfo{Reason: string(api.RATELIMITED), Domain: ErrorDomain},
+		&errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)},
+	)
+	if err != nil {
+		return st.Err()
+	}
+	return withDetails.Err()
+}
@@ -0,0 +1,73 @@
+package grpcapi
+
+import (
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/AtoyanMikhail/PRAssignmentService/internal/grpcapi/prassignmentv1"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+)
+
+// StreamEvents отправляет события назначения. С last_event_id сначала
+// отправляются сохраненные события после него, как с Last-Event-ID в SSE.
+func (s *Server) StreamEvents(req *pb.StreamEventsRequest, stream grpc.ServerStreamingServer[pb.AssignmentEvent]) error {
+	ctx := stream.Context()
+	filter := models.EventFilter{
+		UserID:        req.GetUserId(),
+		TeamName:      req.GetTeamName(),
+		PullRequestID: req.GetPullRequestId(),
+	}
+
+	// Подписка до чтения журнала: события, записанные во время чтения, не теряются
+	sub := s.services.Events.Subscribe(filter)
+	defer sub.Close()
+
+	lastID := req.GetLastEventId()
+	if req.LastEventId != nil {
+		err := sub.Replay(ctx, lastID, func(e models.Event) error {
+			lastID = e.ID
+			return stream.Send(toAssignmentEvent(e))
+		})
+		if err != nil {
+			return toStatus(err)
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e, ok := <-sub.Events():
+			if !ok {
+				// Клиент не успевал читать события и продолжит с last_event_id
+				return status.Errorf(codes.Unavailable, "event stream closed, resume after event %d", lastID)
+			}
+			// Событие долгой транзакции приходит после следующих за ним
+			lastID = max(lastID, e.ID)
+			if err := stream.Send(toAssignmentEvent(e)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func toAssignmentEvent(e models.Event) *pb.AssignmentEvent {
+	event := &pb.AssignmentEvent{
+		Id:             e.ID,
+		Type:           string(e.Type),
+		PullRequestId:  e.PullRequestID,
+		AuthorId:       e.AuthorID,
+		UserId:         e.UserID,
+		PreviousUserId: e.PreviousUserID,
+		TeamName:       e.TeamName,
+		CreatedAt:      timestamppb.New(e.CreatedAt),
+	}
+	// Детали пишутся триггерами как JSON и всегда представимы в Struct
+	if details, err := structpb.NewStruct(e.Details); err == nil && len(e.Details) > 0 {
+		event.Details = details
+	}
+	return event
+}
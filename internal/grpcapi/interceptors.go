@@ -0,0 +1,212 @@
+package grpcapi
+
+import (
+	"context"
+	"math"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/api"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/logger"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/metrics"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/policy"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/ratelimit"
+)
+
+// requestIDKey - ключ метаданных с идентификатором запроса, как X-Request-ID в REST API
+const requestIDKey = "x-request-id"
+
+type identityKey struct{}
+
+type adminKey struct{}
+
+// ClientIdentity возвращает идентичность клиента, определенную по
+// клиентскому сертификату. Без сертификата используется IP адрес клиента.
+func ClientIdentity(ctx context.Context) string {
+	if identity, ok := ctx.Value(identityKey{}).(string); ok && identity != "" {
+		return identity
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if host, _, err := net.SplitHostPort(p.Addr.String()); err == nil {
+			return host
+		}
+		return p.Addr.String()
+	}
+	return ""
+}
+
+// withClientIdentity определяет идентичность клиента по CN проверенного
+// клиентского сертификата, так же как api.ClientCertIdentityMiddleware
+func withClientIdentity(ctx context.Context, identities map[string]string) context.Context {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return ctx
+	}
+	if identity := api.CertIdentity(&info.State, identities); identity != "" {
+		return context.WithValue(ctx, identityKey{}, identity)
+	}
+	return ctx
+}
+
+// withAdmin отмечает запрос администратора, как api.AdminMiddleware.
+// Токен передается в метаданных authorization.
+func withAdmin(ctx context.Context, auth *api.AdminAuth) context.Context {
+	if auth == nil {
+		return ctx
+	}
+	identity, _ := ctx.Value(identityKey{}).(string)
+	authorization := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			authorization = values[0]
+		}
+	}
+	if auth.Check(identity, authorization) != nil {
+		return ctx
+	}
+	return context.WithValue(ctx, adminKey{}, true)
+}
+
+// isAdmin сообщает, подтвердил ли клиент права администратора
+func isAdmin(ctx context.Context) bool {
+	admin, _ := ctx.Value(adminKey{}).(bool)
+	return admin
+}
+
+// Interceptors - перехватчики запросов gRPC, повторяющие middleware REST API:
+// идентичность по клиентскому сертификату, снимок политики назначения,
+// логирование с идентификатором запроса, восстановление после паники
+// и ограничение частоты запросов
+type Interceptors struct {
+	Identities map[string]string
+	// Admin - проверка прав администратора, nil - администраторов нет
+	Admin *api.AdminAuth
+	// Log - обязательный логгер, от него создается логгер запроса
+	Log     *logger.Logger
+	Metrics *metrics.Metrics
+	// Policies - политики назначения, снимок закрепляется на время запроса
+	Policies *policy.Store
+	// Limiter - ограничитель частоты запросов, nil - без ограничения.
+	// Маршрутом считается полное имя метода gRPC.
+	Limiter *ratelimit.Limiter
+}
+
+// ServerOptions возвращает перехватчики как опции grpc.Server
+func (i Interceptors) ServerOptions() []grpc.ServerOption {
+	return []grpc.ServerOption{
+		grpc.ChainUnaryInterceptor(i.unary),
+		grpc.ChainStreamInterceptor(i.stream),
+	}
+}
+
+func (i Interceptors) unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	ctx, finish := i.begin(ctx, info.FullMethod)
+	defer func() { finish(recover(), &err) }()
+
+	if err = i.allow(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (i Interceptors) stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	ctx, finish := i.begin(ss.Context(), info.FullMethod)
+	defer func() { finish(recover(), &err) }()
+
+	if err = i.allow(ctx, info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+}
+
+// begin готовит контекст запроса и возвращает функцию, которая после
+// обработки превращает панику в Internal и логирует результат
+func (i Interceptors) begin(ctx context.Context, method string) (context.Context, func(recovered any, err *error)) {
+	start := time.Now()
+	if i.Policies != nil {
+		// Запрос целиком выполняется по одной версии политики, как в api.PolicyMiddleware
+		ctx = policy.WithSnapshot(ctx, i.Policies.Current())
+	}
+	ctx = withClientIdentity(ctx, i.Identities)
+	ctx = withAdmin(ctx, i.Admin)
+
+	requestID := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(requestIDKey); len(values) > 0 {
+			requestID = values[0]
+		}
+	}
+	if !api.ValidRequestID(requestID) {
+		requestID = uuid.NewString()
+	}
+
+	reqLog := i.Log.With(zap.String("request_id", requestID))
+	ctx = logger.WithContext(ctx, reqLog)
+
+	return ctx, func(recovered any, err *error) {
+		if recovered != nil {
+			reqLog.Error("Panic recovered", zap.Any("panic", recovered), zap.Stack("stack"))
+			*err = status.Error(codes.Internal, "internal server error")
+		}
+
+		code := status.Code(*err)
+		fields := []zap.Field{
+			zap.String("method", method),
+			zap.String("code", code.String()),
+			zap.Duration("latency", time.Since(start)),
+			zap.String("client", ClientIdentity(ctx)),
+		}
+		switch code {
+		case codes.OK, codes.Canceled:
+			reqLog.Info("gRPC request", fields...)
+		case codes.Internal, codes.Unknown, codes.DataLoss:
+			reqLog.Error("gRPC request", append(fields, zap.Error(*err))...)
+		default:
+			reqLog.Warn("gRPC request", append(fields, zap.Error(*err))...)
+		}
+	}
+}
+
+// allow расходует токен ограничителя частоты запросов. При превышении
+// возвращает ResourceExhausted с кодом RATE_LIMITED и google.rpc.RetryInfo.
+func (i Interceptors) allow(ctx context.Context, method string) error {
+	if i.Limiter == nil {
+		return nil
+	}
+
+	ok, retryAfter := i.Limiter.Allow(ClientIdentity(ctx), method)
+	if ok {
+		return nil
+	}
+	i.Metrics.RateLimited(method)
+
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	return rateLimited(time.Duration(seconds)*time.Second, "Rate limit exceeded, retry in "+strconv.Itoa(seconds)+"s")
+}
+
+// serverStream подменяет контекст потока контекстом с идентичностью и логгером
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
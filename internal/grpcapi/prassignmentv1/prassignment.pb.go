@@ -0,0 +1,4808 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        (unknown)
+// source: prassignment/v1/prassignment.proto
+
+// gRPC API сервиса назначения ревьюеров. Операции повторяют REST API из
+// docs/openapi.yaml, поля называются так же, как в JSON. Ошибки
+// возвращаются статусом gRPC с google.rpc.ErrorInfo, где reason - код
+// ошибки REST API (PR_EXISTS, NOT_FOUND, INVALID_REVIEWERS, ...).
+// Правила, аудит, аналитика и администрирование есть только в REST API.
+
+package prassignmentv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TeamMember struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// По умолчанию true
+	IsActive *bool `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3,oneof" json:"is_active,omitempty"`
+	// learner, regular или senior
+	Seniority     string `protobuf:"bytes,4,opt,name=seniority,proto3" json:"seniority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMember) Reset() {
+	*x = TeamMember{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMember) ProtoMessage() {}
+
+func (x *TeamMember) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
+func (*TeamMember) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TeamMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TeamMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *TeamMember) GetIsActive() bool {
+	if x != nil && x.IsActive != nil {
+		return *x.IsActive
+	}
+	return false
+}
+
+func (x *TeamMember) GetSeniority() string {
+	if x != nil {
+		return x.Seniority
+	}
+	return ""
+}
+
+type Team struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TeamName          string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	MentorshipEnabled bool                   `protobuf:"varint,2,opt,name=mentorship_enabled,json=mentorshipEnabled,proto3" json:"mentorship_enabled,omitempty"`
+	Members           []*TeamMember          `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Team) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *Team) GetMentorshipEnabled() bool {
+	if x != nil {
+		return x.MentorshipEnabled
+	}
+	return false
+}
+
+func (x *Team) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	TeamName      string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IsActive      bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Seniority     string                 `protobuf:"bytes,5,opt,name=seniority,proto3" json:"seniority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *User) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *User) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *User) GetSeniority() string {
+	if x != nil {
+		return x.Seniority
+	}
+	return ""
+}
+
+type AddTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Members       []*TeamMember          `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamRequest) Reset() {
+	*x = AddTeamRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamRequest) ProtoMessage() {}
+
+func (x *AddTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamRequest.ProtoReflect.Descriptor instead.
+func (*AddTeamRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AddTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *AddTeamRequest) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type AddTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamResponse) Reset() {
+	*x = AddTeamResponse{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamResponse) ProtoMessage() {}
+
+func (x *AddTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamResponse.ProtoReflect.Descriptor instead.
+func (*AddTeamResponse) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AddTeamResponse) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *GetTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type DeactivateTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateTeamRequest) Reset() {
+	*x = DeactivateTeamRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateTeamRequest) ProtoMessage() {}
+
+func (x *DeactivateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateTeamRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DeactivateTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type DeactivateTeamResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	DeactivatedUsers int64                  `protobuf:"varint,1,opt,name=deactivated_users,json=deactivatedUsers,proto3" json:"deactivated_users,omitempty"`
+	ReassignedPrs    int64                  `protobuf:"varint,2,opt,name=reassigned_prs,json=reassignedPrs,proto3" json:"reassigned_prs,omitempty"`
+	DurationMs       int64                  `protobuf:"varint,3,opt,name=duration_ms,json=durationMs,proto3" json:"duration_ms,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *DeactivateTeamResponse) Reset() {
+	*x = DeactivateTeamResponse{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateTeamResponse) ProtoMessage() {}
+
+func (x *DeactivateTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateTeamResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateTeamResponse) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DeactivateTeamResponse) GetDeactivatedUsers() int64 {
+	if x != nil {
+		return x.DeactivatedUsers
+	}
+	return 0
+}
+
+func (x *DeactivateTeamResponse) GetReassignedPrs() int64 {
+	if x != nil {
+		return x.ReassignedPrs
+	}
+	return 0
+}
+
+func (x *DeactivateTeamResponse) GetDurationMs() int64 {
+	if x != nil {
+		return x.DurationMs
+	}
+	return 0
+}
+
+type SetMentorshipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Enabled       bool                   `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetMentorshipRequest) Reset() {
+	*x = SetMentorshipRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMentorshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMentorshipRequest) ProtoMessage() {}
+
+func (x *SetMentorshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMentorshipRequest.ProtoReflect.Descriptor instead.
+func (*SetMentorshipRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *SetMentorshipRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetMentorshipRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SetMentorshipResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TeamName          string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	MentorshipEnabled bool                   `protobuf:"varint,2,opt,name=mentorship_enabled,json=mentorshipEnabled,proto3" json:"mentorship_enabled,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SetMentorshipResponse) Reset() {
+	*x = SetMentorshipResponse{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetMentorshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMentorshipResponse) ProtoMessage() {}
+
+func (x *SetMentorshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMentorshipResponse.ProtoReflect.Descriptor instead.
+func (*SetMentorshipResponse) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *SetMentorshipResponse) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *SetMentorshipResponse) GetMentorshipEnabled() bool {
+	if x != nil {
+		return x.MentorshipEnabled
+	}
+	return false
+}
+
+type SetIsActiveRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsActive      bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetIsActiveRequest) Reset() {
+	*x = SetIsActiveRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetIsActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIsActiveRequest) ProtoMessage() {}
+
+func (x *SetIsActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIsActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetIsActiveRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SetIsActiveRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetIsActiveRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type GetReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewRequest) Reset() {
+	*x = GetReviewRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewRequest) ProtoMessage() {}
+
+func (x *GetReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetReviewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type PullRequestShort struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// OPEN или MERGED
+	Status        string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullRequestShort) Reset() {
+	*x = PullRequestShort{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestShort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestShort) ProtoMessage() {}
+
+func (x *PullRequestShort) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestShort.ProtoReflect.Descriptor instead.
+func (*PullRequestShort) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *PullRequestShort) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PullRequests  []*PullRequestShort    `protobuf:"bytes,2,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewResponse) Reset() {
+	*x = GetReviewResponse{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewResponse) ProtoMessage() {}
+
+func (x *GetReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewResponse.ProtoReflect.Descriptor instead.
+func (*GetReviewResponse) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetReviewResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetReviewResponse) GetPullRequests() []*PullRequestShort {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+type SetSeniorityRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// learner, regular или senior
+	Seniority     string `protobuf:"bytes,2,opt,name=seniority,proto3" json:"seniority,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSeniorityRequest) Reset() {
+	*x = SetSeniorityRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSeniorityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSeniorityRequest) ProtoMessage() {}
+
+func (x *SetSeniorityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSeniorityRequest.ProtoReflect.Descriptor instead.
+func (*SetSeniorityRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *SetSeniorityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetSeniorityRequest) GetSeniority() string {
+	if x != nil {
+		return x.Seniority
+	}
+	return ""
+}
+
+type UserSkill struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Tag   string                 `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// novice, intermediate или expert
+	Level         string `protobuf:"bytes,2,opt,name=level,proto3" json:"level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSkill) Reset() {
+	*x = UserSkill{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSkill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSkill) ProtoMessage() {}
+
+func (x *UserSkill) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSkill.ProtoReflect.Descriptor instead.
+func (*UserSkill) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UserSkill) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *UserSkill) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+type UserSkills struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Skills        []*UserSkill           `protobuf:"bytes,2,rep,name=skills,proto3" json:"skills,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserSkills) Reset() {
+	*x = UserSkills{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserSkills) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserSkills) ProtoMessage() {}
+
+func (x *UserSkills) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserSkills.ProtoReflect.Descriptor instead.
+func (*UserSkills) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *UserSkills) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserSkills) GetSkills() []*UserSkill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+type GetSkillsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSkillsRequest) Reset() {
+	*x = GetSkillsRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSkillsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSkillsRequest) ProtoMessage() {}
+
+func (x *GetSkillsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSkillsRequest.ProtoReflect.Descriptor instead.
+func (*GetSkillsRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetSkillsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type SetSkillsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Skills        []*UserSkill           `protobuf:"bytes,2,rep,name=skills,proto3" json:"skills,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetSkillsRequest) Reset() {
+	*x = SetSkillsRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetSkillsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetSkillsRequest) ProtoMessage() {}
+
+func (x *SetSkillsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetSkillsRequest.ProtoReflect.Descriptor instead.
+func (*SetSkillsRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SetSkillsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetSkillsRequest) GetSkills() []*UserSkill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+// Unavailability - период [starts_at, ends_at), когда пользователь не назначается ревьюером
+type Unavailability struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Unavailability) Reset() {
+	*x = Unavailability{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Unavailability) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Unavailability) ProtoMessage() {}
+
+func (x *Unavailability) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Unavailability.ProtoReflect.Descriptor instead.
+func (*Unavailability) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *Unavailability) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Unavailability) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *Unavailability) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *Unavailability) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *Unavailability) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type AddUnavailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	StartsAt      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddUnavailabilityRequest) Reset() {
+	*x = AddUnavailabilityRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddUnavailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddUnavailabilityRequest) ProtoMessage() {}
+
+func (x *AddUnavailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddUnavailabilityRequest.ProtoReflect.Descriptor instead.
+func (*AddUnavailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *AddUnavailabilityRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AddUnavailabilityRequest) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *AddUnavailabilityRequest) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *AddUnavailabilityRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DeleteUnavailabilityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteUnavailabilityRequest) Reset() {
+	*x = DeleteUnavailabilityRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteUnavailabilityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteUnavailabilityRequest) ProtoMessage() {}
+
+func (x *DeleteUnavailabilityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteUnavailabilityRequest.ProtoReflect.Descriptor instead.
+func (*DeleteUnavailabilityRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteUnavailabilityRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type GetDashboardRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDashboardRequest) Reset() {
+	*x = GetDashboardRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDashboardRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDashboardRequest) ProtoMessage() {}
+
+func (x *GetDashboardRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDashboardRequest.ProtoReflect.Descriptor instead.
+func (*GetDashboardRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetDashboardRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type PendingReview struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	AgeSeconds      int64                  `protobuf:"varint,5,opt,name=age_seconds,json=ageSeconds,proto3" json:"age_seconds,omitempty"`
+	// Срок ревью по review_sla политики, считается от назначения
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	Overdue       bool                   `protobuf:"varint,7,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	Assignment    *ReviewerAssignment    `protobuf:"bytes,8,opt,name=assignment,proto3" json:"assignment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PendingReview) Reset() {
+	*x = PendingReview{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PendingReview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PendingReview) ProtoMessage() {}
+
+func (x *PendingReview) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PendingReview.ProtoReflect.Descriptor instead.
+func (*PendingReview) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *PendingReview) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PendingReview) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PendingReview) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PendingReview) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PendingReview) GetAgeSeconds() int64 {
+	if x != nil {
+		return x.AgeSeconds
+	}
+	return 0
+}
+
+func (x *PendingReview) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *PendingReview) GetOverdue() bool {
+	if x != nil {
+		return x.Overdue
+	}
+	return false
+}
+
+func (x *PendingReview) GetAssignment() *ReviewerAssignment {
+	if x != nil {
+		return x.Assignment
+	}
+	return nil
+}
+
+type AuthoredPullRequestReviewer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Source        string                 `protobuf:"bytes,4,opt,name=source,proto3" json:"source,omitempty"`
+	AssignedAt    *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	DueAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=due_at,json=dueAt,proto3" json:"due_at,omitempty"`
+	Overdue       bool                   `protobuf:"varint,7,opt,name=overdue,proto3" json:"overdue,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthoredPullRequestReviewer) Reset() {
+	*x = AuthoredPullRequestReviewer{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthoredPullRequestReviewer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthoredPullRequestReviewer) ProtoMessage() {}
+
+func (x *AuthoredPullRequestReviewer) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthoredPullRequestReviewer.ProtoReflect.Descriptor instead.
+func (*AuthoredPullRequestReviewer) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *AuthoredPullRequestReviewer) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AuthoredPullRequestReviewer) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AuthoredPullRequestReviewer) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *AuthoredPullRequestReviewer) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *AuthoredPullRequestReviewer) GetAssignedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+func (x *AuthoredPullRequestReviewer) GetDueAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DueAt
+	}
+	return nil
+}
+
+func (x *AuthoredPullRequestReviewer) GetOverdue() bool {
+	if x != nil {
+		return x.Overdue
+	}
+	return false
+}
+
+type AuthoredPullRequest struct {
+	state           protoimpl.MessageState         `protogen:"open.v1"`
+	PullRequestId   string                         `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                         `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	CreatedAt       *timestamppb.Timestamp         `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Reviewers       []*AuthoredPullRequestReviewer `protobuf:"bytes,4,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *AuthoredPullRequest) Reset() {
+	*x = AuthoredPullRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthoredPullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthoredPullRequest) ProtoMessage() {}
+
+func (x *AuthoredPullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthoredPullRequest.ProtoReflect.Descriptor instead.
+func (*AuthoredPullRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *AuthoredPullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *AuthoredPullRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *AuthoredPullRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AuthoredPullRequest) GetReviewers() []*AuthoredPullRequestReviewer {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+type ReviewCapacity struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	OpenReviews int64                  `protobuf:"varint,1,opt,name=open_reviews,json=openReviews,proto3" json:"open_reviews,omitempty"`
+	// Лимит политики, 0 - без ограничения
+	MaxOpenReviews int32 `protobuf:"varint,2,opt,name=max_open_reviews,json=maxOpenReviews,proto3" json:"max_open_reviews,omitempty"`
+	// Пустое - без ограничения
+	Remaining     *int64 `protobuf:"varint,3,opt,name=remaining,proto3,oneof" json:"remaining,omitempty"`
+	AtCapacity    bool   `protobuf:"varint,4,opt,name=at_capacity,json=atCapacity,proto3" json:"at_capacity,omitempty"`
+	Unavailable   bool   `protobuf:"varint,5,opt,name=unavailable,proto3" json:"unavailable,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewCapacity) Reset() {
+	*x = ReviewCapacity{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewCapacity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewCapacity) ProtoMessage() {}
+
+func (x *ReviewCapacity) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewCapacity.ProtoReflect.Descriptor instead.
+func (*ReviewCapacity) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReviewCapacity) GetOpenReviews() int64 {
+	if x != nil {
+		return x.OpenReviews
+	}
+	return 0
+}
+
+func (x *ReviewCapacity) GetMaxOpenReviews() int32 {
+	if x != nil {
+		return x.MaxOpenReviews
+	}
+	return 0
+}
+
+func (x *ReviewCapacity) GetRemaining() int64 {
+	if x != nil && x.Remaining != nil {
+		return *x.Remaining
+	}
+	return 0
+}
+
+func (x *ReviewCapacity) GetAtCapacity() bool {
+	if x != nil {
+		return x.AtCapacity
+	}
+	return false
+}
+
+func (x *ReviewCapacity) GetUnavailable() bool {
+	if x != nil {
+		return x.Unavailable
+	}
+	return false
+}
+
+type RecentReviewStats struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	From            *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To              *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	ReviewsAssigned int64                  `protobuf:"varint,3,opt,name=reviews_assigned,json=reviewsAssigned,proto3" json:"reviews_assigned,omitempty"`
+	ReviewsMerged   int64                  `protobuf:"varint,4,opt,name=reviews_merged,json=reviewsMerged,proto3" json:"reviews_merged,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RecentReviewStats) Reset() {
+	*x = RecentReviewStats{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecentReviewStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecentReviewStats) ProtoMessage() {}
+
+func (x *RecentReviewStats) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecentReviewStats.ProtoReflect.Descriptor instead.
+func (*RecentReviewStats) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *RecentReviewStats) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *RecentReviewStats) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *RecentReviewStats) GetReviewsAssigned() int64 {
+	if x != nil {
+		return x.ReviewsAssigned
+	}
+	return 0
+}
+
+func (x *RecentReviewStats) GetReviewsMerged() int64 {
+	if x != nil {
+		return x.ReviewsMerged
+	}
+	return 0
+}
+
+type UserDashboard struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	User                 *User                  `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	PendingReviews       []*PendingReview       `protobuf:"bytes,2,rep,name=pending_reviews,json=pendingReviews,proto3" json:"pending_reviews,omitempty"`
+	AuthoredPullRequests []*AuthoredPullRequest `protobuf:"bytes,3,rep,name=authored_pull_requests,json=authoredPullRequests,proto3" json:"authored_pull_requests,omitempty"`
+	Capacity             *ReviewCapacity        `protobuf:"bytes,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Unavailability       []*Unavailability      `protobuf:"bytes,5,rep,name=unavailability,proto3" json:"unavailability,omitempty"`
+	RecentStats          *RecentReviewStats     `protobuf:"bytes,6,opt,name=recent_stats,json=recentStats,proto3" json:"recent_stats,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *UserDashboard) Reset() {
+	*x = UserDashboard{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserDashboard) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserDashboard) ProtoMessage() {}
+
+func (x *UserDashboard) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserDashboard.ProtoReflect.Descriptor instead.
+func (*UserDashboard) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UserDashboard) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *UserDashboard) GetPendingReviews() []*PendingReview {
+	if x != nil {
+		return x.PendingReviews
+	}
+	return nil
+}
+
+func (x *UserDashboard) GetAuthoredPullRequests() []*AuthoredPullRequest {
+	if x != nil {
+		return x.AuthoredPullRequests
+	}
+	return nil
+}
+
+func (x *UserDashboard) GetCapacity() *ReviewCapacity {
+	if x != nil {
+		return x.Capacity
+	}
+	return nil
+}
+
+func (x *UserDashboard) GetUnavailability() []*Unavailability {
+	if x != nil {
+		return x.Unavailability
+	}
+	return nil
+}
+
+func (x *UserDashboard) GetRecentStats() *RecentReviewStats {
+	if x != nil {
+		return x.RecentStats
+	}
+	return nil
+}
+
+// ReviewerAssignment - ревьюер PR и причина его назначения
+type ReviewerAssignment struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username *string                `protobuf:"bytes,2,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	// auto, manual, fallback_team, code_owner, sla_escalation или inactive_reassignment
+	Source               string                 `protobuf:"bytes,3,opt,name=source,proto3" json:"source,omitempty"`
+	Strategy             *string                `protobuf:"bytes,4,opt,name=strategy,proto3,oneof" json:"strategy,omitempty"`
+	WorkloadAtAssignment *int64                 `protobuf:"varint,5,opt,name=workload_at_assignment,json=workloadAtAssignment,proto3,oneof" json:"workload_at_assignment,omitempty"`
+	TieBreak             *bool                  `protobuf:"varint,6,opt,name=tie_break,json=tieBreak,proto3,oneof" json:"tie_break,omitempty"`
+	AssignedAt           *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=assigned_at,json=assignedAt,proto3" json:"assigned_at,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ReviewerAssignment) Reset() {
+	*x = ReviewerAssignment{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewerAssignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerAssignment) ProtoMessage() {}
+
+func (x *ReviewerAssignment) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerAssignment.ProtoReflect.Descriptor instead.
+func (*ReviewerAssignment) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ReviewerAssignment) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReviewerAssignment) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *ReviewerAssignment) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *ReviewerAssignment) GetStrategy() string {
+	if x != nil && x.Strategy != nil {
+		return *x.Strategy
+	}
+	return ""
+}
+
+func (x *ReviewerAssignment) GetWorkloadAtAssignment() int64 {
+	if x != nil && x.WorkloadAtAssignment != nil {
+		return *x.WorkloadAtAssignment
+	}
+	return 0
+}
+
+func (x *ReviewerAssignment) GetTieBreak() bool {
+	if x != nil && x.TieBreak != nil {
+		return *x.TieBreak
+	}
+	return false
+}
+
+func (x *ReviewerAssignment) GetAssignedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AssignedAt
+	}
+	return nil
+}
+
+type PullRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// OPEN или MERGED
+	Status              string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	AssignedReviewers   []string               `protobuf:"bytes,5,rep,name=assigned_reviewers,json=assignedReviewers,proto3" json:"assigned_reviewers,omitempty"`
+	ReviewerAssignments []*ReviewerAssignment  `protobuf:"bytes,6,rep,name=reviewer_assignments,json=reviewerAssignments,proto3" json:"reviewer_assignments,omitempty"`
+	RequiredTags        []string               `protobuf:"bytes,7,rep,name=required_tags,json=requiredTags,proto3" json:"required_tags,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MergedAt            *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *PullRequest) Reset() {
+	*x = PullRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequest) ProtoMessage() {}
+
+func (x *PullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
+func (*PullRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PullRequest) GetAssignedReviewers() []string {
+	if x != nil {
+		return x.AssignedReviewers
+	}
+	return nil
+}
+
+func (x *PullRequest) GetReviewerAssignments() []*ReviewerAssignment {
+	if x != nil {
+		return x.ReviewerAssignments
+	}
+	return nil
+}
+
+func (x *PullRequest) GetRequiredTags() []string {
+	if x != nil {
+		return x.RequiredTags
+	}
+	return nil
+}
+
+func (x *PullRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PullRequest) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+type CreatePullRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// Количество ревьюеров, 0 - по политике назначения
+	ReviewerCount      int32    `protobuf:"varint,4,opt,name=reviewer_count,json=reviewerCount,proto3" json:"reviewer_count,omitempty"`
+	PreferredReviewers []string `protobuf:"bytes,5,rep,name=preferred_reviewers,json=preferredReviewers,proto3" json:"preferred_reviewers,omitempty"`
+	ExcludedReviewers  []string `protobuf:"bytes,6,rep,name=excluded_reviewers,json=excludedReviewers,proto3" json:"excluded_reviewers,omitempty"`
+	RequiredTags       []string `protobuf:"bytes,7,rep,name=required_tags,json=requiredTags,proto3" json:"required_tags,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestRequest) Reset() {
+	*x = CreatePullRequestRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestRequest) ProtoMessage() {}
+
+func (x *CreatePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetReviewerCount() int32 {
+	if x != nil {
+		return x.ReviewerCount
+	}
+	return 0
+}
+
+func (x *CreatePullRequestRequest) GetPreferredReviewers() []string {
+	if x != nil {
+		return x.PreferredReviewers
+	}
+	return nil
+}
+
+func (x *CreatePullRequestRequest) GetExcludedReviewers() []string {
+	if x != nil {
+		return x.ExcludedReviewers
+	}
+	return nil
+}
+
+func (x *CreatePullRequestRequest) GetRequiredTags() []string {
+	if x != nil {
+		return x.RequiredTags
+	}
+	return nil
+}
+
+type MergePullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestRequest) Reset() {
+	*x = MergePullRequestRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestRequest) ProtoMessage() {}
+
+func (x *MergePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*MergePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MergePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type GetRequiredTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRequiredTagsRequest) Reset() {
+	*x = GetRequiredTagsRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRequiredTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRequiredTagsRequest) ProtoMessage() {}
+
+func (x *GetRequiredTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRequiredTagsRequest.ProtoReflect.Descriptor instead.
+func (*GetRequiredTagsRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetRequiredTagsRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type SetRequiredTagsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	RequiredTags  []string               `protobuf:"bytes,2,rep,name=required_tags,json=requiredTags,proto3" json:"required_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRequiredTagsRequest) Reset() {
+	*x = SetRequiredTagsRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRequiredTagsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRequiredTagsRequest) ProtoMessage() {}
+
+func (x *SetRequiredTagsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRequiredTagsRequest.ProtoReflect.Descriptor instead.
+func (*SetRequiredTagsRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetRequiredTagsRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *SetRequiredTagsRequest) GetRequiredTags() []string {
+	if x != nil {
+		return x.RequiredTags
+	}
+	return nil
+}
+
+type PullRequestTags struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	RequiredTags  []string               `protobuf:"bytes,2,rep,name=required_tags,json=requiredTags,proto3" json:"required_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullRequestTags) Reset() {
+	*x = PullRequestTags{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestTags) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestTags) ProtoMessage() {}
+
+func (x *PullRequestTags) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestTags.ProtoReflect.Descriptor instead.
+func (*PullRequestTags) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PullRequestTags) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequestTags) GetRequiredTags() []string {
+	if x != nil {
+		return x.RequiredTags
+	}
+	return nil
+}
+
+type ReassignReviewerRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	OldUserId     string                 `protobuf:"bytes,2,opt,name=old_user_id,json=oldUserId,proto3" json:"old_user_id,omitempty"`
+	// Пустой - замена выбирается автоматически
+	NewUserId      string `protobuf:"bytes,3,opt,name=new_user_id,json=newUserId,proto3" json:"new_user_id,omitempty"`
+	Override       bool   `protobuf:"varint,4,opt,name=override,proto3" json:"override,omitempty"`
+	OverrideReason string `protobuf:"bytes,5,opt,name=override_reason,json=overrideReason,proto3" json:"override_reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerRequest) Reset() {
+	*x = ReassignReviewerRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerRequest) ProtoMessage() {}
+
+func (x *ReassignReviewerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerRequest.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReassignReviewerRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReassignReviewerRequest) GetOldUserId() string {
+	if x != nil {
+		return x.OldUserId
+	}
+	return ""
+}
+
+func (x *ReassignReviewerRequest) GetNewUserId() string {
+	if x != nil {
+		return x.NewUserId
+	}
+	return ""
+}
+
+func (x *ReassignReviewerRequest) GetOverride() bool {
+	if x != nil {
+		return x.Override
+	}
+	return false
+}
+
+func (x *ReassignReviewerRequest) GetOverrideReason() string {
+	if x != nil {
+		return x.OverrideReason
+	}
+	return ""
+}
+
+type ReassignReviewerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignReviewerResponse) Reset() {
+	*x = ReassignReviewerResponse{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignReviewerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignReviewerResponse) ProtoMessage() {}
+
+func (x *ReassignReviewerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignReviewerResponse.ProtoReflect.Descriptor instead.
+func (*ReassignReviewerResponse) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{37}
+}
+
+type AssignReviewerRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId  string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	UserId         string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Override       bool                   `protobuf:"varint,3,opt,name=override,proto3" json:"override,omitempty"`
+	OverrideReason string                 `protobuf:"bytes,4,opt,name=override_reason,json=overrideReason,proto3" json:"override_reason,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AssignReviewerRequest) Reset() {
+	*x = AssignReviewerRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignReviewerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignReviewerRequest) ProtoMessage() {}
+
+func (x *AssignReviewerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignReviewerRequest.ProtoReflect.Descriptor instead.
+func (*AssignReviewerRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *AssignReviewerRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *AssignReviewerRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AssignReviewerRequest) GetOverride() bool {
+	if x != nil {
+		return x.Override
+	}
+	return false
+}
+
+func (x *AssignReviewerRequest) GetOverrideReason() string {
+	if x != nil {
+		return x.OverrideReason
+	}
+	return ""
+}
+
+type ListReviewersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewersRequest) Reset() {
+	*x = ListReviewersRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewersRequest) ProtoMessage() {}
+
+func (x *ListReviewersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewersRequest.ProtoReflect.Descriptor instead.
+func (*ListReviewersRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ListReviewersRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type ListReviewersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	Reviewers     []*ReviewerAssignment  `protobuf:"bytes,2,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReviewersResponse) Reset() {
+	*x = ListReviewersResponse{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReviewersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReviewersResponse) ProtoMessage() {}
+
+func (x *ListReviewersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReviewersResponse.ProtoReflect.Descriptor instead.
+func (*ListReviewersResponse) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListReviewersResponse) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ListReviewersResponse) GetReviewers() []*ReviewerAssignment {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+type PreviewReviewersRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId string                 `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// Необязателен: уже назначенные ревьюеры PR не выбираются повторно
+	PullRequestId string `protobuf:"bytes,2,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	// Общее количество ревьюеров, 0 - по политике назначения
+	ReviewerCount     int32    `protobuf:"varint,3,opt,name=reviewer_count,json=reviewerCount,proto3" json:"reviewer_count,omitempty"`
+	ExcludedReviewers []string `protobuf:"bytes,4,rep,name=excluded_reviewers,json=excludedReviewers,proto3" json:"excluded_reviewers,omitempty"`
+	// Пустые - теги PR
+	RequiredTags  []string `protobuf:"bytes,5,rep,name=required_tags,json=requiredTags,proto3" json:"required_tags,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PreviewReviewersRequest) Reset() {
+	*x = PreviewReviewersRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PreviewReviewersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PreviewReviewersRequest) ProtoMessage() {}
+
+func (x *PreviewReviewersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PreviewReviewersRequest.ProtoReflect.Descriptor instead.
+func (*PreviewReviewersRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PreviewReviewersRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PreviewReviewersRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PreviewReviewersRequest) GetReviewerCount() int32 {
+	if x != nil {
+		return x.ReviewerCount
+	}
+	return 0
+}
+
+func (x *PreviewReviewersRequest) GetExcludedReviewers() []string {
+	if x != nil {
+		return x.ExcludedReviewers
+	}
+	return nil
+}
+
+func (x *PreviewReviewersRequest) GetRequiredTags() []string {
+	if x != nil {
+		return x.RequiredTags
+	}
+	return nil
+}
+
+type ScoreComponent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value         float64                `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScoreComponent) Reset() {
+	*x = ScoreComponent{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScoreComponent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScoreComponent) ProtoMessage() {}
+
+func (x *ScoreComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScoreComponent.ProtoReflect.Descriptor instead.
+func (*ScoreComponent) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ScoreComponent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ScoreComponent) GetValue() float64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type RankedCandidate struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rank          int32                  `protobuf:"varint,1,opt,name=rank,proto3" json:"rank,omitempty"`
+	UserId        string                 `protobuf:"bytes,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Workload      int64                  `protobuf:"varint,4,opt,name=workload,proto3" json:"workload,omitempty"`
+	Seniority     string                 `protobuf:"bytes,5,opt,name=seniority,proto3" json:"seniority,omitempty"`
+	Score         float64                `protobuf:"fixed64,6,opt,name=score,proto3" json:"score,omitempty"`
+	Breakdown     []*ScoreComponent      `protobuf:"bytes,7,rep,name=breakdown,proto3" json:"breakdown,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RankedCandidate) Reset() {
+	*x = RankedCandidate{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RankedCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RankedCandidate) ProtoMessage() {}
+
+func (x *RankedCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RankedCandidate.ProtoReflect.Descriptor instead.
+func (*RankedCandidate) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RankedCandidate) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *RankedCandidate) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *RankedCandidate) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RankedCandidate) GetWorkload() int64 {
+	if x != nil {
+		return x.Workload
+	}
+	return 0
+}
+
+func (x *RankedCandidate) GetSeniority() string {
+	if x != nil {
+		return x.Seniority
+	}
+	return ""
+}
+
+func (x *RankedCandidate) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *RankedCandidate) GetBreakdown() []*ScoreComponent {
+	if x != nil {
+		return x.Breakdown
+	}
+	return nil
+}
+
+type ExcludedCandidate struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Workload int64                  `protobuf:"varint,3,opt,name=workload,proto3" json:"workload,omitempty"`
+	// author, inactive, unavailable, at_capacity, already_assigned, excluded или blocked
+	Reason        string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExcludedCandidate) Reset() {
+	*x = ExcludedCandidate{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExcludedCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExcludedCandidate) ProtoMessage() {}
+
+func (x *ExcludedCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExcludedCandidate.ProtoReflect.Descriptor instead.
+func (*ExcludedCandidate) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ExcludedCandidate) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ExcludedCandidate) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ExcludedCandidate) GetWorkload() int64 {
+	if x != nil {
+		return x.Workload
+	}
+	return 0
+}
+
+func (x *ExcludedCandidate) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ReviewerPreview struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AuthorId      string                 `protobuf:"bytes,1,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	TeamName      string                 `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Strategy      string                 `protobuf:"bytes,3,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	PolicyVersion int64                  `protobuf:"varint,4,opt,name=policy_version,json=policyVersion,proto3" json:"policy_version,omitempty"`
+	ReviewerCount int32                  `protobuf:"varint,5,opt,name=reviewer_count,json=reviewerCount,proto3" json:"reviewer_count,omitempty"`
+	RequiredTags  []string               `protobuf:"bytes,6,rep,name=required_tags,json=requiredTags,proto3" json:"required_tags,omitempty"`
+	Mentorship    bool                   `protobuf:"varint,7,opt,name=mentorship,proto3" json:"mentorship,omitempty"`
+	Selected      []string               `protobuf:"bytes,8,rep,name=selected,proto3" json:"selected,omitempty"`
+	Candidates    []*RankedCandidate     `protobuf:"bytes,9,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	Excluded      []*ExcludedCandidate   `protobuf:"bytes,10,rep,name=excluded,proto3" json:"excluded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewerPreview) Reset() {
+	*x = ReviewerPreview{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewerPreview) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerPreview) ProtoMessage() {}
+
+func (x *ReviewerPreview) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerPreview.ProtoReflect.Descriptor instead.
+func (*ReviewerPreview) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ReviewerPreview) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *ReviewerPreview) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *ReviewerPreview) GetStrategy() string {
+	if x != nil {
+		return x.Strategy
+	}
+	return ""
+}
+
+func (x *ReviewerPreview) GetPolicyVersion() int64 {
+	if x != nil {
+		return x.PolicyVersion
+	}
+	return 0
+}
+
+func (x *ReviewerPreview) GetReviewerCount() int32 {
+	if x != nil {
+		return x.ReviewerCount
+	}
+	return 0
+}
+
+func (x *ReviewerPreview) GetRequiredTags() []string {
+	if x != nil {
+		return x.RequiredTags
+	}
+	return nil
+}
+
+func (x *ReviewerPreview) GetMentorship() bool {
+	if x != nil {
+		return x.Mentorship
+	}
+	return false
+}
+
+func (x *ReviewerPreview) GetSelected() []string {
+	if x != nil {
+		return x.Selected
+	}
+	return nil
+}
+
+func (x *ReviewerPreview) GetCandidates() []*RankedCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *ReviewerPreview) GetExcluded() []*ExcludedCandidate {
+	if x != nil {
+		return x.Excluded
+	}
+	return nil
+}
+
+// TimeWindow - полуинтервал [from, to), пустая граница не ограничивает окно
+type TimeWindow struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	From          *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TimeWindow) Reset() {
+	*x = TimeWindow{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TimeWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TimeWindow) ProtoMessage() {}
+
+func (x *TimeWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TimeWindow.ProtoReflect.Descriptor instead.
+func (*TimeWindow) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *TimeWindow) GetFrom() *timestamppb.Timestamp {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *TimeWindow) GetTo() *timestamppb.Timestamp {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+type AssignmentStats struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username         string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	TeamName         *string                `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3,oneof" json:"team_name,omitempty"`
+	TotalAssignments int64                  `protobuf:"varint,4,opt,name=total_assignments,json=totalAssignments,proto3" json:"total_assignments,omitempty"`
+	OpenPrs          int64                  `protobuf:"varint,5,opt,name=open_prs,json=openPrs,proto3" json:"open_prs,omitempty"`
+	MergedPrs        int64                  `protobuf:"varint,6,opt,name=merged_prs,json=mergedPrs,proto3" json:"merged_prs,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AssignmentStats) Reset() {
+	*x = AssignmentStats{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentStats) ProtoMessage() {}
+
+func (x *AssignmentStats) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentStats.ProtoReflect.Descriptor instead.
+func (*AssignmentStats) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *AssignmentStats) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AssignmentStats) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AssignmentStats) GetTeamName() string {
+	if x != nil && x.TeamName != nil {
+		return *x.TeamName
+	}
+	return ""
+}
+
+func (x *AssignmentStats) GetTotalAssignments() int64 {
+	if x != nil {
+		return x.TotalAssignments
+	}
+	return 0
+}
+
+func (x *AssignmentStats) GetOpenPrs() int64 {
+	if x != nil {
+		return x.OpenPrs
+	}
+	return 0
+}
+
+func (x *AssignmentStats) GetMergedPrs() int64 {
+	if x != nil {
+		return x.MergedPrs
+	}
+	return 0
+}
+
+type GetAssignmentStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssignmentStatsRequest) Reset() {
+	*x = GetAssignmentStatsRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssignmentStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssignmentStatsRequest) ProtoMessage() {}
+
+func (x *GetAssignmentStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssignmentStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetAssignmentStatsRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{48}
+}
+
+type GetAssignmentStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statistics    []*AssignmentStats     `protobuf:"bytes,1,rep,name=statistics,proto3" json:"statistics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssignmentStatsResponse) Reset() {
+	*x = GetAssignmentStatsResponse{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssignmentStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssignmentStatsResponse) ProtoMessage() {}
+
+func (x *GetAssignmentStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssignmentStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetAssignmentStatsResponse) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetAssignmentStatsResponse) GetStatistics() []*AssignmentStats {
+	if x != nil {
+		return x.Statistics
+	}
+	return nil
+}
+
+type PullRequestStats struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status          string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	ReviewersCount  int64                  `protobuf:"varint,5,opt,name=reviewers_count,json=reviewersCount,proto3" json:"reviewers_count,omitempty"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MergedAt        *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PullRequestStats) Reset() {
+	*x = PullRequestStats{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestStats) ProtoMessage() {}
+
+func (x *PullRequestStats) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestStats.ProtoReflect.Descriptor instead.
+func (*PullRequestStats) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *PullRequestStats) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequestStats) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequestStats) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequestStats) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PullRequestStats) GetReviewersCount() int64 {
+	if x != nil {
+		return x.ReviewersCount
+	}
+	return 0
+}
+
+func (x *PullRequestStats) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PullRequestStats) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+type GetPullRequestStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        *TimeWindow            `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPullRequestStatsRequest) Reset() {
+	*x = GetPullRequestStatsRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestStatsRequest) ProtoMessage() {}
+
+func (x *GetPullRequestStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetPullRequestStatsRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetPullRequestStatsRequest) GetWindow() *TimeWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type GetPullRequestStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statistics    []*PullRequestStats    `protobuf:"bytes,1,rep,name=statistics,proto3" json:"statistics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPullRequestStatsResponse) Reset() {
+	*x = GetPullRequestStatsResponse{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestStatsResponse) ProtoMessage() {}
+
+func (x *GetPullRequestStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetPullRequestStatsResponse) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetPullRequestStatsResponse) GetStatistics() []*PullRequestStats {
+	if x != nil {
+		return x.Statistics
+	}
+	return nil
+}
+
+type TeamStats struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	TeamName          string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	TotalMembers      int64                  `protobuf:"varint,2,opt,name=total_members,json=totalMembers,proto3" json:"total_members,omitempty"`
+	ActiveMembers     int64                  `protobuf:"varint,3,opt,name=active_members,json=activeMembers,proto3" json:"active_members,omitempty"`
+	PrsAuthored       int64                  `protobuf:"varint,4,opt,name=prs_authored,json=prsAuthored,proto3" json:"prs_authored,omitempty"`
+	PrsMerged         int64                  `protobuf:"varint,5,opt,name=prs_merged,json=prsMerged,proto3" json:"prs_merged,omitempty"`
+	PrsReviewed       int64                  `protobuf:"varint,6,opt,name=prs_reviewed,json=prsReviewed,proto3" json:"prs_reviewed,omitempty"`
+	ReviewAssignments int64                  `protobuf:"varint,7,opt,name=review_assignments,json=reviewAssignments,proto3" json:"review_assignments,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *TeamStats) Reset() {
+	*x = TeamStats{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamStats) ProtoMessage() {}
+
+func (x *TeamStats) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamStats.ProtoReflect.Descriptor instead.
+func (*TeamStats) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *TeamStats) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamStats) GetTotalMembers() int64 {
+	if x != nil {
+		return x.TotalMembers
+	}
+	return 0
+}
+
+func (x *TeamStats) GetActiveMembers() int64 {
+	if x != nil {
+		return x.ActiveMembers
+	}
+	return 0
+}
+
+func (x *TeamStats) GetPrsAuthored() int64 {
+	if x != nil {
+		return x.PrsAuthored
+	}
+	return 0
+}
+
+func (x *TeamStats) GetPrsMerged() int64 {
+	if x != nil {
+		return x.PrsMerged
+	}
+	return 0
+}
+
+func (x *TeamStats) GetPrsReviewed() int64 {
+	if x != nil {
+		return x.PrsReviewed
+	}
+	return 0
+}
+
+func (x *TeamStats) GetReviewAssignments() int64 {
+	if x != nil {
+		return x.ReviewAssignments
+	}
+	return 0
+}
+
+type GetTeamStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Window        *TimeWindow            `protobuf:"bytes,1,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamStatsRequest) Reset() {
+	*x = GetTeamStatsRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamStatsRequest) ProtoMessage() {}
+
+func (x *GetTeamStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamStatsRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetTeamStatsRequest) GetWindow() *TimeWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+type GetTeamStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Statistics    []*TeamStats           `protobuf:"bytes,1,rep,name=statistics,proto3" json:"statistics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamStatsResponse) Reset() {
+	*x = GetTeamStatsResponse{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamStatsResponse) ProtoMessage() {}
+
+func (x *GetTeamStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetTeamStatsResponse) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetTeamStatsResponse) GetStatistics() []*TeamStats {
+	if x != nil {
+		return x.Statistics
+	}
+	return nil
+}
+
+type ReviewerFairness struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	UserId              string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username            string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive            bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	Assignments         int64                  `protobuf:"varint,4,opt,name=assignments,proto3" json:"assignments,omitempty"`
+	TieBreakAssignments int64                  `protobuf:"varint,5,opt,name=tie_break_assignments,json=tieBreakAssignments,proto3" json:"tie_break_assignments,omitempty"`
+	Deviation           float64                `protobuf:"fixed64,6,opt,name=deviation,proto3" json:"deviation,omitempty"`
+	RelativeDeviation   float64                `protobuf:"fixed64,7,opt,name=relative_deviation,json=relativeDeviation,proto3" json:"relative_deviation,omitempty"`
+	// over_assigned, under_assigned или balanced
+	Status        string `protobuf:"bytes,8,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewerFairness) Reset() {
+	*x = ReviewerFairness{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewerFairness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerFairness) ProtoMessage() {}
+
+func (x *ReviewerFairness) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerFairness.ProtoReflect.Descriptor instead.
+func (*ReviewerFairness) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ReviewerFairness) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReviewerFairness) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ReviewerFairness) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *ReviewerFairness) GetAssignments() int64 {
+	if x != nil {
+		return x.Assignments
+	}
+	return 0
+}
+
+func (x *ReviewerFairness) GetTieBreakAssignments() int64 {
+	if x != nil {
+		return x.TieBreakAssignments
+	}
+	return 0
+}
+
+func (x *ReviewerFairness) GetDeviation() float64 {
+	if x != nil {
+		return x.Deviation
+	}
+	return 0
+}
+
+func (x *ReviewerFairness) GetRelativeDeviation() float64 {
+	if x != nil {
+		return x.RelativeDeviation
+	}
+	return 0
+}
+
+func (x *ReviewerFairness) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type TeamFairness struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	TeamName             string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Reviewers            int32                  `protobuf:"varint,2,opt,name=reviewers,proto3" json:"reviewers,omitempty"`
+	TotalAssignments     int64                  `protobuf:"varint,3,opt,name=total_assignments,json=totalAssignments,proto3" json:"total_assignments,omitempty"`
+	Mean                 float64                `protobuf:"fixed64,4,opt,name=mean,proto3" json:"mean,omitempty"`
+	Gini                 float64                `protobuf:"fixed64,5,opt,name=gini,proto3" json:"gini,omitempty"`
+	GiniWithoutTieBreaks float64                `protobuf:"fixed64,6,opt,name=gini_without_tie_breaks,json=giniWithoutTieBreaks,proto3" json:"gini_without_tie_breaks,omitempty"`
+	MaxMinRatio          *float64               `protobuf:"fixed64,7,opt,name=max_min_ratio,json=maxMinRatio,proto3,oneof" json:"max_min_ratio,omitempty"`
+	PolicyAssignments    int64                  `protobuf:"varint,8,opt,name=policy_assignments,json=policyAssignments,proto3" json:"policy_assignments,omitempty"`
+	TieBreakAssignments  int64                  `protobuf:"varint,9,opt,name=tie_break_assignments,json=tieBreakAssignments,proto3" json:"tie_break_assignments,omitempty"`
+	TieBreakShare        float64                `protobuf:"fixed64,10,opt,name=tie_break_share,json=tieBreakShare,proto3" json:"tie_break_share,omitempty"`
+	Members              []*ReviewerFairness    `protobuf:"bytes,11,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *TeamFairness) Reset() {
+	*x = TeamFairness{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamFairness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamFairness) ProtoMessage() {}
+
+func (x *TeamFairness) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamFairness.ProtoReflect.Descriptor instead.
+func (*TeamFairness) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *TeamFairness) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *TeamFairness) GetReviewers() int32 {
+	if x != nil {
+		return x.Reviewers
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetTotalAssignments() int64 {
+	if x != nil {
+		return x.TotalAssignments
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetGini() float64 {
+	if x != nil {
+		return x.Gini
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetGiniWithoutTieBreaks() float64 {
+	if x != nil {
+		return x.GiniWithoutTieBreaks
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetMaxMinRatio() float64 {
+	if x != nil && x.MaxMinRatio != nil {
+		return *x.MaxMinRatio
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetPolicyAssignments() int64 {
+	if x != nil {
+		return x.PolicyAssignments
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetTieBreakAssignments() int64 {
+	if x != nil {
+		return x.TieBreakAssignments
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetTieBreakShare() float64 {
+	if x != nil {
+		return x.TieBreakShare
+	}
+	return 0
+}
+
+func (x *TeamFairness) GetMembers() []*ReviewerFairness {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type GetFairnessRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Пустое - все команды
+	TeamName string      `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Window   *TimeWindow `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	// По умолчанию 0.2
+	Tolerance     *float64 `protobuf:"fixed64,3,opt,name=tolerance,proto3,oneof" json:"tolerance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFairnessRequest) Reset() {
+	*x = GetFairnessRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFairnessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFairnessRequest) ProtoMessage() {}
+
+func (x *GetFairnessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFairnessRequest.ProtoReflect.Descriptor instead.
+func (*GetFairnessRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetFairnessRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *GetFairnessRequest) GetWindow() *TimeWindow {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *GetFairnessRequest) GetTolerance() float64 {
+	if x != nil && x.Tolerance != nil {
+		return *x.Tolerance
+	}
+	return 0
+}
+
+type FairnessReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tolerance     float64                `protobuf:"fixed64,1,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	Teams         []*TeamFairness        `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FairnessReport) Reset() {
+	*x = FairnessReport{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FairnessReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FairnessReport) ProtoMessage() {}
+
+func (x *FairnessReport) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FairnessReport.ProtoReflect.Descriptor instead.
+func (*FairnessReport) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *FairnessReport) GetTolerance() float64 {
+	if x != nil {
+		return x.Tolerance
+	}
+	return 0
+}
+
+func (x *FairnessReport) GetTeams() []*TeamFairness {
+	if x != nil {
+		return x.Teams
+	}
+	return nil
+}
+
+type UserWorkload struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username         string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	TeamName         *string                `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3,oneof" json:"team_name,omitempty"`
+	IsActive         bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	OpenReviewsCount int64                  `protobuf:"varint,5,opt,name=open_reviews_count,json=openReviewsCount,proto3" json:"open_reviews_count,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UserWorkload) Reset() {
+	*x = UserWorkload{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserWorkload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserWorkload) ProtoMessage() {}
+
+func (x *UserWorkload) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserWorkload.ProtoReflect.Descriptor instead.
+func (*UserWorkload) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *UserWorkload) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *UserWorkload) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserWorkload) GetTeamName() string {
+	if x != nil && x.TeamName != nil {
+		return *x.TeamName
+	}
+	return ""
+}
+
+func (x *UserWorkload) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *UserWorkload) GetOpenReviewsCount() int64 {
+	if x != nil {
+		return x.OpenReviewsCount
+	}
+	return 0
+}
+
+type GetWorkloadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkloadRequest) Reset() {
+	*x = GetWorkloadRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkloadRequest) ProtoMessage() {}
+
+func (x *GetWorkloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkloadRequest.ProtoReflect.Descriptor instead.
+func (*GetWorkloadRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{61}
+}
+
+type GetWorkloadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Workload      []*UserWorkload        `protobuf:"bytes,1,rep,name=workload,proto3" json:"workload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWorkloadResponse) Reset() {
+	*x = GetWorkloadResponse{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWorkloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWorkloadResponse) ProtoMessage() {}
+
+func (x *GetWorkloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWorkloadResponse.ProtoReflect.Descriptor instead.
+func (*GetWorkloadResponse) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *GetWorkloadResponse) GetWorkload() []*UserWorkload {
+	if x != nil {
+		return x.Workload
+	}
+	return nil
+}
+
+type StreamEventsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Фильтры, пустое поле не ограничивает поток
+	UserId        string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	TeamName      string `protobuf:"bytes,2,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	PullRequestId string `protobuf:"bytes,3,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	// Аналог заголовка Last-Event-ID
+	LastEventId   *int64 `protobuf:"varint,4,opt,name=last_event_id,json=lastEventId,proto3,oneof" json:"last_event_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEventsRequest) Reset() {
+	*x = StreamEventsRequest{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsRequest) ProtoMessage() {}
+
+func (x *StreamEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *StreamEventsRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *StreamEventsRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *StreamEventsRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *StreamEventsRequest) GetLastEventId() int64 {
+	if x != nil && x.LastEventId != nil {
+		return *x.LastEventId
+	}
+	return 0
+}
+
+type AssignmentEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// reviewer.assigned, reviewer.replaced, pr.merged или user.deactivated
+	Type           string                 `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	PullRequestId  *string                `protobuf:"bytes,3,opt,name=pull_request_id,json=pullRequestId,proto3,oneof" json:"pull_request_id,omitempty"`
+	AuthorId       *string                `protobuf:"bytes,4,opt,name=author_id,json=authorId,proto3,oneof" json:"author_id,omitempty"`
+	UserId         *string                `protobuf:"bytes,5,opt,name=user_id,json=userId,proto3,oneof" json:"user_id,omitempty"`
+	PreviousUserId *string                `protobuf:"bytes,6,opt,name=previous_user_id,json=previousUserId,proto3,oneof" json:"previous_user_id,omitempty"`
+	TeamName       *string                `protobuf:"bytes,7,opt,name=team_name,json=teamName,proto3,oneof" json:"team_name,omitempty"`
+	Details        *structpb.Struct       `protobuf:"bytes,8,opt,name=details,proto3" json:"details,omitempty"`
+	CreatedAt      *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AssignmentEvent) Reset() {
+	*x = AssignmentEvent{}
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentEvent) ProtoMessage() {}
+
+func (x *AssignmentEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_prassignment_v1_prassignment_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentEvent.ProtoReflect.Descriptor instead.
+func (*AssignmentEvent) Descriptor() ([]byte, []int) {
+	return file_prassignment_v1_prassignment_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *AssignmentEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AssignmentEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *AssignmentEvent) GetPullRequestId() string {
+	if x != nil && x.PullRequestId != nil {
+		return *x.PullRequestId
+	}
+	return ""
+}
+
+func (x *AssignmentEvent) GetAuthorId() string {
+	if x != nil && x.AuthorId != nil {
+		return *x.AuthorId
+	}
+	return ""
+}
+
+func (x *AssignmentEvent) GetUserId() string {
+	if x != nil && x.UserId != nil {
+		return *x.UserId
+	}
+	return ""
+}
+
+func (x *AssignmentEvent) GetPreviousUserId() string {
+	if x != nil && x.PreviousUserId != nil {
+		return *x.PreviousUserId
+	}
+	return ""
+}
+
+func (x *AssignmentEvent) GetTeamName() string {
+	if x != nil && x.TeamName != nil {
+		return *x.TeamName
+	}
+	return ""
+}
+
+func (x *AssignmentEvent) GetDetails() *structpb.Struct {
+	if x != nil {
+		return x.Details
+	}
+	return nil
+}
+
+func (x *AssignmentEvent) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+var File_prassignment_v1_prassignment_proto protoreflect.FileDescriptor
+
+const file_prassignment_v1_prassignment_proto_rawDesc = "" +
+	"\n" +
+	"\"prassignment/v1/prassignment.proto\x12\x0fprassignment.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x8f\x01\n" +
+	"\n" +
+	"TeamMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12 \n" +
+	"\tis_active\x18\x03 \x01(\bH\x00R\bisActive\x88\x01\x01\x12\x1c\n" +
+	"\tseniority\x18\x04 \x01(\tR\tseniorityB\f\n" +
+	"\n" +
+	"_is_active\"\x89\x01\n" +
+	"\x04Team\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12-\n" +
+	"\x12mentorship_enabled\x18\x02 \x01(\bR\x11mentorshipEnabled\x125\n" +
+	"\amembers\x18\x03 \x03(\v2\x1b.prassignment.v1.TeamMemberR\amembers\"\x93\x01\n" +
+	"\x04User\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\x12\x1c\n" +
+	"\tseniority\x18\x05 \x01(\tR\tseniority\"d\n" +
+	"\x0eAddTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x125\n" +
+	"\amembers\x18\x02 \x03(\v2\x1b.prassignment.v1.TeamMemberR\amembers\".\n" +
+	"\x0fAddTeamResponse\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"-\n" +
+	"\x0eGetTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"4\n" +
+	"\x15DeactivateTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"\x8d\x01\n" +
+	"\x16DeactivateTeamResponse\x12+\n" +
+	"\x11deactivated_users\x18\x01 \x01(\x03R\x10deactivatedUsers\x12%\n" +
+	"\x0ereassigned_prs\x18\x02 \x01(\x03R\rreassignedPrs\x12\x1f\n" +
+	"\vduration_ms\x18\x03 \x01(\x03R\n" +
+	"durationMs\"M\n" +
+	"\x14SetMentorshipRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x18\n" +
+	"\aenabled\x18\x02 \x01(\bR\aenabled\"c\n" +
+	"\x15SetMentorshipResponse\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12-\n" +
+	"\x12mentorship_enabled\x18\x02 \x01(\bR\x11mentorshipEnabled\"J\n" +
+	"\x12SetIsActiveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\"+\n" +
+	"\x10GetReviewRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\x9b\x01\n" +
+	"\x10PullRequestShort\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"t\n" +
+	"\x11GetReviewResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12F\n" +
+	"\rpull_requests\x18\x02 \x03(\v2!.prassignment.v1.PullRequestShortR\fpullRequests\"L\n" +
+	"\x13SetSeniorityRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1c\n" +
+	"\tseniority\x18\x02 \x01(\tR\tseniority\"3\n" +
+	"\tUserSkill\x12\x10\n" +
+	"\x03tag\x18\x01 \x01(\tR\x03tag\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\tR\x05level\"Y\n" +
+	"\n" +
+	"UserSkills\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x122\n" +
+	"\x06skills\x18\x02 \x03(\v2\x1a.prassignment.v1.UserSkillR\x06skills\"+\n" +
+	"\x10GetSkillsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"_\n" +
+	"\x10SetSkillsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x122\n" +
+	"\x06skills\x18\x02 \x03(\v2\x1a.prassignment.v1.UserSkillR\x06skills\"\xbf\x01\n" +
+	"\x0eUnavailability\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x127\n" +
+	"\tstarts_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xb9\x01\n" +
+	"\x18AddUnavailabilityRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x127\n" +
+	"\tstarts_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\bstartsAt\x123\n" +
+	"\aends_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x06endsAt\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"-\n" +
+	"\x1bDeleteUnavailabilityRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\".\n" +
+	"\x13GetDashboardRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"\xee\x02\n" +
+	"\rPendingReview\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x129\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x1f\n" +
+	"\vage_seconds\x18\x05 \x01(\x03R\n" +
+	"ageSeconds\x121\n" +
+	"\x06due_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x18\n" +
+	"\aoverdue\x18\a \x01(\bR\aoverdue\x12C\n" +
+	"\n" +
+	"assignment\x18\b \x01(\v2#.prassignment.v1.ReviewerAssignmentR\n" +
+	"assignment\"\x91\x02\n" +
+	"\x1bAuthoredPullRequestReviewer\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\x12\x16\n" +
+	"\x06source\x18\x04 \x01(\tR\x06source\x12;\n" +
+	"\vassigned_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"assignedAt\x121\n" +
+	"\x06due_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\x05dueAt\x12\x18\n" +
+	"\aoverdue\x18\a \x01(\bR\aoverdue\"\xf0\x01\n" +
+	"\x13AuthoredPullRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x129\n" +
+	"\n" +
+	"created_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12J\n" +
+	"\treviewers\x18\x04 \x03(\v2,.prassignment.v1.AuthoredPullRequestReviewerR\treviewers\"\xd1\x01\n" +
+	"\x0eReviewCapacity\x12!\n" +
+	"\fopen_reviews\x18\x01 \x01(\x03R\vopenReviews\x12(\n" +
+	"\x10max_open_reviews\x18\x02 \x01(\x05R\x0emaxOpenReviews\x12!\n" +
+	"\tremaining\x18\x03 \x01(\x03H\x00R\tremaining\x88\x01\x01\x12\x1f\n" +
+	"\vat_capacity\x18\x04 \x01(\bR\n" +
+	"atCapacity\x12 \n" +
+	"\vunavailable\x18\x05 \x01(\bR\vunavailableB\f\n" +
+	"\n" +
+	"_remaining\"\xc1\x01\n" +
+	"\x11RecentReviewStats\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\x12)\n" +
+	"\x10reviews_assigned\x18\x03 \x01(\x03R\x0freviewsAssigned\x12%\n" +
+	"\x0ereviews_merged\x18\x04 \x01(\x03R\rreviewsMerged\"\xac\x03\n" +
+	"\rUserDashboard\x12)\n" +
+	"\x04user\x18\x01 \x01(\v2\x15.prassignment.v1.UserR\x04user\x12G\n" +
+	"\x0fpending_reviews\x18\x02 \x03(\v2\x1e.prassignment.v1.PendingReviewR\x0ependingReviews\x12Z\n" +
+	"\x16authored_pull_requests\x18\x03 \x03(\v2$.prassignment.v1.AuthoredPullRequestR\x14authoredPullRequests\x12;\n" +
+	"\bcapacity\x18\x04 \x01(\v2\x1f.prassignment.v1.ReviewCapacityR\bcapacity\x12G\n" +
+	"\x0eunavailability\x18\x05 \x03(\v2\x1f.prassignment.v1.UnavailabilityR\x0eunavailability\x12E\n" +
+	"\frecent_stats\x18\x06 \x01(\v2\".prassignment.v1.RecentReviewStatsR\vrecentStats\"\xe4\x02\n" +
+	"\x12ReviewerAssignment\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1f\n" +
+	"\busername\x18\x02 \x01(\tH\x00R\busername\x88\x01\x01\x12\x16\n" +
+	"\x06source\x18\x03 \x01(\tR\x06source\x12\x1f\n" +
+	"\bstrategy\x18\x04 \x01(\tH\x01R\bstrategy\x88\x01\x01\x129\n" +
+	"\x16workload_at_assignment\x18\x05 \x01(\x03H\x02R\x14workloadAtAssignment\x88\x01\x01\x12 \n" +
+	"\ttie_break\x18\x06 \x01(\bH\x03R\btieBreak\x88\x01\x01\x12;\n" +
+	"\vassigned_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"assignedAtB\v\n" +
+	"\t_usernameB\v\n" +
+	"\t_strategyB\x19\n" +
+	"\x17_workload_at_assignmentB\f\n" +
+	"\n" +
+	"_tie_break\"\xb6\x03\n" +
+	"\vPullRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12-\n" +
+	"\x12assigned_reviewers\x18\x05 \x03(\tR\x11assignedReviewers\x12V\n" +
+	"\x14reviewer_assignments\x18\x06 \x03(\v2#.prassignment.v1.ReviewerAssignmentR\x13reviewerAssignments\x12#\n" +
+	"\rrequired_tags\x18\a \x03(\tR\frequiredTags\x129\n" +
+	"\n" +
+	"created_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tmerged_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\bmergedAt\"\xb7\x02\n" +
+	"\x18CreatePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12%\n" +
+	"\x0ereviewer_count\x18\x04 \x01(\x05R\rreviewerCount\x12/\n" +
+	"\x13preferred_reviewers\x18\x05 \x03(\tR\x12preferredReviewers\x12-\n" +
+	"\x12excluded_reviewers\x18\x06 \x03(\tR\x11excludedReviewers\x12#\n" +
+	"\rrequired_tags\x18\a \x03(\tR\frequiredTags\"A\n" +
+	"\x17MergePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"@\n" +
+	"\x16GetRequiredTagsRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"e\n" +
+	"\x16SetRequiredTagsRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12#\n" +
+	"\rrequired_tags\x18\x02 \x03(\tR\frequiredTags\"^\n" +
+	"\x0fPullRequestTags\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12#\n" +
+	"\rrequired_tags\x18\x02 \x03(\tR\frequiredTags\"\xc6\x01\n" +
+	"\x17ReassignReviewerRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1e\n" +
+	"\vold_user_id\x18\x02 \x01(\tR\toldUserId\x12\x1e\n" +
+	"\vnew_user_id\x18\x03 \x01(\tR\tnewUserId\x12\x1a\n" +
+	"\boverride\x18\x04 \x01(\bR\boverride\x12'\n" +
+	"\x0foverride_reason\x18\x05 \x01(\tR\x0eoverrideReason\"\x1a\n" +
+	"\x18ReassignReviewerResponse\"\x9d\x01\n" +
+	"\x15AssignReviewerRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\boverride\x18\x03 \x01(\bR\boverride\x12'\n" +
+	"\x0foverride_reason\x18\x04 \x01(\tR\x0eoverrideReason\">\n" +
+	"\x14ListReviewersRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"\x82\x01\n" +
+	"\x15ListReviewersResponse\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12A\n" +
+	"\treviewers\x18\x02 \x03(\v2#.prassignment.v1.ReviewerAssignmentR\treviewers\"\xd9\x01\n" +
+	"\x17PreviewReviewersRequest\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\tR\bauthorId\x12&\n" +
+	"\x0fpull_request_id\x18\x02 \x01(\tR\rpullRequestId\x12%\n" +
+	"\x0ereviewer_count\x18\x03 \x01(\x05R\rreviewerCount\x12-\n" +
+	"\x12excluded_reviewers\x18\x04 \x03(\tR\x11excludedReviewers\x12#\n" +
+	"\rrequired_tags\x18\x05 \x03(\tR\frequiredTags\":\n" +
+	"\x0eScoreComponent\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\x01R\x05value\"\xe9\x01\n" +
+	"\x0fRankedCandidate\x12\x12\n" +
+	"\x04rank\x18\x01 \x01(\x05R\x04rank\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
+	"\bworkload\x18\x04 \x01(\x03R\bworkload\x12\x1c\n" +
+	"\tseniority\x18\x05 \x01(\tR\tseniority\x12\x14\n" +
+	"\x05score\x18\x06 \x01(\x01R\x05score\x12=\n" +
+	"\tbreakdown\x18\a \x03(\v2\x1f.prassignment.v1.ScoreComponentR\tbreakdown\"|\n" +
+	"\x11ExcludedCandidate\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bworkload\x18\x03 \x01(\x03R\bworkload\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x98\x03\n" +
+	"\x0fReviewerPreview\x12\x1b\n" +
+	"\tauthor_id\x18\x01 \x01(\tR\bauthorId\x12\x1b\n" +
+	"\tteam_name\x18\x02 \x01(\tR\bteamName\x12\x1a\n" +
+	"\bstrategy\x18\x03 \x01(\tR\bstrategy\x12%\n" +
+	"\x0epolicy_version\x18\x04 \x01(\x03R\rpolicyVersion\x12%\n" +
+	"\x0ereviewer_count\x18\x05 \x01(\x05R\rreviewerCount\x12#\n" +
+	"\rrequired_tags\x18\x06 \x03(\tR\frequiredTags\x12\x1e\n" +
+	"\n" +
+	"mentorship\x18\a \x01(\bR\n" +
+	"mentorship\x12\x1a\n" +
+	"\bselected\x18\b \x03(\tR\bselected\x12@\n" +
+	"\n" +
+	"candidates\x18\t \x03(\v2 .prassignment.v1.RankedCandidateR\n" +
+	"candidates\x12>\n" +
+	"\bexcluded\x18\n" +
+	" \x03(\v2\".prassignment.v1.ExcludedCandidateR\bexcluded\"h\n" +
+	"\n" +
+	"TimeWindow\x12.\n" +
+	"\x04from\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\x04from\x12*\n" +
+	"\x02to\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x02to\"\xdd\x01\n" +
+	"\x0fAssignmentStats\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12 \n" +
+	"\tteam_name\x18\x03 \x01(\tH\x00R\bteamName\x88\x01\x01\x12+\n" +
+	"\x11total_assignments\x18\x04 \x01(\x03R\x10totalAssignments\x12\x19\n" +
+	"\bopen_prs\x18\x05 \x01(\x03R\aopenPrs\x12\x1d\n" +
+	"\n" +
+	"merged_prs\x18\x06 \x01(\x03R\tmergedPrsB\f\n" +
+	"\n" +
+	"_team_name\"\x1b\n" +
+	"\x19GetAssignmentStatsRequest\"^\n" +
+	"\x1aGetAssignmentStatsResponse\x12@\n" +
+	"\n" +
+	"statistics\x18\x01 \x03(\v2 .prassignment.v1.AssignmentStatsR\n" +
+	"statistics\"\xb8\x02\n" +
+	"\x10PullRequestStats\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12'\n" +
+	"\x0freviewers_count\x18\x05 \x01(\x03R\x0ereviewersCount\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tmerged_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bmergedAt\"Q\n" +
+	"\x1aGetPullRequestStatsRequest\x123\n" +
+	"\x06window\x18\x01 \x01(\v2\x1b.prassignment.v1.TimeWindowR\x06window\"`\n" +
+	"\x1bGetPullRequestStatsResponse\x12A\n" +
+	"\n" +
+	"statistics\x18\x01 \x03(\v2!.prassignment.v1.PullRequestStatsR\n" +
+	"statistics\"\x88\x02\n" +
+	"\tTeamStats\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12#\n" +
+	"\rtotal_members\x18\x02 \x01(\x03R\ftotalMembers\x12%\n" +
+	"\x0eactive_members\x18\x03 \x01(\x03R\ractiveMembers\x12!\n" +
+	"\fprs_authored\x18\x04 \x01(\x03R\vprsAuthored\x12\x1d\n" +
+	"\n" +
+	"prs_merged\x18\x05 \x01(\x03R\tprsMerged\x12!\n" +
+	"\fprs_reviewed\x18\x06 \x01(\x03R\vprsReviewed\x12-\n" +
+	"\x12review_assignments\x18\a \x01(\x03R\x11reviewAssignments\"J\n" +
+	"\x13GetTeamStatsRequest\x123\n" +
+	"\x06window\x18\x01 \x01(\v2\x1b.prassignment.v1.TimeWindowR\x06window\"R\n" +
+	"\x14GetTeamStatsResponse\x12:\n" +
+	"\n" +
+	"statistics\x18\x01 \x03(\v2\x1a.prassignment.v1.TeamStatsR\n" +
+	"statistics\"\x9f\x02\n" +
+	"\x10ReviewerFairness\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\x12 \n" +
+	"\vassignments\x18\x04 \x01(\x03R\vassignments\x122\n" +
+	"\x15tie_break_assignments\x18\x05 \x01(\x03R\x13tieBreakAssignments\x12\x1c\n" +
+	"\tdeviation\x18\x06 \x01(\x01R\tdeviation\x12-\n" +
+	"\x12relative_deviation\x18\a \x01(\x01R\x11relativeDeviation\x12\x16\n" +
+	"\x06status\x18\b \x01(\tR\x06status\"\xd8\x03\n" +
+	"\fTeamFairness\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x1c\n" +
+	"\treviewers\x18\x02 \x01(\x05R\treviewers\x12+\n" +
+	"\x11total_assignments\x18\x03 \x01(\x03R\x10totalAssignments\x12\x12\n" +
+	"\x04mean\x18\x04 \x01(\x01R\x04mean\x12\x12\n" +
+	"\x04gini\x18\x05 \x01(\x01R\x04gini\x125\n" +
+	"\x17gini_without_tie_breaks\x18\x06 \x01(\x01R\x14giniWithoutTieBreaks\x12'\n" +
+	"\rmax_min_ratio\x18\a \x01(\x01H\x00R\vmaxMinRatio\x88\x01\x01\x12-\n" +
+	"\x12policy_assignments\x18\b \x01(\x03R\x11policyAssignments\x122\n" +
+	"\x15tie_break_assignments\x18\t \x01(\x03R\x13tieBreakAssignments\x12&\n" +
+	"\x0ftie_break_share\x18\n" +
+	" \x01(\x01R\rtieBreakShare\x12;\n" +
+	"\amembers\x18\v \x03(\v2!.prassignment.v1.ReviewerFairnessR\amembersB\x10\n" +
+	"\x0e_max_min_ratio\"\x97\x01\n" +
+	"\x12GetFairnessRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x123\n" +
+	"\x06window\x18\x02 \x01(\v2\x1b.prassignment.v1.TimeWindowR\x06window\x12!\n" +
+	"\ttolerance\x18\x03 \x01(\x01H\x00R\ttolerance\x88\x01\x01B\f\n" +
+	"\n" +
+	"_tolerance\"c\n" +
+	"\x0eFairnessReport\x12\x1c\n" +
+	"\ttolerance\x18\x01 \x01(\x01R\ttolerance\x123\n" +
+	"\x05teams\x18\x02 \x03(\v2\x1d.prassignment.v1.TeamFairnessR\x05teams\"\xbe\x01\n" +
+	"\fUserWorkload\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12 \n" +
+	"\tteam_name\x18\x03 \x01(\tH\x00R\bteamName\x88\x01\x01\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\x12,\n" +
+	"\x12open_reviews_count\x18\x05 \x01(\x03R\x10openReviewsCountB\f\n" +
+	"\n" +
+	"_team_name\"\x14\n" +
+	"\x12GetWorkloadRequest\"P\n" +
+	"\x13GetWorkloadResponse\x129\n" +
+	"\bworkload\x18\x01 \x03(\v2\x1d.prassignment.v1.UserWorkloadR\bworkload\"\xae\x01\n" +
+	"\x13StreamEventsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tteam_name\x18\x02 \x01(\tR\bteamName\x12&\n" +
+	"\x0fpull_request_id\x18\x03 \x01(\tR\rpullRequestId\x12'\n" +
+	"\rlast_event_id\x18\x04 \x01(\x03H\x00R\vlastEventId\x88\x01\x01B\x10\n" +
+	"\x0e_last_event_id\"\xb2\x03\n" +
+	"\x0fAssignmentEvent\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04type\x18\x02 \x01(\tR\x04type\x12+\n" +
+	"\x0fpull_request_id\x18\x03 \x01(\tH\x00R\rpullRequestId\x88\x01\x01\x12 \n" +
+	"\tauthor_id\x18\x04 \x01(\tH\x01R\bauthorId\x88\x01\x01\x12\x1c\n" +
+	"\auser_id\x18\x05 \x01(\tH\x02R\x06userId\x88\x01\x01\x12-\n" +
+	"\x10previous_user_id\x18\x06 \x01(\tH\x03R\x0epreviousUserId\x88\x01\x01\x12 \n" +
+	"\tteam_name\x18\a \x01(\tH\x04R\bteamName\x88\x01\x01\x121\n" +
+	"\adetails\x18\b \x01(\v2\x17.google.protobuf.StructR\adetails\x129\n" +
+	"\n" +
+	"created_at\x18\t \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAtB\x12\n" +
+	"\x10_pull_request_idB\f\n" +
+	"\n" +
+	"_author_idB\n" +
+	"\n" +
+	"\b_user_idB\x13\n" +
+	"\x11_previous_user_idB\f\n" +
+	"\n" +
+	"_team_name2\xe1\x02\n" +
+	"\vTeamService\x12L\n" +
+	"\aAddTeam\x12\x1f.prassignment.v1.AddTeamRequest\x1a .prassignment.v1.AddTeamResponse\x12A\n" +
+	"\aGetTeam\x12\x1f.prassignment.v1.GetTeamRequest\x1a\x15.prassignment.v1.Team\x12a\n" +
+	"\x0eDeactivateTeam\x12&.prassignment.v1.DeactivateTeamRequest\x1a'.prassignment.v1.DeactivateTeamResponse\x12^\n" +
+	"\rSetMentorship\x12%.prassignment.v1.SetMentorshipRequest\x1a&.prassignment.v1.SetMentorshipResponse2\xb1\x05\n" +
+	"\vUserService\x12I\n" +
+	"\vSetIsActive\x12#.prassignment.v1.SetIsActiveRequest\x1a\x15.prassignment.v1.User\x12R\n" +
+	"\tGetReview\x12!.prassignment.v1.GetReviewRequest\x1a\".prassignment.v1.GetReviewResponse\x12K\n" +
+	"\fSetSeniority\x12$.prassignment.v1.SetSeniorityRequest\x1a\x15.prassignment.v1.User\x12K\n" +
+	"\tGetSkills\x12!.prassignment.v1.GetSkillsRequest\x1a\x1b.prassignment.v1.UserSkills\x12K\n" +
+	"\tSetSkills\x12!.prassignment.v1.SetSkillsRequest\x1a\x1b.prassignment.v1.UserSkills\x12T\n" +
+	"\fGetDashboard\x12$.prassignment.v1.GetDashboardRequest\x1a\x1e.prassignment.v1.UserDashboard\x12_\n" +
+	"\x11AddUnavailability\x12).prassignment.v1.AddUnavailabilityRequest\x1a\x1f.prassignment.v1.Unavailability\x12e\n" +
+	"\x14DeleteUnavailability\x12,.prassignment.v1.DeleteUnavailabilityRequest\x1a\x1f.prassignment.v1.Unavailability2\x8a\x03\n" +
+	"\x12PullRequestService\x12\\\n" +
+	"\x11CreatePullRequest\x12).prassignment.v1.CreatePullRequestRequest\x1a\x1c.prassignment.v1.PullRequest\x12Z\n" +
+	"\x10MergePullRequest\x12(.prassignment.v1.MergePullRequestRequest\x1a\x1c.prassignment.v1.PullRequest\x12\\\n" +
+	"\x0fGetRequiredTags\x12'.prassignment.v1.GetRequiredTagsRequest\x1a .prassignment.v1.PullRequestTags\x12\\\n" +
+	"\x0fSetRequiredTags\x12'.prassignment.v1.SetRequiredTagsRequest\x1a .prassignment.v1.PullRequestTags2\x99\x03\n" +
+	"\x0fReviewerService\x12g\n" +
+	"\x10ReassignReviewer\x12(.prassignment.v1.ReassignReviewerRequest\x1a).prassignment.v1.ReassignReviewerResponse\x12]\n" +
+	"\x0eAssignReviewer\x12&.prassignment.v1.AssignReviewerRequest\x1a#.prassignment.v1.ReviewerAssignment\x12^\n" +
+	"\rListReviewers\x12%.prassignment.v1.ListReviewersRequest\x1a&.prassignment.v1.ListReviewersResponse\x12^\n" +
+	"\x10PreviewReviewers\x12(.prassignment.v1.PreviewReviewersRequest\x1a .prassignment.v1.ReviewerPreview2\x80\x04\n" +
+	"\x11StatisticsService\x12m\n" +
+	"\x12GetAssignmentStats\x12*.prassignment.v1.GetAssignmentStatsRequest\x1a+.prassignment.v1.GetAssignmentStatsResponse\x12p\n" +
+	"\x13GetPullRequestStats\x12+.prassignment.v1.GetPullRequestStatsRequest\x1a,.prassignment.v1.GetPullRequestStatsResponse\x12[\n" +
+	"\fGetTeamStats\x12$.prassignment.v1.GetTeamStatsRequest\x1a%.prassignment.v1.GetTeamStatsResponse\x12S\n" +
+	"\vGetFairness\x12#.prassignment.v1.GetFairnessRequest\x1a\x1f.prassignment.v1.FairnessReport\x12X\n" +
+	"\vGetWorkload\x12#.prassignment.v1.GetWorkloadRequest\x1a$.prassignment.v1.GetWorkloadResponse2h\n" +
+	"\fEventService\x12X\n" +
+	"\fStreamEvents\x12$.prassignment.v1.StreamEventsRequest\x1a .prassignment.v1.AssignmentEvent0\x01B]Z[github.com/AtoyanMikhail/PRAssignmentService/internal/grpcapi/prassignmentv1;prassignmentv1b\x06proto3"
+
+var (
+	file_prassignment_v1_prassignment_proto_rawDescOnce sync.Once
+	file_prassignment_v1_prassignment_proto_rawDescData []byte
+)
+
+func file_prassignment_v1_prassignment_proto_rawDescGZIP() []byte {
+	file_prassignment_v1_prassignment_proto_rawDescOnce.Do(func() {
+		file_prassignment_v1_prassignment_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_prassignment_v1_prassignment_proto_rawDesc), len(file_prassignment_v1_prassignment_proto_rawDesc)))
+	})
+	return file_prassignment_v1_prassignment_proto_rawDescData
+}
+
+var file_prassignment_v1_prassignment_proto_msgTypes = make([]protoimpl.MessageInfo, 65)
+var file_prassignment_v1_prassignment_proto_goTypes = []any{
+	(*TeamMember)(nil),                  // 0: prassignment.v1.TeamMember
+	(*Team)(nil),                        // 1: prassignment.v1.Team
+	(*User)(nil),                        // 2: prassignment.v1.User
+	(*AddTeamRequest)(nil),              // 3: prassignment.v1.AddTeamRequest
+	(*AddTeamResponse)(nil),             // 4: prassignment.v1.AddTeamResponse
+	(*GetTeamRequest)(nil),              // 5: prassignment.v1.GetTeamRequest
+	(*DeactivateTeamRequest)(nil),       // 6: prassignment.v1.DeactivateTeamRequest
+	(*DeactivateTeamResponse)(nil),      // 7: prassignment.v1.DeactivateTeamResponse
+	(*SetMentorshipRequest)(nil),        // 8: prassignment.v1.SetMentorshipRequest
+	(*SetMentorshipResponse)(nil),       // 9: prassignment.v1.SetMentorshipResponse
+	(*SetIsActiveRequest)(nil),          // 10: prassignment.v1.SetIsActiveRequest
+	(*GetReviewRequest)(nil),            // 11: prassignment.v1.GetReviewRequest
+	(*PullRequestShort)(nil),            // 12: prassignment.v1.PullRequestShort
+	(*GetReviewResponse)(nil),           // 13: prassignment.v1.GetReviewResponse
+	(*SetSeniorityRequest)(nil),         // 14: prassignment.v1.SetSeniorityRequest
+	(*UserSkill)(nil),                   // 15: prassignment.v1.UserSkill
+	(*UserSkills)(nil),                  // 16: prassignment.v1.UserSkills
+	(*GetSkillsRequest)(nil),            // 17: prassignment.v1.GetSkillsRequest
+	(*SetSkillsRequest)(nil),            // 18: prassignment.v1.SetSkillsRequest
+	(*Unavailability)(nil),              // 19: prassignment.v1.Unavailability
+	(*AddUnavailabilityRequest)(nil),    // 20: prassignment.v1.AddUnavailabilityRequest
+	(*DeleteUnavailabilityRequest)(nil), // 21: prassignment.v1.DeleteUnavailabilityRequest
+	(*GetDashboardRequest)(nil),         // 22: prassignment.v1.GetDashboardRequest
+	(*PendingReview)(nil),               // 23: prassignment.v1.PendingReview
+	(*AuthoredPullRequestReviewer)(nil), // 24: prassignment.v1.AuthoredPullRequestReviewer
+	(*AuthoredPullRequest)(nil),         // 25: prassignment.v1.AuthoredPullRequest
+	(*ReviewCapacity)(nil),              // 26: prassignment.v1.ReviewCapacity
+	(*RecentReviewStats)(nil),           // 27: prassignment.v1.RecentReviewStats
+	(*UserDashboard)(nil),               // 28: prassignment.v1.UserDashboard
+	(*ReviewerAssignment)(nil),          // 29: prassignment.v1.ReviewerAssignment
+	(*PullRequest)(nil),                 // 30: prassignment.v1.PullRequest
+	(*CreatePullRequestRequest)(nil),    // 31: prassignment.v1.CreatePullRequestRequest
+	(*MergePullRequestRequest)(nil),     // 32: prassignment.v1.MergePullRequestRequest
+	(*GetRequiredTagsRequest)(nil),      // 33: prassignment.v1.GetRequiredTagsRequest
+	(*SetRequiredTagsRequest)(nil),      // 34: prassignment.v1.SetRequiredTagsRequest
+	(*PullRequestTags)(nil),             // 35: prassignment.v1.PullRequestTags
+	(*ReassignReviewerRequest)(nil),     // 36: prassignment.v1.ReassignReviewerRequest
+	(*ReassignReviewerResponse)(nil),    // 37: prassignment.v1.ReassignReviewerResponse
+	(*AssignReviewerRequest)(nil),       // 38: prassignment.v1.AssignReviewerRequest
+	(*ListReviewersRequest)(nil),        // 39: prassignment.v1.ListReviewersRequest
+	(*ListReviewersResponse)(nil),       // 40: prassignment.v1.ListReviewersResponse
+	(*PreviewReviewersRequest)(nil),     // 41: prassignment.v1.PreviewReviewersRequest
+	(*ScoreComponent)(nil),              // 42: prassignment.v1.ScoreComponent
+	(*RankedCandidate)(nil),             // 43: prassignment.v1.RankedCandidate
+	(*ExcludedCandidate)(nil),           // 44: prassignment.v1.ExcludedCandidate
+	(*ReviewerPreview)(nil),             // 45: prassignment.v1.ReviewerPreview
+	(*TimeWindow)(nil),                  // 46: prassignment.v1.TimeWindow
+	(*AssignmentStats)(nil),             // 47: prassignment.v1.AssignmentStats
+	(*GetAssignmentStatsRequest)(nil),   // 48: prassignment.v1.GetAssignmentStatsRequest
+	(*GetAssignmentStatsResponse)(nil),  // 49: prassignment.v1.GetAssignmentStatsResponse
+	(*PullRequestStats)(nil),            // 50: prassignment.v1.PullRequestStats
+	(*GetPullRequestStatsRequest)(nil),  // 51: prassignment.v1.GetPullRequestStatsRequest
+	(*GetPullRequestStatsResponse)(nil), // 52: prassignment.v1.GetPullRequestStatsResponse
+	(*TeamStats)(nil),                   // 53: prassignment.v1.TeamStats
+	(*GetTeamStatsRequest)(nil),         // 54: prassignment.v1.GetTeamStatsRequest
+	(*GetTeamStatsResponse)(nil),        // 55: prassignment.v1.GetTeamStatsResponse
+	(*ReviewerFairness)(nil),            // 56: prassignment.v1.ReviewerFairness
+	(*TeamFairness)(nil),                // 57: prassignment.v1.TeamFairness
+	(*GetFairnessRequest)(nil),          // 58: prassignment.v1.GetFairnessRequest
+	(*FairnessReport)(nil),              // 59: prassignment.v1.FairnessReport
+	(*UserWorkload)(nil),                // 60: prassignment.v1.UserWorkload
+	(*GetWorkloadRequest)(nil),          // 61: prassignment.v1.GetWorkloadRequest
+	(*GetWorkloadResponse)(nil),         // 62: prassignment.v1.GetWorkloadResponse
+	(*StreamEventsRequest)(nil),         // 63: prassignment.v1.StreamEventsRequest
+	(*AssignmentEvent)(nil),             // 64: prassignment.v1.AssignmentEvent
+	(*timestamppb.Timestamp)(nil),       // 65: google.protobuf.Timestamp
+	(*structpb.Struct)(nil),             // 66: google.protobuf.Struct
+}
+var file_prassignment_v1_prassignment_proto_depIdxs = []int32{
+	0,  // 0: prassignment.v1.Team.members:type_name -> prassignment.v1.TeamMember
+	0,  // 1: prassignment.v1.AddTeamRequest.members:type_name -> prassignment.v1.TeamMember
+	12, // 2: prassignment.v1.GetReviewResponse.pull_requests:type_name -> prassignment.v1.PullRequestShort
+	15, // 3: prassignment.v1.UserSkills.skills:type_name -> prassignment.v1.UserSkill
+	15, // 4: prassignment.v1.SetSkillsRequest.skills:type_name -> prassignment.v1.UserSkill
+	65, // 5: prassignment.v1.Unavailability.starts_at:type_name -> google.protobuf.Timestamp
+	65, // 6: prassignment.v1.Unavailability.ends_at:type_name -> google.protobuf.Timestamp
+	65, // 7: prassignment.v1.AddUnavailabilityRequest.starts_at:type_name -> google.protobuf.Timestamp
+	65, // 8: prassignment.v1.AddUnavailabilityRequest.ends_at:type_name -> google.protobuf.Timestamp
+	65, // 9: prassignment.v1.PendingReview.created_at:type_name -> google.protobuf.Timestamp
+	65, // 10: prassignment.v1.PendingReview.due_at:type_name -> google.protobuf.Timestamp
+	29, // 11: prassignment.v1.PendingReview.assignment:type_name -> prassignment.v1.ReviewerAssignment
+	65, // 12: prassignment.v1.AuthoredPullRequestReviewer.assigned_at:type_name -> google.protobuf.Timestamp
+	65, // 13: prassignment.v1.AuthoredPullRequestReviewer.due_at:type_name -> google.protobuf.Timestamp
+	65, // 14: prassignment.v1.AuthoredPullRequest.created_at:type_name -> google.protobuf.Timestamp
+	24, // 15: prassignment.v1.AuthoredPullRequest.reviewers:type_name -> prassignment.v1.AuthoredPullRequestReviewer
+	65, // 16: prassignment.v1.RecentReviewStats.from:type_name -> google.protobuf.Timestamp
+	65, // 17: prassignment.v1.RecentReviewStats.to:type_name -> google.protobuf.Timestamp
+	2,  // 18: prassignment.v1.UserDashboard.user:type_name -> prassignment.v1.User
+	23, // 19: prassignment.v1.UserDashboard.pending_reviews:type_name -> prassignment.v1.PendingReview
+	25, // 20: prassignment.v1.UserDashboard.authored_pull_requests:type_name -> prassignment.v1.AuthoredPullRequest
+	26, // 21: prassignment.v1.UserDashboard.capacity:type_name -> prassignment.v1.ReviewCapacity
+	19, // 22: prassignment.v1.UserDashboard.unavailability:type_name -> prassignment.v1.Unavailability
+	27, // 23: prassignment.v1.UserDashboard.recent_stats:type_name -> prassignment.v1.RecentReviewStats
+	65, // 24: prassignment.v1.ReviewerAssignment.assigned_at:type_name -> google.protobuf.Timestamp
+	29, // 25: prassignment.v1.PullRequest.reviewer_assignments:type_name -> prassignment.v1.ReviewerAssignment
+	65, // 26: prassignment.v1.PullRequest.created_at:type_name -> google.protobuf.Timestamp
+	65, // 27: prassignment.v1.PullRequest.merged_at:type_name -> google.protobuf.Timestamp
+	29, // 28: prassignment.v1.ListReviewersResponse.reviewers:type_name -> prassignment.v1.ReviewerAssignment
+	42, // 29: prassignment.v1.RankedCandidate.breakdown:type_name -> prassignment.v1.ScoreComponent
+	43, // 30: prassignment.v1.ReviewerPreview.candidates:type_name -> prassignment.v1.RankedCandidate
+	44, // 31: prassignment.v1.ReviewerPreview.excluded:type_name -> prassignment.v1.ExcludedCandidate
+	65, // 32: prassignment.v1.TimeWindow.from:type_name -> google.protobuf.Timestamp
+	65, // 33: prassignment.v1.TimeWindow.to:type_name -> google.protobuf.Timestamp
+	47, // 34: prassignment.v1.GetAssignmentStatsResponse.statistics:type_name -> prassignment.v1.AssignmentStats
+	65, // 35: prassignment.v1.PullRequestStats.created_at:type_name -> google.protobuf.Timestamp
+	65, // 36: prassignment.v1.PullRequestStats.merged_at:type_name -> google.protobuf.Timestamp
+	46, // 37: prassignment.v1.GetPullRequestStatsRequest.window:type_name -> prassignment.v1.TimeWindow
+	50, // 38: prassignment.v1.GetPullRequestStatsResponse.statistics:type_name -> prassignment.v1.PullRequestStats
+	46, // 39: prassignment.v1.GetTeamStatsRequest.window:type_name -> prassignment.v1.TimeWindow
+	53, // 40: prassignment.v1.GetTeamStatsResponse.statistics:type_name -> prassignment.v1.TeamStats
+	56, // 41: prassignment.v1.TeamFairness.members:type_name -> prassignment.v1.ReviewerFairness
+	46, // 42: prassignment.v1.GetFairnessRequest.window:type_name -> prassignment.v1.TimeWindow
+	57, // 43: prassignment.v1.FairnessReport.teams:type_name -> prassignment.v1.TeamFairness
+	60, // 44: prassignment.v1.GetWorkloadResponse.workload:type_name -> prassignment.v1.UserWorkload
+	66, // 45: prassignment.v1.AssignmentEvent.details:type_name -> google.protobuf.Struct
+	65, // 46: prassignment.v1.AssignmentEvent.created_at:type_name -> google.protobuf.Timestamp
+	3,  // 47: prassignment.v1.TeamService.AddTeam:input_type -> prassignment.v1.AddTeamRequest
+	5,  // 48: prassignment.v1.TeamService.GetTeam:input_type -> prassignment.v1.GetTeamRequest
+	6,  // 49: prassignment.v1.TeamService.DeactivateTeam:input_type -> prassignment.v1.DeactivateTeamRequest
+	8,  // 50: prassignment.v1.TeamService.SetMentorship:input_type -> prassignment.v1.SetMentorshipRequest
+	10, // 51: prassignment.v1.UserService.SetIsActive:input_type -> prassignment.v1.SetIsActiveRequest
+	11, // 52: prassignment.v1.UserService.GetReview:input_type -> prassignment.v1.GetReviewRequest
+	14, // 53: prassignment.v1.UserService.SetSeniority:input_type -> prassignment.v1.SetSeniorityRequest
+	17, // 54: prassignment.v1.UserService.GetSkills:input_type -> prassignment.v1.GetSkillsRequest
+	18, // 55: prassignment.v1.UserService.SetSkills:input_type -> prassignment.v1.SetSkillsRequest
+	22, // 56: prassignment.v1.UserService.GetDashboard:input_type -> prassignment.v1.GetDashboardRequest
+	20, // 57: prassignment.v1.UserService.AddUnavailability:input_type -> prassignment.v1.AddUnavailabilityRequest
+	21, // 58: prassignment.v1.UserService.DeleteUnavailability:input_type -> prassignment.v1.DeleteUnavailabilityRequest
+	31, // 59: prassignment.v1.PullRequestService.CreatePullRequest:input_type -> prassignment.v1.CreatePullRequestRequest
+	32, // 60: prassignment.v1.PullRequestService.MergePullRequest:input_type -> prassignment.v1.MergePullRequestRequest
+	33, // 61: prassignment.v1.PullRequestService.GetRequiredTags:input_type -> prassignment.v1.GetRequiredTagsRequest
+	34, // 62: prassignment.v1.PullRequestService.SetRequiredTags:input_type -> prassignment.v1.SetRequiredTagsRequest
+	36, // 63: prassignment.v1.ReviewerService.ReassignReviewer:input_type -> prassignment.v1.ReassignReviewerRequest
+	38, // 64: prassignment.v1.ReviewerService.AssignReviewer:input_type -> prassignment.v1.AssignReviewerRequest
+	39, // 65: prassignment.v1.ReviewerService.ListReviewers:input_type -> prassignment.v1.ListReviewersRequest
+	41, // 66: prassignment.v1.ReviewerService.PreviewReviewers:input_type -> prassignment.v1.PreviewReviewersRequest
+	48, // 67: prassignment.v1.StatisticsService.GetAssignmentStats:input_type -> prassignment.v1.GetAssignmentStatsRequest
+	51, // 68: prassignment.v1.StatisticsService.GetPullRequestStats:input_type -> prassignment.v1.GetPullRequestStatsRequest
+	54, // 69: prassignment.v1.StatisticsService.GetTeamStats:input_type -> prassignment.v1.GetTeamStatsRequest
+	58, // 70: prassignment.v1.StatisticsService.GetFairness:input_type -> prassignment.v1.GetFairnessRequest
+	61, // 71: prassignment.v1.StatisticsService.GetWorkload:input_type -> prassignment.v1.GetWorkloadRequest
+	63, // 72: prassignment.v1.EventService.StreamEvents:input_type -> prassignment.v1.StreamEventsRequest
+	4,  // 73: prassignment.v1.TeamService.AddTeam:output_type -> prassignment.v1.AddTeamResponse
+	1,  // 74: prassignment.v1.TeamService.GetTeam:output_type -> prassignment.v1.Team
+	7,  // 75: prassignment.v1.TeamService.DeactivateTeam:output_type -> prassignment.v1.DeactivateTeamResponse
+	9,  // 76: prassignment.v1.TeamService.SetMentorship:output_type -> prassignment.v1.SetMentorshipResponse
+	2,  // 77: prassignment.v1.UserService.SetIsActive:output_type -> prassignment.v1.User
+	13, // 78: prassignment.v1.UserService.GetReview:output_type -> prassignment.v1.GetReviewResponse
+	2,  // 79: prassignment.v1.UserService.SetSeniority:output_type -> prassignment.v1.User
+	16, // 80: prassignment.v1.UserService.GetSkills:output_type -> prassignment.v1.UserSkills
+	16, // 81: prassignment.v1.UserService.SetSkills:output_type -> prassignment.v1.UserSkills
+	28, // 82: prassignment.v1.UserService.GetDashboard:output_type -> prassignment.v1.UserDashboard
+	19, // 83: prassignment.v1.UserService.AddUnavailability:output_type -> prassignment.v1.Unavailability
+	19, // 84: prassignment.v1.UserService.DeleteUnavailability:output_type -> prassignment.v1.Unavailability
+	30, // 85: prassignment.v1.PullRequestService.CreatePullRequest:output_type -> prassignment.v1.PullRequest
+	30, // 86: prassignment.v1.PullRequestService.MergePullRequest:output_type -> prassignment.v1.PullRequest
+	35, // 87: prassignment.v1.PullRequestService.GetRequiredTags:output_type -> prassignment.v1.PullRequestTags
+	35, // 88: prassignment.v1.PullRequestService.SetRequiredTags:output_type -> prassignment.v1.PullRequestTags
+	37, // 89: prassignment.v1.ReviewerService.ReassignReviewer:output_type -> prassignment.v1.ReassignReviewerResponse
+	29, // 90: prassignment.v1.ReviewerService.AssignReviewer:output_type -> prassignment.v1.ReviewerAssignment
+	40, // 91: prassignment.v1.ReviewerService.ListReviewers:output_type -> prassignment.v1.ListReviewersResponse
+	45, // 92: prassignment.v1.ReviewerService.PreviewReviewers:output_type -> prassignment.v1.ReviewerPreview
+	49, // 93: prassignment.v1.StatisticsService.GetAssignmentStats:output_type -> prassignment.v1.GetAssignmentStatsResponse
+	52, // 94: prassignment.v1.StatisticsService.GetPullRequestStats:output_type -> prassignment.v1.GetPullRequestStatsResponse
+	55, // 95: prassignment.v1.StatisticsService.GetTeamStats:output_type -> prassignment.v1.GetTeamStatsResponse
+	59, // 96: prassignment.v1.StatisticsService.GetFairness:output_type -> prassignment.v1.FairnessReport
+	62, // 97: prassignment.v1.StatisticsService.GetWorkload:output_type -> prassignment.v1.GetWorkloadResponse
+	64, // 98: prassignment.v1.EventService.StreamEvents:output_type -> prassignment.v1.AssignmentEvent
+	73, // [73:99] is the sub-list for method output_type
+	47, // [47:73] is the sub-list for method input_type
+	47, // [47:47] is the sub-list for extension type_name
+	47, // [47:47] is the sub-list for extension extendee
+	0,  // [0:47] is the sub-list for field type_name
+}
+
+func init() { file_prassignment_v1_prassignment_proto_init() }
+func file_prassignment_v1_prassignment_proto_init() {
+	if File_prassignment_v1_prassignment_proto != nil {
+		return
+	}
+	file_prassignment_v1_prassignment_proto_msgTypes[0].OneofWrappers = []any{}
+	file_prassignment_v1_prassignment_proto_msgTypes[26].OneofWrappers = []any{}
+	file_prassignment_v1_prassignment_proto_msgTypes[29].OneofWrappers = []any{}
+	file_prassignment_v1_prassignment_proto_msgTypes[47].OneofWrappers = []any{}
+	file_prassignment_v1_prassignment_proto_msgTypes[57].OneofWrappers = []any{}
+	file_prassignment_v1_prassignment_proto_msgTypes[58].OneofWrappers = []any{}
+	file_prassignment_v1_prassignment_proto_msgTypes[60].OneofWrappers = []any{}
+	file_prassignment_v1_prassignment_proto_msgTypes[63].OneofWrappers = []any{}
+	file_prassignment_v1_prassignment_proto_msgTypes[64].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_prassignment_v1_prassignment_proto_rawDesc), len(file_prassignment_v1_prassignment_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   65,
+			NumExtensions: 0,
+			NumServices:   6,
+		},
+		GoTypes:           file_prassignment_v1_prassignment_proto_goTypes,
+		DependencyIndexes: file_prassignment_v1_prassignment_proto_depIdxs,
+		MessageInfos:      file_prassignment_v1_prassignment_proto_msgTypes,
+	}.Build()
+	File_prassignment_v1_prassignment_proto = out.File
+	file_prassignment_v1_prassignment_proto_goTypes = nil
+	file_prassignment_v1_prassignment_proto_depIdxs = nil
+}
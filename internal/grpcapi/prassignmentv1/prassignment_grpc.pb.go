@@ -0,0 +1,1478 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: prassignment/v1/prassignment.proto
+
+// gRPC API сервиса назначения ревьюеров. Операции повторяют REST API из
+// docs/openapi.yaml, поля называются так же, как в JSON. Ошибки
+// возвращаются статусом gRPC с google.rpc.ErrorInfo, где reason - код
+// ошибки REST API (PR_EXISTS, NOT_FOUND, INVALID_REVIEWERS, ...).
+// Правила, аудит, аналитика и администрирование есть только в REST API.
+
+package prassignmentv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TeamService_AddTeam_FullMethodName        = "/prassignment.v1.TeamService/AddTeam"
+	TeamService_GetTeam_FullMethodName        = "/prassignment.v1.TeamService/GetTeam"
+	TeamService_DeactivateTeam_FullMethodName = "/prassignment.v1.TeamService/DeactivateTeam"
+	TeamService_SetMentorship_FullMethodName  = "/prassignment.v1.TeamService/SetMentorship"
+)
+
+// TeamServiceClient is the client API for TeamService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// TeamService управляет командами
+type TeamServiceClient interface {
+	// AddTeam создает команду с участниками или обновляет существующих (POST /team/add)
+	AddTeam(ctx context.Context, in *AddTeamRequest, opts ...grpc.CallOption) (*AddTeamResponse, error)
+	// GetTeam возвращает команду с участниками (GET /team/get)
+	GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*Team, error)
+	// DeactivateTeam деактивирует участников команды и переназначает их PR (POST /team/deactivate)
+	DeactivateTeam(ctx context.Context, in *DeactivateTeamRequest, opts ...grpc.CallOption) (*DeactivateTeamResponse, error)
+	// SetMentorship включает или выключает наставничество в команде (POST /team/setMentorship)
+	SetMentorship(ctx context.Context, in *SetMentorshipRequest, opts ...grpc.CallOption) (*SetMentorshipResponse, error)
+}
+
+type teamServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTeamServiceClient(cc grpc.ClientConnInterface) TeamServiceClient {
+	return &teamServiceClient{cc}
+}
+
+func (c *teamServiceClient) AddTeam(ctx context.Context, in *AddTeamRequest, opts ...grpc.CallOption) (*AddTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AddTeamResponse)
+	err := c.cc.Invoke(ctx, TeamService_AddTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*Team, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Team)
+	err := c.cc.Invoke(ctx, TeamService_GetTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) DeactivateTeam(ctx context.Context, in *DeactivateTeamRequest, opts ...grpc.CallOption) (*DeactivateTeamResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivateTeamResponse)
+	err := c.cc.Invoke(ctx, TeamService_DeactivateTeam_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *teamServiceClient) SetMentorship(ctx context.Context, in *SetMentorshipRequest, opts ...grpc.CallOption) (*SetMentorshipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetMentorshipResponse)
+	err := c.cc.Invoke(ctx, TeamService_SetMentorship_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TeamServiceServer is the server API for TeamService service.
+// All implementations must embed UnimplementedTeamServiceServer
+// for forward compatibility.
+//
+// TeamService управляет командами
+type TeamServiceServer interface {
+	// AddTeam создает команду с участниками или обновляет существующих (POST /team/add)
+	AddTeam(context.Context, *AddTeamRequest) (*AddTeamResponse, error)
+	// GetTeam возвращает команду с участниками (GET /team/get)
+	GetTeam(context.Context, *GetTeamRequest) (*Team, error)
+	// DeactivateTeam деактивирует участников команды и переназначает их PR (POST /team/deactivate)
+	DeactivateTeam(context.Context, *DeactivateTeamRequest) (*DeactivateTeamResponse, error)
+	// SetMentorship включает или выключает наставничество в команде (POST /team/setMentorship)
+	SetMentorship(context.Context, *SetMentorshipRequest) (*SetMentorshipResponse, error)
+	mustEmbedUnimplementedTeamServiceServer()
+}
+
+// UnimplementedTeamServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTeamServiceServer struct{}
+
+func (UnimplementedTeamServiceServer) AddTeam(context.Context, *AddTeamRequest) (*AddTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTeam not implemented")
+}
+func (UnimplementedTeamServiceServer) GetTeam(context.Context, *GetTeamRequest) (*Team, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeam not implemented")
+}
+func (UnimplementedTeamServiceServer) DeactivateTeam(context.Context, *DeactivateTeamRequest) (*DeactivateTeamResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateTeam not implemented")
+}
+func (UnimplementedTeamServiceServer) SetMentorship(context.Context, *SetMentorshipRequest) (*SetMentorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMentorship not implemented")
+}
+func (UnimplementedTeamServiceServer) mustEmbedUnimplementedTeamServiceServer() {}
+func (UnimplementedTeamServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeTeamServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TeamServiceServer will
+// result in compilation errors.
+type UnsafeTeamServiceServer interface {
+	mustEmbedUnimplementedTeamServiceServer()
+}
+
+func RegisterTeamServiceServer(s grpc.ServiceRegistrar, srv TeamServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTeamServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TeamService_ServiceDesc, srv)
+}
+
+func _TeamService_AddTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).AddTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_AddTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).AddTeam(ctx, req.(*AddTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).GetTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_GetTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).GetTeam(ctx, req.(*GetTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_DeactivateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).DeactivateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_DeactivateTeam_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).DeactivateTeam(ctx, req.(*DeactivateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TeamService_SetMentorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMentorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TeamServiceServer).SetMentorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TeamService_SetMentorship_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TeamServiceServer).SetMentorship(ctx, req.(*SetMentorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TeamService_ServiceDesc is the grpc.ServiceDesc for TeamService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TeamService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "prassignment.v1.TeamService",
+	HandlerType: (*TeamServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddTeam",
+			Handler:    _TeamService_AddTeam_Handler,
+		},
+		{
+			MethodName: "GetTeam",
+			Handler:    _TeamService_GetTeam_Handler,
+		},
+		{
+			MethodName: "DeactivateTeam",
+			Handler:    _TeamService_DeactivateTeam_Handler,
+		},
+		{
+			MethodName: "SetMentorship",
+			Handler:    _TeamService_SetMentorship_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "prassignment/v1/prassignment.proto",
+}
+
+const (
+	UserService_SetIsActive_FullMethodName          = "/prassignment.v1.UserService/SetIsActive"
+	UserService_GetReview_FullMethodName            = "/prassignment.v1.UserService/GetReview"
+	UserService_SetSeniority_FullMethodName         = "/prassignment.v1.UserService/SetSeniority"
+	UserService_GetSkills_FullMethodName            = "/prassignment.v1.UserService/GetSkills"
+	UserService_SetSkills_FullMethodName            = "/prassignment.v1.UserService/SetSkills"
+	UserService_GetDashboard_FullMethodName         = "/prassignment.v1.UserService/GetDashboard"
+	UserService_AddUnavailability_FullMethodName    = "/prassignment.v1.UserService/AddUnavailability"
+	UserService_DeleteUnavailability_FullMethodName = "/prassignment.v1.UserService/DeleteUnavailability"
+)
+
+// UserServiceClient is the client API for UserService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// UserService управляет пользователями
+type UserServiceClient interface {
+	// SetIsActive устанавливает флаг активности пользователя (POST /users/setIsActive)
+	SetIsActive(ctx context.Context, in *SetIsActiveRequest, opts ...grpc.CallOption) (*User, error)
+	// GetReview возвращает PR, где пользователь назначен ревьюером (GET /users/getReview)
+	GetReview(ctx context.Context, in *GetReviewRequest, opts ...grpc.CallOption) (*GetReviewResponse, error)
+	// SetSeniority задает уровень пользователя для наставничества (POST /users/setSeniority)
+	SetSeniority(ctx context.Context, in *SetSeniorityRequest, opts ...grpc.CallOption) (*User, error)
+	// GetSkills возвращает навыки пользователя (GET /users/skills)
+	GetSkills(ctx context.Context, in *GetSkillsRequest, opts ...grpc.CallOption) (*UserSkills, error)
+	// SetSkills заменяет навыки пользователя (POST /users/setSkills)
+	SetSkills(ctx context.Context, in *SetSkillsRequest, opts ...grpc.CallOption) (*UserSkills, error)
+	// GetDashboard возвращает персональную сводку ревьюера (GET /users/dashboard)
+	GetDashboard(ctx context.Context, in *GetDashboardRequest, opts ...grpc.CallOption) (*UserDashboard, error)
+	// AddUnavailability добавляет период недоступности (POST /users/addUnavailability)
+	AddUnavailability(ctx context.Context, in *AddUnavailabilityRequest, opts ...grpc.CallOption) (*Unavailability, error)
+	// DeleteUnavailability удаляет период недоступности (POST /users/deleteUnavailability)
+	DeleteUnavailability(ctx context.Context, in *DeleteUnavailabilityRequest, opts ...grpc.CallOption) (*Unavailability, error)
+}
+
+type userServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserServiceClient(cc grpc.ClientConnInterface) UserServiceClient {
+	return &userServiceClient{cc}
+}
+
+func (c *userServiceClient) SetIsActive(ctx context.Context, in *SetIsActiveRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_SetIsActive_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetReview(ctx context.Context, in *GetReviewRequest, opts ...grpc.CallOption) (*GetReviewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetReviewResponse)
+	err := c.cc.Invoke(ctx, UserService_GetReview_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetSeniority(ctx context.Context, in *SetSeniorityRequest, opts ...grpc.CallOption) (*User, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(User)
+	err := c.cc.Invoke(ctx, UserService_SetSeniority_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetSkills(ctx context.Context, in *GetSkillsRequest, opts ...grpc.CallOption) (*UserSkills, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserSkills)
+	err := c.cc.Invoke(ctx, UserService_GetSkills_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) SetSkills(ctx context.Context, in *SetSkillsRequest, opts ...grpc.CallOption) (*UserSkills, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserSkills)
+	err := c.cc.Invoke(ctx, UserService_SetSkills_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) GetDashboard(ctx context.Context, in *GetDashboardRequest, opts ...grpc.CallOption) (*UserDashboard, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserDashboard)
+	err := c.cc.Invoke(ctx, UserService_GetDashboard_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) AddUnavailability(ctx context.Context, in *AddUnavailabilityRequest, opts ...grpc.CallOption) (*Unavailability, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Unavailability)
+	err := c.cc.Invoke(ctx, UserService_AddUnavailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userServiceClient) DeleteUnavailability(ctx context.Context, in *DeleteUnavailabilityRequest, opts ...grpc.CallOption) (*Unavailability, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(Unavailability)
+	err := c.cc.Invoke(ctx, UserService_DeleteUnavailability_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserServiceServer is the server API for UserService service.
+// All implementations must embed UnimplementedUserServiceServer
+// for forward compatibility.
+//
+// UserService управляет пользователями
+type UserServiceServer interface {
+	// SetIsActive устанавливает флаг активности пользователя (POST /users/setIsActive)
+	SetIsActive(context.Context, *SetIsActiveRequest) (*User, error)
+	// GetReview возвращает PR, где пользователь назначен ревьюером (GET /users/getReview)
+	GetReview(context.Context, *GetReviewRequest) (*GetReviewResponse, error)
+	// SetSeniority задает уровень пользователя для наставничества (POST /users/setSeniority)
+	SetSeniority(context.Context, *SetSeniorityRequest) (*User, error)
+	// GetSkills возвращает навыки пользователя (GET /users/skills)
+	GetSkills(context.Context, *GetSkillsRequest) (*UserSkills, error)
+	// SetSkills заменяет навыки пользователя (POST /users/setSkills)
+	SetSkills(context.Context, *SetSkillsRequest) (*UserSkills, error)
+	// GetDashboard возвращает персональную сводку ревьюера (GET /users/dashboard)
+	GetDashboard(context.Context, *GetDashboardRequest) (*UserDashboard, error)
+	// AddUnavailability добавляет период недоступности (POST /users/addUnavailability)
+	AddUnavailability(context.Context, *AddUnavailabilityRequest) (*Unavailability, error)
+	// DeleteUnavailability удаляет период недоступности (POST /users/deleteUnavailability)
+	DeleteUnavailability(context.Context, *DeleteUnavailabilityRequest) (*Unavailability, error)
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+// UnimplementedUserServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserServiceServer struct{}
+
+func (UnimplementedUserServiceServer) SetIsActive(context.Context, *SetIsActiveRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetIsActive not implemented")
+}
+func (UnimplementedUserServiceServer) GetReview(context.Context, *GetReviewRequest) (*GetReviewResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetReview not implemented")
+}
+func (UnimplementedUserServiceServer) SetSeniority(context.Context, *SetSeniorityRequest) (*User, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSeniority not implemented")
+}
+func (UnimplementedUserServiceServer) GetSkills(context.Context, *GetSkillsRequest) (*UserSkills, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSkills not implemented")
+}
+func (UnimplementedUserServiceServer) SetSkills(context.Context, *SetSkillsRequest) (*UserSkills, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSkills not implemented")
+}
+func (UnimplementedUserServiceServer) GetDashboard(context.Context, *GetDashboardRequest) (*UserDashboard, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDashboard not implemented")
+}
+func (UnimplementedUserServiceServer) AddUnavailability(context.Context, *AddUnavailabilityRequest) (*Unavailability, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddUnavailability not implemented")
+}
+func (UnimplementedUserServiceServer) DeleteUnavailability(context.Context, *DeleteUnavailabilityRequest) (*Unavailability, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteUnavailability not implemented")
+}
+func (UnimplementedUserServiceServer) mustEmbedUnimplementedUserServiceServer() {}
+func (UnimplementedUserServiceServer) testEmbeddedByValue()                     {}
+
+// UnsafeUserServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserServiceServer will
+// result in compilation errors.
+type UnsafeUserServiceServer interface {
+	mustEmbedUnimplementedUserServiceServer()
+}
+
+func RegisterUserServiceServer(s grpc.ServiceRegistrar, srv UserServiceServer) {
+	// If the following call pancis, it indicates UnimplementedUserServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&UserService_ServiceDesc, srv)
+}
+
+func _UserService_SetIsActive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetIsActiveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetIsActive(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetIsActive_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetIsActive(ctx, req.(*SetIsActiveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetReview_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetReviewRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetReview(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetReview_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetReview(ctx, req.(*GetReviewRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetSeniority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSeniorityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetSeniority(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetSeniority_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetSeniority(ctx, req.(*SetSeniorityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetSkills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSkillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetSkills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetSkills_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetSkills(ctx, req.(*GetSkillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_SetSkills_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSkillsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).SetSkills(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_SetSkills_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).SetSkills(ctx, req.(*SetSkillsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_GetDashboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDashboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).GetDashboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_GetDashboard_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).GetDashboard(ctx, req.(*GetDashboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_AddUnavailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddUnavailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).AddUnavailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_AddUnavailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).AddUnavailability(ctx, req.(*AddUnavailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserService_DeleteUnavailability_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUnavailabilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).DeleteUnavailability(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserService_DeleteUnavailability_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).DeleteUnavailability(ctx, req.(*DeleteUnavailabilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// UserService_ServiceDesc is the grpc.ServiceDesc for UserService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var UserService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "prassignment.v1.UserService",
+	HandlerType: (*UserServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetIsActive",
+			Handler:    _UserService_SetIsActive_Handler,
+		},
+		{
+			MethodName: "GetReview",
+			Handler:    _UserService_GetReview_Handler,
+		},
+		{
+			MethodName: "SetSeniority",
+			Handler:    _UserService_SetSeniority_Handler,
+		},
+		{
+			MethodName: "GetSkills",
+			Handler:    _UserService_GetSkills_Handler,
+		},
+		{
+			MethodName: "SetSkills",
+			Handler:    _UserService_SetSkills_Handler,
+		},
+		{
+			MethodName: "GetDashboard",
+			Handler:    _UserService_GetDashboard_Handler,
+		},
+		{
+			MethodName: "AddUnavailability",
+			Handler:    _UserService_AddUnavailability_Handler,
+		},
+		{
+			MethodName: "DeleteUnavailability",
+			Handler:    _UserService_DeleteUnavailability_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "prassignment/v1/prassignment.proto",
+}
+
+const (
+	PullRequestService_CreatePullRequest_FullMethodName = "/prassignment.v1.PullRequestService/CreatePullRequest"
+	PullRequestService_MergePullRequest_FullMethodName  = "/prassignment.v1.PullRequestService/MergePullRequest"
+	PullRequestService_GetRequiredTags_FullMethodName   = "/prassignment.v1.PullRequestService/GetRequiredTags"
+	PullRequestService_SetRequiredTags_FullMethodName   = "/prassignment.v1.PullRequestService/SetRequiredTags"
+)
+
+// PullRequestServiceClient is the client API for PullRequestService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// PullRequestService управляет Pull Request'ами
+type PullRequestServiceClient interface {
+	// CreatePullRequest создает PR и назначает ревьюеров (POST /pullRequest/create)
+	CreatePullRequest(ctx context.Context, in *CreatePullRequestRequest, opts ...grpc.CallOption) (*PullRequest, error)
+	// MergePullRequest помечает PR как MERGED (POST /pullRequest/merge)
+	MergePullRequest(ctx context.Context, in *MergePullRequestRequest, opts ...grpc.CallOption) (*PullRequest, error)
+	// GetRequiredTags возвращает навыки, нужные для ревью PR (GET /pullRequest/requiredTags)
+	GetRequiredTags(ctx context.Context, in *GetRequiredTagsRequest, opts ...grpc.CallOption) (*PullRequestTags, error)
+	// SetRequiredTags заменяет навыки, нужные для ревью PR (POST /pullRequest/setRequiredTags)
+	SetRequiredTags(ctx context.Context, in *SetRequiredTagsRequest, opts ...grpc.CallOption) (*PullRequestTags, error)
+}
+
+type pullRequestServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPullRequestServiceClient(cc grpc.ClientConnInterface) PullRequestServiceClient {
+	return &pullRequestServiceClient{cc}
+}
+
+func (c *pullRequestServiceClient) CreatePullRequest(ctx context.Context, in *CreatePullRequestRequest, opts ...grpc.CallOption) (*PullRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequest)
+	err := c.cc.Invoke(ctx, PullRequestService_CreatePullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) MergePullRequest(ctx context.Context, in *MergePullRequestRequest, opts ...grpc.CallOption) (*PullRequest, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequest)
+	err := c.cc.Invoke(ctx, PullRequestService_MergePullRequest_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) GetRequiredTags(ctx context.Context, in *GetRequiredTagsRequest, opts ...grpc.CallOption) (*PullRequestTags, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequestTags)
+	err := c.cc.Invoke(ctx, PullRequestService_GetRequiredTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *pullRequestServiceClient) SetRequiredTags(ctx context.Context, in *SetRequiredTagsRequest, opts ...grpc.CallOption) (*PullRequestTags, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PullRequestTags)
+	err := c.cc.Invoke(ctx, PullRequestService_SetRequiredTags_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PullRequestServiceServer is the server API for PullRequestService service.
+// All implementations must embed UnimplementedPullRequestServiceServer
+// for forward compatibility.
+//
+// PullRequestService управляет Pull Request'ами
+type PullRequestServiceServer interface {
+	// CreatePullRequest создает PR и назначает ревьюеров (POST /pullRequest/create)
+	CreatePullRequest(context.Context, *CreatePullRequestRequest) (*PullRequest, error)
+	// MergePullRequest помечает PR как MERGED (POST /pullRequest/merge)
+	MergePullRequest(context.Context, *MergePullRequestRequest) (*PullRequest, error)
+	// GetRequiredTags возвращает навыки, нужные для ревью PR (GET /pullRequest/requiredTags)
+	GetRequiredTags(context.Context, *GetRequiredTagsRequest) (*PullRequestTags, error)
+	// SetRequiredTags заменяет навыки, нужные для ревью PR (POST /pullRequest/setRequiredTags)
+	SetRequiredTags(context.Context, *SetRequiredTagsRequest) (*PullRequestTags, error)
+	mustEmbedUnimplementedPullRequestServiceServer()
+}
+
+// UnimplementedPullRequestServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedPullRequestServiceServer struct{}
+
+func (UnimplementedPullRequestServiceServer) CreatePullRequest(context.Context, *CreatePullRequestRequest) (*PullRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePullRequest not implemented")
+}
+func (UnimplementedPullRequestServiceServer) MergePullRequest(context.Context, *MergePullRequestRequest) (*PullRequest, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergePullRequest not implemented")
+}
+func (UnimplementedPullRequestServiceServer) GetRequiredTags(context.Context, *GetRequiredTagsRequest) (*PullRequestTags, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRequiredTags not implemented")
+}
+func (UnimplementedPullRequestServiceServer) SetRequiredTags(context.Context, *SetRequiredTagsRequest) (*PullRequestTags, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRequiredTags not implemented")
+}
+func (UnimplementedPullRequestServiceServer) mustEmbedUnimplementedPullRequestServiceServer() {}
+func (UnimplementedPullRequestServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafePullRequestServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PullRequestServiceServer will
+// result in compilation errors.
+type UnsafePullRequestServiceServer interface {
+	mustEmbedUnimplementedPullRequestServiceServer()
+}
+
+func RegisterPullRequestServiceServer(s grpc.ServiceRegistrar, srv PullRequestServiceServer) {
+	// If the following call pancis, it indicates UnimplementedPullRequestServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&PullRequestService_ServiceDesc, srv)
+}
+
+func _PullRequestService_CreatePullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).CreatePullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_CreatePullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).CreatePullRequest(ctx, req.(*CreatePullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_MergePullRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergePullRequestRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).MergePullRequest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_MergePullRequest_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).MergePullRequest(ctx, req.(*MergePullRequestRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_GetRequiredTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequiredTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).GetRequiredTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_GetRequiredTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).GetRequiredTags(ctx, req.(*GetRequiredTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PullRequestService_SetRequiredTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRequiredTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PullRequestServiceServer).SetRequiredTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PullRequestService_SetRequiredTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PullRequestServiceServer).SetRequiredTags(ctx, req.(*SetRequiredTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PullRequestService_ServiceDesc is the grpc.ServiceDesc for PullRequestService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PullRequestService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "prassignment.v1.PullRequestService",
+	HandlerType: (*PullRequestServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreatePullRequest",
+			Handler:    _PullRequestService_CreatePullRequest_Handler,
+		},
+		{
+			MethodName: "MergePullRequest",
+			Handler:    _PullRequestService_MergePullRequest_Handler,
+		},
+		{
+			MethodName: "GetRequiredTags",
+			Handler:    _PullRequestService_GetRequiredTags_Handler,
+		},
+		{
+			MethodName: "SetRequiredTags",
+			Handler:    _PullRequestService_SetRequiredTags_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "prassignment/v1/prassignment.proto",
+}
+
+const (
+	ReviewerService_ReassignReviewer_FullMethodName = "/prassignment.v1.ReviewerService/ReassignReviewer"
+	ReviewerService_AssignReviewer_FullMethodName   = "/prassignment.v1.ReviewerService/AssignReviewer"
+	ReviewerService_ListReviewers_FullMethodName    = "/prassignment.v1.ReviewerService/ListReviewers"
+	ReviewerService_PreviewReviewers_FullMethodName = "/prassignment.v1.ReviewerService/PreviewReviewers"
+)
+
+// ReviewerServiceClient is the client API for ReviewerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// ReviewerService управляет ревьюерами PR
+type ReviewerServiceClient interface {
+	// ReassignReviewer заменяет ревьюера (POST /pullRequest/reassign)
+	ReassignReviewer(ctx context.Context, in *ReassignReviewerRequest, opts ...grpc.CallOption) (*ReassignReviewerResponse, error)
+	// AssignReviewer вручную назначает ревьюера (POST /pullRequest/assign)
+	AssignReviewer(ctx context.Context, in *AssignReviewerRequest, opts ...grpc.CallOption) (*ReviewerAssignment, error)
+	// ListReviewers возвращает ревьюеров PR с причинами назначения (GET /pullRequest/reviewers)
+	ListReviewers(ctx context.Context, in *ListReviewersRequest, opts ...grpc.CallOption) (*ListReviewersResponse, error)
+	// PreviewReviewers показывает, кого назначит сервис, ничего не записывая (POST /pullRequest/previewReviewers)
+	PreviewReviewers(ctx context.Context, in *PreviewReviewersRequest, opts ...grpc.CallOption) (*ReviewerPreview, error)
+}
+
+type reviewerServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewReviewerServiceClient(cc grpc.ClientConnInterface) ReviewerServiceClient {
+	return &reviewerServiceClient{cc}
+}
+
+func (c *reviewerServiceClient) ReassignReviewer(ctx context.Context, in *ReassignReviewerRequest, opts ...grpc.CallOption) (*ReassignReviewerResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReassignReviewerResponse)
+	err := c.cc.Invoke(ctx, ReviewerService_ReassignReviewer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) AssignReviewer(ctx context.Context, in *AssignReviewerRequest, opts ...grpc.CallOption) (*ReviewerAssignment, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewerAssignment)
+	err := c.cc.Invoke(ctx, ReviewerService_AssignReviewer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) ListReviewers(ctx context.Context, in *ListReviewersRequest, opts ...grpc.CallOption) (*ListReviewersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListReviewersResponse)
+	err := c.cc.Invoke(ctx, ReviewerService_ListReviewers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reviewerServiceClient) PreviewReviewers(ctx context.Context, in *PreviewReviewersRequest, opts ...grpc.CallOption) (*ReviewerPreview, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewerPreview)
+	err := c.cc.Invoke(ctx, ReviewerService_PreviewReviewers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ReviewerServiceServer is the server API for ReviewerService service.
+// All implementations must embed UnimplementedReviewerServiceServer
+// for forward compatibility.
+//
+// ReviewerService управляет ревьюерами PR
+type ReviewerServiceServer interface {
+	// ReassignReviewer заменяет ревьюера (POST /pullRequest/reassign)
+	ReassignReviewer(context.Context, *ReassignReviewerRequest) (*ReassignReviewerResponse, error)
+	// AssignReviewer вручную назначает ревьюера (POST /pullRequest/assign)
+	AssignReviewer(context.Context, *AssignReviewerRequest) (*ReviewerAssignment, error)
+	// ListReviewers возвращает ревьюеров PR с причинами назначения (GET /pullRequest/reviewers)
+	ListReviewers(context.Context, *ListReviewersRequest) (*ListReviewersResponse, error)
+	// PreviewReviewers показывает, кого назначит сервис, ничего не записывая (POST /pullRequest/previewReviewers)
+	PreviewReviewers(context.Context, *PreviewReviewersRequest) (*ReviewerPreview, error)
+	mustEmbedUnimplementedReviewerServiceServer()
+}
+
+// UnimplementedReviewerServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedReviewerServiceServer struct{}
+
+func (UnimplementedReviewerServiceServer) ReassignReviewer(context.Context, *ReassignReviewerRequest) (*ReassignReviewerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReassignReviewer not implemented")
+}
+func (UnimplementedReviewerServiceServer) AssignReviewer(context.Context, *AssignReviewerRequest) (*ReviewerAssignment, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AssignReviewer not implemented")
+}
+func (UnimplementedReviewerServiceServer) ListReviewers(context.Context, *ListReviewersRequest) (*ListReviewersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListReviewers not implemented")
+}
+func (UnimplementedReviewerServiceServer) PreviewReviewers(context.Context, *PreviewReviewersRequest) (*ReviewerPreview, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewReviewers not implemented")
+}
+func (UnimplementedReviewerServiceServer) mustEmbedUnimplementedReviewerServiceServer() {}
+func (UnimplementedReviewerServiceServer) testEmbeddedByValue()                         {}
+
+// UnsafeReviewerServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to ReviewerServiceServer will
+// result in compilation errors.
+type UnsafeReviewerServiceServer interface {
+	mustEmbedUnimplementedReviewerServiceServer()
+}
+
+func RegisterReviewerServiceServer(s grpc.ServiceRegistrar, srv ReviewerServiceServer) {
+	// If the following call pancis, it indicates UnimplementedReviewerServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&ReviewerService_ServiceDesc, srv)
+}
+
+func _ReviewerService_ReassignReviewer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReassignReviewerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).ReassignReviewer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_ReassignReviewer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).ReassignReviewer(ctx, req.(*ReassignReviewerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_AssignReviewer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AssignReviewerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).AssignReviewer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_AssignReviewer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).AssignReviewer(ctx, req.(*AssignReviewerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_ListReviewers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListReviewersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).ListReviewers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_ListReviewers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).ListReviewers(ctx, req.(*ListReviewersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ReviewerService_PreviewReviewers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewReviewersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReviewerServiceServer).PreviewReviewers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ReviewerService_PreviewReviewers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReviewerServiceServer).PreviewReviewers(ctx, req.(*PreviewReviewersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ReviewerService_ServiceDesc is the grpc.ServiceDesc for ReviewerService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ReviewerService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "prassignment.v1.ReviewerService",
+	HandlerType: (*ReviewerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ReassignReviewer",
+			Handler:    _ReviewerService_ReassignReviewer_Handler,
+		},
+		{
+			MethodName: "AssignReviewer",
+			Handler:    _ReviewerService_AssignReviewer_Handler,
+		},
+		{
+			MethodName: "ListReviewers",
+			Handler:    _ReviewerService_ListReviewers_Handler,
+		},
+		{
+			MethodName: "PreviewReviewers",
+			Handler:    _ReviewerService_PreviewReviewers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "prassignment/v1/prassignment.proto",
+}
+
+const (
+	StatisticsService_GetAssignmentStats_FullMethodName  = "/prassignment.v1.StatisticsService/GetAssignmentStats"
+	StatisticsService_GetPullRequestStats_FullMethodName = "/prassignment.v1.StatisticsService/GetPullRequestStats"
+	StatisticsService_GetTeamStats_FullMethodName        = "/prassignment.v1.StatisticsService/GetTeamStats"
+	StatisticsService_GetFairness_FullMethodName         = "/prassignment.v1.StatisticsService/GetFairness"
+	StatisticsService_GetWorkload_FullMethodName         = "/prassignment.v1.StatisticsService/GetWorkload"
+)
+
+// StatisticsServiceClient is the client API for StatisticsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// StatisticsService предоставляет статистику
+type StatisticsServiceClient interface {
+	// GetAssignmentStats - назначения по пользователям (GET /statistics/assignments)
+	GetAssignmentStats(ctx context.Context, in *GetAssignmentStatsRequest, opts ...grpc.CallOption) (*GetAssignmentStatsResponse, error)
+	// GetPullRequestStats - PR, созданные в окне (GET /statistics/pullRequests)
+	GetPullRequestStats(ctx context.Context, in *GetPullRequestStatsRequest, opts ...grpc.CallOption) (*GetPullRequestStatsResponse, error)
+	// GetTeamStats - статистика команд за окно (GET /statistics/teams)
+	GetTeamStats(ctx context.Context, in *GetTeamStatsRequest, opts ...grpc.CallOption) (*GetTeamStatsResponse, error)
+	// GetFairness - неравенство назначений внутри команд (GET /statistics/fairness)
+	GetFairness(ctx context.Context, in *GetFairnessRequest, opts ...grpc.CallOption) (*FairnessReport, error)
+	// GetWorkload - открытые ревью пользователей (GET /statistics/workload)
+	GetWorkload(ctx context.Context, in *GetWorkloadRequest, opts ...grpc.CallOption) (*GetWorkloadResponse, error)
+}
+
+type statisticsServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewStatisticsServiceClient(cc grpc.ClientConnInterface) StatisticsServiceClient {
+	return &statisticsServiceClient{cc}
+}
+
+func (c *statisticsServiceClient) GetAssignmentStats(ctx context.Context, in *GetAssignmentStatsRequest, opts ...grpc.CallOption) (*GetAssignmentStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssignmentStatsResponse)
+	err := c.cc.Invoke(ctx, StatisticsService_GetAssignmentStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statisticsServiceClient) GetPullRequestStats(ctx context.Context, in *GetPullRequestStatsRequest, opts ...grpc.CallOption) (*GetPullRequestStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPullRequestStatsResponse)
+	err := c.cc.Invoke(ctx, StatisticsService_GetPullRequestStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statisticsServiceClient) GetTeamStats(ctx context.Context, in *GetTeamStatsRequest, opts ...grpc.CallOption) (*GetTeamStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetTeamStatsResponse)
+	err := c.cc.Invoke(ctx, StatisticsService_GetTeamStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statisticsServiceClient) GetFairness(ctx context.Context, in *GetFairnessRequest, opts ...grpc.CallOption) (*FairnessReport, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FairnessReport)
+	err := c.cc.Invoke(ctx, StatisticsService_GetFairness_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statisticsServiceClient) GetWorkload(ctx context.Context, in *GetWorkloadRequest, opts ...grpc.CallOption) (*GetWorkloadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWorkloadResponse)
+	err := c.cc.Invoke(ctx, StatisticsService_GetWorkload_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// StatisticsServiceServer is the server API for StatisticsService service.
+// All implementations must embed UnimplementedStatisticsServiceServer
+// for forward compatibility.
+//
+// StatisticsService предоставляет статистику
+type StatisticsServiceServer interface {
+	// GetAssignmentStats - назначения по пользователям (GET /statistics/assignments)
+	GetAssignmentStats(context.Context, *GetAssignmentStatsRequest) (*GetAssignmentStatsResponse, error)
+	// GetPullRequestStats - PR, созданные в окне (GET /statistics/pullRequests)
+	GetPullRequestStats(context.Context, *GetPullRequestStatsRequest) (*GetPullRequestStatsResponse, error)
+	// GetTeamStats - статистика команд за окно (GET /statistics/teams)
+	GetTeamStats(context.Context, *GetTeamStatsRequest) (*GetTeamStatsResponse, error)
+	// GetFairness - неравенство назначений внутри команд (GET /statistics/fairness)
+	GetFairness(context.Context, *GetFairnessRequest) (*FairnessReport, error)
+	// GetWorkload - открытые ревью пользователей (GET /statistics/workload)
+	GetWorkload(context.Context, *GetWorkloadRequest) (*GetWorkloadResponse, error)
+	mustEmbedUnimplementedStatisticsServiceServer()
+}
+
+// UnimplementedStatisticsServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedStatisticsServiceServer struct{}
+
+func (UnimplementedStatisticsServiceServer) GetAssignmentStats(context.Context, *GetAssignmentStatsRequest) (*GetAssignmentStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssignmentStats not implemented")
+}
+func (UnimplementedStatisticsServiceServer) GetPullRequestStats(context.Context, *GetPullRequestStatsRequest) (*GetPullRequestStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPullRequestStats not implemented")
+}
+func (UnimplementedStatisticsServiceServer) GetTeamStats(context.Context, *GetTeamStatsRequest) (*GetTeamStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTeamStats not implemented")
+}
+func (UnimplementedStatisticsServiceServer) GetFairness(context.Context, *GetFairnessRequest) (*FairnessReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFairness not implemented")
+}
+func (UnimplementedStatisticsServiceServer) GetWorkload(context.Context, *GetWorkloadRequest) (*GetWorkloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkload not implemented")
+}
+func (UnimplementedStatisticsServiceServer) mustEmbedUnimplementedStatisticsServiceServer() {}
+func (UnimplementedStatisticsServiceServer) testEmbeddedByValue()                           {}
+
+// UnsafeStatisticsServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to StatisticsServiceServer will
+// result in compilation errors.
+type UnsafeStatisticsServiceServer interface {
+	mustEmbedUnimplementedStatisticsServiceServer()
+}
+
+func RegisterStatisticsServiceServer(s grpc.ServiceRegistrar, srv StatisticsServiceServer) {
+	// If the following call pancis, it indicates UnimplementedStatisticsServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&StatisticsService_ServiceDesc, srv)
+}
+
+func _StatisticsService_GetAssignmentStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssignmentStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatisticsServiceServer).GetAssignmentStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatisticsService_GetAssignmentStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatisticsServiceServer).GetAssignmentStats(ctx, req.(*GetAssignmentStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatisticsService_GetPullRequestStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPullRequestStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatisticsServiceServer).GetPullRequestStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatisticsService_GetPullRequestStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatisticsServiceServer).GetPullRequestStats(ctx, req.(*GetPullRequestStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatisticsService_GetTeamStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatisticsServiceServer).GetTeamStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatisticsService_GetTeamStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatisticsServiceServer).GetTeamStats(ctx, req.(*GetTeamStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatisticsService_GetFairness_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFairnessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatisticsServiceServer).GetFairness(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatisticsService_GetFairness_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatisticsServiceServer).GetFairness(ctx, req.(*GetFairnessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatisticsService_GetWorkload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatisticsServiceServer).GetWorkload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: StatisticsService_GetWorkload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatisticsServiceServer).GetWorkload(ctx, req.(*GetWorkloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// StatisticsService_ServiceDesc is the grpc.ServiceDesc for StatisticsService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var StatisticsService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "prassignment.v1.StatisticsService",
+	HandlerType: (*StatisticsServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAssignmentStats",
+			Handler:    _StatisticsService_GetAssignmentStats_Handler,
+		},
+		{
+			MethodName: "GetPullRequestStats",
+			Handler:    _StatisticsService_GetPullRequestStats_Handler,
+		},
+		{
+			MethodName: "GetTeamStats",
+			Handler:    _StatisticsService_GetTeamStats_Handler,
+		},
+		{
+			MethodName: "GetFairness",
+			Handler:    _StatisticsService_GetFairness_Handler,
+		},
+		{
+			MethodName: "GetWorkload",
+			Handler:    _StatisticsService_GetWorkload_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "prassignment/v1/prassignment.proto",
+}
+
+const (
+	EventService_StreamEvents_FullMethodName = "/prassignment.v1.EventService/StreamEvents"
+)
+
+// EventServiceClient is the client API for EventService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// EventService отдает поток событий назначения
+type EventServiceClient interface {
+	// StreamEvents отправляет новые события назначения (GET /events/stream).
+	// С last_event_id сначала отправляются сохраненные события после него.
+	StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AssignmentEvent], error)
+}
+
+type eventServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewEventServiceClient(cc grpc.ClientConnInterface) EventServiceClient {
+	return &eventServiceClient{cc}
+}
+
+func (c *eventServiceClient) StreamEvents(ctx context.Context, in *StreamEventsRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AssignmentEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &EventService_ServiceDesc.Streams[0], EventService_StreamEvents_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[StreamEventsRequest, AssignmentEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EventService_StreamEventsClient = grpc.ServerStreamingClient[AssignmentEvent]
+
+// EventServiceServer is the server API for EventService service.
+// All implementations must embed UnimplementedEventServiceServer
+// for forward compatibility.
+//
+// EventService отдает поток событий назначения
+type EventServiceServer interface {
+	// StreamEvents отправляет новые события назначения (GET /events/stream).
+	// С last_event_id сначала отправляются сохраненные события после него.
+	StreamEvents(*StreamEventsRequest, grpc.ServerStreamingServer[AssignmentEvent]) error
+	mustEmbedUnimplementedEventServiceServer()
+}
+
+// UnimplementedEventServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedEventServiceServer struct{}
+
+func (UnimplementedEventServiceServer) StreamEvents(*StreamEventsRequest, grpc.ServerStreamingServer[AssignmentEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamEvents not implemented")
+}
+func (UnimplementedEventServiceServer) mustEmbedUnimplementedEventServiceServer() {}
+func (UnimplementedEventServiceServer) testEmbeddedByValue()                      {}
+
+// UnsafeEventServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to EventServiceServer will
+// result in compilation errors.
+type UnsafeEventServiceServer interface {
+	mustEmbedUnimplementedEventServiceServer()
+}
+
+func RegisterEventServiceServer(s grpc.ServiceRegistrar, srv EventServiceServer) {
+	// If the following call pancis, it indicates UnimplementedEventServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&EventService_ServiceDesc, srv)
+}
+
+func _EventService_StreamEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(EventServiceServer).StreamEvents(m, &grpc.GenericServerStream[StreamEventsRequest, AssignmentEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type EventService_StreamEventsServer = grpc.ServerStreamingServer[AssignmentEvent]
+
+// EventService_ServiceDesc is the grpc.ServiceDesc for EventService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var EventService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "prassignment.v1.EventService",
+	HandlerType: (*EventServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamEvents",
+			Handler:       _EventService_StreamEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "prassignment/v1/prassignment.proto",
+}
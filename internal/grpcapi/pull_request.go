@@ -0,0 +1,253 @@
+package grpcapi
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/api"
+	pb "github.com/AtoyanMikhail/PRAssignmentService/internal/grpcapi/prassignmentv1"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/service"
+)
+
+// CreatePullRequest создает PR и автоматически назначает ревьюеров
+func (s *Server) CreatePullRequest(ctx context.Context, req *pb.CreatePullRequestRequest) (*pb.PullRequest, error) {
+	err := requireFields(
+		"pull_request_id", req.GetPullRequestId(),
+		"pull_request_name", req.GetPullRequestName(),
+		"author_id", req.GetAuthorId(),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := service.ReviewerOptions{
+		Count:        int(req.GetReviewerCount()),
+		Preferred:    req.GetPreferredReviewers(),
+		Excluded:     req.GetExcludedReviewers(),
+		RequiredTags: req.GetRequiredTags(),
+	}
+	pr, reviewers, err := s.services.PullRequest.CreateWithReviewers(ctx, req.GetPullRequestId(), req.GetPullRequestName(), req.GetAuthorId(), opts)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := toPullRequest(pr)
+	for _, r := range reviewers {
+		resp.AssignedReviewers = append(resp.AssignedReviewers, r.UserID)
+		resp.ReviewerAssignments = append(resp.ReviewerAssignments, toReviewerAssignment(r.UserID, nil, r.Assignment, r.AssignedAt))
+	}
+	return resp, nil
+}
+
+// MergePullRequest помечает PR как MERGED. Повторный вызов возвращает тот же PR.
+func (s *Server) MergePullRequest(ctx context.Context, req *pb.MergePullRequestRequest) (*pb.PullRequest, error) {
+	if err := requireFields("pull_request_id", req.GetPullRequestId()); err != nil {
+		return nil, err
+	}
+
+	pr, err := s.services.PullRequest.MergePR(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := toPullRequest(pr)
+	reviewers, _ := s.services.Reviewer.GetPRReviewers(ctx, pr.PullRequestID)
+	for _, r := range reviewers {
+		resp.AssignedReviewers = append(resp.AssignedReviewers, r.UserID)
+	}
+	resp.ReviewerAssignments = toReviewerInfoAssignments(reviewers)
+	resp.RequiredTags, err = s.services.Skill.GetRequiredTags(ctx, pr.PullRequestID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return resp, nil
+}
+
+// ReassignReviewer заменяет ревьюера указанным или выбранным автоматически
+func (s *Server) ReassignReviewer(ctx context.Context, req *pb.ReassignReviewerRequest) (*pb.ReassignReviewerResponse, error) {
+	err := requireFields("pull_request_id", req.GetPullRequestId(), "old_user_id", req.GetOldUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	override := service.Override{Enabled: req.GetOverride(), Reason: req.GetOverrideReason()}
+	if override.Enabled && !isAdmin(ctx) {
+		return nil, newStatus(codes.PermissionDenied, api.FORBIDDEN, "Override requires admin access")
+	}
+	err = s.services.Reviewer.ReplaceReviewer(actorContext(ctx), req.GetPullRequestId(), req.GetOldUserId(), req.GetNewUserId(), override)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.ReassignReviewerResponse{}, nil
+}
+
+// AssignReviewer вручную назначает ревьюера на PR
+func (s *Server) AssignReviewer(ctx context.Context, req *pb.AssignReviewerRequest) (*pb.ReviewerAssignment, error) {
+	err := requireFields("pull_request_id", req.GetPullRequestId(), "user_id", req.GetUserId())
+	if err != nil {
+		return nil, err
+	}
+
+	override := service.Override{Enabled: req.GetOverride(), Reason: req.GetOverrideReason()}
+	if override.Enabled && !isAdmin(ctx) {
+		return nil, newStatus(codes.PermissionDenied, api.FORBIDDEN, "Override requires admin access")
+	}
+	reviewer, err := s.services.Reviewer.AssignReviewer(actorContext(ctx), req.GetPullRequestId(), req.GetUserId(), override)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toReviewerAssignment(reviewer.UserID, nil, reviewer.Assignment, reviewer.AssignedAt), nil
+}
+
+// ListReviewers возвращает ревьюеров PR с причинами назначения
+func (s *Server) ListReviewers(ctx context.Context, req *pb.ListReviewersRequest) (*pb.ListReviewersResponse, error) {
+	if err := requireFields("pull_request_id", req.GetPullRequestId()); err != nil {
+		return nil, err
+	}
+
+	reviewers, err := s.services.Reviewer.GetPRReviewers(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.ListReviewersResponse{
+		PullRequestId: req.GetPullRequestId(),
+		Reviewers:     toReviewerInfoAssignments(reviewers),
+	}, nil
+}
+
+// GetRequiredTags возвращает навыки, которые нужны для ревью PR
+func (s *Server) GetRequiredTags(ctx context.Context, req *pb.GetRequiredTagsRequest) (*pb.PullRequestTags, error) {
+	if err := requireFields("pull_request_id", req.GetPullRequestId()); err != nil {
+		return nil, err
+	}
+
+	tags, err := s.services.Skill.GetRequiredTags(ctx, req.GetPullRequestId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.PullRequestTags{PullRequestId: req.GetPullRequestId(), RequiredTags: tags}, nil
+}
+
+// SetRequiredTags заменяет навыки, которые нужны для ревью PR
+func (s *Server) SetRequiredTags(ctx context.Context, req *pb.SetRequiredTagsRequest) (*pb.PullRequestTags, error) {
+	if err := requireFields("pull_request_id", req.GetPullRequestId()); err != nil {
+		return nil, err
+	}
+
+	tags, err := s.services.Skill.SetRequiredTags(ctx, req.GetPullRequestId(), req.GetRequiredTags())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.PullRequestTags{PullRequestId: req.GetPullRequestId(), RequiredTags: tags}, nil
+}
+
+// PreviewReviewers показывает, кого назначит сервис, ничего не записывая
+func (s *Server) PreviewReviewers(ctx context.Context, req *pb.PreviewReviewersRequest) (*pb.ReviewerPreview, error) {
+	if err := requireFields("author_id", req.GetAuthorId()); err != nil {
+		return nil, err
+	}
+
+	preview, err := s.services.Reviewer.PreviewReviewers(ctx, service.PreviewRequest{
+		AuthorID:      req.GetAuthorId(),
+		PullRequestID: req.GetPullRequestId(),
+		Count:         int(req.GetReviewerCount()),
+		Excluded:      req.GetExcludedReviewers(),
+		RequiredTags:  req.GetRequiredTags(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.ReviewerPreview{
+		AuthorId:      preview.Author.UserID,
+		TeamName:      preview.Author.TeamName,
+		Strategy:      preview.Strategy,
+		PolicyVersion: preview.PolicyVersion,
+		ReviewerCount: int32(preview.Count),
+		RequiredTags:  preview.RequiredTags,
+		Mentorship:    preview.Mentorship,
+		Selected:      make([]string, 0, len(preview.Selected)),
+		Candidates:    make([]*pb.RankedCandidate, 0, len(preview.Ranked)),
+		Excluded:      make([]*pb.ExcludedCandidate, 0, len(preview.Excluded)),
+	}
+	for _, user := range preview.Selected {
+		resp.Selected = append(resp.Selected, user.UserID)
+	}
+	for i, candidate := range preview.Ranked {
+		breakdown := make([]*pb.ScoreComponent, 0, len(candidate.Breakdown))
+		for _, component := range candidate.Breakdown {
+			breakdown = append(breakdown, &pb.ScoreComponent{Name: component.Name, Value: component.Value})
+		}
+		resp.Candidates = append(resp.Candidates, &pb.RankedCandidate{
+			Rank:      int32(i + 1),
+			UserId:    candidate.User.UserID,
+			Username:  candidate.User.Username,
+			Workload:  candidate.Workload,
+			Seniority: string(candidate.User.Seniority),
+			Score:     candidate.Score,
+			Breakdown: breakdown,
+		})
+	}
+	for _, excluded := range preview.Excluded {
+		resp.Excluded = append(resp.Excluded, &pb.ExcludedCandidate{
+			UserId:   excluded.User.UserID,
+			Username: excluded.User.Username,
+			Workload: excluded.Workload,
+			Reason:   excluded.Reason,
+		})
+	}
+	return resp, nil
+}
+
+// actorContext добавляет инициатора операции для журнала аудита
+func actorContext(ctx context.Context) context.Context {
+	return service.WithActor(ctx, ClientIdentity(ctx))
+}
+
+func toPullRequest(pr models.PullRequest) *pb.PullRequest {
+	return &pb.PullRequest{
+		PullRequestId:       pr.PullRequestID,
+		PullRequestName:     pr.PullRequestName,
+		AuthorId:            pr.AuthorID,
+		Status:              string(pr.Status),
+		AssignedReviewers:   []string{},
+		ReviewerAssignments: []*pb.ReviewerAssignment{},
+		RequiredTags:        pr.RequiredTags,
+		CreatedAt:           timestamppb.New(pr.CreatedAt),
+		MergedAt:            toTimestamp(pr.MergedAt),
+	}
+}
+
+func toReviewerAssignment(userID string, username *string, assignment models.Assignment, assignedAt time.Time) *pb.ReviewerAssignment {
+	return &pb.ReviewerAssignment{
+		UserId:               userID,
+		Username:             username,
+		Source:               string(assignment.Source),
+		Strategy:             assignment.StrategyToDB(),
+		WorkloadAtAssignment: assignment.WorkloadAtAssignment,
+		TieBreak:             assignment.TieBreak,
+		AssignedAt:           timestamppb.New(assignedAt),
+	}
+}
+
+func toReviewerInfoAssignments(reviewers []models.ReviewerInfo) []*pb.ReviewerAssignment {
+	assignments := make([]*pb.ReviewerAssignment, 0, len(reviewers))
+	for _, r := range reviewers {
+		username := r.Username
+		assignments = append(assignments, toReviewerAssignment(r.UserID, &username, r.Assignment, r.AssignedAt))
+	}
+	return assignments
+}
+
+// toTimestamp преобразует необязательное время, nil остается пустым полем
+func toTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
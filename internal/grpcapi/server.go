@@ -0,0 +1,63 @@
+package grpcapi
+
+import (
+	"context"
+	"net"
+
+	"google.golang.org/grpc"
+
+	pb "github.com/AtoyanMikhail/PRAssignmentService/internal/grpcapi/prassignmentv1"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/service"
+)
+
+// Server реализует сервисы gRPC API поверх тех же сервисов, что и REST API
+type Server struct {
+	pb.UnimplementedTeamServiceServer
+	pb.UnimplementedUserServiceServer
+	pb.UnimplementedPullRequestServiceServer
+	pb.UnimplementedReviewerServiceServer
+	pb.UnimplementedStatisticsServiceServer
+	pb.UnimplementedEventServiceServer
+
+	services *service.Services
+}
+
+// NewServer создает обработчик gRPC API
+func NewServer(services *service.Services) *Server {
+	return &Server{services: services}
+}
+
+// Register регистрирует все сервисы gRPC API
+func (s *Server) Register(r grpc.ServiceRegistrar) {
+	pb.RegisterTeamServiceServer(r, s)
+	pb.RegisterUserServiceServer(r, s)
+	pb.RegisterPullRequestServiceServer(r, s)
+	pb.RegisterReviewerServiceServer(r, s)
+	pb.RegisterStatisticsServiceServer(r, s)
+	pb.RegisterEventServiceServer(r, s)
+}
+
+// Serve принимает соединения gRPC на addr до остановки srv
+func Serve(srv *grpc.Server, addr string) error {
+	ln, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	return srv.Serve(ln)
+}
+
+// Shutdown дожидается завершения активных вызовов. Потоки событий не
+// заканчиваются сами, поэтому по истечении ctx соединения закрываются.
+func Shutdown(ctx context.Context, srv *grpc.Server) {
+	done := make(chan struct{})
+	go func() {
+		srv.GracefulStop()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-ctx.Done():
+		srv.Stop()
+	}
+}
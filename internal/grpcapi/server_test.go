@@ -0,0 +1,278 @@
+package grpcapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/config"
+	pb "github.com/AtoyanMikhail/PRAssignmentService/internal/grpcapi/prassignmentv1"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/logger"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/policy"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/ratelimit"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/service"
+)
+
+// fakeTeamService возвращает заданную ошибку на любой запрос команды
+type fakeTeamService struct {
+	service.TeamService
+	err error
+}
+
+func (s *fakeTeamService) GetTeam(context.Context, string) (models.Team, error) {
+	return models.Team{}, s.err
+}
+
+// fakeEventRepository хранит журнал событий в памяти
+type fakeEventRepository struct {
+	events []models.Event
+}
+
+func (r *fakeEventRepository) ListEventsAfter(_ context.Context, afterID int64, filter models.EventFilter, limit int) ([]models.Event, error) {
+	var events []models.Event
+	for _, e := range r.events {
+		if e.ID > afterID && filter.Matches(e) && len(events) < limit {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func (r *fakeEventRepository) ListEventsByIDs(context.Context, []int64) ([]models.Event, error) {
+	return nil, nil
+}
+
+func (r *fakeEventRepository) GetLatestEventID(context.Context) (int64, error) {
+	return int64(len(r.events)), nil
+}
+
+func (r *fakeEventRepository) DeleteEventsBefore(context.Context, time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (r *fakeEventRepository) ListenEvents(context.Context, time.Duration, func() error) error {
+	return nil
+}
+
+// newTestClient поднимает gRPC API в памяти и возвращает соединение с ним
+func newTestClient(t *testing.T, services *service.Services, interceptors Interceptors) *grpc.ClientConn {
+	t.Helper()
+
+	ln := bufconn.Listen(1 << 20)
+	interceptors.Log = logger.New(io.Discard, "error", "json")
+	srv := grpc.NewServer(interceptors.ServerOptions()...)
+	NewServer(services).Register(srv)
+	go func() { _ = srv.Serve(ln) }()
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return ln.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
+
+// errorReason возвращает код ошибки REST API из google.rpc.ErrorInfo
+func errorReason(t *testing.T, err error) string {
+	t.Helper()
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			assert.Equal(t, ErrorDomain, info.GetDomain())
+			return info.GetReason()
+		}
+	}
+	return ""
+}
+
+func TestToStatus(t *testing.T) {
+	tests := []struct {
+		err    error
+		code   codes.Code
+		reason string
+	}{
+		{service.ErrPullRequestAlreadyExists, codes.AlreadyExists, "PR_EXISTS"},
+		{fmt.Errorf("assign: %w", service.ErrReviewerBlocked), codes.FailedPrecondition, "REVIEWER_BLOCKED"},
+		{service.ErrInvalidStatus, codes.FailedPrecondition, "PR_MERGED"},
+		{service.ErrReviewerNotAssigned, codes.InvalidArgument, "NOT_ASSIGNED"},
+		{service.ErrNoActiveReviewers, codes.NotFound, "NO_CANDIDATE"},
+		{service.ErrCannotAssignAuthor, codes.InvalidArgument, "INVALID_REVIEWERS"},
+		{service.ErrInvalidTimeWindow, codes.InvalidArgument, "INVALID_TIME_RANGE"},
+		{service.ErrUserNotFound, codes.NotFound, "NOT_FOUND"},
+		{context.Canceled, codes.Canceled, ""},
+		{fmt.Errorf("connection reset"), codes.Internal, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			err := toStatus(tt.err)
+			assert.Equal(t, tt.code, status.Code(err))
+			assert.Equal(t, tt.reason, errorReason(t, err))
+		})
+	}
+}
+
+func TestServer_Errors(t *testing.T) {
+	services := &service.Services{Team: &fakeTeamService{err: service.ErrTeamNotFound}}
+	client := pb.NewTeamServiceClient(newTestClient(t, services, Interceptors{}))
+
+	_, err := client.GetTeam(context.Background(), &pb.GetTeamRequest{})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "VALIDATION_ERROR", errorReason(t, err))
+
+	_, err = client.GetTeam(context.Background(), &pb.GetTeamRequest{TeamName: "  "})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "VALIDATION_ERROR", errorReason(t, err))
+
+	_, err = client.GetTeam(context.Background(), &pb.GetTeamRequest{TeamName: strings.Repeat("a", 256)})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	assert.Equal(t, "VALIDATION_ERROR", errorReason(t, err))
+
+	_, err = client.GetTeam(context.Background(), &pb.GetTeamRequest{TeamName: "backend"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	assert.Equal(t, "Team not found", status.Convert(err).Message())
+	assert.Equal(t, "NOT_FOUND", errorReason(t, err))
+}
+
+func TestServer_RequiredFields(t *testing.T) {
+	conn := newTestClient(t, &service.Services{}, Interceptors{})
+	users := pb.NewUserServiceClient(conn)
+	ctx := context.Background()
+
+	calls := map[string]func() error{
+		"SetMentorship": func() error {
+			_, err := pb.NewTeamServiceClient(conn).SetMentorship(ctx, &pb.SetMentorshipRequest{Enabled: true})
+			return err
+		},
+		"SetSeniority": func() error {
+			_, err := users.SetSeniority(ctx, &pb.SetSeniorityRequest{UserId: "u1"})
+			return err
+		},
+		"SetSkills": func() error {
+			_, err := users.SetSkills(ctx, &pb.SetSkillsRequest{UserId: " "})
+			return err
+		},
+		"GetDashboard": func() error {
+			_, err := users.GetDashboard(ctx, &pb.GetDashboardRequest{})
+			return err
+		},
+		"AddUnavailability": func() error {
+			_, err := users.AddUnavailability(ctx, &pb.AddUnavailabilityRequest{UserId: "u1"})
+			return err
+		},
+		"SetRequiredTags": func() error {
+			_, err := pb.NewPullRequestServiceClient(conn).SetRequiredTags(ctx, &pb.SetRequiredTagsRequest{})
+			return err
+		},
+		"PreviewReviewers": func() error {
+			_, err := pb.NewReviewerServiceClient(conn).PreviewReviewers(ctx, &pb.PreviewReviewersRequest{})
+			return err
+		},
+	}
+	for name, call := range calls {
+		t.Run(name, func(t *testing.T) {
+			err := call()
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Equal(t, "VALIDATION_ERROR", errorReason(t, err))
+		})
+	}
+}
+
+func TestServer_OverrideRequiresAdmin(t *testing.T) {
+	client := pb.NewReviewerServiceClient(newTestClient(t, &service.Services{}, Interceptors{}))
+
+	_, err := client.AssignReviewer(context.Background(), &pb.AssignReviewerRequest{
+		PullRequestId: "pr-1",
+		UserId:        "u2",
+		Override:      true,
+	})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.Equal(t, "FORBIDDEN", errorReason(t, err))
+}
+
+func TestInterceptors_Begin(t *testing.T) {
+	policies, err := policy.NewStore(config.Default().Assignment)
+	require.NoError(t, err)
+	var logs bytes.Buffer
+	i := Interceptors{Log: logger.New(&logs, "info", "json"), Policies: policies}
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(requestIDKey, "evil\u00a0id"))
+	ctx, finish := i.begin(ctx, "/test")
+
+	// Обновление политики во время запроса не меняет его снимок
+	_, err = policies.Update(config.Default().Assignment, policy.SourceAdmin)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), policies.FromContext(ctx).Version)
+
+	var callErr error
+	finish(nil, &callErr)
+	var entry map[string]any
+	require.NoError(t, json.Unmarshal(logs.Bytes(), &entry))
+	assert.Len(t, entry["request_id"], 36, "непечатный идентификатор заменен на UUID")
+}
+
+func TestServer_RateLimit(t *testing.T) {
+	limiter, err := ratelimit.New(config.RateLimitConfig{Enabled: true, RPS: 0.001, Burst: 1, IdleTimeout: time.Minute})
+	require.NoError(t, err)
+
+	services := &service.Services{Team: &fakeTeamService{err: service.ErrTeamNotFound}}
+	client := pb.NewTeamServiceClient(newTestClient(t, services, Interceptors{Limiter: limiter}))
+
+	_, err = client.GetTeam(context.Background(), &pb.GetTeamRequest{TeamName: "backend"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.GetTeam(context.Background(), &pb.GetTeamRequest{TeamName: "backend"})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, "RATE_LIMITED", errorReason(t, err))
+}
+
+func TestServer_StreamEvents(t *testing.T) {
+	repo := &fakeEventRepository{}
+	for i, user := range []string{"u1", "u2", "u1"} {
+		repo.events = append(repo.events, models.Event{
+			ID:      int64(i + 1),
+			Type:    models.EventReviewerAssigned,
+			UserID:  &user,
+			Details: map[string]any{"strategy": "least_loaded"},
+		})
+	}
+	services := &service.Services{Events: service.NewEventService(repo, nil)}
+	client := pb.NewEventServiceClient(newTestClient(t, services, Interceptors{}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	lastID := int64(0)
+	stream, err := client.StreamEvents(ctx, &pb.StreamEventsRequest{UserId: "u1", LastEventId: &lastID})
+	require.NoError(t, err)
+
+	// Сохраненные события после last_event_id приходят по порядку
+	for _, id := range []int64{1, 3} {
+		e, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, id, e.GetId())
+		assert.Equal(t, "reviewer.assigned", e.GetType())
+		assert.Equal(t, "u1", e.GetUserId())
+		assert.Equal(t, "least_loaded", e.GetDetails().AsMap()["strategy"])
+	}
+}
@@ -0,0 +1,152 @@
+package grpcapi
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pb "github.com/AtoyanMikhail/PRAssignmentService/internal/grpcapi/prassignmentv1"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/service"
+)
+
+// GetAssignmentStats возвращает статистику назначений по пользователям
+func (s *Server) GetAssignmentStats(ctx context.Context, _ *pb.GetAssignmentStatsRequest) (*pb.GetAssignmentStatsResponse, error) {
+	stats, err := s.services.Statistics.GetAssignmentStats(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.GetAssignmentStatsResponse{Statistics: make([]*pb.AssignmentStats, 0, len(stats))}
+	for _, st := range stats {
+		resp.Statistics = append(resp.Statistics, &pb.AssignmentStats{
+			UserId:           st.UserID,
+			Username:         st.Username,
+			TeamName:         st.TeamName,
+			TotalAssignments: st.TotalAssignments,
+			OpenPrs:          st.OpenPRs,
+			MergedPrs:        st.MergedPRs,
+		})
+	}
+	return resp, nil
+}
+
+// GetPullRequestStats возвращает статистику по PR, созданным в окне
+func (s *Server) GetPullRequestStats(ctx context.Context, req *pb.GetPullRequestStatsRequest) (*pb.GetPullRequestStatsResponse, error) {
+	stats, err := s.services.Statistics.GetPRStats(ctx, toTimeWindow(req.GetWindow()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.GetPullRequestStatsResponse{Statistics: make([]*pb.PullRequestStats, 0, len(stats))}
+	for _, st := range stats {
+		resp.Statistics = append(resp.Statistics, &pb.PullRequestStats{
+			PullRequestId:   st.PullRequestID,
+			PullRequestName: st.PullRequestName,
+			AuthorId:        st.AuthorID,
+			Status:          string(st.Status),
+			ReviewersCount:  st.ReviewersCount,
+			CreatedAt:       timestamppb.New(st.CreatedAt),
+			MergedAt:        toTimestamp(st.MergedAt),
+		})
+	}
+	return resp, nil
+}
+
+// GetTeamStats возвращает статистику по командам за окно
+func (s *Server) GetTeamStats(ctx context.Context, req *pb.GetTeamStatsRequest) (*pb.GetTeamStatsResponse, error) {
+	stats, err := s.services.Statistics.GetTeamStats(ctx, toTimeWindow(req.GetWindow()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.GetTeamStatsResponse{Statistics: make([]*pb.TeamStats, 0, len(stats))}
+	for _, st := range stats {
+		resp.Statistics = append(resp.Statistics, &pb.TeamStats{
+			TeamName:          st.TeamName,
+			TotalMembers:      st.TotalMembers,
+			ActiveMembers:     st.ActiveMembers,
+			PrsAuthored:       st.PRsAuthored,
+			PrsMerged:         st.PRsMerged,
+			PrsReviewed:       st.PRsReviewed,
+			ReviewAssignments: st.ReviewAssignments,
+		})
+	}
+	return resp, nil
+}
+
+// GetFairness возвращает неравенство назначений внутри команд
+func (s *Server) GetFairness(ctx context.Context, req *pb.GetFairnessRequest) (*pb.FairnessReport, error) {
+	tolerance := service.DefaultFairnessTolerance
+	if req.Tolerance != nil {
+		tolerance = req.GetTolerance()
+	}
+
+	teams, err := s.services.Statistics.GetFairness(ctx, req.GetTeamName(), toTimeWindow(req.GetWindow()), tolerance)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.FairnessReport{Tolerance: tolerance, Teams: make([]*pb.TeamFairness, 0, len(teams))}
+	for _, team := range teams {
+		members := make([]*pb.ReviewerFairness, 0, len(team.Members))
+		for _, m := range team.Members {
+			members = append(members, &pb.ReviewerFairness{
+				UserId:              m.UserID,
+				Username:            m.Username,
+				IsActive:            m.IsActive,
+				Assignments:         m.Assignments,
+				TieBreakAssignments: m.TieBreakAssignments,
+				Deviation:           m.Deviation,
+				RelativeDeviation:   m.RelativeDeviation,
+				Status:              string(m.Status),
+			})
+		}
+		resp.Teams = append(resp.Teams, &pb.TeamFairness{
+			TeamName:             team.TeamName,
+			Reviewers:            int32(team.Reviewers),
+			TotalAssignments:     team.TotalAssignments,
+			Mean:                 team.Mean,
+			Gini:                 team.Gini,
+			GiniWithoutTieBreaks: team.GiniWithoutTieBreaks,
+			MaxMinRatio:          team.MaxMinRatio,
+			PolicyAssignments:    team.PolicyAssignments,
+			TieBreakAssignments:  team.TieBreakAssignments,
+			TieBreakShare:        team.TieBreakShare,
+			Members:              members,
+		})
+	}
+	return resp, nil
+}
+
+// GetWorkload возвращает рабочую нагрузку пользователей
+func (s *Server) GetWorkload(ctx context.Context, _ *pb.GetWorkloadRequest) (*pb.GetWorkloadResponse, error) {
+	workload, err := s.services.Statistics.GetUserWorkload(ctx)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.GetWorkloadResponse{Workload: make([]*pb.UserWorkload, 0, len(workload))}
+	for _, w := range workload {
+		resp.Workload = append(resp.Workload, &pb.UserWorkload{
+			UserId:           w.UserID,
+			Username:         w.Username,
+			TeamName:         w.TeamName,
+			IsActive:         w.IsActive,
+			OpenReviewsCount: w.OpenReviewsCount,
+		})
+	}
+	return resp, nil
+}
+
+// toTimeWindow преобразует окно запроса, пустая граница не ограничивает окно
+func toTimeWindow(w *pb.TimeWindow) models.TimeWindow {
+	var window models.TimeWindow
+	if w.GetFrom() != nil {
+		window.From = w.GetFrom().AsTime()
+	}
+	if w.GetTo() != nil {
+		window.To = w.GetTo().AsTime()
+	}
+	return window
+}
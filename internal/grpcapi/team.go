@@ -0,0 +1,121 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	pb "github.com/AtoyanMikhail/PRAssignmentService/internal/grpcapi/prassignmentv1"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/service"
+)
+
+// AddTeam создает команду с участниками. Существующие пользователи
+// переводятся в команду и обновляются, как в POST /team/add.
+func (s *Server) AddTeam(ctx context.Context, req *pb.AddTeamRequest) (*pb.AddTeamResponse, error) {
+	if err := requireFields("team_name", req.GetTeamName()); err != nil {
+		return nil, err
+	}
+	for _, member := range req.GetMembers() {
+		if err := requireFields("user_id", member.GetUserId(), "username", member.GetUsername()); err != nil {
+			return nil, err
+		}
+	}
+
+	team, err := s.services.Team.GetTeam(ctx, req.GetTeamName())
+	if errors.Is(err, service.ErrTeamNotFound) {
+		team, err = s.services.Team.CreateTeam(ctx, req.GetTeamName())
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	for _, member := range req.GetMembers() {
+		_, err := s.services.User.GetUser(ctx, member.GetUserId())
+		switch {
+		case errors.Is(err, service.ErrUserNotFound):
+			_, err = s.services.User.CreateUser(ctx, member.GetUserId(), member.GetUsername(), team.ID)
+		case err == nil:
+			isActive := true
+			if member.IsActive != nil {
+				isActive = member.GetIsActive()
+			}
+			_, err = s.services.User.UpdateUser(ctx, member.GetUserId(), member.GetUsername(), team.ID, isActive)
+		}
+		if err != nil {
+			return nil, toStatus(err)
+		}
+	}
+
+	return &pb.AddTeamResponse{TeamName: req.GetTeamName()}, nil
+}
+
+// GetTeam возвращает команду с участниками
+func (s *Server) GetTeam(ctx context.Context, req *pb.GetTeamRequest) (*pb.Team, error) {
+	if err := requireFields("team_name", req.GetTeamName()); err != nil {
+		return nil, err
+	}
+
+	team, err := s.services.Team.GetTeam(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	users, err := s.services.User.ListTeamUsers(ctx, team.ID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.Team{
+		TeamName:          team.TeamName,
+		MentorshipEnabled: team.MentorshipEnabled,
+		Members:           make([]*pb.TeamMember, 0, len(users)),
+	}
+	for _, user := range users {
+		isActive := user.IsActive
+		resp.Members = append(resp.Members, &pb.TeamMember{
+			UserId:    user.UserID,
+			Username:  user.Username,
+			IsActive:  &isActive,
+			Seniority: string(user.Seniority),
+		})
+	}
+	return resp, nil
+}
+
+// DeactivateTeam деактивирует всех участников команды и переназначает их PR
+func (s *Server) DeactivateTeam(ctx context.Context, req *pb.DeactivateTeamRequest) (*pb.DeactivateTeamResponse, error) {
+	if err := requireFields("team_name", req.GetTeamName()); err != nil {
+		return nil, err
+	}
+
+	start := time.Now()
+
+	team, err := s.services.Team.GetTeam(ctx, req.GetTeamName())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	deactivatedUsers, reassignedPRs, err := s.services.User.DeactivateTeamUsers(ctx, team.ID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.DeactivateTeamResponse{
+		DeactivatedUsers: int64(deactivatedUsers),
+		ReassignedPrs:    int64(reassignedPRs),
+		DurationMs:       time.Since(start).Milliseconds(),
+	}, nil
+}
+
+// SetMentorship включает или выключает наставничество в команде
+func (s *Server) SetMentorship(ctx context.Context, req *pb.SetMentorshipRequest) (*pb.SetMentorshipResponse, error) {
+	if err := requireFields("team_name", req.GetTeamName()); err != nil {
+		return nil, err
+	}
+
+	team, err := s.services.Team.SetMentorship(ctx, req.GetTeamName(), req.GetEnabled())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return &pb.SetMentorshipResponse{TeamName: team.TeamName, MentorshipEnabled: team.MentorshipEnabled}, nil
+}
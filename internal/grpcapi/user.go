@@ -0,0 +1,254 @@
+package grpcapi
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/api"
+	pb "github.com/AtoyanMikhail/PRAssignmentService/internal/grpcapi/prassignmentv1"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/logger"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+)
+
+// SetIsActive устанавливает флаг активности пользователя. После
+// деактивации его открытые ревью переназначаются.
+func (s *Server) SetIsActive(ctx context.Context, req *pb.SetIsActiveRequest) (*pb.User, error) {
+	if err := requireFields("user_id", req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	user, err := s.services.User.GetUser(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	if req.GetIsActive() {
+		_, err = s.services.User.ActivateUser(ctx, user.UserID)
+	} else {
+		_, err = s.services.User.DeactivateUser(ctx, user.UserID)
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	if !req.GetIsActive() {
+		// Как и в REST API, ошибка переназначения не отменяет деактивацию
+		if err := s.services.Reviewer.ReassignFromInactiveReviewers(ctx); err != nil {
+			logger.FromContext(ctx).Error("Failed to reassign reviews of deactivated user", zap.Error(err))
+		}
+	}
+
+	updated, err := s.services.User.GetUserWithTeam(ctx, user.UserID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toUser(updated), nil
+}
+
+// GetReview возвращает PR, где пользователь назначен ревьюером
+func (s *Server) GetReview(ctx context.Context, req *pb.GetReviewRequest) (*pb.GetReviewResponse, error) {
+	if err := requireFields("user_id", req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	prs, err := s.services.Reviewer.GetUserPRs(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.GetReviewResponse{
+		UserId:       req.GetUserId(),
+		PullRequests: make([]*pb.PullRequestShort, 0, len(prs)),
+	}
+	for _, pr := range prs {
+		resp.PullRequests = append(resp.PullRequests, &pb.PullRequestShort{
+			PullRequestId:   pr.PullRequestID,
+			PullRequestName: pr.PullRequestName,
+			AuthorId:        pr.AuthorID,
+			Status:          string(pr.Status),
+		})
+	}
+	return resp, nil
+}
+
+// SetSeniority задает уровень пользователя для наставничества
+func (s *Server) SetSeniority(ctx context.Context, req *pb.SetSeniorityRequest) (*pb.User, error) {
+	if err := requireFields("user_id", req.GetUserId(), "seniority", req.GetSeniority()); err != nil {
+		return nil, err
+	}
+
+	user, err := s.services.User.SetSeniority(ctx, req.GetUserId(), models.Seniority(req.GetSeniority()))
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	updated, err := s.services.User.GetUserWithTeam(ctx, user.UserID)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toUser(updated), nil
+}
+
+// GetSkills возвращает навыки пользователя
+func (s *Server) GetSkills(ctx context.Context, req *pb.GetSkillsRequest) (*pb.UserSkills, error) {
+	if err := requireFields("user_id", req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	skills, err := s.services.Skill.GetUserSkills(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toUserSkills(req.GetUserId(), skills), nil
+}
+
+// SetSkills заменяет навыки пользователя
+func (s *Server) SetSkills(ctx context.Context, req *pb.SetSkillsRequest) (*pb.UserSkills, error) {
+	if err := requireFields("user_id", req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	skills := make([]models.UserSkill, 0, len(req.GetSkills()))
+	for _, skill := range req.GetSkills() {
+		skills = append(skills, models.UserSkill{Tag: skill.GetTag(), Level: models.SkillLevel(skill.GetLevel())})
+	}
+
+	saved, err := s.services.Skill.SetUserSkills(ctx, req.GetUserId(), skills)
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toUserSkills(req.GetUserId(), saved), nil
+}
+
+// GetDashboard возвращает персональную сводку ревьюера
+func (s *Server) GetDashboard(ctx context.Context, req *pb.GetDashboardRequest) (*pb.UserDashboard, error) {
+	if err := requireFields("user_id", req.GetUserId()); err != nil {
+		return nil, err
+	}
+
+	d, err := s.services.Dashboard.GetDashboard(ctx, req.GetUserId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	resp := &pb.UserDashboard{
+		User:                 toUser(d.User),
+		PendingReviews:       make([]*pb.PendingReview, 0, len(d.PendingReviews)),
+		AuthoredPullRequests: make([]*pb.AuthoredPullRequest, 0, len(d.AuthoredPRs)),
+		Capacity: &pb.ReviewCapacity{
+			OpenReviews:    d.Capacity.OpenReviews,
+			MaxOpenReviews: int32(d.Capacity.MaxOpenReviews),
+			Remaining:      d.Capacity.Remaining,
+			AtCapacity:     d.Capacity.AtCapacity,
+			Unavailable:    d.Capacity.Unavailable,
+		},
+		Unavailability: make([]*pb.Unavailability, 0, len(d.Unavailability)),
+		RecentStats: &pb.RecentReviewStats{
+			From:            timestamppb.New(d.RecentStats.Window.From),
+			To:              timestamppb.New(d.RecentStats.Window.To),
+			ReviewsAssigned: d.RecentStats.ReviewsAssigned,
+			ReviewsMerged:   d.RecentStats.ReviewsMerged,
+		},
+	}
+
+	for _, r := range d.PendingReviews {
+		username := d.User.Username
+		resp.PendingReviews = append(resp.PendingReviews, &pb.PendingReview{
+			PullRequestId:   r.PullRequestID,
+			PullRequestName: r.PullRequestName,
+			AuthorId:        r.AuthorID,
+			CreatedAt:       timestamppb.New(r.CreatedAt),
+			AgeSeconds:      int64(r.Age.Seconds()),
+			DueAt:           timestamppb.New(r.DueAt),
+			Overdue:         r.Overdue,
+			Assignment:      toReviewerAssignment(d.User.UserID, &username, r.Assignment, r.AssignedAt),
+		})
+	}
+
+	for _, pr := range d.AuthoredPRs {
+		reviewers := make([]*pb.AuthoredPullRequestReviewer, 0, len(pr.Reviewers))
+		for _, r := range pr.Reviewers {
+			reviewers = append(reviewers, &pb.AuthoredPullRequestReviewer{
+				UserId:     r.UserID,
+				Username:   r.Username,
+				IsActive:   r.IsActive,
+				Source:     string(r.Source),
+				AssignedAt: timestamppb.New(r.AssignedAt),
+				DueAt:      timestamppb.New(r.DueAt),
+				Overdue:    r.Overdue,
+			})
+		}
+		resp.AuthoredPullRequests = append(resp.AuthoredPullRequests, &pb.AuthoredPullRequest{
+			PullRequestId:   pr.PullRequestID,
+			PullRequestName: pr.PullRequestName,
+			CreatedAt:       timestamppb.New(pr.CreatedAt),
+			Reviewers:       reviewers,
+		})
+	}
+
+	for _, u := range d.Unavailability {
+		resp.Unavailability = append(resp.Unavailability, toUnavailability(u))
+	}
+	return resp, nil
+}
+
+// AddUnavailability добавляет период недоступности пользователя
+func (s *Server) AddUnavailability(ctx context.Context, req *pb.AddUnavailabilityRequest) (*pb.Unavailability, error) {
+	if err := requireFields("user_id", req.GetUserId()); err != nil {
+		return nil, err
+	}
+	if req.GetStartsAt() == nil || req.GetEndsAt() == nil {
+		return nil, newStatus(codes.InvalidArgument, api.VALIDATIONERROR, "Invalid request: starts_at and ends_at are required")
+	}
+
+	period, err := s.services.User.AddUnavailability(ctx, models.Unavailability{
+		UserID:   req.GetUserId(),
+		StartsAt: req.GetStartsAt().AsTime(),
+		EndsAt:   req.GetEndsAt().AsTime(),
+		Reason:   req.GetReason(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toUnavailability(period), nil
+}
+
+// DeleteUnavailability удаляет период недоступности
+func (s *Server) DeleteUnavailability(ctx context.Context, req *pb.DeleteUnavailabilityRequest) (*pb.Unavailability, error) {
+	period, err := s.services.User.DeleteUnavailability(ctx, req.GetId())
+	if err != nil {
+		return nil, toStatus(err)
+	}
+	return toUnavailability(period), nil
+}
+
+func toUser(u models.UserWithTeam) *pb.User {
+	return &pb.User{
+		UserId:    u.UserID,
+		Username:  u.Username,
+		TeamName:  u.TeamName,
+		IsActive:  u.IsActive,
+		Seniority: string(u.Seniority),
+	}
+}
+
+func toUserSkills(userID string, skills []models.UserSkill) *pb.UserSkills {
+	resp := &pb.UserSkills{UserId: userID, Skills: make([]*pb.UserSkill, 0, len(skills))}
+	for _, skill := range skills {
+		resp.Skills = append(resp.Skills, &pb.UserSkill{Tag: skill.Tag, Level: string(skill.Level)})
+	}
+	return resp
+}
+
+func toUnavailability(u models.Unavailability) *pb.Unavailability {
+	return &pb.Unavailability{
+		Id:       u.ID,
+		UserId:   u.UserID,
+		StartsAt: timestamppb.New(u.StartsAt),
+		EndsAt:   timestamppb.New(u.EndsAt),
+		Reason:   u.Reason,
+	}
+}
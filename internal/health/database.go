@@ -0,0 +1,95 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// DatabaseCheck проверяет доступность БД через пул соединений
+func DatabaseCheck(pool *pgxpool.Pool) CheckFunc {
+	return func(ctx context.Context) Result {
+		if err := pool.Ping(ctx); err != nil {
+			return Result{Status: StatusDown, Message: err.Error()}
+		}
+		return Result{Status: StatusOK}
+	}
+}
+
+// PoolCheck сообщает о деградации, когда занятых соединений не меньше
+// saturationThreshold от максимального размера пула
+func PoolCheck(pool *pgxpool.Pool, saturationThreshold float64) CheckFunc {
+	return func(context.Context) Result {
+		stat := pool.Stat()
+		return poolResult(stat.AcquiredConns(), stat.MaxConns(), stat.EmptyAcquireCount(), saturationThreshold)
+	}
+}
+
+// poolResult вычисляет состояние пула по его статистике
+func poolResult(acquired, maxConns int32, emptyAcquires int64, threshold float64) Result {
+	res := Result{
+		Status: StatusOK,
+		Details: map[string]any{
+			"acquired":       acquired,
+			"max":            maxConns,
+			"empty_acquires": emptyAcquires,
+		},
+	}
+	if maxConns <= 0 {
+		return res
+	}
+
+	saturation := float64(acquired) / float64(maxConns)
+	if saturation >= threshold {
+		res.Status = StatusDegraded
+		res.Message = fmt.Sprintf("pool saturation %.0f%%", saturation*100)
+	}
+	return res
+}
+
+// MigrationCheck сверяет версию схемы из таблицы golang-migrate с последней
+// миграцией, известной сборке. Незавершенная (dirty) или отстающая схема
+// означает, что запросы сервиса могут не работать.
+func MigrationCheck(pool *pgxpool.Pool, expected uint) CheckFunc {
+	return func(ctx context.Context) Result {
+		var version int64
+		var dirty bool
+		err := pool.QueryRow(ctx, "SELECT version, dirty FROM schema_migrations LIMIT 1").Scan(&version, &dirty)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				return Result{Status: StatusDown, Message: "no migrations applied"}
+			}
+			return Result{Status: StatusDown, Message: err.Error()}
+		}
+		return migrationResult(uint(version), dirty, expected)
+	}
+}
+
+// migrationResult вычисляет состояние схемы по версии миграций
+func migrationResult(version uint, dirty bool, expected uint) Result {
+	res := Result{
+		Status: StatusOK,
+		Details: map[string]any{
+			"version":  version,
+			"expected": expected,
+			"dirty":    dirty,
+		},
+	}
+
+	switch {
+	case dirty:
+		res.Status = StatusDown
+		res.Message = fmt.Sprintf("migration %d is dirty", version)
+	case version < expected:
+		res.Status = StatusDown
+		res.Message = fmt.Sprintf("schema version %d is behind expected %d", version, expected)
+	case version > expected:
+		// Схема новее сборки - допустимо во время выкатки новой версии
+		res.Status = StatusDegraded
+		res.Message = fmt.Sprintf("schema version %d is ahead of expected %d", version, expected)
+	}
+	return res
+}
@@ -0,0 +1,133 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Status - состояние компонента
+type Status string
+
+const (
+	// StatusOK - компонент работает нормально
+	StatusOK Status = "ok"
+	// StatusDegraded - компонент работает с проблемами, трафик принимается
+	StatusDegraded Status = "degraded"
+	// StatusDown - компонент недоступен, трафик не принимается
+	StatusDown Status = "down"
+)
+
+// defaultCheckTimeout ограничивает время одной проверки
+const defaultCheckTimeout = 2 * time.Second
+
+// severity задает порядок статусов для вычисления общего статуса
+var severity = map[Status]int{
+	StatusOK:       0,
+	StatusDegraded: 1,
+	StatusDown:     2,
+}
+
+// Result - результат проверки компонента
+type Result struct {
+	Status  Status
+	Message string
+	Details map[string]any
+}
+
+// ComponentReport - результат проверки компонента с именем и длительностью
+type ComponentReport struct {
+	Name    string
+	Latency time.Duration
+	Result
+}
+
+// Report - результат проверки готовности сервиса
+type Report struct {
+	Status     Status
+	Timestamp  time.Time
+	Components []ComponentReport
+}
+
+// CheckFunc проверяет состояние компонента
+type CheckFunc func(ctx context.Context) Result
+
+type namedCheck struct {
+	name  string
+	check CheckFunc
+}
+
+// Checker выполняет зарегистрированные проверки готовности
+type Checker struct {
+	timeout time.Duration
+	checks  []namedCheck
+}
+
+// NewChecker создает Checker с таймаутом на каждую проверку
+func NewChecker(timeout time.Duration) *Checker {
+	if timeout <= 0 {
+		timeout = defaultCheckTimeout
+	}
+	return &Checker{timeout: timeout}
+}
+
+// Register добавляет проверку компонента. Вызывается до начала обработки запросов.
+func (c *Checker) Register(name string, check CheckFunc) {
+	c.checks = append(c.checks, namedCheck{name: name, check: check})
+}
+
+// Check параллельно выполняет все проверки.
+// Общий статус - худший из статусов компонентов.
+func (c *Checker) Check(ctx context.Context) Report {
+	report := Report{
+		Status:     StatusOK,
+		Timestamp:  time.Now().UTC(),
+		Components: make([]ComponentReport, len(c.checks)),
+	}
+
+	var wg sync.WaitGroup
+	for i, nc := range c.checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			report.Components[i] = c.run(ctx, nc)
+		}()
+	}
+	wg.Wait()
+
+	for _, comp := range report.Components {
+		report.Status = Worst(report.Status, comp.Status)
+	}
+	return report
+}
+
+// run выполняет одну проверку с таймаутом
+func (c *Checker) run(ctx context.Context, nc namedCheck) ComponentReport {
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	start := time.Now()
+	done := make(chan Result, 1)
+	go func() { done <- nc.check(ctx) }()
+
+	var res Result
+	select {
+	case res = <-done:
+	case <-ctx.Done():
+		res = Result{Status: StatusDown, Message: "check timed out"}
+	}
+
+	return ComponentReport{
+		Name:    nc.name,
+		Latency: time.Since(start),
+		Result:  res,
+	}
+}
+
+// Worst возвращает наиболее тяжелый из двух статусов
+func Worst(a, b Status) Status {
+	if severity[b] > severity[a] {
+		return b
+	}
+	return a
+}
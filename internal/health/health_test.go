@@ -0,0 +1,106 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func staticCheck(status Status) CheckFunc {
+	return func(context.Context) Result { return Result{Status: status} }
+}
+
+func TestChecker_Check(t *testing.T) {
+	tests := []struct {
+		name   string
+		checks []Status
+		want   Status
+	}{
+		{"нет проверок", nil, StatusOK},
+		{"все в порядке", []Status{StatusOK, StatusOK}, StatusOK},
+		{"деградация", []Status{StatusOK, StatusDegraded}, StatusDegraded},
+		{"недоступность важнее деградации", []Status{StatusDegraded, StatusDown, StatusOK}, StatusDown},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewChecker(time.Second)
+			for i, st := range tt.checks {
+				c.Register(string(rune('a'+i)), staticCheck(st))
+			}
+
+			report := c.Check(context.Background())
+			assert.Equal(t, tt.want, report.Status)
+			require.Len(t, report.Components, len(tt.checks))
+			for i, comp := range report.Components {
+				assert.Equal(t, tt.checks[i], comp.Status)
+			}
+		})
+	}
+}
+
+func TestChecker_Timeout(t *testing.T) {
+	c := NewChecker(20 * time.Millisecond)
+	c.Register("slow", func(ctx context.Context) Result {
+		time.Sleep(time.Second)
+		return Result{Status: StatusOK}
+	})
+
+	report := c.Check(context.Background())
+	assert.Equal(t, StatusDown, report.Status)
+	assert.Equal(t, "check timed out", report.Components[0].Message)
+}
+
+func TestPoolResult(t *testing.T) {
+	assert.Equal(t, StatusOK, poolResult(5, 25, 0, 0.9).Status)
+	assert.Equal(t, StatusDegraded, poolResult(23, 25, 10, 0.9).Status)
+	assert.Equal(t, StatusOK, poolResult(0, 0, 0, 0.9).Status)
+}
+
+func TestMigrationResult(t *testing.T) {
+	tests := []struct {
+		name     string
+		version  uint
+		dirty    bool
+		expected uint
+		want     Status
+	}{
+		{"актуальная схема", 2, false, 2, StatusOK},
+		{"схема отстает", 1, false, 2, StatusDown},
+		{"незавершенная миграция", 2, true, 2, StatusDown},
+		{"схема новее сборки", 3, false, 2, StatusDegraded},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, migrationResult(tt.version, tt.dirty, tt.expected).Status)
+		})
+	}
+}
+
+func TestWorkers_Check(t *testing.T) {
+	w := NewWorkers()
+	assert.Equal(t, StatusOK, w.Check(context.Background()).Status)
+
+	hb := w.Register("reconcile", time.Hour)
+	hb.Beat(nil)
+	assert.Equal(t, StatusOK, w.Check(context.Background()).Status)
+
+	hb.Beat(errors.New("db unavailable"))
+	res := w.Check(context.Background())
+	assert.Equal(t, StatusDegraded, res.Status)
+	assert.Contains(t, res.Message, "reconcile")
+
+	stale := w.Register("stale", time.Millisecond)
+	stale.Beat(nil)
+	time.Sleep(5 * time.Millisecond)
+	hb.Beat(nil)
+	res = w.Check(context.Background())
+	assert.Equal(t, StatusDegraded, res.Status)
+	assert.Equal(t, string(StatusDegraded), res.Details["stale"])
+	assert.Equal(t, string(StatusOK), res.Details["reconcile"])
+}
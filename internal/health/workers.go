@@ -0,0 +1,98 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// staleFactor - во сколько интервалов воркер может не отчитываться
+const staleFactor = 3
+
+// Heartbeat фиксирует последнее успешное выполнение фонового воркера
+type Heartbeat struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	last time.Time
+	err  error
+}
+
+// Beat отмечает очередное выполнение воркера. err - ошибка итерации, если была.
+func (h *Heartbeat) Beat(err error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.last = time.Now()
+	h.err = err
+}
+
+func (h *Heartbeat) snapshot() (time.Time, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.last, h.err
+}
+
+// Workers - реестр фоновых воркеров для проверки готовности
+type Workers struct {
+	mu      sync.Mutex
+	started time.Time
+	beats   map[string]*Heartbeat
+}
+
+// NewWorkers создает пустой реестр воркеров
+func NewWorkers() *Workers {
+	return &Workers{
+		started: time.Now(),
+		beats:   make(map[string]*Heartbeat),
+	}
+}
+
+// Register регистрирует воркер с ожидаемым интервалом выполнения
+func (w *Workers) Register(name string, interval time.Duration) *Heartbeat {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	hb := &Heartbeat{interval: interval}
+	w.beats[name] = hb
+	return hb
+}
+
+// Check проверяет, что все воркеры отчитываются вовремя.
+// Отставание воркера не мешает обслуживать запросы, поэтому статус - degraded.
+func (w *Workers) Check(context.Context) Result {
+	w.mu.Lock()
+	beats := make(map[string]*Heartbeat, len(w.beats))
+	names := make([]string, 0, len(w.beats))
+	for name, hb := range w.beats {
+		beats[name] = hb
+		names = append(names, name)
+	}
+	w.mu.Unlock()
+	sort.Strings(names)
+
+	now := time.Now()
+	res := Result{Status: StatusOK, Details: make(map[string]any, len(names))}
+	for _, name := range names {
+		hb := beats[name]
+		last, err := hb.snapshot()
+		since := last
+		if since.IsZero() {
+			since = w.started
+		}
+
+		state := string(StatusOK)
+		switch {
+		case now.Sub(since) > staleFactor*hb.interval:
+			state = string(StatusDegraded)
+			res.Status = StatusDegraded
+			res.Message = fmt.Sprintf("worker %s has not run since %s", name, since.Format(time.RFC3339))
+		case err != nil:
+			state = string(StatusDegraded)
+			res.Status = StatusDegraded
+			res.Message = fmt.Sprintf("worker %s failed: %v", name, err)
+		}
+		res.Details[name] = state
+	}
+	return res
+}
@@ -0,0 +1,35 @@
+package logger
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+)
+
+// ctxKey - ключ логгера в context.Context
+type ctxKey struct{}
+
+// nop используется, когда логгер не инициализирован (например, в unit тестах)
+var nop = &Logger{zap: zap.NewNop()}
+
+// With возвращает дочерний логгер с дополнительными полями
+func (l *Logger) With(fields ...zap.Field) *Logger {
+	return &Logger{zap: l.zap.With(fields...)}
+}
+
+// WithContext сохраняет логгер в контексте
+func WithContext(ctx context.Context, l *Logger) context.Context {
+	return context.WithValue(ctx, ctxKey{}, l)
+}
+
+// FromContext возвращает логгер запроса из контекста.
+// Если в контексте логгера нет, возвращается глобальный логгер.
+func FromContext(ctx context.Context) *Logger {
+	if l, ok := ctx.Value(ctxKey{}).(*Logger); ok && l != nil {
+		return l
+	}
+	if instance != nil {
+		return instance
+	}
+	return nop
+}
@@ -21,60 +21,65 @@ type Logger struct {
 // Init инициализирует глобальный логгер
 func Init(writer io.Writer, level string, format string) {
 	once.Do(func() {
-		encoderConfig := zapcore.EncoderConfig{
-			TimeKey:        "time",
-			LevelKey:       "level",
-			NameKey:        "logger",
-			CallerKey:      "caller",
-			MessageKey:     "msg",
-			StacktraceKey:  "stacktrace",
-			LineEnding:     zapcore.DefaultLineEnding,
-			EncodeLevel:    zapcore.CapitalLevelEncoder,
-			EncodeTime:     zapcore.ISO8601TimeEncoder,
-			EncodeDuration: zapcore.SecondsDurationEncoder,
-			EncodeCaller:   zapcore.ShortCallerEncoder,
-		}
-
-		// Парсим уровень логирования
-		var zapLevel zapcore.Level
-		switch level {
-		case "debug":
-			zapLevel = zapcore.DebugLevel
-		case "info":
-			zapLevel = zapcore.InfoLevel
-		case "warn", "warning":
-			zapLevel = zapcore.WarnLevel
-		case "error":
-			zapLevel = zapcore.ErrorLevel
-		case "fatal":
-			zapLevel = zapcore.FatalLevel
-		default:
-			zapLevel = zapcore.InfoLevel // по умолчанию info
-		}
-
-		// Выбираем энкодер в зависимости от формата
-		var encoder zapcore.Encoder
-		switch format {
-		case "json":
-			encoder = zapcore.NewJSONEncoder(encoderConfig)
-		case "console":
-			encoder = zapcore.NewConsoleEncoder(encoderConfig)
-		default:
-			encoder = zapcore.NewConsoleEncoder(encoderConfig) // по умолчанию console
-		}
-
-		core := zapcore.NewCore(
-			encoder,
-			zapcore.AddSync(writer),
-			zapLevel,
-		)
-
-		instance = &Logger{
-			zap: zap.New(core, zap.AddCaller(), zap.AddCallerSkip(1)),
-		}
+		instance = New(writer, level, format)
 	})
 }
 
+// New создает логгер, не затрагивая глобальный экземпляр
+func New(writer io.Writer, level string, format string) *Logger {
+	encoderConfig := zapcore.EncoderConfig{
+		TimeKey:        "time",
+		LevelKey:       "level",
+		NameKey:        "logger",
+		CallerKey:      "caller",
+		MessageKey:     "msg",
+		StacktraceKey:  "stacktrace",
+		LineEnding:     zapcore.DefaultLineEnding,
+		EncodeLevel:    zapcore.CapitalLevelEncoder,
+		EncodeTime:     zapcore.ISO8601TimeEncoder,
+		EncodeDuration: zapcore.SecondsDurationEncoder,
+		EncodeCaller:   zapcore.ShortCallerEncoder,
+	}
+
+	// Парсим уровень логирования
+	var zapLevel zapcore.Level
+	switch level {
+	case "debug":
+		zapLevel = zapcore.DebugLevel
+	case "info":
+		zapLevel = zapcore.InfoLevel
+	case "warn", "warning":
+		zapLevel = zapcore.WarnLevel
+	case "error":
+		zapLevel = zapcore.ErrorLevel
+	case "fatal":
+		zapLevel = zapcore.FatalLevel
+	default:
+		zapLevel = zapcore.InfoLevel // по умолчанию info
+	}
+
+	// Выбираем энкодер в зависимости от формата
+	var encoder zapcore.Encoder
+	switch format {
+	case "json":
+		encoder = zapcore.NewJSONEncoder(encoderConfig)
+	case "console":
+		encoder = zapcore.NewConsoleEncoder(encoderConfig)
+	default:
+		encoder = zapcore.NewConsoleEncoder(encoderConfig) // по умолчанию console
+	}
+
+	core := zapcore.NewCore(
+		encoder,
+		zapcore.AddSync(writer),
+		zapLevel,
+	)
+
+	return &Logger{
+		zap: zap.New(core, zap.AddCaller(), zap.AddCallerSkip(1)),
+	}
+}
+
 // Get возвращает экземпляр глобального логгера
 func Get() *Logger {
 	if instance == nil {
@@ -83,6 +88,11 @@ func Get() *Logger {
 	return instance
 }
 
+// Debug логирует отладочное сообщение
+func (l *Logger) Debug(msg string, fields ...zap.Field) {
+	l.zap.Debug(msg, fields...)
+}
+
 // Info логирует информационное сообщение
 func (l *Logger) Info(msg string, fields ...zap.Field) {
 	l.zap.Info(msg, fields...)
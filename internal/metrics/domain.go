@@ -0,0 +1,123 @@
+package metrics
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+)
+
+// domainScrapeTimeout ограничивает время запросов к БД во время scrape
+const domainScrapeTimeout = 5 * time.Second
+
+// workloadBuckets - границы гистограммы распределения нагрузки ревьюеров
+var workloadBuckets = []float64{0, 1, 2, 3, 5, 8, 13, 21}
+
+// DomainSource предоставляет данные для доменных метрик
+type DomainSource interface {
+	GetTeamReviewLoad(ctx context.Context) ([]models.TeamReviewLoad, error)
+	GetUserWorkload(ctx context.Context) ([]models.UserWorkload, error)
+}
+
+// domainCollector вычисляет доменные метрики по данным БД в момент scrape
+type domainCollector struct {
+	source DomainSource
+
+	teamActiveMembers *prometheus.Desc
+	teamOpenReviews   *prometheus.Desc
+	reviewerWorkload  *prometheus.Desc
+	scrapeErrors      prometheus.Counter
+}
+
+// RegisterDomain регистрирует коллектор доменных метрик
+func (m *Metrics) RegisterDomain(source DomainSource) {
+	c := &domainCollector{
+		source: source,
+		teamActiveMembers: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "team", "active_members"),
+			"Количество активных участников команды, доступных для ревью.",
+			[]string{"team"}, nil,
+		),
+		teamOpenReviews: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "team", "open_reviews"),
+			"Количество открытых ревью, назначенных на участников команды.",
+			[]string{"team"}, nil,
+		),
+		reviewerWorkload: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "reviewer", "open_reviews"),
+			"Распределение количества открытых ревью по активным ревьюерам.",
+			nil, nil,
+		),
+		scrapeErrors: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "domain_scrape_errors_total",
+			Help:      "Количество ошибок при сборе доменных метрик.",
+		}),
+	}
+
+	m.registry.MustRegister(c)
+}
+
+// Describe реализует prometheus.Collector
+func (c *domainCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.teamActiveMembers
+	ch <- c.teamOpenReviews
+	ch <- c.reviewerWorkload
+	c.scrapeErrors.Describe(ch)
+}
+
+// Collect реализует prometheus.Collector
+func (c *domainCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), domainScrapeTimeout)
+	defer cancel()
+
+	loads, err := c.source.GetTeamReviewLoad(ctx)
+	if err != nil {
+		c.scrapeErrors.Inc()
+	} else {
+		for _, l := range loads {
+			ch <- prometheus.MustNewConstMetric(c.teamActiveMembers, prometheus.GaugeValue, float64(l.ActiveMembers), l.TeamName)
+			ch <- prometheus.MustNewConstMetric(c.teamOpenReviews, prometheus.GaugeValue, float64(l.OpenReviews), l.TeamName)
+		}
+	}
+
+	workloads, err := c.source.GetUserWorkload(ctx)
+	if err != nil {
+		c.scrapeErrors.Inc()
+	} else {
+		count, sum, buckets := workloadHistogram(workloads)
+		ch <- prometheus.MustNewConstHistogram(c.reviewerWorkload, count, sum, buckets)
+	}
+
+	c.scrapeErrors.Collect(ch)
+}
+
+// workloadHistogram строит кумулятивную гистограмму открытых ревью
+func workloadHistogram(workloads []models.UserWorkload) (uint64, float64, map[float64]uint64) {
+	buckets := make(map[float64]uint64, len(workloadBuckets))
+	for _, b := range workloadBuckets {
+		buckets[b] = 0
+	}
+
+	values := make([]float64, 0, len(workloads))
+	var sum float64
+	for _, w := range workloads {
+		v := float64(w.OpenReviewsCount)
+		values = append(values, v)
+		sum += v
+	}
+	sort.Float64s(values)
+
+	i := 0
+	for _, b := range workloadBuckets {
+		for i < len(values) && values[i] <= b {
+			i++
+		}
+		buckets[b] = uint64(i)
+	}
+
+	return uint64(len(values)), sum, buckets
+}
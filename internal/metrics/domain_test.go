@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+)
+
+func TestWorkloadHistogram(t *testing.T) {
+	t.Run("кумулятивные бакеты", func(t *testing.T) {
+		workloads := []models.UserWorkload{
+			{UserID: "u1", OpenReviewsCount: 0},
+			{UserID: "u2", OpenReviewsCount: 1},
+			{UserID: "u3", OpenReviewsCount: 1},
+			{UserID: "u4", OpenReviewsCount: 4},
+			{UserID: "u5", OpenReviewsCount: 30},
+		}
+
+		count, sum, buckets := workloadHistogram(workloads)
+
+		assert.Equal(t, uint64(5), count)
+		assert.Equal(t, float64(36), sum)
+		assert.Equal(t, uint64(1), buckets[0])
+		assert.Equal(t, uint64(3), buckets[1])
+		assert.Equal(t, uint64(3), buckets[3])
+		assert.Equal(t, uint64(4), buckets[5])
+		assert.Equal(t, uint64(4), buckets[21])
+	})
+
+	t.Run("пустая нагрузка", func(t *testing.T) {
+		count, sum, buckets := workloadHistogram(nil)
+
+		assert.Equal(t, uint64(0), count)
+		assert.Equal(t, float64(0), sum)
+		assert.Len(t, buckets, len(workloadBuckets))
+	})
+}
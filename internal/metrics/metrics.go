@@ -0,0 +1,197 @@
+package metrics
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "pr_assignment"
+
+// Причины переназначения ревьюеров
+const (
+	ReasonManual           = "manual"
+	ReasonAuto             = "auto"
+	ReasonInactiveReviewer = "inactive_reviewer"
+	ReasonTeamDeactivation = "team_deactivation"
+)
+
+// Источники назначения ревьюеров
+const (
+	SourceAuto         = "auto"
+	SourceManual       = "manual"
+	SourceReassignment = "reassignment"
+)
+
+// Metrics содержит все метрики приложения и их реестр.
+// Все методы безопасно вызывать на nil-получателе, чтобы сервисы
+// можно было создавать без метрик (например, в тестах).
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests *prometheus.CounterVec
+	httpDuration *prometheus.HistogramVec
+	httpInFlight prometheus.Gauge
+	rateLimited  *prometheus.CounterVec
+
+	assignments       *prometheus.CounterVec
+	reassignments     *prometheus.CounterVec
+	noActiveReviewers *prometheus.CounterVec
+	workloadDrift     prometheus.Counter
+	eventSubscribers  prometheus.Gauge
+}
+
+// New создает метрики и регистрирует их в собственном реестре
+func New() *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_total",
+			Help:      "Количество обработанных HTTP запросов по операциям OpenAPI.",
+		}, []string{"operation", "method", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "request_duration_seconds",
+			Help:      "Длительность обработки HTTP запросов по операциям OpenAPI.",
+			Buckets:   []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5},
+		}, []string{"operation", "method"}),
+		httpInFlight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "requests_in_flight",
+			Help:      "Количество HTTP запросов в обработке.",
+		}),
+		rateLimited: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: "http",
+			Name:      "rate_limited_total",
+			Help:      "Количество запросов, отклоненных ограничением частоты.",
+		}, []string{"route"}),
+		assignments: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reviewer_assignments_total",
+			Help:      "Количество назначенных ревьюеров по источнику назначения.",
+		}, []string{"source"}),
+		reassignments: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reviewer_reassignments_total",
+			Help:      "Количество переназначений ревьюеров по причине.",
+		}, []string{"reason"}),
+		noActiveReviewers: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "no_active_reviewers_total",
+			Help:      "Количество случаев, когда в команде не нашлось активного ревьюера.",
+		}, []string{"team"}),
+		workloadDrift: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "workload_drift_total",
+			Help:      "Количество исправленных расхождений учтенной нагрузки ревьюеров с фактической.",
+		}),
+		eventSubscribers: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: namespace,
+			Name:      "event_subscribers",
+			Help:      "Количество открытых потоков событий назначения.",
+		}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpDuration,
+		m.httpInFlight,
+		m.rateLimited,
+		m.assignments,
+		m.reassignments,
+		m.noActiveReviewers,
+		m.workloadDrift,
+		m.eventSubscribers,
+	)
+
+	return m
+}
+
+// Registry возвращает реестр метрик для регистрации дополнительных коллекторов
+func (m *Metrics) Registry() *prometheus.Registry {
+	return m.registry
+}
+
+// Handler возвращает HTTP handler для эндпоинта /metrics
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{
+		Registry: m.registry,
+	})
+}
+
+// HTTPRequestStarted отмечает начало обработки запроса
+func (m *Metrics) HTTPRequestStarted() {
+	if m == nil {
+		return
+	}
+	m.httpInFlight.Inc()
+}
+
+// HTTPRequestFinished записывает результат обработки запроса
+func (m *Metrics) HTTPRequestFinished(operation, method string, status int, duration time.Duration) {
+	if m == nil {
+		return
+	}
+	m.httpInFlight.Dec()
+	m.httpRequests.WithLabelValues(operation, method, strconv.Itoa(status)).Inc()
+	m.httpDuration.WithLabelValues(operation, method).Observe(duration.Seconds())
+}
+
+// RateLimited учитывает запрос, отклоненный ограничением частоты
+func (m *Metrics) RateLimited(route string) {
+	if m == nil {
+		return
+	}
+	m.rateLimited.WithLabelValues(route).Inc()
+}
+
+// ReviewersAssigned учитывает назначенных ревьюеров
+func (m *Metrics) ReviewersAssigned(source string, count int) {
+	if m == nil || count <= 0 {
+		return
+	}
+	m.assignments.WithLabelValues(source).Add(float64(count))
+}
+
+// ReviewerReassigned учитывает переназначения ревьюеров
+func (m *Metrics) ReviewerReassigned(reason string, count int) {
+	if m == nil || count <= 0 {
+		return
+	}
+	m.reassignments.WithLabelValues(reason).Add(float64(count))
+}
+
+// NoActiveReviewers учитывает случай ErrNoActiveReviewers для команды
+func (m *Metrics) NoActiveReviewers(team string) {
+	if m == nil {
+		return
+	}
+	m.noActiveReviewers.WithLabelValues(team).Inc()
+}
+
+// WorkloadDrift учитывает исправленные расхождения нагрузки ревьюеров
+func (m *Metrics) WorkloadDrift(count int) {
+	if m == nil || count <= 0 {
+		return
+	}
+	m.workloadDrift.Add(float64(count))
+}
+
+// EventSubscribers изменяет число открытых потоков событий на delta
+func (m *Metrics) EventSubscribers(delta int) {
+	if m == nil {
+		return
+	}
+	m.eventSubscribers.Add(float64(delta))
+}
@@ -0,0 +1,73 @@
+package metrics
+
+import (
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// poolCollector снимает статистику pgxpool в момент scrape
+type poolCollector struct {
+	pool *pgxpool.Pool
+
+	acquiredConns        *prometheus.Desc
+	idleConns            *prometheus.Desc
+	totalConns           *prometheus.Desc
+	maxConns             *prometheus.Desc
+	constructingConns    *prometheus.Desc
+	acquireCount         *prometheus.Desc
+	emptyAcquireCount    *prometheus.Desc
+	canceledAcquireCount *prometheus.Desc
+	acquireDuration      *prometheus.Desc
+	emptyAcquireWait     *prometheus.Desc
+}
+
+// RegisterPool регистрирует коллектор статистики пула соединений
+func (m *Metrics) RegisterPool(pool *pgxpool.Pool) {
+	desc := func(name, help string) *prometheus.Desc {
+		return prometheus.NewDesc(prometheus.BuildFQName(namespace, "db_pool", name), help, nil, nil)
+	}
+
+	m.registry.MustRegister(&poolCollector{
+		pool:                 pool,
+		acquiredConns:        desc("acquired_conns", "Количество занятых соединений."),
+		idleConns:            desc("idle_conns", "Количество свободных соединений."),
+		totalConns:           desc("total_conns", "Общее количество соединений в пуле."),
+		maxConns:             desc("max_conns", "Максимальный размер пула."),
+		constructingConns:    desc("constructing_conns", "Количество устанавливаемых соединений."),
+		acquireCount:         desc("acquire_total", "Количество успешных получений соединения."),
+		emptyAcquireCount:    desc("empty_acquire_total", "Количество получений соединения с ожиданием из-за пустого пула."),
+		canceledAcquireCount: desc("canceled_acquire_total", "Количество отмененных получений соединения."),
+		acquireDuration:      desc("acquire_duration_seconds_total", "Суммарное время получения соединений."),
+		emptyAcquireWait:     desc("empty_acquire_wait_seconds_total", "Суммарное время ожидания соединения при пустом пуле."),
+	})
+}
+
+// Describe реализует prometheus.Collector
+func (c *poolCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.acquiredConns
+	ch <- c.idleConns
+	ch <- c.totalConns
+	ch <- c.maxConns
+	ch <- c.constructingConns
+	ch <- c.acquireCount
+	ch <- c.emptyAcquireCount
+	ch <- c.canceledAcquireCount
+	ch <- c.acquireDuration
+	ch <- c.emptyAcquireWait
+}
+
+// Collect реализует prometheus.Collector
+func (c *poolCollector) Collect(ch chan<- prometheus.Metric) {
+	stat := c.pool.Stat()
+
+	ch <- prometheus.MustNewConstMetric(c.acquiredConns, prometheus.GaugeValue, float64(stat.AcquiredConns()))
+	ch <- prometheus.MustNewConstMetric(c.idleConns, prometheus.GaugeValue, float64(stat.IdleConns()))
+	ch <- prometheus.MustNewConstMetric(c.totalConns, prometheus.GaugeValue, float64(stat.TotalConns()))
+	ch <- prometheus.MustNewConstMetric(c.maxConns, prometheus.GaugeValue, float64(stat.MaxConns()))
+	ch <- prometheus.MustNewConstMetric(c.constructingConns, prometheus.GaugeValue, float64(stat.ConstructingConns()))
+	ch <- prometheus.MustNewConstMetric(c.acquireCount, prometheus.CounterValue, float64(stat.AcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquireCount, prometheus.CounterValue, float64(stat.EmptyAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.canceledAcquireCount, prometheus.CounterValue, float64(stat.CanceledAcquireCount()))
+	ch <- prometheus.MustNewConstMetric(c.acquireDuration, prometheus.CounterValue, stat.AcquireDuration().Seconds())
+	ch <- prometheus.MustNewConstMetric(c.emptyAcquireWait, prometheus.CounterValue, stat.EmptyAcquireWaitTime().Seconds())
+}
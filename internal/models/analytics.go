@@ -0,0 +1,112 @@
+package models
+
+import (
+	"time"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/db"
+)
+
+// AnalyticsBucket задает шаг временного ряда аналитики
+type AnalyticsBucket string
+
+const (
+	AnalyticsBucketDay   AnalyticsBucket = "day"
+	AnalyticsBucketWeek  AnalyticsBucket = "week"
+	AnalyticsBucketMonth AnalyticsBucket = "month"
+)
+
+// IsValid проверяет, является ли шаг валидным
+func (b AnalyticsBucket) IsValid() bool {
+	return b == AnalyticsBucketDay || b == AnalyticsBucketWeek || b == AnalyticsBucketMonth
+}
+
+// Percentiles - перцентили длительности
+type Percentiles struct {
+	P50 time.Duration
+	P90 time.Duration
+	P99 time.Duration
+}
+
+// PercentilesFromDB преобразует секунды p50, p90 и p99 из запроса.
+// Для интервала без данных возвращает nil.
+func PercentilesFromDB(seconds []float64) *Percentiles {
+	if len(seconds) != 3 {
+		return nil
+	}
+	return &Percentiles{
+		P50: secondsToDuration(seconds[0]),
+		P90: secondsToDuration(seconds[1]),
+		P99: secondsToDuration(seconds[2]),
+	}
+}
+
+func secondsToDuration(seconds float64) time.Duration {
+	return time.Duration(seconds * float64(time.Second)).Round(time.Second)
+}
+
+// ReviewTimeBucket - время ревью PR, созданных в интервале
+type ReviewTimeBucket struct {
+	BucketStart time.Time
+	PRsOpened   int64
+	PRsMerged   int64
+	// TimeToFirstAssignment и TimeToMerge - nil, если в интервале нет
+	// назначенных или слитых PR
+	TimeToFirstAssignment *Percentiles
+	TimeToMerge           *Percentiles
+}
+
+// ReviewTimeBucketFromDBRow преобразует результат запроса GetReviewTimeAnalytics
+func ReviewTimeBucketFromDBRow(dbRow db.GetReviewTimeAnalyticsRow) ReviewTimeBucket {
+	return ReviewTimeBucket{
+		BucketStart:           dbRow.BucketStart.Time,
+		PRsOpened:             dbRow.PrsOpened,
+		PRsMerged:             dbRow.PrsMerged,
+		TimeToFirstAssignment: PercentilesFromDB(dbRow.FirstAssignmentPercentiles),
+		TimeToMerge:           PercentilesFromDB(dbRow.MergePercentiles),
+	}
+}
+
+// ReviewerThroughput - назначения ревьюера в интервале
+type ReviewerThroughput struct {
+	BucketStart     time.Time
+	UserID          string
+	Username        string
+	TeamName        string
+	ReviewsAssigned int64
+	ReviewsMerged   int64
+	// Share - доля ревьюера среди всех назначений интервала
+	Share float64
+}
+
+// ReviewerThroughputFromDBRow преобразует результат запроса GetReviewerThroughput
+func ReviewerThroughputFromDBRow(dbRow db.GetReviewerThroughputRow) ReviewerThroughput {
+	return ReviewerThroughput{
+		BucketStart:     dbRow.BucketStart.Time,
+		UserID:          dbRow.UserID,
+		Username:        dbRow.Username,
+		TeamName:        dbRow.TeamName,
+		ReviewsAssigned: dbRow.ReviewsAssigned,
+		ReviewsMerged:   dbRow.ReviewsMerged,
+		Share:           dbRow.Share,
+	}
+}
+
+// TeamThroughput - PR команды, открытые и слитые в интервале
+type TeamThroughput struct {
+	BucketStart         time.Time
+	TeamName            string
+	PRsOpened           int64
+	PRsMerged           int64
+	PRsMergedCumulative int64
+}
+
+// TeamThroughputFromDBRow преобразует результат запроса GetTeamThroughput
+func TeamThroughputFromDBRow(dbRow db.GetTeamThroughputRow) TeamThroughput {
+	return TeamThroughput{
+		BucketStart:         dbRow.BucketStart.Time,
+		TeamName:            dbRow.TeamName,
+		PRsOpened:           dbRow.PrsOpened,
+		PRsMerged:           dbRow.PrsMerged,
+		PRsMergedCumulative: dbRow.PrsMergedCumulative,
+	}
+}
@@ -0,0 +1,95 @@
+package models
+
+import (
+	"time"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/db"
+)
+
+// UserReview - PR, на который назначен пользователь, с деталями назначения
+type UserReview struct {
+	PullRequestShort
+	CreatedAt  time.Time
+	MergedAt   *time.Time
+	AssignedAt time.Time
+	Assignment
+}
+
+// UserReviewFromDBRow преобразует результат запроса GetPullRequestsByReviewerUserID
+func UserReviewFromDBRow(dbRow db.GetPullRequestsByReviewerUserIDRow) UserReview {
+	review := UserReview{
+		PullRequestShort: PullRequestShortFromDBRow(dbRow),
+		CreatedAt:        dbRow.CreatedAt.Time,
+		AssignedAt:       dbRow.AssignedAt.Time,
+		Assignment:       assignmentFromDB(dbRow.Source, dbRow.Strategy, dbRow.WorkloadAtAssignment, dbRow.TieBreak),
+	}
+	if dbRow.MergedAt.Valid {
+		review.MergedAt = &dbRow.MergedAt.Time
+	}
+	return review
+}
+
+// UserReviewsFromDBRows преобразует список результатов запроса
+func UserReviewsFromDBRows(dbRows []db.GetPullRequestsByReviewerUserIDRow) []UserReview {
+	reviews := make([]UserReview, len(dbRows))
+	for i, dbRow := range dbRows {
+		reviews[i] = UserReviewFromDBRow(dbRow)
+	}
+	return reviews
+}
+
+// PendingReview - открытый PR, ожидающий ревью пользователя
+type PendingReview struct {
+	UserReview
+	// Age - время с создания PR
+	Age time.Duration
+	// DueAt - срок ревью по review_sla политики, считается от назначения
+	DueAt   time.Time
+	Overdue bool
+}
+
+// AuthoredPR - открытый PR пользователя с его ревьюерами
+type AuthoredPR struct {
+	PullRequest
+	Reviewers []AuthoredPRReviewer
+}
+
+// AuthoredPRReviewer - ревьюер PR пользователя и срок его ревью
+type AuthoredPRReviewer struct {
+	ReviewerInfo
+	DueAt   time.Time
+	Overdue bool
+}
+
+// ReviewCapacity - текущая нагрузка пользователя относительно лимита политики
+type ReviewCapacity struct {
+	OpenReviews int64
+	// MaxOpenReviews - лимит политики, 0 - без ограничения
+	MaxOpenReviews int
+	// Remaining - сколько ревью еще можно назначить, nil без ограничения
+	Remaining *int64
+	// AtCapacity - лимит достигнут, автоназначение пропускает пользователя
+	AtCapacity bool
+	// Unavailable - пользователь сейчас в периоде недоступности
+	Unavailable bool
+}
+
+// RecentReviewStats - ревью пользователя за последний период
+type RecentReviewStats struct {
+	Window TimeWindow
+	// ReviewsAssigned - назначения на ревью в окне
+	ReviewsAssigned int64
+	// ReviewsMerged - из них на PR, которые уже слиты
+	ReviewsMerged int64
+}
+
+// Dashboard - персональная сводка ревьюера
+type Dashboard struct {
+	User           UserWithTeam
+	PendingReviews []PendingReview
+	AuthoredPRs    []AuthoredPR
+	Capacity       ReviewCapacity
+	// Unavailability - текущие и будущие периоды недоступности
+	Unavailability []Unavailability
+	RecentStats    RecentReviewStats
+}
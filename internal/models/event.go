@@ -0,0 +1,58 @@
+package models
+
+import "time"
+
+// EventType - вид события назначения
+type EventType string
+
+const (
+	EventReviewerAssigned EventType = "reviewer.assigned"
+	EventReviewerReplaced EventType = "reviewer.replaced"
+	EventPRMerged         EventType = "pr.merged"
+	EventUserDeactivated  EventType = "user.deactivated"
+)
+
+// Event - событие из журнала назначений. События пишутся триггерами БД
+// в той же транзакции, что и изменение.
+type Event struct {
+	ID            int64
+	Type          EventType
+	PullRequestID *string
+	// AuthorID - автор PR, для событий PR
+	AuthorID *string
+	// UserID - назначенный ревьюер или деактивированный пользователь
+	UserID *string
+	// PreviousUserID - замененный ревьюер для reviewer.replaced
+	PreviousUserID *string
+	// TeamName - команда автора PR или деактивированного пользователя
+	TeamName  *string
+	Details   map[string]any
+	CreatedAt time.Time
+}
+
+// EventFilter отбирает события для подписчика. Пустое поле не ограничивает
+// выборку, UserID совпадает с ревьюером, замененным ревьюером или автором PR.
+type EventFilter struct {
+	UserID        string
+	TeamName      string
+	PullRequestID string
+}
+
+// Matches проверяет, что событие подходит под фильтр
+func (f EventFilter) Matches(e Event) bool {
+	if f.PullRequestID != "" && !equalPtr(e.PullRequestID, f.PullRequestID) {
+		return false
+	}
+	if f.TeamName != "" && !equalPtr(e.TeamName, f.TeamName) {
+		return false
+	}
+	if f.UserID != "" && !equalPtr(e.UserID, f.UserID) &&
+		!equalPtr(e.PreviousUserID, f.UserID) && !equalPtr(e.AuthorID, f.UserID) {
+		return false
+	}
+	return true
+}
+
+func equalPtr(p *string, s string) bool {
+	return p != nil && *p == s
+}
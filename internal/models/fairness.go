@@ -0,0 +1,70 @@
+package models
+
+import "github.com/AtoyanMikhail/PRAssignmentService/internal/db"
+
+// ReviewerAssignmentCount - назначения ревьюера за окно
+type ReviewerAssignmentCount struct {
+	TeamName    string
+	UserID      string
+	Username    string
+	IsActive    bool
+	Assignments int64
+	// PolicyAssignments - назначения, выбранные политикой, с известным
+	// признаком равенства оценок
+	PolicyAssignments int64
+	// TieBreakAssignments - из них решенные порядком среди равных кандидатов
+	TieBreakAssignments int64
+}
+
+// ReviewerAssignmentCountFromDBRow преобразует результат запроса GetReviewerAssignmentCounts
+func ReviewerAssignmentCountFromDBRow(dbRow db.GetReviewerAssignmentCountsRow) ReviewerAssignmentCount {
+	return ReviewerAssignmentCount{
+		TeamName:            dbRow.TeamName,
+		UserID:              dbRow.UserID,
+		Username:            dbRow.Username,
+		IsActive:            dbRow.IsActive,
+		Assignments:         dbRow.Assignments,
+		PolicyAssignments:   dbRow.PolicyAssignments,
+		TieBreakAssignments: dbRow.TieBreakAssignments,
+	}
+}
+
+// FairnessStatus - положение ревьюера относительно среднего по команде
+type FairnessStatus string
+
+const (
+	FairnessOverAssigned  FairnessStatus = "over_assigned"
+	FairnessUnderAssigned FairnessStatus = "under_assigned"
+	FairnessBalanced      FairnessStatus = "balanced"
+)
+
+// ReviewerFairness - назначения ревьюера относительно среднего по команде
+type ReviewerFairness struct {
+	ReviewerAssignmentCount
+	// Deviation - отклонение от среднего по команде в назначениях
+	Deviation float64
+	// RelativeDeviation - отклонение в долях среднего, 0 при нулевом среднем
+	RelativeDeviation float64
+	Status            FairnessStatus
+}
+
+// TeamFairness - неравенство назначений внутри команды за окно
+type TeamFairness struct {
+	TeamName         string
+	Reviewers        int
+	TotalAssignments int64
+	Mean             float64
+	// Gini - коэффициент Джини назначений: 0 - поровну, 1 - все у одного
+	Gini float64
+	// GiniWithoutTieBreaks - коэффициент Джини без назначений, решенных
+	// равенством оценок. Разница с Gini показывает вклад порядка кандидатов.
+	GiniWithoutTieBreaks float64
+	// MaxMinRatio - отношение наибольшего числа назначений к наименьшему,
+	// nil, если у кого-то из ревьюеров нет назначений
+	MaxMinRatio         *float64
+	PolicyAssignments   int64
+	TieBreakAssignments int64
+	// TieBreakShare - доля решенных равенством среди назначений по политике
+	TieBreakShare float64
+	Members       []ReviewerFairness
+}
@@ -0,0 +1,15 @@
+package models
+
+// IdempotentResponse - сохраненный ответ на запрос с ключом идемпотентности
+type IdempotentResponse struct {
+	StatusCode  int
+	ContentType string
+	Body        []byte
+}
+
+// IdempotencyRecord - занятый клиентом ключ идемпотентности. Response
+// пустой, пока первый запрос с этим ключом не завершен.
+type IdempotencyRecord struct {
+	RequestHash string
+	Response    *IdempotentResponse
+}
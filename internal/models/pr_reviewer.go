@@ -7,12 +7,58 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+// AssignmentSource описывает, каким путем ревьювер попал на PR
+type AssignmentSource string
+
+const (
+	AssignmentSourceAuto                 AssignmentSource = "auto"
+	AssignmentSourceManual               AssignmentSource = "manual"
+	AssignmentSourceFallbackTeam         AssignmentSource = "fallback_team"
+	AssignmentSourceCodeOwner            AssignmentSource = "code_owner"
+	AssignmentSourceSLAEscalation        AssignmentSource = "sla_escalation"
+	AssignmentSourceInactiveReassignment AssignmentSource = "inactive_reassignment"
+)
+
+// Assignment объясняет, почему ревьювер был выбран
+type Assignment struct {
+	Source AssignmentSource
+	// Strategy - стратегия выбора, пустая для ручного назначения
+	Strategy string
+	// WorkloadAtAssignment - количество открытых ревью кандидата в момент назначения
+	WorkloadAtAssignment *int64
+	// TieBreak - выбор решил порядок кандидатов: невыбранный кандидат имел
+	// ту же оценку. nil для назначений не по политике.
+	TieBreak *bool
+}
+
+// StrategyToDB преобразует пустую стратегию в NULL
+func (a Assignment) StrategyToDB() *string {
+	if a.Strategy == "" {
+		return nil
+	}
+	return &a.Strategy
+}
+
+// assignmentFromDB собирает Assignment из колонок pr_reviewers
+func assignmentFromDB(source string, strategy *string, workload *int64, tieBreak *bool) Assignment {
+	a := Assignment{
+		Source:               AssignmentSource(source),
+		WorkloadAtAssignment: workload,
+		TieBreak:             tieBreak,
+	}
+	if strategy != nil {
+		a.Strategy = *strategy
+	}
+	return a
+}
+
 // PRReviewer представляет назначение ревьювера на PR
 type PRReviewer struct {
 	ID            int64
 	PullRequestID string
 	UserID        string
 	AssignedAt    time.Time
+	Assignment
 }
 
 // ToDBPRReviewer преобразует доменную модель в модель базы данных
@@ -25,6 +71,10 @@ func (r *PRReviewer) ToDBPRReviewer() db.PrReviewer {
 			Time:  r.AssignedAt,
 			Valid: true,
 		},
+		Source:               string(r.Source),
+		Strategy:             r.StrategyToDB(),
+		WorkloadAtAssignment: r.WorkloadAtAssignment,
+		TieBreak:             r.TieBreak,
 	}
 }
 
@@ -35,6 +85,7 @@ func PRReviewerFromDB(dbReviewer db.PrReviewer) PRReviewer {
 		PullRequestID: dbReviewer.PullRequestID,
 		UserID:        dbReviewer.UserID,
 		AssignedAt:    dbReviewer.AssignedAt.Time,
+		Assignment:    assignmentFromDB(dbReviewer.Source, dbReviewer.Strategy, dbReviewer.WorkloadAtAssignment, dbReviewer.TieBreak),
 	}
 }
 
@@ -55,7 +106,9 @@ type ReviewerInfo struct {
 	Username      string
 	TeamID        int64
 	IsActive      bool
+	Seniority     Seniority
 	AssignedAt    time.Time
+	Assignment
 }
 
 // ReviewerInfoFromDBRow преобразует результат запроса GetReviewersByPRID
@@ -67,7 +120,9 @@ func ReviewerInfoFromDBRow(dbRow db.GetReviewersByPRIDRow) ReviewerInfo {
 		Username:      dbRow.Username,
 		TeamID:        dbRow.TeamID,
 		IsActive:      dbRow.IsActive,
+		Seniority:     Seniority(dbRow.Seniority),
 		AssignedAt:    dbRow.AssignedAt.Time,
+		Assignment:    assignmentFromDB(dbRow.Source, dbRow.Strategy, dbRow.WorkloadAtAssignment, dbRow.TieBreak),
 	}
 }
 
@@ -85,7 +140,9 @@ type InactiveReviewerInfo struct {
 	PullRequestID      string
 	AuthorID           string
 	InactiveReviewerID string
-	TeamID             int64
+	// TeamID - команда неактивного ревьюера, AuthorTeamID - команда автора PR
+	TeamID       int64
+	AuthorTeamID int64
 }
 
 // InactiveReviewerInfoFromDBRow преобразует результат запроса GetOpenPRsWithInactiveReviewers
@@ -95,6 +152,7 @@ func InactiveReviewerInfoFromDBRow(dbRow db.GetOpenPRsWithInactiveReviewersRow)
 		AuthorID:           dbRow.AuthorID,
 		InactiveReviewerID: dbRow.InactiveReviewerID,
 		TeamID:             dbRow.TeamID,
+		AuthorTeamID:       dbRow.AuthorTeamID,
 	}
 }
 
@@ -29,6 +29,9 @@ type PullRequest struct {
 	Status          PullRequestStatus
 	CreatedAt       time.Time
 	MergedAt        *time.Time
+	// RequiredTags - навыки, нужные для ревью. Заполняется только там, где
+	// теги загружаются вместе с PR.
+	RequiredTags []string
 }
 
 // ToDBPullRequest преобразует доменную модель в модель базы данных
@@ -0,0 +1,72 @@
+package models
+
+import (
+	"time"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/db"
+)
+
+// ReviewerRuleEffect определяет действие правила назначения
+type ReviewerRuleEffect string
+
+const (
+	// ReviewerRuleBlock запрещает назначать ревьюера
+	ReviewerRuleBlock ReviewerRuleEffect = "block"
+	// ReviewerRulePrefer повышает оценку ревьюера при автоматическом выборе
+	ReviewerRulePrefer ReviewerRuleEffect = "prefer"
+)
+
+// IsValid проверяет, является ли действие валидным
+func (e ReviewerRuleEffect) IsValid() bool {
+	return e == ReviewerRuleBlock || e == ReviewerRulePrefer
+}
+
+// ReviewerRule - постоянное правило назначения ревьюера на PR автора
+// (AuthorID) или на PR всех участников команды (TeamID). Задано ровно
+// одно из двух.
+type ReviewerRule struct {
+	ID         int64
+	Effect     ReviewerRuleEffect
+	ReviewerID string
+	AuthorID   *string
+	TeamID     *int64
+	TeamName   *string
+	Reason     string
+	CreatedBy  string
+	CreatedAt  time.Time
+}
+
+// ReviewerRuleFromDB преобразует модель базы данных в доменную модель
+func ReviewerRuleFromDB(dbRule db.ReviewerRule, teamName *string) ReviewerRule {
+	return ReviewerRule{
+		ID:         dbRule.ID,
+		Effect:     ReviewerRuleEffect(dbRule.Effect),
+		ReviewerID: dbRule.ReviewerID,
+		AuthorID:   dbRule.AuthorID,
+		TeamID:     dbRule.TeamID,
+		TeamName:   teamName,
+		Reason:     dbRule.Reason,
+		CreatedBy:  dbRule.CreatedBy,
+		CreatedAt:  dbRule.CreatedAt.Time,
+	}
+}
+
+// AuditAction - вид события в журнале аудита
+type AuditAction string
+
+const (
+	AuditRuleCreated    AuditAction = "rule_created"
+	AuditRuleDeleted    AuditAction = "rule_deleted"
+	AuditRuleOverridden AuditAction = "rule_overridden"
+)
+
+// AuditEntry - запись журнала аудита
+type AuditEntry struct {
+	ID            int64
+	Action        AuditAction
+	Actor         string
+	PullRequestID *string
+	UserID        *string
+	Details       map[string]any
+	CreatedAt     time.Time
+}
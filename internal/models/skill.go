@@ -0,0 +1,56 @@
+package models
+
+import "github.com/AtoyanMikhail/PRAssignmentService/internal/db"
+
+// SkillLevel представляет уровень владения навыком
+type SkillLevel string
+
+const (
+	SkillLevelNovice       SkillLevel = "novice"
+	SkillLevelIntermediate SkillLevel = "intermediate"
+	SkillLevelExpert       SkillLevel = "expert"
+)
+
+// IsValid проверяет, является ли уровень валидным
+func (l SkillLevel) IsValid() bool {
+	return l == SkillLevelNovice || l == SkillLevelIntermediate || l == SkillLevelExpert
+}
+
+// Rank возвращает числовое значение уровня: 1 для novice, 3 для expert
+func (l SkillLevel) Rank() int {
+	switch l {
+	case SkillLevelNovice:
+		return 1
+	case SkillLevelIntermediate:
+		return 2
+	case SkillLevelExpert:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// UserSkill представляет навык пользователя, например go:expert
+type UserSkill struct {
+	UserID string
+	Tag    string
+	Level  SkillLevel
+}
+
+// UserSkillFromDB преобразует модель базы данных в доменную модель
+func UserSkillFromDB(dbSkill db.UserSkill) UserSkill {
+	return UserSkill{
+		UserID: dbSkill.UserID,
+		Tag:    dbSkill.Tag,
+		Level:  SkillLevel(dbSkill.Level),
+	}
+}
+
+// UserSkillsFromDB преобразует список моделей базы данных в доменные модели
+func UserSkillsFromDB(dbSkills []db.UserSkill) []UserSkill {
+	skills := make([]UserSkill, len(dbSkills))
+	for i, dbSkill := range dbSkills {
+		skills[i] = UserSkillFromDB(dbSkill)
+	}
+	return skills
+}
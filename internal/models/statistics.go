@@ -1,9 +1,29 @@
 package models
 
 import (
+	"time"
+
 	"github.com/AtoyanMikhail/PRAssignmentService/internal/db"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
+// TimeWindow ограничивает статистику полуинтервалом [From, To).
+// Нулевая граница не ограничивает выборку.
+type TimeWindow struct {
+	From time.Time
+	To   time.Time
+}
+
+// StartToDB возвращает нижнюю границу окна для запроса
+func (w TimeWindow) StartToDB() pgtype.Timestamp {
+	return pgtype.Timestamp{Time: w.From.UTC(), Valid: !w.From.IsZero()}
+}
+
+// EndToDB возвращает верхнюю границу окна для запроса
+func (w TimeWindow) EndToDB() pgtype.Timestamp {
+	return pgtype.Timestamp{Time: w.To.UTC(), Valid: !w.To.IsZero()}
+}
+
 // AssignmentStats представляет статистику назначений по пользователю
 type AssignmentStats struct {
 	UserID           string
@@ -42,17 +62,26 @@ type PRStats struct {
 	AuthorID        string
 	Status          PullRequestStatus
 	ReviewersCount  int64
+	CreatedAt       time.Time
+	MergedAt        *time.Time
 }
 
 // PRStatsFromDBRow преобразует результат запроса GetPRStats
 func PRStatsFromDBRow(dbRow db.GetPRStatsRow) PRStats {
-	return PRStats{
+	stats := PRStats{
 		PullRequestID:   dbRow.PullRequestID,
 		PullRequestName: dbRow.PullRequestName,
 		AuthorID:        dbRow.AuthorID,
 		Status:          PullRequestStatus(dbRow.Status),
 		ReviewersCount:  dbRow.ReviewersCount,
+		CreatedAt:       dbRow.CreatedAt.Time,
+	}
+
+	if dbRow.MergedAt.Valid {
+		stats.MergedAt = &dbRow.MergedAt.Time
 	}
+
+	return stats
 }
 
 // PRStatsListFromDBRows преобразует список результатов запроса
@@ -66,21 +95,28 @@ func PRStatsListFromDBRows(dbRows []db.GetPRStatsRow) []PRStats {
 
 // TeamStats представляет статистику по команде
 type TeamStats struct {
-	TeamName         string
-	TotalMembers     int64
-	ActiveMembers    int64
-	TotalPRsAuthored int64
-	TotalPRsReviewed int64
+	TeamName      string
+	TotalMembers  int64
+	ActiveMembers int64
+	// PRsAuthored и PRsMerged - PR участников команды, созданные в окне
+	PRsAuthored int64
+	PRsMerged   int64
+	// PRsReviewed - различные PR, на которые участники команды назначены
+	// в окне, ReviewAssignments - все такие назначения
+	PRsReviewed       int64
+	ReviewAssignments int64
 }
 
 // TeamStatsFromDBRow преобразует результат запроса GetTeamStats
 func TeamStatsFromDBRow(dbRow db.GetTeamStatsRow) TeamStats {
 	return TeamStats{
-		TeamName:         dbRow.TeamName,
-		TotalMembers:     dbRow.TotalMembers,
-		ActiveMembers:    dbRow.ActiveMembers,
-		TotalPRsAuthored: dbRow.TotalPrsAuthored,
-		TotalPRsReviewed: dbRow.TotalPrsReviewed,
+		TeamName:          dbRow.TeamName,
+		TotalMembers:      dbRow.TotalMembers,
+		ActiveMembers:     dbRow.ActiveMembers,
+		PRsAuthored:       dbRow.PrsAuthored,
+		PRsMerged:         dbRow.PrsMerged,
+		PRsReviewed:       dbRow.PrsReviewed,
+		ReviewAssignments: dbRow.ReviewAssignments,
 	}
 }
 
@@ -121,3 +157,28 @@ func UserWorkloadListFromDBRows(dbRows []db.GetUserWorkloadRow) []UserWorkload {
 	}
 	return workloads
 }
+
+// TeamReviewLoad представляет доступных ревьюеров и открытые ревью команды
+type TeamReviewLoad struct {
+	TeamName      string
+	ActiveMembers int64
+	OpenReviews   int64
+}
+
+// TeamReviewLoadFromDBRow преобразует результат запроса GetTeamReviewLoad
+func TeamReviewLoadFromDBRow(dbRow db.GetTeamReviewLoadRow) TeamReviewLoad {
+	return TeamReviewLoad{
+		TeamName:      dbRow.TeamName,
+		ActiveMembers: dbRow.ActiveMembers,
+		OpenReviews:   dbRow.OpenReviews,
+	}
+}
+
+// TeamReviewLoadListFromDBRows преобразует список результатов запроса
+func TeamReviewLoadListFromDBRows(dbRows []db.GetTeamReviewLoadRow) []TeamReviewLoad {
+	loads := make([]TeamReviewLoad, len(dbRows))
+	for i, dbRow := range dbRows {
+		loads[i] = TeamReviewLoadFromDBRow(dbRow)
+	}
+	return loads
+}
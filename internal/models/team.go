@@ -12,6 +12,9 @@ type Team struct {
 	ID        int64
 	TeamName  string
 	CreatedAt time.Time
+	// MentorshipEnabled требует на каждом PR команды senior-ревьюера и,
+	// если возможно, learner-ревьюера
+	MentorshipEnabled bool
 }
 
 // ToDBTeam преобразует доменную модель в модель базы данных
@@ -23,15 +26,17 @@ func (t *Team) ToDBTeam() db.Team {
 			Time:  t.CreatedAt,
 			Valid: true,
 		},
+		MentorshipEnabled: t.MentorshipEnabled,
 	}
 }
 
 // TeamFromDB преобразует модель базы данных в доменную модель
 func TeamFromDB(dbTeam db.Team) Team {
 	return Team{
-		ID:        dbTeam.ID,
-		TeamName:  dbTeam.TeamName,
-		CreatedAt: dbTeam.CreatedAt.Time,
+		ID:                dbTeam.ID,
+		TeamName:          dbTeam.TeamName,
+		CreatedAt:         dbTeam.CreatedAt.Time,
+		MentorshipEnabled: dbTeam.MentorshipEnabled,
 	}
 }
 
@@ -0,0 +1,54 @@
+package models
+
+import (
+	"time"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/db"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// Unavailability - период, когда пользователь не может ревьюить [StartsAt, EndsAt)
+type Unavailability struct {
+	ID        int64
+	UserID    string
+	StartsAt  time.Time
+	EndsAt    time.Time
+	Reason    string
+	CreatedAt time.Time
+}
+
+// ActiveAt проверяет, что момент t попадает в период
+func (u Unavailability) ActiveAt(t time.Time) bool {
+	return !t.Before(u.StartsAt) && t.Before(u.EndsAt)
+}
+
+// ToDBParams преобразует период в параметры запроса AddUnavailability
+func (u Unavailability) ToDBParams() db.AddUnavailabilityParams {
+	return db.AddUnavailabilityParams{
+		UserID:   u.UserID,
+		StartsAt: pgtype.Timestamp{Time: u.StartsAt.UTC(), Valid: true},
+		EndsAt:   pgtype.Timestamp{Time: u.EndsAt.UTC(), Valid: true},
+		Reason:   u.Reason,
+	}
+}
+
+// UnavailabilityFromDB преобразует модель базы данных в доменную модель
+func UnavailabilityFromDB(dbRow db.UserUnavailability) Unavailability {
+	return Unavailability{
+		ID:        dbRow.ID,
+		UserID:    dbRow.UserID,
+		StartsAt:  dbRow.StartsAt.Time,
+		EndsAt:    dbRow.EndsAt.Time,
+		Reason:    dbRow.Reason,
+		CreatedAt: dbRow.CreatedAt.Time,
+	}
+}
+
+// UnavailabilityListFromDB преобразует список моделей базы данных в доменные модели
+func UnavailabilityListFromDB(dbRows []db.UserUnavailability) []Unavailability {
+	periods := make([]Unavailability, len(dbRows))
+	for i, dbRow := range dbRows {
+		periods[i] = UnavailabilityFromDB(dbRow)
+	}
+	return periods
+}
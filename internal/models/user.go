@@ -7,6 +7,20 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+// Seniority представляет уровень ревьюера для наставничества
+type Seniority string
+
+const (
+	SeniorityLearner Seniority = "learner"
+	SeniorityRegular Seniority = "regular"
+	SenioritySenior  Seniority = "senior"
+)
+
+// IsValid проверяет, является ли уровень валидным
+func (s Seniority) IsValid() bool {
+	return s == SeniorityLearner || s == SeniorityRegular || s == SenioritySenior
+}
+
 // User представляет пользователя в доменной модели
 type User struct {
 	ID        int64
@@ -14,6 +28,7 @@ type User struct {
 	Username  string
 	TeamID    int64
 	IsActive  bool
+	Seniority Seniority
 	CreatedAt time.Time
 	UpdatedAt time.Time
 }
@@ -21,11 +36,12 @@ type User struct {
 // ToDBUser преобразует доменную модель в модель базы данных
 func (u *User) ToDBUser() db.User {
 	return db.User{
-		ID:       u.ID,
-		UserID:   u.UserID,
-		Username: u.Username,
-		TeamID:   u.TeamID,
-		IsActive: u.IsActive,
+		ID:        u.ID,
+		UserID:    u.UserID,
+		Username:  u.Username,
+		TeamID:    u.TeamID,
+		IsActive:  u.IsActive,
+		Seniority: string(u.Seniority),
 		CreatedAt: pgtype.Timestamp{
 			Time:  u.CreatedAt,
 			Valid: true,
@@ -45,6 +61,7 @@ func UserFromDB(dbUser db.User) User {
 		Username:  dbUser.Username,
 		TeamID:    dbUser.TeamID,
 		IsActive:  dbUser.IsActive,
+		Seniority: Seniority(dbUser.Seniority),
 		CreatedAt: dbUser.CreatedAt.Time,
 		UpdatedAt: dbUser.UpdatedAt.Time,
 	}
@@ -67,8 +84,11 @@ type UserWithTeam struct {
 	TeamID    int64
 	TeamName  string
 	IsActive  bool
+	Seniority Seniority
 	CreatedAt time.Time
 	UpdatedAt time.Time
+	// TeamMentorshipEnabled - включено ли наставничество в команде пользователя
+	TeamMentorshipEnabled bool
 }
 
 // UserWithTeamFromDB преобразует результат запроса GetUserWithTeam в доменную модель
@@ -80,7 +100,10 @@ func UserWithTeamFromDB(dbRow db.GetUserWithTeamRow) UserWithTeam {
 		TeamID:    dbRow.TeamID,
 		TeamName:  dbRow.TeamName,
 		IsActive:  dbRow.IsActive,
+		Seniority: Seniority(dbRow.Seniority),
 		CreatedAt: dbRow.CreatedAt.Time,
 		UpdatedAt: dbRow.UpdatedAt.Time,
+
+		TeamMentorshipEnabled: dbRow.MentorshipEnabled,
 	}
 }
@@ -0,0 +1,21 @@
+package models
+
+import "github.com/AtoyanMikhail/PRAssignmentService/internal/db"
+
+// WorkloadDrift - расхождение учтенной нагрузки ревьюера с фактической
+type WorkloadDrift struct {
+	UserID string
+	// Recorded - значение в reviewer_workload до исправления
+	Recorded int64
+	// Actual - число открытых PR, где пользователь назначен ревьюером
+	Actual int64
+}
+
+// WorkloadDriftFromDBRow преобразует результат запроса ReconcileReviewerWorkload
+func WorkloadDriftFromDBRow(dbRow db.ReconcileReviewerWorkloadRow) WorkloadDrift {
+	return WorkloadDrift{
+		UserID:   dbRow.UserID,
+		Recorded: dbRow.Recorded,
+		Actual:   dbRow.Actual,
+	}
+}
@@ -0,0 +1,102 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/config"
+)
+
+// Источники обновления политики
+const (
+	SourceStartup = "startup"
+	SourceSIGHUP  = "sighup"
+	SourceAdmin   = "admin"
+)
+
+// Policy - политика назначения ревьюеров
+type Policy = config.AssignmentConfig
+
+// Snapshot - неизменяемая версия политики. Запрос использует снимок,
+// полученный при его начале, даже если политику обновили во время обработки.
+type Snapshot struct {
+	Policy
+	Version   int64
+	UpdatedAt time.Time
+	Source    string
+}
+
+// ValidationError содержит все нарушения в документе политики
+type ValidationError struct {
+	Errors []error
+}
+
+// Error реализует error
+func (e *ValidationError) Error() string {
+	return "invalid assignment policy: " + errors.Join(e.Errors...).Error()
+}
+
+// Store хранит текущую политику и атомарно подменяет ее
+type Store struct {
+	current atomic.Pointer[Snapshot]
+	mu      sync.Mutex // сериализует обновления, чтобы версии шли по порядку
+}
+
+// NewStore создает хранилище с начальной политикой
+func NewStore(initial Policy) (*Store, error) {
+	s := &Store{}
+	if _, err := s.Update(initial, SourceStartup); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// Current возвращает текущий снимок политики
+func (s *Store) Current() *Snapshot {
+	return s.current.Load()
+}
+
+// Update проверяет политику и делает ее текущей.
+// При ошибке проверки текущая политика не меняется.
+func (s *Store) Update(p Policy, source string) (*Snapshot, error) {
+	if errs := p.Validate(); len(errs) > 0 {
+		return nil, &ValidationError{Errors: errs}
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var version int64 = 1
+	if prev := s.current.Load(); prev != nil {
+		version = prev.Version + 1
+	}
+
+	snap := &Snapshot{
+		Policy:    p,
+		Version:   version,
+		UpdatedAt: time.Now().UTC(),
+		Source:    source,
+	}
+	s.current.Store(snap)
+	return snap, nil
+}
+
+// ctxKey - ключ снимка политики в context.Context
+type ctxKey struct{}
+
+// WithSnapshot сохраняет снимок политики в контексте запроса
+func WithSnapshot(ctx context.Context, snap *Snapshot) context.Context {
+	return context.WithValue(ctx, ctxKey{}, snap)
+}
+
+// FromContext возвращает снимок политики из контекста, а если его нет -
+// текущую политику хранилища
+func (s *Store) FromContext(ctx context.Context) *Snapshot {
+	if snap, ok := ctx.Value(ctxKey{}).(*Snapshot); ok && snap != nil {
+		return snap
+	}
+	return s.Current()
+}
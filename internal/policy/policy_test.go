@@ -0,0 +1,60 @@
+package policy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/config"
+)
+
+func TestStore_Update(t *testing.T) {
+	store, err := NewStore(config.Default().Assignment)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), store.Current().Version)
+	assert.Equal(t, SourceStartup, store.Current().Source)
+
+	t.Run("недопустимая политика не применяется", func(t *testing.T) {
+		bad := store.Current().Policy
+		bad.DefaultReviewerCount = -1
+		bad.Strategy = "round_robin"
+
+		_, err := store.Update(bad, SourceAdmin)
+		var verr *ValidationError
+		require.ErrorAs(t, err, &verr)
+		assert.Len(t, verr.Errors, 2)
+		assert.Equal(t, int64(1), store.Current().Version)
+		assert.Equal(t, 2, store.Current().DefaultReviewerCount)
+	})
+
+	t.Run("новая версия", func(t *testing.T) {
+		next := store.Current().Policy
+		next.MaxOpenReviews = 5
+
+		snap, err := store.Update(next, SourceSIGHUP)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), snap.Version)
+		assert.Equal(t, SourceSIGHUP, snap.Source)
+		assert.Same(t, snap, store.Current())
+	})
+}
+
+func TestStore_FromContext(t *testing.T) {
+	store, err := NewStore(config.Default().Assignment)
+	require.NoError(t, err)
+
+	assert.Same(t, store.Current(), store.FromContext(context.Background()))
+
+	// Запрос продолжает работать со снимком, полученным в начале
+	ctx := WithSnapshot(context.Background(), store.Current())
+	next := store.Current().Policy
+	next.Strategy = config.StrategyRandom
+	_, err = store.Update(next, SourceAdmin)
+	require.NoError(t, err)
+
+	assert.Equal(t, int64(1), store.FromContext(ctx).Version)
+	assert.Equal(t, config.StrategyLeastLoaded, store.FromContext(ctx).Strategy)
+	assert.Equal(t, config.StrategyRandom, store.Current().Strategy)
+}
@@ -0,0 +1,120 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/config"
+)
+
+// Limiter ограничивает частоту запросов по алгоритму token bucket
+// отдельно для каждой пары клиент - маршрут
+type Limiter struct {
+	defaults config.RouteLimit
+	routes   map[string]config.RouteLimit
+	idle     time.Duration
+	now      func() time.Time
+
+	mu      sync.Mutex
+	buckets map[bucketKey]*bucket
+}
+
+type bucketKey struct {
+	client string
+	route  string
+}
+
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// New создает ограничитель по конфигурации
+func New(cfg config.RateLimitConfig) (*Limiter, error) {
+	routes, err := cfg.RouteLimits()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Limiter{
+		defaults: config.RouteLimit{RPS: cfg.RPS, Burst: cfg.Burst},
+		routes:   routes,
+		idle:     cfg.IdleTimeout,
+		now:      time.Now,
+		buckets:  make(map[bucketKey]*bucket),
+	}, nil
+}
+
+// Allow расходует токен клиента для маршрута. Если токенов нет, возвращает
+// false и время, через которое запрос будет разрешен.
+func (l *Limiter) Allow(client, route string) (bool, time.Duration) {
+	now := l.now()
+	b := l.bucket(client, route, now)
+
+	r := b.ReserveN(now, 1)
+	if !r.OK() {
+		return false, 0
+	}
+	if delay := r.DelayFrom(now); delay > 0 {
+		// Отказ не должен расходовать токен, иначе клиент, повторяющий
+		// запросы, никогда не дождется разрешения
+		r.CancelAt(now)
+		return false, delay
+	}
+	return true, 0
+}
+
+// bucket возвращает лимит клиента для маршрута, создавая его при необходимости
+func (l *Limiter) bucket(client, route string, now time.Time) *rate.Limiter {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	key := bucketKey{client: client, route: route}
+	b, ok := l.buckets[key]
+	if !ok {
+		limit, ok := l.routes[route]
+		if !ok {
+			limit = l.defaults
+		}
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(limit.RPS), limit.Burst)}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+	return b.limiter
+}
+
+// Cleanup удаляет лимиты клиентов, не обращавшихся дольше IdleTimeout,
+// и возвращает количество удаленных
+func (l *Limiter) Cleanup() int {
+	cutoff := l.now().Add(-l.idle)
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	removed := 0
+	for key, b := range l.buckets {
+		if b.lastSeen.Before(cutoff) {
+			delete(l.buckets, key)
+			removed++
+		}
+	}
+	return removed
+}
+
+// Run периодически очищает неактивные лимиты до отмены контекста
+func (l *Limiter) Run(ctx context.Context) {
+	ticker := time.NewTicker(l.idle)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			l.Cleanup()
+		}
+	}
+}
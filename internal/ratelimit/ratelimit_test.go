@@ -0,0 +1,75 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/config"
+)
+
+// newTestLimiter создает ограничитель с управляемыми часами
+func newTestLimiter(t *testing.T, cfg config.RateLimitConfig) (*Limiter, *time.Time) {
+	t.Helper()
+	l, err := New(cfg)
+	require.NoError(t, err)
+
+	now := time.Date(2025, 11, 10, 12, 0, 0, 0, time.UTC)
+	l.now = func() time.Time { return now }
+	return l, &now
+}
+
+func TestLimiter_Allow(t *testing.T) {
+	l, now := newTestLimiter(t, config.RateLimitConfig{
+		RPS:         1,
+		Burst:       2,
+		Routes:      map[string]string{"/team/deactivate": "0.1:1"},
+		IdleTimeout: time.Minute,
+	})
+
+	t.Run("burst по умолчанию", func(t *testing.T) {
+		for range 2 {
+			ok, _ := l.Allow("ci", "/pullRequest/create")
+			assert.True(t, ok)
+		}
+		ok, retry := l.Allow("ci", "/pullRequest/create")
+		assert.False(t, ok)
+		assert.Equal(t, time.Second, retry)
+	})
+
+	t.Run("лимит маршрута", func(t *testing.T) {
+		ok, _ := l.Allow("ci", "/team/deactivate")
+		assert.True(t, ok)
+		ok, retry := l.Allow("ci", "/team/deactivate")
+		assert.False(t, ok)
+		assert.Equal(t, 10*time.Second, retry)
+	})
+
+	t.Run("клиенты не влияют друг на друга", func(t *testing.T) {
+		ok, _ := l.Allow("dashboard", "/pullRequest/create")
+		assert.True(t, ok)
+	})
+
+	t.Run("отказ не расходует токены", func(t *testing.T) {
+		for range 5 {
+			ok, _ := l.Allow("ci", "/pullRequest/create")
+			assert.False(t, ok)
+		}
+		*now = now.Add(time.Second)
+		ok, _ := l.Allow("ci", "/pullRequest/create")
+		assert.True(t, ok)
+	})
+}
+
+func TestLimiter_Cleanup(t *testing.T) {
+	l, now := newTestLimiter(t, config.RateLimitConfig{RPS: 1, Burst: 1, IdleTimeout: time.Minute})
+
+	l.Allow("old", "/team/get")
+	*now = now.Add(2 * time.Minute)
+	l.Allow("recent", "/team/get")
+
+	assert.Equal(t, 1, l.Cleanup())
+	assert.Len(t, l.buckets, 1)
+}
@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/db"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+)
+
+// --- AnalyticsRepository implementation ---
+
+func (r *PostgresRepository) GetReviewTimeAnalytics(ctx context.Context, bucket models.AnalyticsBucket, window models.TimeWindow) ([]models.ReviewTimeBucket, error) {
+	rows, err := r.queries.GetReviewTimeAnalytics(ctx, db.GetReviewTimeAnalyticsParams{
+		Bucket:     string(bucket),
+		RangeStart: window.StartToDB(),
+		RangeEnd:   window.EndToDB(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	buckets := make([]models.ReviewTimeBucket, len(rows))
+	for i, row := range rows {
+		buckets[i] = models.ReviewTimeBucketFromDBRow(row)
+	}
+	return buckets, nil
+}
+
+func (r *PostgresRepository) GetReviewerThroughput(ctx context.Context, bucket models.AnalyticsBucket, window models.TimeWindow) ([]models.ReviewerThroughput, error) {
+	rows, err := r.queries.GetReviewerThroughput(ctx, db.GetReviewerThroughputParams{
+		Bucket:     string(bucket),
+		RangeStart: window.StartToDB(),
+		RangeEnd:   window.EndToDB(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	throughput := make([]models.ReviewerThroughput, len(rows))
+	for i, row := range rows {
+		throughput[i] = models.ReviewerThroughputFromDBRow(row)
+	}
+	return throughput, nil
+}
+
+func (r *PostgresRepository) GetTeamThroughput(ctx context.Context, bucket models.AnalyticsBucket, window models.TimeWindow) ([]models.TeamThroughput, error) {
+	rows, err := r.queries.GetTeamThroughput(ctx, db.GetTeamThroughputParams{
+		Bucket:     string(bucket),
+		RangeStart: window.StartToDB(),
+		RangeEnd:   window.EndToDB(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	throughput := make([]models.TeamThroughput, len(rows))
+	for i, row := range rows {
+		throughput[i] = models.TeamThroughputFromDBRow(row)
+	}
+	return throughput, nil
+}
@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/db"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+)
+
+// eventsChannel - канал NOTIFY, в который пишет триггер журнала событий
+const eventsChannel = "assignment_events"
+
+// --- EventRepository implementation ---
+
+func (r *PostgresRepository) ListEventsAfter(ctx context.Context, afterID int64, filter models.EventFilter, limit int) ([]models.Event, error) {
+	dbEvents, err := r.queries.ListEventsAfter(ctx, db.ListEventsAfterParams{
+		AfterID:       afterID,
+		PullRequestID: filter.PullRequestID,
+		TeamName:      filter.TeamName,
+		UserID:        filter.UserID,
+		MaxEvents:     int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+	events := make([]models.Event, 0, len(dbEvents))
+	for _, dbEvent := range dbEvents {
+		event, err := eventFromDB(dbEvent)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func (r *PostgresRepository) ListEventsByIDs(ctx context.Context, ids []int64) ([]models.Event, error) {
+	dbEvents, err := r.queries.ListEventsByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]models.Event, 0, len(dbEvents))
+	for _, dbEvent := range dbEvents {
+		event, err := eventFromDB(dbEvent)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+func (r *PostgresRepository) GetLatestEventID(ctx context.Context) (int64, error) {
+	return r.queries.GetLatestEventID(ctx)
+}
+
+func (r *PostgresRepository) DeleteEventsBefore(ctx context.Context, before time.Time) (int64, error) {
+	return r.queries.DeleteEventsBefore(ctx, pgtype.Timestamp{Time: before.UTC(), Valid: true})
+}
+
+// ListenEvents подписывается на уведомления о новых событиях и вызывает fn
+// сразу после подписки, на каждое уведомление и раз в poll без уведомлений.
+// Соединение забирается из пула до выхода. Возвращает ошибку соединения или fn.
+func (r *PostgresRepository) ListenEvents(ctx context.Context, poll time.Duration, fn func() error) error {
+	pooled, err := r.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire listen connection: %w", err)
+	}
+	// Соединение с LISTEN не должно вернуться в пул
+	conn := pooled.Hijack()
+	defer func() { _ = conn.Close(context.Background()) }()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+eventsChannel); err != nil {
+		return fmt.Errorf("failed to listen for events: %w", err)
+	}
+
+	for {
+		if err := fn(); err != nil {
+			return err
+		}
+
+		waitCtx, cancel := context.WithTimeout(ctx, poll)
+		_, err := conn.WaitForNotification(waitCtx)
+		cancel()
+		if err != nil && (ctx.Err() != nil || !errors.Is(err, context.DeadlineExceeded)) {
+			return err
+		}
+	}
+}
+
+func eventFromDB(dbEvent db.AssignmentEvent) (models.Event, error) {
+	event := models.Event{
+		ID:             dbEvent.ID,
+		Type:           models.EventType(dbEvent.EventType),
+		PullRequestID:  dbEvent.PullRequestID,
+		AuthorID:       dbEvent.AuthorID,
+		UserID:         dbEvent.UserID,
+		PreviousUserID: dbEvent.PreviousUserID,
+		TeamName:       dbEvent.TeamName,
+		CreatedAt:      dbEvent.CreatedAt.Time,
+	}
+	if err := json.Unmarshal(dbEvent.Details, &event.Details); err != nil {
+		return models.Event{}, err
+	}
+	return event, nil
+}
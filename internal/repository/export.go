@@ -0,0 +1,172 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/db"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+)
+
+// Запросы потокового экспорта статистики. Они возвращают те же столбцы,
+// что GetAssignmentStats, GetPRStats, GetTeamStats и GetUserWorkload из
+// database/queries/statistics.sql, но строки передаются вызывающему по
+// мере чтения, без сбора результата в память.
+
+const exportAssignmentStats = `-- name: ExportAssignmentStats
+SELECT
+    u.user_id,
+    u.username,
+    t.team_name,
+    COUNT(pr.id) as total_assignments,
+    COUNT(CASE WHEN p.status = 'OPEN' THEN 1 END) as open_prs,
+    COUNT(CASE WHEN p.status = 'MERGED' THEN 1 END) as merged_prs
+FROM users u
+LEFT JOIN teams t ON u.team_id = t.id
+LEFT JOIN pr_reviewers pr ON u.user_id = pr.user_id
+LEFT JOIN pull_requests p ON pr.pull_request_id = p.pull_request_id
+GROUP BY u.user_id, u.username, t.team_name
+ORDER BY total_assignments DESC
+`
+
+const exportPRStats = `-- name: ExportPRStats
+SELECT
+    pr.pull_request_id,
+    pr.pull_request_name,
+    pr.author_id,
+    pr.status,
+    COUNT(r.id) as reviewers_count,
+    pr.created_at,
+    pr.merged_at
+FROM pull_requests pr
+LEFT JOIN pr_reviewers r ON pr.pull_request_id = r.pull_request_id
+WHERE ($1::timestamp IS NULL OR pr.created_at >= $1)
+  AND ($2::timestamp IS NULL OR pr.created_at < $2)
+GROUP BY pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.created_at, pr.merged_at
+ORDER BY pr.created_at DESC
+`
+
+const exportTeamStats = `-- name: ExportTeamStats
+WITH members AS (
+    SELECT
+        u.team_id,
+        COUNT(*) as total_members,
+        COUNT(*) FILTER (WHERE u.is_active) as active_members
+    FROM users u
+    GROUP BY u.team_id
+), authored AS (
+    SELECT
+        u.team_id,
+        COUNT(*) as prs_authored,
+        COUNT(*) FILTER (WHERE p.status = 'MERGED') as prs_merged
+    FROM pull_requests p
+    JOIN users u ON p.author_id = u.user_id
+    WHERE ($1::timestamp IS NULL OR p.created_at >= $1)
+      AND ($2::timestamp IS NULL OR p.created_at < $2)
+    GROUP BY u.team_id
+), reviewed AS (
+    SELECT
+        u.team_id,
+        COUNT(*) as review_assignments,
+        COUNT(DISTINCT r.pull_request_id) as prs_reviewed
+    FROM pr_reviewers r
+    JOIN users u ON r.user_id = u.user_id
+    WHERE ($1::timestamp IS NULL OR r.assigned_at >= $1)
+      AND ($2::timestamp IS NULL OR r.assigned_at < $2)
+    GROUP BY u.team_id
+)
+SELECT
+    t.team_name,
+    COALESCE(m.total_members, 0)::bigint as total_members,
+    COALESCE(m.active_members, 0)::bigint as active_members,
+    COALESCE(a.prs_authored, 0)::bigint as prs_authored,
+    COALESCE(a.prs_merged, 0)::bigint as prs_merged,
+    COALESCE(rv.prs_reviewed, 0)::bigint as prs_reviewed,
+    COALESCE(rv.review_assignments, 0)::bigint as review_assignments
+FROM teams t
+LEFT JOIN members m ON m.team_id = t.id
+LEFT JOIN authored a ON a.team_id = t.id
+LEFT JOIN reviewed rv ON rv.team_id = t.id
+ORDER BY t.team_name
+`
+
+const exportUserWorkload = `-- name: ExportUserWorkload
+SELECT
+    u.user_id,
+    u.username,
+    t.team_name,
+    u.is_active,
+    COALESCE(w.open_reviews, 0)::bigint as open_reviews_count
+FROM users u
+LEFT JOIN teams t ON u.team_id = t.id
+LEFT JOIN reviewer_workload w ON w.user_id = u.user_id
+WHERE u.is_active = true
+ORDER BY open_reviews_count DESC, u.username
+`
+
+// forEachRow выполняет запрос и передает строки в fn по одной. scan читает
+// столбцы текущей строки, ошибка fn прерывает чтение.
+func forEachRow[T any](ctx context.Context, conn db.DBTX, query string, scan func(pgx.Rows) (T, error), fn func(T) error, args ...any) error {
+	rows, err := conn.Query(ctx, query, args...)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		row, err := scan(rows)
+		if err != nil {
+			return err
+		}
+		if err := fn(row); err != nil {
+			return err
+		}
+	}
+	return rows.Err()
+}
+
+func (r *PostgresRepository) ForEachAssignmentStats(ctx context.Context, fn func(models.AssignmentStats) error) error {
+	scan := func(rows pgx.Rows) (db.GetAssignmentStatsRow, error) {
+		var row db.GetAssignmentStatsRow
+		err := rows.Scan(&row.UserID, &row.Username, &row.TeamName, &row.TotalAssignments, &row.OpenPrs, &row.MergedPrs)
+		return row, err
+	}
+	return forEachRow(ctx, r.conn, exportAssignmentStats, scan, func(dbRow db.GetAssignmentStatsRow) error {
+		return fn(models.AssignmentStatsFromDBRow(dbRow))
+	})
+}
+
+func (r *PostgresRepository) ForEachPRStats(ctx context.Context, window models.TimeWindow, fn func(models.PRStats) error) error {
+	scan := func(rows pgx.Rows) (db.GetPRStatsRow, error) {
+		var row db.GetPRStatsRow
+		err := rows.Scan(&row.PullRequestID, &row.PullRequestName, &row.AuthorID, &row.Status,
+			&row.ReviewersCount, &row.CreatedAt, &row.MergedAt)
+		return row, err
+	}
+	return forEachRow(ctx, r.conn, exportPRStats, scan, func(dbRow db.GetPRStatsRow) error {
+		return fn(models.PRStatsFromDBRow(dbRow))
+	}, window.StartToDB(), window.EndToDB())
+}
+
+func (r *PostgresRepository) ForEachTeamStats(ctx context.Context, window models.TimeWindow, fn func(models.TeamStats) error) error {
+	scan := func(rows pgx.Rows) (db.GetTeamStatsRow, error) {
+		var row db.GetTeamStatsRow
+		err := rows.Scan(&row.TeamName, &row.TotalMembers, &row.ActiveMembers, &row.PrsAuthored,
+			&row.PrsMerged, &row.PrsReviewed, &row.ReviewAssignments)
+		return row, err
+	}
+	return forEachRow(ctx, r.conn, exportTeamStats, scan, func(dbRow db.GetTeamStatsRow) error {
+		return fn(models.TeamStatsFromDBRow(dbRow))
+	}, window.StartToDB(), window.EndToDB())
+}
+
+func (r *PostgresRepository) ForEachUserWorkload(ctx context.Context, fn func(models.UserWorkload) error) error {
+	scan := func(rows pgx.Rows) (db.GetUserWorkloadRow, error) {
+		var row db.GetUserWorkloadRow
+		err := rows.Scan(&row.UserID, &row.Username, &row.TeamName, &row.IsActive, &row.OpenReviewsCount)
+		return row, err
+	}
+	return forEachRow(ctx, r.conn, exportUserWorkload, scan, func(dbRow db.GetUserWorkloadRow) error {
+		return fn(models.UserWorkloadFromDBRow(dbRow))
+	})
+}
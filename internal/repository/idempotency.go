@@ -0,0 +1,68 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/db"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+)
+
+// --- IdempotencyRepository implementation ---
+
+func (r *PostgresRepository) ReserveIdempotencyKey(ctx context.Context, client, key, requestHash string, lease time.Duration) (bool, error) {
+	rows, err := r.queries.ReserveIdempotencyKey(ctx, db.ReserveIdempotencyKeyParams{
+		Client:         client,
+		IdempotencyKey: key,
+		RequestHash:    requestHash,
+		LeaseSeconds:   lease.Seconds(),
+	})
+	if err != nil {
+		return false, err
+	}
+	return rows > 0, nil
+}
+
+func (r *PostgresRepository) GetIdempotencyKey(ctx context.Context, client, key string) (models.IdempotencyRecord, error) {
+	row, err := r.queries.GetIdempotencyKey(ctx, db.GetIdempotencyKeyParams{
+		Client:         client,
+		IdempotencyKey: key,
+	})
+	if err != nil {
+		return models.IdempotencyRecord{}, err
+	}
+
+	record := models.IdempotencyRecord{RequestHash: row.RequestHash}
+	if row.StatusCode != nil {
+		record.Response = &models.IdempotentResponse{
+			StatusCode:  int(*row.StatusCode),
+			ContentType: row.ContentType,
+			Body:        row.ResponseBody,
+		}
+	}
+	return record, nil
+}
+
+func (r *PostgresRepository) CompleteIdempotencyKey(ctx context.Context, client, key string, resp models.IdempotentResponse) error {
+	status := int32(resp.StatusCode)
+	return r.queries.CompleteIdempotencyKey(ctx, db.CompleteIdempotencyKeyParams{
+		Client:         client,
+		IdempotencyKey: key,
+		StatusCode:     &status,
+		ContentType:    resp.ContentType,
+		ResponseBody:   resp.Body,
+	})
+}
+
+func (r *PostgresRepository) ReleaseIdempotencyKey(ctx context.Context, client, key string) error {
+	return r.queries.ReleaseIdempotencyKey(ctx, db.ReleaseIdempotencyKeyParams{
+		Client:         client,
+		IdempotencyKey: key,
+	})
+}
+
+func (r *PostgresRepository) DeleteIdempotencyKeysBefore(ctx context.Context, before time.Time) (int64, error) {
+	return r.queries.DeleteIdempotencyKeysBefore(ctx, pgtype.Timestamp{Time: before.UTC(), Valid: true})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/AtoyanMikhail/PRAssignmentService/internal/db"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/tracing"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
@@ -12,14 +13,17 @@ import (
 // PostgresRepository implements all repository interfaces
 type PostgresRepository struct {
 	pool    *pgxpool.Pool
+	conn    db.DBTX // for queries streamed outside sqlc
 	queries *db.Queries
 }
 
 // NewPostgresRepository creates a new PostgresRepository
 func NewPostgresRepository(pool *pgxpool.Pool) *PostgresRepository {
+	conn := tracing.WrapDBTX(pool)
 	return &PostgresRepository{
 		pool:    pool,
-		queries: db.New(pool),
+		conn:    conn,
+		queries: db.New(conn),
 	}
 }
 
@@ -30,19 +34,26 @@ func (r *PostgresRepository) GetQueries() *db.Queries {
 
 // WithTx creates a new repository instance with a transaction
 func (r *PostgresRepository) WithTx(tx pgx.Tx) *PostgresRepository {
+	conn := tracing.WrapDBTX(tx)
 	return &PostgresRepository{
 		pool:    r.pool,
-		queries: r.queries.WithTx(tx),
+		conn:    conn,
+		queries: db.New(conn),
 	}
 }
 
 // Verify that PostgresRepository implements all interfaces
 var (
-	_ TeamRepository        = (*PostgresRepository)(nil)
-	_ UserRepository        = (*PostgresRepository)(nil)
-	_ PullRequestRepository = (*PostgresRepository)(nil)
-	_ PRReviewerRepository  = (*PostgresRepository)(nil)
-	_ StatisticsRepository  = (*PostgresRepository)(nil)
+	_ TeamRepository         = (*PostgresRepository)(nil)
+	_ UserRepository         = (*PostgresRepository)(nil)
+	_ PullRequestRepository  = (*PostgresRepository)(nil)
+	_ PRReviewerRepository   = (*PostgresRepository)(nil)
+	_ StatisticsRepository   = (*PostgresRepository)(nil)
+	_ SkillRepository        = (*PostgresRepository)(nil)
+	_ ReviewerRuleRepository = (*PostgresRepository)(nil)
+	_ AuditRepository        = (*PostgresRepository)(nil)
+	_ AnalyticsRepository    = (*PostgresRepository)(nil)
+	_ EventRepository        = (*PostgresRepository)(nil)
 )
 
 // ExecTx executes a function within a database transaction
@@ -65,16 +65,28 @@ func (r *PostgresRepository) ListByStatus(ctx context.Context, status models.Pul
 	return models.PullRequestsFromDB(dbPRs), nil
 }
 
+func (r *PostgresRepository) ListOpenByAuthorID(ctx context.Context, authorID string) ([]models.PullRequest, error) {
+	dbPRs, err := r.queries.ListOpenPullRequestsByAuthorID(ctx, authorID)
+	if err != nil {
+		return nil, err
+	}
+	return models.PullRequestsFromDB(dbPRs), nil
+}
+
 func (r *PostgresRepository) PRExists(ctx context.Context, pullRequestID string) (bool, error) {
 	return r.queries.PullRequestExists(ctx, pullRequestID)
 }
 
 // --- PRReviewerRepository implementation ---
 
-func (r *PostgresRepository) Add(ctx context.Context, pullRequestID, userID string) (models.PRReviewer, error) {
+func (r *PostgresRepository) Add(ctx context.Context, pullRequestID, userID string, assignment models.Assignment) (models.PRReviewer, error) {
 	dbReviewer, err := r.queries.AddReviewer(ctx, db.AddReviewerParams{
-		PullRequestID: pullRequestID,
-		UserID:        userID,
+		PullRequestID:        pullRequestID,
+		UserID:               userID,
+		Source:               string(assignment.Source),
+		Strategy:             assignment.StrategyToDB(),
+		WorkloadAtAssignment: assignment.WorkloadAtAssignment,
+		TieBreak:             assignment.TieBreak,
 	})
 	if err != nil {
 		return models.PRReviewer{}, err
@@ -105,6 +117,27 @@ func (r *PostgresRepository) GetPRsByReviewerUserID(ctx context.Context, userID
 	return models.PullRequestShortsFromDBRows(dbRows), nil
 }
 
+func (r *PostgresRepository) GetReviewsByUserID(ctx context.Context, userID string) ([]models.UserReview, error) {
+	dbRows, err := r.queries.GetPullRequestsByReviewerUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return models.UserReviewsFromDBRows(dbRows), nil
+}
+
+func (r *PostgresRepository) GetReviewersByPRIDs(ctx context.Context, pullRequestIDs []string) ([]models.ReviewerInfo, error) {
+	dbRows, err := r.queries.GetReviewersByPRIDs(ctx, pullRequestIDs)
+	if err != nil {
+		return nil, err
+	}
+	reviewers := make([]models.ReviewerInfo, len(dbRows))
+	for i, dbRow := range dbRows {
+		// Столбцы запросов совпадают, поэтому строки приводятся друг к другу
+		reviewers[i] = models.ReviewerInfoFromDBRow(db.GetReviewersByPRIDRow(dbRow))
+	}
+	return reviewers, nil
+}
+
 func (r *PostgresRepository) IsUserAssigned(ctx context.Context, pullRequestID, userID string) (bool, error) {
 	return r.queries.IsUserAssignedToPR(ctx, db.IsUserAssignedToPRParams{
 		PullRequestID: pullRequestID,
@@ -116,11 +149,15 @@ func (r *PostgresRepository) Count(ctx context.Context, pullRequestID string) (i
 	return r.queries.CountReviewersByPRID(ctx, pullRequestID)
 }
 
-func (r *PostgresRepository) Replace(ctx context.Context, pullRequestID, oldUserID, newUserID string) error {
+func (r *PostgresRepository) Replace(ctx context.Context, pullRequestID, oldUserID, newUserID string, assignment models.Assignment) error {
 	return r.queries.ReplaceReviewer(ctx, db.ReplaceReviewerParams{
-		PullRequestID: pullRequestID,
-		UserID:        oldUserID,
-		UserID_2:      newUserID,
+		PullRequestID:        pullRequestID,
+		UserID:               oldUserID,
+		UserID_2:             newUserID,
+		Source:               string(assignment.Source),
+		Strategy:             assignment.StrategyToDB(),
+		WorkloadAtAssignment: assignment.WorkloadAtAssignment,
+		TieBreak:             assignment.TieBreak,
 	})
 }
 
@@ -149,22 +186,44 @@ func (r *PostgresRepository) GetAssignmentStats(ctx context.Context) ([]models.A
 	return models.AssignmentStatsListFromDBRows(dbRows), nil
 }
 
-func (r *PostgresRepository) GetPRStats(ctx context.Context) ([]models.PRStats, error) {
-	dbRows, err := r.queries.GetPRStats(ctx)
+func (r *PostgresRepository) GetPRStats(ctx context.Context, window models.TimeWindow) ([]models.PRStats, error) {
+	dbRows, err := r.queries.GetPRStats(ctx, db.GetPRStatsParams{
+		WindowStart: window.StartToDB(),
+		WindowEnd:   window.EndToDB(),
+	})
 	if err != nil {
 		return nil, err
 	}
 	return models.PRStatsListFromDBRows(dbRows), nil
 }
 
-func (r *PostgresRepository) GetTeamStats(ctx context.Context) ([]models.TeamStats, error) {
-	dbRows, err := r.queries.GetTeamStats(ctx)
+func (r *PostgresRepository) GetTeamStats(ctx context.Context, window models.TimeWindow) ([]models.TeamStats, error) {
+	dbRows, err := r.queries.GetTeamStats(ctx, db.GetTeamStatsParams{
+		WindowStart: window.StartToDB(),
+		WindowEnd:   window.EndToDB(),
+	})
 	if err != nil {
 		return nil, err
 	}
 	return models.TeamStatsListFromDBRows(dbRows), nil
 }
 
+func (r *PostgresRepository) GetReviewerAssignmentCounts(ctx context.Context, teamName string, window models.TimeWindow) ([]models.ReviewerAssignmentCount, error) {
+	dbRows, err := r.queries.GetReviewerAssignmentCounts(ctx, db.GetReviewerAssignmentCountsParams{
+		WindowStart: window.StartToDB(),
+		WindowEnd:   window.EndToDB(),
+		TeamName:    teamName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	counts := make([]models.ReviewerAssignmentCount, len(dbRows))
+	for i, dbRow := range dbRows {
+		counts[i] = models.ReviewerAssignmentCountFromDBRow(dbRow)
+	}
+	return counts, nil
+}
+
 func (r *PostgresRepository) GetUserWorkload(ctx context.Context) ([]models.UserWorkload, error) {
 	dbRows, err := r.queries.GetUserWorkload(ctx)
 	if err != nil {
@@ -172,3 +231,11 @@ func (r *PostgresRepository) GetUserWorkload(ctx context.Context) ([]models.User
 	}
 	return models.UserWorkloadListFromDBRows(dbRows), nil
 }
+
+func (r *PostgresRepository) GetTeamReviewLoad(ctx context.Context) ([]models.TeamReviewLoad, error) {
+	dbRows, err := r.queries.GetTeamReviewLoad(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return models.TeamReviewLoadListFromDBRows(dbRows), nil
+}
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
 )
@@ -13,6 +14,8 @@ type TeamRepository interface {
 	GetTeamByID(ctx context.Context, id int64) (models.Team, error)
 	List(ctx context.Context) ([]models.Team, error)
 	Exists(ctx context.Context, teamName string) (bool, error)
+	SetMentorship(ctx context.Context, teamName string, enabled bool) (models.Team, error)
+	LockTeamAssignment(ctx context.Context, teamID int64) error
 }
 
 // UserRepository описывает операции с пользователями
@@ -22,6 +25,7 @@ type UserRepository interface {
 	GetUserByID(ctx context.Context, id int64) (models.User, error)
 	Update(ctx context.Context, userID, username string, teamID int64, isActive bool) (models.User, error)
 	UpdateIsActive(ctx context.Context, userID string, isActive bool) (models.User, error)
+	UpdateSeniority(ctx context.Context, userID string, seniority models.Seniority) (models.User, error)
 	ListByTeamID(ctx context.Context, teamID int64) ([]models.User, error)
 	ListActiveByTeamID(ctx context.Context, teamID int64) ([]models.User, error)
 	ListActiveByTeamIDExcludingUser(ctx context.Context, teamID int64, excludeUserID string) ([]models.User, error)
@@ -38,26 +42,106 @@ type PullRequestRepository interface {
 	UpdateStatus(ctx context.Context, pullRequestID string, status models.PullRequestStatus) (models.PullRequest, error)
 	Merge(ctx context.Context, pullRequestID string) (models.PullRequest, error)
 	ListByStatus(ctx context.Context, status models.PullRequestStatus) ([]models.PullRequest, error)
+	ListOpenByAuthorID(ctx context.Context, authorID string) ([]models.PullRequest, error)
 	PRExists(ctx context.Context, pullRequestID string) (bool, error)
 }
 
 // PRReviewerRepository описывает операции с ревьюерами
 type PRReviewerRepository interface {
-	Add(ctx context.Context, pullRequestID, userID string) (models.PRReviewer, error)
+	Add(ctx context.Context, pullRequestID, userID string, assignment models.Assignment) (models.PRReviewer, error)
 	Remove(ctx context.Context, pullRequestID, userID string) error
 	GetReviewersByPRID(ctx context.Context, pullRequestID string) ([]models.ReviewerInfo, error)
 	GetPRsByReviewerUserID(ctx context.Context, userID string) ([]models.PullRequestShort, error)
+	GetReviewsByUserID(ctx context.Context, userID string) ([]models.UserReview, error)
+	GetReviewersByPRIDs(ctx context.Context, pullRequestIDs []string) ([]models.ReviewerInfo, error)
 	IsUserAssigned(ctx context.Context, pullRequestID, userID string) (bool, error)
 	Count(ctx context.Context, pullRequestID string) (int64, error)
-	Replace(ctx context.Context, pullRequestID, oldUserID, newUserID string) error
+	Replace(ctx context.Context, pullRequestID, oldUserID, newUserID string, assignment models.Assignment) error
 	GetOpenPRsWithInactiveReviewers(ctx context.Context) ([]models.InactiveReviewerInfo, error)
 	RemoveInactiveReviewers(ctx context.Context, pullRequestID string, userIDs []string) error
 }
 
+// SkillRepository описывает операции с навыками ревьюеров и тегами PR
+type SkillRepository interface {
+	GetUserSkills(ctx context.Context, userID string) ([]models.UserSkill, error)
+	SetUserSkills(ctx context.Context, userID string, skills []models.UserSkill) error
+	GetTeamSkills(ctx context.Context, teamID int64, tags []string) ([]models.UserSkill, error)
+	GetRequiredTags(ctx context.Context, pullRequestID string) ([]string, error)
+	SetRequiredTags(ctx context.Context, pullRequestID string, tags []string) error
+}
+
+// ReviewerRuleRepository описывает операции с правилами назначения ревьюеров
+type ReviewerRuleRepository interface {
+	ListReviewerRules(ctx context.Context, userID string) ([]models.ReviewerRule, error)
+	ListReviewerRulesForAuthor(ctx context.Context, authorID string) ([]models.ReviewerRule, error)
+	CreateReviewerRule(ctx context.Context, rule models.ReviewerRule) (models.ReviewerRule, error)
+	DeleteReviewerRule(ctx context.Context, id int64) (models.ReviewerRule, error)
+}
+
+// AuditRepository описывает операции с журналом аудита
+type AuditRepository interface {
+	AddAuditEntry(ctx context.Context, entry models.AuditEntry) (models.AuditEntry, error)
+	ListAuditEntries(ctx context.Context, pullRequestID string, limit int) ([]models.AuditEntry, error)
+}
+
 // StatisticsRepository описывает операции для получения статистики
 type StatisticsRepository interface {
 	GetAssignmentStats(ctx context.Context) ([]models.AssignmentStats, error)
-	GetPRStats(ctx context.Context) ([]models.PRStats, error)
-	GetTeamStats(ctx context.Context) ([]models.TeamStats, error)
+	GetPRStats(ctx context.Context, window models.TimeWindow) ([]models.PRStats, error)
+	GetTeamStats(ctx context.Context, window models.TimeWindow) ([]models.TeamStats, error)
 	GetUserWorkload(ctx context.Context) ([]models.UserWorkload, error)
+	GetTeamReviewLoad(ctx context.Context) ([]models.TeamReviewLoad, error)
+	GetReviewerAssignmentCounts(ctx context.Context, teamName string, window models.TimeWindow) ([]models.ReviewerAssignmentCount, error)
+
+	// ForEach* передают строки статистики в fn по мере чтения из БД
+	ForEachAssignmentStats(ctx context.Context, fn func(models.AssignmentStats) error) error
+	ForEachPRStats(ctx context.Context, window models.TimeWindow, fn func(models.PRStats) error) error
+	ForEachTeamStats(ctx context.Context, window models.TimeWindow, fn func(models.TeamStats) error) error
+	ForEachUserWorkload(ctx context.Context, fn func(models.UserWorkload) error) error
+}
+
+// UnavailabilityRepository описывает операции с периодами недоступности пользователей
+type UnavailabilityRepository interface {
+	AddUnavailability(ctx context.Context, period models.Unavailability) (models.Unavailability, error)
+	DeleteUnavailability(ctx context.Context, id int64) (models.Unavailability, error)
+	ListUpcomingUnavailability(ctx context.Context, userID string, since time.Time) ([]models.Unavailability, error)
+	// ListUnavailableTeamMembers возвращает участников команды, недоступных в момент at
+	ListUnavailableTeamMembers(ctx context.Context, teamID int64, at time.Time) ([]string, error)
+}
+
+// WorkloadRepository описывает учтенную нагрузку ревьюеров - число открытых
+// PR, где пользователь назначен ревьюером
+type WorkloadRepository interface {
+	GetTeamWorkload(ctx context.Context, teamID int64) (map[string]int64, error)
+}
+
+// EventRepository описывает журнал событий назначения
+type EventRepository interface {
+	ListEventsAfter(ctx context.Context, afterID int64, filter models.EventFilter, limit int) ([]models.Event, error)
+	// ListEventsByIDs возвращает сохраненные события из ids по возрастанию id
+	ListEventsByIDs(ctx context.Context, ids []int64) ([]models.Event, error)
+	GetLatestEventID(ctx context.Context) (int64, error)
+	DeleteEventsBefore(ctx context.Context, before time.Time) (int64, error)
+	ListenEvents(ctx context.Context, poll time.Duration, fn func() error) error
+}
+
+// IdempotencyRepository описывает ключи идемпотентности клиентов и
+// сохраненные ответы на запросы с ними
+type IdempotencyRepository interface {
+	// ReserveIdempotencyKey занимает ключ и возвращает false, если он уже занят.
+	// Незавершенный запрос с тем же хешем старше lease занимается заново.
+	ReserveIdempotencyKey(ctx context.Context, client, key, requestHash string, lease time.Duration) (bool, error)
+	GetIdempotencyKey(ctx context.Context, client, key string) (models.IdempotencyRecord, error)
+	CompleteIdempotencyKey(ctx context.Context, client, key string, resp models.IdempotentResponse) error
+	// ReleaseIdempotencyKey освобождает ключ, если ответ по нему не сохранен
+	ReleaseIdempotencyKey(ctx context.Context, client, key string) error
+	DeleteIdempotencyKeysBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// AnalyticsRepository описывает временные ряды аналитики ревью.
+// Окно должно быть ограничено с обеих сторон.
+type AnalyticsRepository interface {
+	GetReviewTimeAnalytics(ctx context.Context, bucket models.AnalyticsBucket, window models.TimeWindow) ([]models.ReviewTimeBucket, error)
+	GetReviewerThroughput(ctx context.Context, bucket models.AnalyticsBucket, window models.TimeWindow) ([]models.ReviewerThroughput, error)
+	GetTeamThroughput(ctx context.Context, bucket models.AnalyticsBucket, window models.TimeWindow) ([]models.TeamThroughput, error)
 }
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/db"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+)
+
+// --- ReviewerRuleRepository implementation ---
+
+// ListReviewerRules возвращает правила, где пользователь является ревьюером
+// или автором. При пустом userID возвращаются все правила.
+func (r *PostgresRepository) ListReviewerRules(ctx context.Context, userID string) ([]models.ReviewerRule, error) {
+	rows, err := r.queries.ListReviewerRules(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	rules := make([]models.ReviewerRule, len(rows))
+	for i, row := range rows {
+		rules[i] = models.ReviewerRuleFromDB(row.ReviewerRule, row.TeamName)
+	}
+	return rules, nil
+}
+
+// ListReviewerRulesForAuthor возвращает правила для PR автора, включая правила его команды
+func (r *PostgresRepository) ListReviewerRulesForAuthor(ctx context.Context, authorID string) ([]models.ReviewerRule, error) {
+	rows, err := r.queries.ListReviewerRulesForAuthor(ctx, authorID)
+	if err != nil {
+		return nil, err
+	}
+	rules := make([]models.ReviewerRule, len(rows))
+	for i, row := range rows {
+		rules[i] = models.ReviewerRuleFromDB(row.ReviewerRule, row.TeamName)
+	}
+	return rules, nil
+}
+
+func (r *PostgresRepository) CreateReviewerRule(ctx context.Context, rule models.ReviewerRule) (models.ReviewerRule, error) {
+	dbRule, err := r.queries.CreateReviewerRule(ctx, db.CreateReviewerRuleParams{
+		Effect:     string(rule.Effect),
+		ReviewerID: rule.ReviewerID,
+		AuthorID:   rule.AuthorID,
+		TeamID:     rule.TeamID,
+		Reason:     rule.Reason,
+		CreatedBy:  rule.CreatedBy,
+	})
+	if err != nil {
+		return models.ReviewerRule{}, err
+	}
+	return models.ReviewerRuleFromDB(dbRule, rule.TeamName), nil
+}
+
+func (r *PostgresRepository) DeleteReviewerRule(ctx context.Context, id int64) (models.ReviewerRule, error) {
+	dbRule, err := r.queries.DeleteReviewerRule(ctx, id)
+	if err != nil {
+		return models.ReviewerRule{}, err
+	}
+	return models.ReviewerRuleFromDB(dbRule, nil), nil
+}
+
+// --- AuditRepository implementation ---
+
+func (r *PostgresRepository) AddAuditEntry(ctx context.Context, entry models.AuditEntry) (models.AuditEntry, error) {
+	details, err := json.Marshal(entry.Details)
+	if err != nil {
+		return models.AuditEntry{}, err
+	}
+	if entry.Details == nil {
+		details = []byte("{}")
+	}
+
+	dbEntry, err := r.queries.AddAuditEntry(ctx, db.AddAuditEntryParams{
+		Action:        string(entry.Action),
+		Actor:         entry.Actor,
+		PullRequestID: entry.PullRequestID,
+		UserID:        entry.UserID,
+		Details:       details,
+	})
+	if err != nil {
+		return models.AuditEntry{}, err
+	}
+	return auditEntryFromDB(dbEntry)
+}
+
+// ListAuditEntries возвращает последние записи журнала, новые первыми.
+// При пустом pullRequestID возвращаются записи по всем PR.
+func (r *PostgresRepository) ListAuditEntries(ctx context.Context, pullRequestID string, limit int) ([]models.AuditEntry, error) {
+	dbEntries, err := r.queries.ListAuditEntries(ctx, db.ListAuditEntriesParams{
+		PullRequestID: pullRequestID,
+		MaxEntries:    int32(limit),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]models.AuditEntry, 0, len(dbEntries))
+	for _, dbEntry := range dbEntries {
+		entry, err := auditEntryFromDB(dbEntry)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, entry)
+	}
+	return entries, nil
+}
+
+func auditEntryFromDB(dbEntry db.AuditLog) (models.AuditEntry, error) {
+	entry := models.AuditEntry{
+		ID:            dbEntry.ID,
+		Action:        models.AuditAction(dbEntry.Action),
+		Actor:         dbEntry.Actor,
+		PullRequestID: dbEntry.PullRequestID,
+		UserID:        dbEntry.UserID,
+		CreatedAt:     dbEntry.CreatedAt.Time,
+	}
+	if err := json.Unmarshal(dbEntry.Details, &entry.Details); err != nil {
+		return models.AuditEntry{}, err
+	}
+	return entry, nil
+}
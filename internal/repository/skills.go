@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/db"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+)
+
+// --- SkillRepository implementation ---
+
+func (r *PostgresRepository) GetUserSkills(ctx context.Context, userID string) ([]models.UserSkill, error) {
+	dbSkills, err := r.queries.ListUserSkills(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	return models.UserSkillsFromDB(dbSkills), nil
+}
+
+// SetUserSkills заменяет навыки пользователя. Вызывается внутри транзакции.
+func (r *PostgresRepository) SetUserSkills(ctx context.Context, userID string, skills []models.UserSkill) error {
+	if err := r.queries.DeleteUserSkills(ctx, userID); err != nil {
+		return err
+	}
+	if len(skills) == 0 {
+		return nil
+	}
+
+	tags := make([]string, len(skills))
+	levels := make([]string, len(skills))
+	for i, skill := range skills {
+		tags[i] = skill.Tag
+		levels[i] = string(skill.Level)
+	}
+	return r.queries.AddUserSkills(ctx, db.AddUserSkillsParams{
+		UserID: userID,
+		Tags:   tags,
+		Levels: levels,
+	})
+}
+
+func (r *PostgresRepository) GetTeamSkills(ctx context.Context, teamID int64, tags []string) ([]models.UserSkill, error) {
+	dbSkills, err := r.queries.ListTeamSkillsByTags(ctx, db.ListTeamSkillsByTagsParams{
+		TeamID: teamID,
+		Tags:   tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return models.UserSkillsFromDB(dbSkills), nil
+}
+
+func (r *PostgresRepository) GetRequiredTags(ctx context.Context, pullRequestID string) ([]string, error) {
+	return r.queries.ListPRRequiredTags(ctx, pullRequestID)
+}
+
+// SetRequiredTags заменяет теги PR. Вызывается внутри транзакции.
+func (r *PostgresRepository) SetRequiredTags(ctx context.Context, pullRequestID string, tags []string) error {
+	if err := r.queries.DeletePRRequiredTags(ctx, pullRequestID); err != nil {
+		return err
+	}
+	if len(tags) == 0 {
+		return nil
+	}
+	return r.queries.AddPRRequiredTags(ctx, db.AddPRRequiredTagsParams{
+		PullRequestID: pullRequestID,
+		Tags:          tags,
+	})
+}
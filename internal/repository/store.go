@@ -5,6 +5,11 @@ import (
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"go.opentelemetry.io/otel/attribute"
+	"go.uber.org/zap"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/logger"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/tracing"
 )
 
 // Store управляет всеми репозиториями и транзакциями
@@ -22,7 +27,10 @@ func NewStore(pool *pgxpool.Pool) *Store {
 }
 
 // ExecTx выполняет функцию внутри транзакции
-func (s *Store) ExecTx(ctx context.Context, fn func(*PostgresRepository) error) error {
+func (s *Store) ExecTx(ctx context.Context, fn func(*PostgresRepository) error) (err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "db.transaction")
+	defer func() { tracing.End(span, err) }()
+
 	tx, err := s.pool.Begin(ctx)
 	if err != nil {
 		return err
@@ -32,6 +40,10 @@ func (s *Store) ExecTx(ctx context.Context, fn func(*PostgresRepository) error)
 	err = fn(repo)
 	if err != nil {
 		if rbErr := tx.Rollback(ctx); rbErr != nil {
+			logger.FromContext(ctx).Error("Transaction rollback failed",
+				zap.Error(rbErr),
+				zap.NamedError("cause", err),
+			)
 			return rbErr
 		}
 		return err
@@ -41,7 +53,11 @@ func (s *Store) ExecTx(ctx context.Context, fn func(*PostgresRepository) error)
 }
 
 // ExecTxWithIsolation выполняет функцию внутри транзакции с заданным уровнем изоляции
-func (s *Store) ExecTxWithIsolation(ctx context.Context, isolation pgx.TxIsoLevel, fn func(*PostgresRepository) error) error {
+func (s *Store) ExecTxWithIsolation(ctx context.Context, isolation pgx.TxIsoLevel, fn func(*PostgresRepository) error) (err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "db.transaction")
+	span.SetAttributes(attribute.String("db.transaction.isolation", string(isolation)))
+	defer func() { tracing.End(span, err) }()
+
 	tx, err := s.pool.BeginTx(ctx, pgx.TxOptions{
 		IsoLevel: isolation,
 	})
@@ -53,6 +69,10 @@ func (s *Store) ExecTxWithIsolation(ctx context.Context, isolation pgx.TxIsoLeve
 	err = fn(repo)
 	if err != nil {
 		if rbErr := tx.Rollback(ctx); rbErr != nil {
+			logger.FromContext(ctx).Error("Transaction rollback failed",
+				zap.Error(rbErr),
+				zap.NamedError("cause", err),
+			)
 			return rbErr
 		}
 		return err
@@ -2,9 +2,11 @@ package repository
 
 import (
 	"context"
+	"time"
 
 	"github.com/AtoyanMikhail/PRAssignmentService/internal/db"
 	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 // --- TeamRepository implementation ---
@@ -33,6 +35,23 @@ func (r *PostgresRepository) GetTeamByID(ctx context.Context, id int64) (models.
 	return models.TeamFromDB(dbTeam), nil
 }
 
+func (r *PostgresRepository) SetMentorship(ctx context.Context, teamName string, enabled bool) (models.Team, error) {
+	dbTeam, err := r.queries.SetTeamMentorship(ctx, db.SetTeamMentorshipParams{
+		TeamName:          teamName,
+		MentorshipEnabled: enabled,
+	})
+	if err != nil {
+		return models.Team{}, err
+	}
+	return models.TeamFromDB(dbTeam), nil
+}
+
+// LockTeamAssignment берет advisory lock команды до конца транзакции.
+// Вне транзакции блокировка снимается сразу после запроса.
+func (r *PostgresRepository) LockTeamAssignment(ctx context.Context, teamID int64) error {
+	return r.queries.LockTeamAssignment(ctx, teamID)
+}
+
 func (r *PostgresRepository) List(ctx context.Context) ([]models.Team, error) {
 	dbTeams, err := r.queries.ListTeams(ctx)
 	if err != nil {
@@ -100,6 +119,17 @@ func (r *PostgresRepository) UpdateIsActive(ctx context.Context, userID string,
 	return models.UserFromDB(dbUser), nil
 }
 
+func (r *PostgresRepository) UpdateSeniority(ctx context.Context, userID string, seniority models.Seniority) (models.User, error) {
+	dbUser, err := r.queries.UpdateUserSeniority(ctx, db.UpdateUserSeniorityParams{
+		UserID:    userID,
+		Seniority: string(seniority),
+	})
+	if err != nil {
+		return models.User{}, err
+	}
+	return models.UserFromDB(dbUser), nil
+}
+
 func (r *PostgresRepository) ListByTeamID(ctx context.Context, teamID int64) ([]models.User, error) {
 	dbUsers, err := r.queries.ListUsersByTeamID(ctx, teamID)
 	if err != nil {
@@ -146,3 +176,39 @@ func (r *PostgresRepository) DeactivateTeamUsers(ctx context.Context, teamID int
 	}
 	return models.UsersFromDB(dbUsers), nil
 }
+
+// --- UnavailabilityRepository implementation ---
+
+func (r *PostgresRepository) AddUnavailability(ctx context.Context, period models.Unavailability) (models.Unavailability, error) {
+	dbRow, err := r.queries.AddUnavailability(ctx, period.ToDBParams())
+	if err != nil {
+		return models.Unavailability{}, err
+	}
+	return models.UnavailabilityFromDB(dbRow), nil
+}
+
+func (r *PostgresRepository) DeleteUnavailability(ctx context.Context, id int64) (models.Unavailability, error) {
+	dbRow, err := r.queries.DeleteUnavailability(ctx, id)
+	if err != nil {
+		return models.Unavailability{}, err
+	}
+	return models.UnavailabilityFromDB(dbRow), nil
+}
+
+func (r *PostgresRepository) ListUpcomingUnavailability(ctx context.Context, userID string, since time.Time) ([]models.Unavailability, error) {
+	dbRows, err := r.queries.ListUpcomingUnavailability(ctx, db.ListUpcomingUnavailabilityParams{
+		UserID: userID,
+		Since:  pgtype.Timestamp{Time: since.UTC(), Valid: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return models.UnavailabilityListFromDB(dbRows), nil
+}
+
+func (r *PostgresRepository) ListUnavailableTeamMembers(ctx context.Context, teamID int64, at time.Time) ([]string, error) {
+	return r.queries.ListUnavailableTeamMembers(ctx, db.ListUnavailableTeamMembersParams{
+		TeamID: teamID,
+		At:     pgtype.Timestamp{Time: at.UTC(), Valid: true},
+	})
+}
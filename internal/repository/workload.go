@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+)
+
+// --- WorkloadRepository implementation ---
+
+func (r *PostgresRepository) GetTeamWorkload(ctx context.Context, teamID int64) (map[string]int64, error) {
+	dbRows, err := r.queries.GetTeamWorkload(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	workload := make(map[string]int64, len(dbRows))
+	for _, dbRow := range dbRows {
+		workload[dbRow.UserID] = dbRow.OpenReviews
+	}
+	return workload, nil
+}
+
+func (r *PostgresRepository) LockReviewerWorkload(ctx context.Context) error {
+	return r.queries.LockReviewerWorkload(ctx)
+}
+
+func (r *PostgresRepository) ReconcileReviewerWorkload(ctx context.Context) ([]models.WorkloadDrift, error) {
+	dbRows, err := r.queries.ReconcileReviewerWorkload(ctx)
+	if err != nil {
+		return nil, err
+	}
+	drift := make([]models.WorkloadDrift, len(dbRows))
+	for i, dbRow := range dbRows {
+		drift[i] = models.WorkloadDriftFromDBRow(dbRow)
+	}
+	return drift, nil
+}
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/logger"
+)
+
+// CertReloader хранит текущие сертификат сервера и CA клиентов и
+// перечитывает их при изменении файлов
+type CertReloader struct {
+	certFile string
+	keyFile  string
+	caFile   string
+
+	mu      sync.RWMutex
+	cert    *tls.Certificate
+	clients *x509.CertPool
+	modTime time.Time
+}
+
+// NewCertReloader загружает сертификаты. caFile может быть пустым, если mTLS не используется.
+func NewCertReloader(certFile, keyFile, caFile string) (*CertReloader, error) {
+	r := &CertReloader{
+		certFile: certFile,
+		keyFile:  keyFile,
+		caFile:   caFile,
+	}
+	if err := r.reload(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetCertificate реализует tls.Config.GetCertificate
+func (r *CertReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.cert, nil
+}
+
+// ClientCAs возвращает текущий пул CA для проверки клиентских сертификатов
+func (r *CertReloader) ClientCAs() *x509.CertPool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.clients
+}
+
+// Watch периодически проверяет время изменения файлов и перезагружает
+// сертификаты. При ошибке загрузки продолжает использовать предыдущие.
+func (r *CertReloader) Watch(ctx context.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	log := logger.FromContext(ctx)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			changed, err := r.changed()
+			if err != nil {
+				log.Warn("Failed to stat TLS files", zap.Error(err))
+				continue
+			}
+			if !changed {
+				continue
+			}
+			if err := r.reload(); err != nil {
+				log.Error("Failed to reload TLS certificates, keeping previous ones", zap.Error(err))
+				continue
+			}
+			log.Info("TLS certificates reloaded")
+		}
+	}
+}
+
+// changed проверяет, изменился ли хотя бы один из файлов после последней загрузки
+func (r *CertReloader) changed() (bool, error) {
+	latest, err := r.latestModTime()
+	if err != nil {
+		return false, err
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return latest.After(r.modTime), nil
+}
+
+// latestModTime возвращает время последнего изменения среди файлов
+func (r *CertReloader) latestModTime() (time.Time, error) {
+	var latest time.Time
+	for _, path := range r.files() {
+		info, err := os.Stat(path)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest, nil
+}
+
+func (r *CertReloader) files() []string {
+	files := []string{r.certFile, r.keyFile}
+	if r.caFile != "" {
+		files = append(files, r.caFile)
+	}
+	return files
+}
+
+// reload читает файлы и атомарно подменяет сертификаты
+func (r *CertReloader) reload() error {
+	modTime, err := r.latestModTime()
+	if err != nil {
+		return err
+	}
+
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load server certificate: %w", err)
+	}
+
+	var clients *x509.CertPool
+	if r.caFile != "" {
+		pem, err := os.ReadFile(r.caFile)
+		if err != nil {
+			return fmt.Errorf("failed to read client CA bundle: %w", err)
+		}
+		clients = x509.NewCertPool()
+		if !clients.AppendCertsFromPEM(pem) {
+			return errors.New("client CA bundle contains no certificates")
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.cert = &cert
+	r.clients = clients
+	r.modTime = modTime
+	return nil
+}
@@ -0,0 +1,143 @@
+package server
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/config"
+)
+
+// Режимы проверки клиентских сертификатов
+const (
+	ClientAuthNone     = "none"
+	ClientAuthOptional = "optional"
+	ClientAuthRequire  = "require"
+)
+
+// Server - HTTP(S) сервер приложения
+type Server struct {
+	cfg        config.ServerConfig
+	http       *http.Server
+	reloader   *CertReloader
+	clientAuth tls.ClientAuthType
+}
+
+// New создает сервер с таймаутами из конфигурации.
+// При включенном TLS сертификаты загружаются сразу, чтобы ошибка
+// конфигурации обнаружилась до начала приема соединений.
+func New(cfg config.ServerConfig, handler http.Handler) (*Server, error) {
+	s := &Server{
+		cfg: cfg,
+		http: &http.Server{
+			Addr:              cfg.Address(),
+			Handler:           handler,
+			ReadTimeout:       cfg.ReadTimeout,
+			ReadHeaderTimeout: cfg.ReadHeaderTimeout,
+			WriteTimeout:      cfg.WriteTimeout,
+			IdleTimeout:       cfg.IdleTimeout,
+		},
+	}
+
+	if !cfg.TLSEnabled {
+		return s, nil
+	}
+
+	var err error
+	s.clientAuth, err = parseClientAuth(cfg.TLSClientAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	caFile := ""
+	if s.clientAuth != tls.NoClientCert {
+		if cfg.TLSClientCAFile == "" {
+			return nil, errors.New("client CA file is required for mTLS")
+		}
+		caFile = cfg.TLSClientCAFile
+	}
+
+	s.reloader, err = NewCertReloader(cfg.TLSCertFile, cfg.TLSKeyFile, caFile)
+	if err != nil {
+		return nil, err
+	}
+
+	s.http.TLSConfig = s.TLSConfig()
+
+	return s, nil
+}
+
+// TLSConfig возвращает конфигурацию TLS сервера для слушателя с протоколами
+// ALPN nextProtos, например для gRPC с "h2". Сертификаты и CA общие с
+// HTTPS и перечитываются, пока работает Run. Без TLS возвращает nil.
+func (s *Server) TLSConfig(nextProtos ...string) *tls.Config {
+	if s.reloader == nil {
+		return nil
+	}
+
+	base := &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: s.reloader.GetCertificate,
+		NextProtos:     nextProtos,
+	}
+	// Конфигурация собирается на каждое рукопожатие, чтобы подхватывать обновленный CA
+	base.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		c := base.Clone()
+		c.GetConfigForClient = nil
+		c.ClientAuth = s.clientAuth
+		c.ClientCAs = s.reloader.ClientCAs()
+		return c, nil
+	}
+	return base
+}
+
+// Run принимает соединения до вызова Shutdown.
+// Пока ctx не отменен, сертификаты перечитываются при изменении файлов.
+func (s *Server) Run(ctx context.Context) error {
+	ln, err := net.Listen("tcp", s.http.Addr)
+	if err != nil {
+		return err
+	}
+
+	if s.reloader == nil {
+		err = s.http.Serve(ln)
+	} else {
+		go s.reloader.Watch(ctx, s.cfg.TLSReloadInterval)
+		err = s.http.ServeTLS(ln, "", "")
+	}
+
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+// Shutdown останавливает сервер, дожидаясь завершения активных запросов
+func (s *Server) Shutdown(ctx context.Context) error {
+	return s.http.Shutdown(ctx)
+}
+
+// Scheme возвращает схему, по которой сервер принимает запросы
+func (s *Server) Scheme() string {
+	if s.reloader != nil {
+		return "https"
+	}
+	return "http"
+}
+
+// parseClientAuth преобразует режим mTLS из конфигурации
+func parseClientAuth(mode string) (tls.ClientAuthType, error) {
+	switch mode {
+	case "", ClientAuthNone:
+		return tls.NoClientCert, nil
+	case ClientAuthOptional:
+		return tls.VerifyClientCertIfGiven, nil
+	case ClientAuthRequire:
+		return tls.RequireAndVerifyClientCert, nil
+	default:
+		return tls.NoClientCert, fmt.Errorf("unknown TLS client auth mode %q", mode)
+	}
+}
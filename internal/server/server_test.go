@@ -0,0 +1,248 @@
+package server
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/config"
+)
+
+// testCA выпускает сертификаты для тестов
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+	pem  []byte
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCA{
+		cert: cert,
+		key:  key,
+		pem:  pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+	}
+}
+
+// issue возвращает сертификат и ключ в PEM
+func (ca *testCA) issue(t *testing.T, cn string, serial int64, usage x509.ExtKeyUsage) ([]byte, []byte) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{usage},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func writeFile(t *testing.T, path string, data []byte, modTime time.Time) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	require.NoError(t, os.Chtimes(path, modTime, modTime))
+}
+
+func freePort(t *testing.T) string {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+	return fmt.Sprint(ln.Addr().(*net.TCPAddr).Port)
+}
+
+// startServer запускает сервер и дожидается, пока он начнет принимать соединения
+func startServer(t *testing.T, cfg config.ServerConfig) *Server {
+	t.Helper()
+
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		cn := ""
+		if r.TLS != nil && len(r.TLS.PeerCertificates) > 0 {
+			cn = r.TLS.PeerCertificates[0].Subject.CommonName
+		}
+		_, _ = io.WriteString(w, cn)
+	})
+
+	srv, err := New(cfg, handler)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() { _ = srv.Run(ctx) }()
+	t.Cleanup(func() {
+		cancel()
+		_ = srv.Shutdown(context.Background())
+	})
+
+	require.Eventually(t, func() bool {
+		conn, err := net.Dial("tcp", cfg.Address())
+		if err == nil {
+			conn.Close()
+		}
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+
+	return srv
+}
+
+func baseConfig(t *testing.T) config.ServerConfig {
+	return config.ServerConfig{
+		Host:              "127.0.0.1",
+		Port:              freePort(t),
+		ReadTimeout:       time.Second,
+		ReadHeaderTimeout: time.Second,
+		WriteTimeout:      time.Second,
+		IdleTimeout:       time.Second,
+	}
+}
+
+func TestServer_PlainHTTP(t *testing.T) {
+	cfg := baseConfig(t)
+	srv := startServer(t, cfg)
+	assert.Equal(t, "http", srv.Scheme())
+	assert.Equal(t, cfg.ReadHeaderTimeout, srv.http.ReadHeaderTimeout)
+	assert.Equal(t, cfg.IdleTimeout, srv.http.IdleTimeout)
+
+	resp, err := http.Get("http://" + cfg.Address())
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+}
+
+func TestServer_MutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca := newTestCA(t)
+	serverCert, serverKey := ca.issue(t, "server", 2, x509.ExtKeyUsageServerAuth)
+	clientCert, clientKey := ca.issue(t, "ci-bot", 3, x509.ExtKeyUsageClientAuth)
+
+	now := time.Now()
+	writeFile(t, filepath.Join(dir, "server.crt"), serverCert, now)
+	writeFile(t, filepath.Join(dir, "server.key"), serverKey, now)
+	writeFile(t, filepath.Join(dir, "ca.crt"), ca.pem, now)
+
+	cfg := baseConfig(t)
+	cfg.TLSEnabled = true
+	cfg.TLSCertFile = filepath.Join(dir, "server.crt")
+	cfg.TLSKeyFile = filepath.Join(dir, "server.key")
+	cfg.TLSClientAuth = ClientAuthRequire
+	cfg.TLSClientCAFile = filepath.Join(dir, "ca.crt")
+	startServer(t, cfg)
+
+	roots := x509.NewCertPool()
+	roots.AppendCertsFromPEM(ca.pem)
+
+	t.Run("без клиентского сертификата", func(t *testing.T) {
+		client := &http.Client{Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: roots},
+		}}
+		_, err := client.Get("https://" + cfg.Address())
+		assert.Error(t, err)
+	})
+
+	t.Run("с клиентским сертификатом", func(t *testing.T) {
+		pair, err := tls.X509KeyPair(clientCert, clientKey)
+		require.NoError(t, err)
+		client := &http.Client{Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: roots, Certificates: []tls.Certificate{pair}},
+		}}
+
+		resp, err := client.Get("https://" + cfg.Address())
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(resp.Body)
+		assert.Equal(t, "ci-bot", string(body))
+	})
+}
+
+func TestServer_MutualTLSRequiresCA(t *testing.T) {
+	cfg := baseConfig(t)
+	cfg.TLSEnabled = true
+	cfg.TLSClientAuth = ClientAuthRequire
+
+	_, err := New(cfg, http.NotFoundHandler())
+	assert.Error(t, err)
+}
+
+func TestCertReloader_Watch(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "server.crt")
+	keyFile := filepath.Join(dir, "server.key")
+
+	ca := newTestCA(t)
+	cert, key := ca.issue(t, "server", 10, x509.ExtKeyUsageServerAuth)
+	past := time.Now().Add(-time.Minute)
+	writeFile(t, certFile, cert, past)
+	writeFile(t, keyFile, key, past)
+
+	r, err := NewCertReloader(certFile, keyFile, "")
+	require.NoError(t, err)
+
+	serialOf := func() int64 {
+		c, err := r.GetCertificate(nil)
+		require.NoError(t, err)
+		leaf, err := x509.ParseCertificate(c.Certificate[0])
+		require.NoError(t, err)
+		return leaf.SerialNumber.Int64()
+	}
+	assert.Equal(t, int64(10), serialOf())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go r.Watch(ctx, 10*time.Millisecond)
+
+	// Поврежденный файл не заменяет рабочий сертификат
+	writeFile(t, certFile, []byte("garbage"), time.Now())
+	time.Sleep(50 * time.Millisecond)
+	assert.Equal(t, int64(10), serialOf())
+
+	cert, key = ca.issue(t, "server", 11, x509.ExtKeyUsageServerAuth)
+	later := time.Now().Add(time.Second)
+	writeFile(t, keyFile, key, later)
+	writeFile(t, certFile, cert, later)
+	assert.Eventually(t, func() bool { return serialOf() == 11 }, time.Second, 10*time.Millisecond)
+}
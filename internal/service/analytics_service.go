@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/repository"
+)
+
+// maxAnalyticsBuckets ограничивает длину временного ряда: год по дням
+const maxAnalyticsBuckets = 366
+
+// AnalyticsServiceImpl реализует AnalyticsService
+type AnalyticsServiceImpl struct {
+	repo repository.AnalyticsRepository
+}
+
+// NewAnalyticsService создает новый AnalyticsService
+func NewAnalyticsService(repo repository.AnalyticsRepository) AnalyticsService {
+	return &AnalyticsServiceImpl{repo: repo}
+}
+
+// GetReviewTimes возвращает перцентили времени ревью по интервалам
+func (s *AnalyticsServiceImpl) GetReviewTimes(ctx context.Context, bucket models.AnalyticsBucket, window models.TimeWindow) ([]models.ReviewTimeBucket, error) {
+	if err := validateAnalyticsRange(bucket, window); err != nil {
+		return nil, err
+	}
+	return s.repo.GetReviewTimeAnalytics(ctx, bucket, window)
+}
+
+// GetReviewerThroughput возвращает назначения ревьюеров по интервалам
+func (s *AnalyticsServiceImpl) GetReviewerThroughput(ctx context.Context, bucket models.AnalyticsBucket, window models.TimeWindow) ([]models.ReviewerThroughput, error) {
+	if err := validateAnalyticsRange(bucket, window); err != nil {
+		return nil, err
+	}
+	return s.repo.GetReviewerThroughput(ctx, bucket, window)
+}
+
+// GetTeamThroughput возвращает открытые и слитые PR команд по интервалам
+func (s *AnalyticsServiceImpl) GetTeamThroughput(ctx context.Context, bucket models.AnalyticsBucket, window models.TimeWindow) ([]models.TeamThroughput, error) {
+	if err := validateAnalyticsRange(bucket, window); err != nil {
+		return nil, err
+	}
+	return s.repo.GetTeamThroughput(ctx, bucket, window)
+}
+
+// validateAnalyticsRange проверяет шаг и окно: окно ограничено с обеих
+// сторон и содержит не больше maxAnalyticsBuckets интервалов
+func validateAnalyticsRange(bucket models.AnalyticsBucket, window models.TimeWindow) error {
+	if !bucket.IsValid() {
+		return fmt.Errorf("%w: unknown bucket %q", ErrInvalidTimeWindow, bucket)
+	}
+	if window.From.IsZero() || window.To.IsZero() {
+		return fmt.Errorf("%w: both from and to are required", ErrInvalidTimeWindow)
+	}
+	if err := validateWindow(window); err != nil {
+		return err
+	}
+	if n := bucketCount(bucket, window); n > maxAnalyticsBuckets {
+		return fmt.Errorf("%w: %d %s buckets exceed the limit of %d", ErrInvalidTimeWindow, n, bucket, maxAnalyticsBuckets)
+	}
+	return nil
+}
+
+// bucketCount оценивает число интервалов в окне сверху
+func bucketCount(bucket models.AnalyticsBucket, window models.TimeWindow) int {
+	from, to := window.From.UTC(), window.To.UTC()
+	switch bucket {
+	case models.AnalyticsBucketMonth:
+		return (to.Year()-from.Year())*12 + int(to.Month()-from.Month()) + 1
+	case models.AnalyticsBucketWeek:
+		return int(to.Sub(from)/(7*24*time.Hour)) + 2
+	default:
+		return int(to.Sub(from)/(24*time.Hour)) + 2
+	}
+}
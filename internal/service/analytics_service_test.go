@@ -0,0 +1,41 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+)
+
+func TestValidateAnalyticsRange(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		bucket  models.AnalyticsBucket
+		window  models.TimeWindow
+		wantErr bool
+	}{
+		{name: "неделя за квартал", bucket: models.AnalyticsBucketWeek, window: models.TimeWindow{From: from, To: from.AddDate(0, 3, 0)}},
+		{name: "дни за год", bucket: models.AnalyticsBucketDay, window: models.TimeWindow{From: from, To: from.AddDate(1, 0, -1)}},
+		{name: "месяцы за десять лет", bucket: models.AnalyticsBucketMonth, window: models.TimeWindow{From: from, To: from.AddDate(10, 0, 0)}},
+		{name: "дни за два года", bucket: models.AnalyticsBucketDay, window: models.TimeWindow{From: from, To: from.AddDate(2, 0, 0)}, wantErr: true},
+		{name: "неизвестный шаг", bucket: "hour", window: models.TimeWindow{From: from, To: from.AddDate(0, 0, 1)}, wantErr: true},
+		{name: "без начала", bucket: models.AnalyticsBucketWeek, window: models.TimeWindow{To: from}, wantErr: true},
+		{name: "без конца", bucket: models.AnalyticsBucketWeek, window: models.TimeWindow{From: from}, wantErr: true},
+		{name: "конец раньше начала", bucket: models.AnalyticsBucketWeek, window: models.TimeWindow{From: from, To: from.AddDate(0, 0, -7)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateAnalyticsRange(tt.bucket, tt.window)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidTimeWindow)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
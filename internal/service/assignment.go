@@ -0,0 +1,238 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/policy"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/repository"
+)
+
+// ReviewerOptions задает пожелания к назначению ревьюеров при создании PR
+type ReviewerOptions struct {
+	// Count - общее количество ревьюеров, при 0 берется значение из политики
+	Count int
+	// Preferred назначаются первыми, оставшиеся места заполняются по политике
+	Preferred []string
+	// Excluded не назначаются ни при каких условиях
+	Excluded []string
+	// RequiredTags - навыки, нужные для ревью, сохраняются как теги PR
+	RequiredTags []string
+}
+
+// assignmentRequest описывает назначение ревьюеров на один PR
+type assignmentRequest struct {
+	pullRequestID string
+	authorID      string
+	teamID        int64
+	count         int
+	preferred     []string
+	excluded      []string
+	requiredTags  []string
+	// mentorship - требовать пару senior и learner среди ревьюеров
+	mentorship bool
+}
+
+// assignmentResult - назначенные ревьюеры по источнику
+type assignmentResult struct {
+	preferred []models.PRReviewer
+	selected  []models.PRReviewer
+}
+
+// all возвращает всех назначенных ревьюеров: сначала предпочтительных
+func (r assignmentResult) all() []models.PRReviewer {
+	return append(slices.Clone(r.preferred), r.selected...)
+}
+
+// assignReviewersTx выбирает и назначает ревьюеров внутри транзакции txRepo.
+// Назначение выполняется под блокировкой команды, поэтому параллельные
+// назначения видят нагрузку друг друга, как если бы шли по очереди.
+func assignReviewersTx(ctx context.Context, txRepo *repository.PostgresRepository, p policy.Policy, req assignmentRequest) (assignmentResult, error) {
+	var result assignmentResult
+
+	if err := txRepo.LockTeamAssignment(ctx, req.teamID); err != nil {
+		return result, err
+	}
+
+	// Нагрузка участников команды для балансировки
+	workloadMap, err := txRepo.GetTeamWorkload(ctx, req.teamID)
+	if err != nil {
+		return result, err
+	}
+
+	// Получение активных пользователей команды (исключая автора)
+	activeUsers, err := txRepo.ListActiveByTeamIDExcludingUser(ctx, req.teamID, req.authorID)
+	if err != nil {
+		return result, err
+	}
+
+	excluded := make(map[string]bool, len(req.excluded)+len(req.preferred))
+	for _, id := range req.excluded {
+		excluded[id] = true
+	}
+
+	mentor := newMentorship(req.mentorship)
+
+	ruleSet, err := loadRuleSet(ctx, txRepo, req.authorID)
+	if err != nil {
+		return result, err
+	}
+
+	// Предпочтительные ревьюеры должны быть активными участниками команды
+	// автора и не должны быть запрещены правилами
+	for _, id := range req.preferred {
+		i := slices.IndexFunc(activeUsers, func(u models.User) bool { return u.UserID == id })
+		if i < 0 {
+			return result, fmt.Errorf("%w: preferred reviewer %s is not an active member of the author's team", ErrInvalidReviewerOptions, id)
+		}
+		if rule, ok := ruleSet.blocked[id]; ok {
+			return result, fmt.Errorf("%w: %s by rule %d", ErrReviewerBlocked, id, rule.ID)
+		}
+		mentor.keep(activeUsers[i].Seniority)
+		reviewer, err := txRepo.Add(ctx, req.pullRequestID, id, manualAssignment(workloadMap, id))
+		if err != nil {
+			return result, err
+		}
+		result.preferred = append(result.preferred, reviewer)
+		excluded[id] = true
+	}
+
+	remaining := req.count - len(result.preferred)
+	if remaining <= 0 {
+		return result, nil
+	}
+
+	candidates := make([]models.User, 0, len(activeUsers))
+	for _, user := range activeUsers {
+		if !excluded[user.UserID] {
+			candidates = append(candidates, user)
+		}
+	}
+
+	if len(candidates) == 0 {
+		if len(result.preferred) > 0 {
+			return result, nil
+		}
+		return result, ErrNoActiveReviewers
+	}
+
+	skills, err := loadSkillMatch(ctx, txRepo, req.teamID, req.requiredTags)
+	if err != nil {
+		return result, err
+	}
+	unavailable, err := loadUnavailable(ctx, txRepo, req.teamID)
+	if err != nil {
+		return result, err
+	}
+
+	// Выбор пользователей по политике назначения
+	rules := selectionRules{skills: skills, unavailable: unavailable, mentorship: mentor, rules: ruleSet}
+	selected, ties := selectReviewers(p, candidates, workloadMap, rules, remaining)
+	for _, user := range selected {
+		reviewer, err := txRepo.Add(ctx, req.pullRequestID, user.UserID,
+			selectedAssignment(models.AssignmentSourceAuto, p, workloadMap, user.UserID, ties[user.UserID]))
+		if err != nil {
+			return result, err
+		}
+		result.selected = append(result.selected, reviewer)
+	}
+
+	// Кандидаты были, но все отсеяны (недоступны, запрещены правилами,
+	// превышен лимит) - для вызывающего это то же, что пустая команда
+	if len(result.selected) == 0 && len(result.preferred) == 0 {
+		return result, ErrNoActiveReviewers
+	}
+
+	return result, nil
+}
+
+// loadSkillMatch загружает навыки участников команды по тегам PR.
+// Без тегов навыки не влияют на выбор и не запрашиваются.
+func loadSkillMatch(ctx context.Context, repo repository.SkillRepository, teamID int64, requiredTags []string) (skillMatch, error) {
+	if len(requiredTags) == 0 {
+		return skillMatch{}, nil
+	}
+	skills, err := repo.GetTeamSkills(ctx, teamID, requiredTags)
+	if err != nil {
+		return skillMatch{}, fmt.Errorf("failed to get skills for team %d: %w", teamID, err)
+	}
+	return newSkillMatch(requiredTags, skills), nil
+}
+
+// loadUnavailable загружает участников команды, недоступных сейчас
+func loadUnavailable(ctx context.Context, repo repository.UnavailabilityRepository, teamID int64) (map[string]bool, error) {
+	userIDs, err := repo.ListUnavailableTeamMembers(ctx, teamID, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get unavailable members of team %d: %w", teamID, err)
+	}
+	unavailable := make(map[string]bool, len(userIDs))
+	for _, id := range userIDs {
+		unavailable[id] = true
+	}
+	return unavailable, nil
+}
+
+// loadRuleSet загружает правила назначения для PR автора
+func loadRuleSet(ctx context.Context, repo repository.ReviewerRuleRepository, authorID string) (ruleSet, error) {
+	rules, err := repo.ListReviewerRulesForAuthor(ctx, authorID)
+	if err != nil {
+		return ruleSet{}, fmt.Errorf("failed to get reviewer rules for author %s: %w", authorID, err)
+	}
+	return newRuleSet(rules), nil
+}
+
+// loadPRSkillMatch загружает теги PR и навыки участников команды по ним
+func loadPRSkillMatch(ctx context.Context, repo repository.SkillRepository, pullRequestID string, teamID int64) (skillMatch, error) {
+	tags, err := repo.GetRequiredTags(ctx, pullRequestID)
+	if err != nil {
+		return skillMatch{}, fmt.Errorf("failed to get tags of PR %s: %w", pullRequestID, err)
+	}
+	return loadSkillMatch(ctx, repo, teamID, tags)
+}
+
+// selectedAssignment объясняет выбор ревьюера по политике p
+func selectedAssignment(source models.AssignmentSource, p policy.Policy, workloadMap map[string]int64, userID string, tieBreak bool) models.Assignment {
+	workload := workloadMap[userID]
+	return models.Assignment{
+		Source:               source,
+		Strategy:             p.Strategy,
+		WorkloadAtAssignment: &workload,
+		TieBreak:             &tieBreak,
+	}
+}
+
+// manualAssignment описывает назначение, явно запрошенное пользователем
+func manualAssignment(workloadMap map[string]int64, userID string) models.Assignment {
+	a := models.Assignment{Source: models.AssignmentSourceManual}
+	if workload, ok := workloadMap[userID]; ok {
+		a.WorkloadAtAssignment = &workload
+	}
+	return a
+}
+
+// validate проверяет согласованность пожеланий к назначению
+func (o ReviewerOptions) validate(authorID string) error {
+	if o.Count < 0 {
+		return fmt.Errorf("%w: reviewer count must not be negative", ErrInvalidReviewerOptions)
+	}
+	if o.Count > 0 && len(o.Preferred) > o.Count {
+		return fmt.Errorf("%w: %d preferred reviewers exceed reviewer count %d", ErrInvalidReviewerOptions, len(o.Preferred), o.Count)
+	}
+
+	seen := make(map[string]bool, len(o.Preferred))
+	for _, id := range o.Preferred {
+		switch {
+		case id == authorID:
+			return fmt.Errorf("%w: %v", ErrInvalidReviewerOptions, ErrCannotAssignAuthor)
+		case seen[id]:
+			return fmt.Errorf("%w: preferred reviewer %s is listed twice", ErrInvalidReviewerOptions, id)
+		case slices.Contains(o.Excluded, id):
+			return fmt.Errorf("%w: reviewer %s is both preferred and excluded", ErrInvalidReviewerOptions, id)
+		}
+		seen[id] = true
+	}
+	return nil
+}
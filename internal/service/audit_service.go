@@ -0,0 +1,107 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/logger"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/repository"
+)
+
+// defaultAuditLimit - количество записей журнала, возвращаемых по умолчанию
+const defaultAuditLimit = 100
+
+// maxAuditLimit ограничивает размер одной выборки журнала
+const maxAuditLimit = 1000
+
+// actorKey - ключ инициатора операции в контексте
+type actorKey struct{}
+
+// WithActor сохраняет в контексте инициатора операции для журнала аудита
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// actorFromContext возвращает инициатора операции или пустую строку
+func actorFromContext(ctx context.Context) string {
+	actor, _ := ctx.Value(actorKey{}).(string)
+	return actor
+}
+
+// Override разрешает ручное назначение ревьюера вопреки правилу block.
+// Каждое такое назначение записывается в журнал аудита.
+type Override struct {
+	Enabled bool
+	Reason  string
+}
+
+// checkManualReviewer проверяет, что правила автора PR разрешают вручную
+// назначить reviewerID. Запрет снимается только явным переопределением,
+// которое записывается в журнал аудита в той же транзакции.
+func checkManualReviewer(
+	ctx context.Context,
+	txRepo *repository.PostgresRepository,
+	pr models.PullRequest,
+	reviewerID string,
+	override Override,
+	operation string,
+) error {
+	rules, err := loadRuleSet(ctx, txRepo, pr.AuthorID)
+	if err != nil {
+		return err
+	}
+	rule, blocked := rules.blocked[reviewerID]
+	if !blocked {
+		return nil
+	}
+	if !override.Enabled {
+		return fmt.Errorf("%w: %s by rule %d", ErrReviewerBlocked, reviewerID, rule.ID)
+	}
+
+	_, err = txRepo.AddAuditEntry(ctx, models.AuditEntry{
+		Action:        models.AuditRuleOverridden,
+		Actor:         actorFromContext(ctx),
+		PullRequestID: &pr.PullRequestID,
+		UserID:        &reviewerID,
+		Details: map[string]any{
+			"rule_id":   rule.ID,
+			"operation": operation,
+			"reason":    override.Reason,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record rule override: %w", err)
+	}
+
+	logger.FromContext(ctx).Warn("Reviewer rule overridden",
+		zap.String("pr_id", pr.PullRequestID),
+		zap.String("reviewer", reviewerID),
+		zap.Int64("rule_id", rule.ID),
+		zap.String("operation", operation),
+	)
+	return nil
+}
+
+// AuditServiceImpl реализует AuditService
+type AuditServiceImpl struct {
+	repo repository.AuditRepository
+}
+
+// NewAuditService создает новый AuditService
+func NewAuditService(repo repository.AuditRepository) AuditService {
+	return &AuditServiceImpl{repo: repo}
+}
+
+// ListEntries возвращает последние записи журнала аудита, новые первыми
+func (s *AuditServiceImpl) ListEntries(ctx context.Context, pullRequestID string, limit int) ([]models.AuditEntry, error) {
+	switch {
+	case limit <= 0:
+		limit = defaultAuditLimit
+	case limit > maxAuditLimit:
+		limit = maxAuditLimit
+	}
+	return s.repo.ListAuditEntries(ctx, pullRequestID, limit)
+}
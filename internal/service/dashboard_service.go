@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/policy"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/repository"
+)
+
+// DashboardStatsWindow - период статистики ревью в сводке
+const DashboardStatsWindow = 30 * 24 * time.Hour
+
+// DashboardServiceImpl реализует DashboardService
+type DashboardServiceImpl struct {
+	userRepo           repository.UserRepository
+	prRepo             repository.PullRequestRepository
+	reviewerRepo       repository.PRReviewerRepository
+	unavailabilityRepo repository.UnavailabilityRepository
+	policies           *policy.Store
+}
+
+// NewDashboardService создает новый DashboardService
+func NewDashboardService(
+	userRepo repository.UserRepository,
+	prRepo repository.PullRequestRepository,
+	reviewerRepo repository.PRReviewerRepository,
+	unavailabilityRepo repository.UnavailabilityRepository,
+	policies *policy.Store,
+) DashboardService {
+	return &DashboardServiceImpl{
+		userRepo:           userRepo,
+		prRepo:             prRepo,
+		reviewerRepo:       reviewerRepo,
+		unavailabilityRepo: unavailabilityRepo,
+		policies:           policies,
+	}
+}
+
+// GetDashboard возвращает персональную сводку ревьюера
+func (s *DashboardServiceImpl) GetDashboard(ctx context.Context, userID string) (models.Dashboard, error) {
+	user, err := s.userRepo.GetWithTeam(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return models.Dashboard{}, ErrUserNotFound
+		}
+		return models.Dashboard{}, err
+	}
+
+	reviews, err := s.reviewerRepo.GetReviewsByUserID(ctx, userID)
+	if err != nil {
+		return models.Dashboard{}, err
+	}
+
+	authored, err := s.prRepo.ListOpenByAuthorID(ctx, userID)
+	if err != nil {
+		return models.Dashboard{}, err
+	}
+	var reviewers []models.ReviewerInfo
+	if len(authored) > 0 {
+		ids := make([]string, len(authored))
+		for i, pr := range authored {
+			ids[i] = pr.PullRequestID
+		}
+		if reviewers, err = s.reviewerRepo.GetReviewersByPRIDs(ctx, ids); err != nil {
+			return models.Dashboard{}, err
+		}
+	}
+
+	now := time.Now()
+	periods, err := s.unavailabilityRepo.ListUpcomingUnavailability(ctx, userID, now)
+	if err != nil {
+		return models.Dashboard{}, err
+	}
+
+	return buildDashboard(now, s.policies.FromContext(ctx).Policy, user, reviews, authored, reviewers, periods), nil
+}
+
+// buildDashboard собирает сводку на момент now из назначений пользователя,
+// его открытых PR с ревьюерами и периодов недоступности
+func buildDashboard(
+	now time.Time,
+	p policy.Policy,
+	user models.UserWithTeam,
+	reviews []models.UserReview,
+	authored []models.PullRequest,
+	reviewers []models.ReviewerInfo,
+	periods []models.Unavailability,
+) models.Dashboard {
+	d := models.Dashboard{
+		User:           user,
+		PendingReviews: []models.PendingReview{},
+		AuthoredPRs:    make([]models.AuthoredPR, 0, len(authored)),
+		Unavailability: periods,
+		RecentStats: models.RecentReviewStats{
+			Window: models.TimeWindow{From: now.Add(-DashboardStatsWindow), To: now},
+		},
+	}
+
+	for _, r := range reviews {
+		if r.Status == models.PullRequestStatusOpen {
+			due := r.AssignedAt.Add(p.ReviewSLA)
+			d.PendingReviews = append(d.PendingReviews, models.PendingReview{
+				UserReview: r,
+				Age:        now.Sub(r.CreatedAt),
+				DueAt:      due,
+				Overdue:    now.After(due),
+			})
+		}
+		if !r.AssignedAt.Before(d.RecentStats.Window.From) {
+			d.RecentStats.ReviewsAssigned++
+			if r.Status == models.PullRequestStatusMerged {
+				d.RecentStats.ReviewsMerged++
+			}
+		}
+	}
+	// Самые старые PR первыми
+	sort.SliceStable(d.PendingReviews, func(i, j int) bool {
+		return d.PendingReviews[i].CreatedAt.Before(d.PendingReviews[j].CreatedAt)
+	})
+
+	byPR := make(map[string][]models.AuthoredPRReviewer, len(authored))
+	for _, r := range reviewers {
+		due := r.AssignedAt.Add(p.ReviewSLA)
+		byPR[r.PullRequestID] = append(byPR[r.PullRequestID], models.AuthoredPRReviewer{
+			ReviewerInfo: r,
+			DueAt:        due,
+			Overdue:      now.After(due),
+		})
+	}
+	for _, pr := range authored {
+		prReviewers := byPR[pr.PullRequestID]
+		if prReviewers == nil {
+			prReviewers = []models.AuthoredPRReviewer{}
+		}
+		d.AuthoredPRs = append(d.AuthoredPRs, models.AuthoredPR{PullRequest: pr, Reviewers: prReviewers})
+	}
+
+	open := int64(len(d.PendingReviews))
+	d.Capacity = models.ReviewCapacity{OpenReviews: open, MaxOpenReviews: p.MaxOpenReviews}
+	if p.MaxOpenReviews > 0 {
+		remaining := max(int64(p.MaxOpenReviews)-open, 0)
+		d.Capacity.Remaining = &remaining
+		d.Capacity.AtCapacity = remaining == 0
+	}
+	for _, period := range periods {
+		if period.ActiveAt(now) {
+			d.Capacity.Unavailable = true
+		}
+	}
+
+	return d
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/policy"
+)
+
+func TestBuildDashboard(t *testing.T) {
+	now := time.Date(2025, 10, 20, 12, 0, 0, 0, time.UTC)
+	p := policy.Policy{ReviewSLA: 24 * time.Hour, MaxOpenReviews: 3}
+
+	review := func(id string, status models.PullRequestStatus, created, assigned time.Time) models.UserReview {
+		return models.UserReview{
+			PullRequestShort: models.PullRequestShort{PullRequestID: id, AuthorID: "u1", Status: status},
+			CreatedAt:        created,
+			AssignedAt:       assigned,
+		}
+	}
+	reviews := []models.UserReview{
+		review("pr-new", models.PullRequestStatusOpen, now.Add(-2*time.Hour), now.Add(-2*time.Hour)),
+		review("pr-old", models.PullRequestStatusOpen, now.Add(-72*time.Hour), now.Add(-48*time.Hour)),
+		review("pr-merged", models.PullRequestStatusMerged, now.Add(-5*24*time.Hour), now.Add(-5*24*time.Hour)),
+		review("pr-ancient", models.PullRequestStatusMerged, now.Add(-90*24*time.Hour), now.Add(-90*24*time.Hour)),
+	}
+	authored := []models.PullRequest{{PullRequestID: "pr-mine"}, {PullRequestID: "pr-alone"}}
+	reviewers := []models.ReviewerInfo{
+		{PullRequestID: "pr-mine", UserID: "u3", AssignedAt: now.Add(-30 * time.Hour)},
+		{PullRequestID: "pr-mine", UserID: "u4", AssignedAt: now.Add(-time.Hour)},
+	}
+	periods := []models.Unavailability{{StartsAt: now.Add(-time.Hour), EndsAt: now.Add(time.Hour)}}
+
+	d := buildDashboard(now, p, models.UserWithTeam{UserID: "u2"}, reviews, authored, reviewers, periods)
+
+	// Только открытые PR, самые старые первыми
+	require.Len(t, d.PendingReviews, 2)
+	assert.Equal(t, "pr-old", d.PendingReviews[0].PullRequestID)
+	assert.Equal(t, 72*time.Hour, d.PendingReviews[0].Age)
+	assert.True(t, d.PendingReviews[0].Overdue)
+	assert.Equal(t, now.Add(22*time.Hour), d.PendingReviews[1].DueAt)
+	assert.False(t, d.PendingReviews[1].Overdue)
+
+	require.Len(t, d.AuthoredPRs, 2)
+	require.Len(t, d.AuthoredPRs[0].Reviewers, 2)
+	assert.True(t, d.AuthoredPRs[0].Reviewers[0].Overdue)
+	assert.False(t, d.AuthoredPRs[0].Reviewers[1].Overdue)
+	assert.NotNil(t, d.AuthoredPRs[1].Reviewers)
+	assert.Empty(t, d.AuthoredPRs[1].Reviewers)
+
+	assert.Equal(t, int64(2), d.Capacity.OpenReviews)
+	if assert.NotNil(t, d.Capacity.Remaining) {
+		assert.Equal(t, int64(1), *d.Capacity.Remaining)
+	}
+	assert.False(t, d.Capacity.AtCapacity)
+	assert.True(t, d.Capacity.Unavailable)
+
+	// Назначение 90 дней назад не попадает в окно статистики
+	assert.Equal(t, int64(3), d.RecentStats.ReviewsAssigned)
+	assert.Equal(t, int64(1), d.RecentStats.ReviewsMerged)
+}
+
+func TestBuildDashboard_NoLimit(t *testing.T) {
+	now := time.Now()
+	d := buildDashboard(now, policy.Policy{ReviewSLA: time.Hour}, models.UserWithTeam{}, nil, nil, nil, nil)
+
+	assert.NotNil(t, d.PendingReviews)
+	assert.NotNil(t, d.AuthoredPRs)
+	assert.Nil(t, d.Capacity.Remaining)
+	assert.False(t, d.Capacity.AtCapacity)
+	assert.False(t, d.Capacity.Unavailable)
+}
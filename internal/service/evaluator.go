@@ -0,0 +1,357 @@
+package service
+
+import (
+	"math/rand/v2"
+	"sort"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/config"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/policy"
+)
+
+// Причины, по которым пользователь не может стать ревьюером
+const (
+	ReasonAuthor          = "author"
+	ReasonInactive        = "inactive"
+	ReasonUnavailable     = "unavailable"
+	ReasonAtCapacity      = "at_capacity"
+	ReasonAlreadyAssigned = "already_assigned"
+	ReasonExcluded        = "excluded"
+	ReasonBlocked         = "blocked"
+)
+
+// Слагаемые оценки кандидата
+const (
+	ScoreWorkload     = "workload"
+	ScoreOverCapacity = "over_capacity"
+	ScoreRandom       = "random"
+	ScoreSkillMatch   = "skill_match"
+	ScorePreferred    = "preferred"
+)
+
+// preferredBonus - надбавка к оценке ревьюера, предпочтительного для автора.
+// При весе нагрузки 1 она равна одному открытому ревью.
+const preferredBonus = 1.0
+
+// ScoreComponent - слагаемое итоговой оценки кандидата
+type ScoreComponent struct {
+	Name  string
+	Value float64
+}
+
+// Candidate - кандидат в ревьюеры с оценкой. Чем выше оценка, тем раньше
+// кандидат будет выбран.
+type Candidate struct {
+	User      models.User
+	Workload  int64
+	Score     float64
+	Breakdown []ScoreComponent
+}
+
+// ExcludedCandidate - пользователь, отсеянный при выборе ревьюеров
+type ExcludedCandidate struct {
+	User     models.User
+	Workload int64
+	Reason   string
+}
+
+// Evaluation - кандидаты в порядке выбора и отсеянные пользователи с причинами
+type Evaluation struct {
+	Ranked   []Candidate
+	Excluded []ExcludedCandidate
+}
+
+// selectionRules задает пользователей, которых нельзя назначить на PR,
+// навыки, по которым оцениваются остальные, требования наставничества
+// и постоянные правила назначения для автора PR
+type selectionRules struct {
+	authorID    string
+	assigned    map[string]bool
+	excluded    map[string]bool
+	unavailable map[string]bool // в отпуске или выходном на момент назначения
+	skills      skillMatch
+	mentorship  mentorship
+	rules       ruleSet
+}
+
+// ruleSet - правила назначения, действующие для PR одного автора
+type ruleSet struct {
+	blocked   map[string]models.ReviewerRule // reviewer_id -> правило block
+	preferred map[string]bool
+}
+
+// newRuleSet группирует правила по ревьюерам. Если для ревьюера есть и
+// block, и prefer (например, для автора и для его команды), действует block.
+func newRuleSet(rules []models.ReviewerRule) ruleSet {
+	set := ruleSet{
+		blocked:   make(map[string]models.ReviewerRule),
+		preferred: make(map[string]bool),
+	}
+	for _, rule := range rules {
+		switch rule.Effect {
+		case models.ReviewerRuleBlock:
+			if _, ok := set.blocked[rule.ReviewerID]; !ok {
+				set.blocked[rule.ReviewerID] = rule
+			}
+		case models.ReviewerRulePrefer:
+			set.preferred[rule.ReviewerID] = true
+		}
+	}
+	return set
+}
+
+// isBlocked проверяет, запрещено ли назначать пользователя
+func (s ruleSet) isBlocked(userID string) bool {
+	_, ok := s.blocked[userID]
+	return ok
+}
+
+// mentorship описывает требование наставничества: среди ревьюеров PR должен
+// быть senior и, если возможно, learner
+type mentorship struct {
+	enabled    bool
+	hasSenior  bool
+	hasLearner bool
+}
+
+// newMentorship учитывает уровни ревьюеров, которые остаются на PR
+func newMentorship(enabled bool, kept ...models.Seniority) mentorship {
+	m := mentorship{enabled: enabled}
+	for _, s := range kept {
+		m.keep(s)
+	}
+	return m
+}
+
+// keep отмечает ревьюера, который остается на PR
+func (m *mentorship) keep(s models.Seniority) {
+	switch s {
+	case models.SenioritySenior:
+		m.hasSenior = true
+	case models.SeniorityLearner:
+		m.hasLearner = true
+	}
+}
+
+// skillMatch описывает теги PR и навыки кандидатов по этим тегам
+type skillMatch struct {
+	required []string
+	levels   map[string]map[string]models.SkillLevel // user_id -> тег -> уровень
+}
+
+// newSkillMatch группирует навыки по пользователям
+func newSkillMatch(required []string, skills []models.UserSkill) skillMatch {
+	m := skillMatch{
+		required: required,
+		levels:   make(map[string]map[string]models.SkillLevel),
+	}
+	for _, skill := range skills {
+		if m.levels[skill.UserID] == nil {
+			m.levels[skill.UserID] = make(map[string]models.SkillLevel)
+		}
+		m.levels[skill.UserID][skill.Tag] = skill.Level
+	}
+	return m
+}
+
+// score возвращает средний уровень владения тегами PR: от 0, если у
+// кандидата нет ни одного из навыков, до 3, если он эксперт во всех
+func (m skillMatch) score(userID string) float64 {
+	if len(m.required) == 0 {
+		return 0
+	}
+	total := 0
+	for _, tag := range m.required {
+		total += m.levels[userID][tag].Rank()
+	}
+	return float64(total) / float64(len(m.required))
+}
+
+// evaluateCandidates оценивает пользователей по политике назначения.
+// Пользователи, достигшие лимита открытых ревью, остаются кандидатами, только
+// если других кандидатов нет и политика разрешает превышение лимита.
+func evaluateCandidates(p policy.Policy, users []models.User, workloadMap map[string]int64, f selectionRules) Evaluation {
+	var eval Evaluation
+	exclude := func(user models.User, reason string) {
+		eval.Excluded = append(eval.Excluded, ExcludedCandidate{
+			User:     user,
+			Workload: workloadMap[user.UserID],
+			Reason:   reason,
+		})
+	}
+
+	var eligible, overCapacity []models.User
+	for _, user := range users {
+		switch {
+		case user.UserID == f.authorID:
+			exclude(user, ReasonAuthor)
+		case !user.IsActive:
+			exclude(user, ReasonInactive)
+		case f.unavailable[user.UserID]:
+			exclude(user, ReasonUnavailable)
+		case f.assigned[user.UserID]:
+			exclude(user, ReasonAlreadyAssigned)
+		case f.excluded[user.UserID]:
+			exclude(user, ReasonExcluded)
+		case f.rules.isBlocked(user.UserID):
+			exclude(user, ReasonBlocked)
+		case p.MaxOpenReviews > 0 && workloadMap[user.UserID] >= int64(p.MaxOpenReviews):
+			overCapacity = append(overCapacity, user)
+		default:
+			eligible = append(eligible, user)
+		}
+	}
+
+	overCapacityAllowed := len(eligible) == 0 && p.FallbackOverCapacity
+	for _, user := range overCapacity {
+		if overCapacityAllowed {
+			eligible = append(eligible, user)
+		} else {
+			exclude(user, ReasonAtCapacity)
+		}
+	}
+
+	for _, user := range eligible {
+		workload := workloadMap[user.UserID]
+		c := Candidate{User: user, Workload: workload}
+
+		if p.Strategy == config.StrategyRandom {
+			c.Breakdown = append(c.Breakdown, ScoreComponent{Name: ScoreRandom, Value: rand.Float64()})
+		} else {
+			c.Breakdown = append(c.Breakdown, ScoreComponent{Name: ScoreWorkload, Value: -p.WorkloadWeight * float64(workload)})
+		}
+		if len(f.skills.required) > 0 && p.SkillWeight > 0 {
+			c.Breakdown = append(c.Breakdown, ScoreComponent{Name: ScoreSkillMatch, Value: p.SkillWeight * f.skills.score(user.UserID)})
+		}
+		if f.rules.preferred[user.UserID] {
+			c.Breakdown = append(c.Breakdown, ScoreComponent{Name: ScorePreferred, Value: preferredBonus})
+		}
+		if p.MaxOpenReviews > 0 && workload >= int64(p.MaxOpenReviews) {
+			c.Breakdown = append(c.Breakdown, ScoreComponent{
+				Name:  ScoreOverCapacity,
+				Value: -float64(workload - int64(p.MaxOpenReviews) + 1),
+			})
+		}
+
+		for _, component := range c.Breakdown {
+			c.Score += component.Value
+		}
+		eval.Ranked = append(eval.Ranked, c)
+	}
+
+	// Стабильная сортировка сохраняет порядок пользователей при равной оценке
+	sort.SliceStable(eval.Ranked, func(i, j int) bool {
+		return eval.Ranked[i].Score > eval.Ranked[j].Score
+	})
+
+	return eval
+}
+
+// top возвращает до count лучших кандидатов
+func (e Evaluation) top(count int) []models.User {
+	count = min(max(count, 0), len(e.Ranked))
+	users := make([]models.User, 0, count)
+	for _, c := range e.Ranked[:count] {
+		users = append(users, c.User)
+	}
+	return users
+}
+
+// selectPaired возвращает до count кандидатов. Без наставничества это лучшие
+// по оценке. С наставничеством сначала берется лучший senior, затем лучший
+// learner, если их еще нет среди ревьюеров PR, остальные места - по оценке.
+func (e Evaluation) selectPaired(count int, m mentorship) []models.User {
+	if !m.enabled {
+		return e.top(count)
+	}
+
+	count = min(max(count, 0), len(e.Ranked))
+	picked := make([]bool, len(e.Ranked))
+	n := 0
+	pick := func(level models.Seniority) {
+		for i, c := range e.Ranked {
+			if n < count && !picked[i] && c.User.Seniority == level {
+				picked[i] = true
+				n++
+				return
+			}
+		}
+	}
+	if !m.hasSenior {
+		pick(models.SenioritySenior)
+	}
+	if !m.hasLearner {
+		pick(models.SeniorityLearner)
+	}
+	for i := range e.Ranked {
+		if n < count && !picked[i] {
+			picked[i] = true
+			n++
+		}
+	}
+
+	users := make([]models.User, 0, count)
+	for i, c := range e.Ranked {
+		if picked[i] {
+			users = append(users, c.User)
+		}
+	}
+	return users
+}
+
+// tieBreaks отмечает выбранных пользователей, у которых есть невыбранный
+// кандидат с той же оценкой: их выбор решила не оценка, а порядок кандидатов
+func (e Evaluation) tieBreaks(selected []models.User) map[string]bool {
+	isSelected := make(map[string]bool, len(selected))
+	for _, user := range selected {
+		isSelected[user.UserID] = true
+	}
+
+	unselectedScores := make(map[float64]bool)
+	for _, c := range e.Ranked {
+		if !isSelected[c.User.UserID] {
+			unselectedScores[c.Score] = true
+		}
+	}
+
+	ties := make(map[string]bool, len(selected))
+	for _, c := range e.Ranked {
+		if isSelected[c.User.UserID] {
+			ties[c.User.UserID] = unselectedScores[c.Score]
+		}
+	}
+	return ties
+}
+
+// selectReviewers выбирает до count пользователей согласно политике назначения.
+// Для каждого выбранного возвращает, решило ли выбор равенство оценок.
+func selectReviewers(p policy.Policy, users []models.User, workloadMap map[string]int64, rules selectionRules, count int) ([]models.User, map[string]bool) {
+	eval := evaluateCandidates(p, users, workloadMap, rules)
+	selected := eval.selectPaired(count, rules.mentorship)
+	return selected, eval.tieBreaks(selected)
+}
+
+// PreviewRequest описывает гипотетический PR для предпросмотра назначения
+type PreviewRequest struct {
+	AuthorID string
+	// PullRequestID необязателен: если указан, уже назначенные ревьюеры
+	// не выбираются повторно и учитываются в количестве
+	PullRequestID string
+	// Count - общее количество ревьюеров, при 0 берется значение из политики
+	Count    int
+	Excluded []string
+	// RequiredTags - навыки, нужные для ревью. Если не заданы, берутся теги PR.
+	RequiredTags []string
+}
+
+// Preview - результат предпросмотра назначения
+type Preview struct {
+	Author        models.UserWithTeam
+	Strategy      string
+	PolicyVersion int64
+	Count         int
+	RequiredTags  []string
+	Mentorship    bool
+	Selected      []models.User
+	Evaluation
+}
@@ -0,0 +1,317 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/config"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/policy"
+)
+
+func userIDs(users []models.User) []string {
+	ids := make([]string, 0, len(users))
+	for _, u := range users {
+		ids = append(ids, u.UserID)
+	}
+	return ids
+}
+
+func TestSelectReviewers_Capacity(t *testing.T) {
+	users := []models.User{{UserID: "u1", IsActive: true}, {UserID: "u2", IsActive: true}, {UserID: "u3", IsActive: true}}
+	base := policy.Policy{Strategy: config.StrategyLeastLoaded, WorkloadWeight: 1, MaxOpenReviews: 3}
+
+	tests := []struct {
+		name     string
+		fallback bool
+		workload map[string]int64
+		count    int
+		want     []string
+	}{
+		{
+			name:     "пользователи на лимите пропускаются",
+			workload: map[string]int64{"u1": 3, "u2": 2, "u3": 1},
+			count:    2,
+			want:     []string{"u3", "u2"},
+		},
+		{
+			name:     "недобор не заполняется пользователями сверх лимита",
+			fallback: true,
+			workload: map[string]int64{"u1": 3, "u2": 5, "u3": 0},
+			count:    2,
+			want:     []string{"u3"},
+		},
+		{
+			name:     "все на лимите, превышение разрешено",
+			fallback: true,
+			workload: map[string]int64{"u1": 4, "u2": 3, "u3": 6},
+			count:    1,
+			want:     []string{"u2"},
+		},
+		{
+			name:     "все на лимите, превышение запрещено",
+			workload: map[string]int64{"u1": 4, "u2": 3, "u3": 6},
+			count:    1,
+			want:     []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := base
+			p.FallbackOverCapacity = tt.fallback
+			got, _ := selectReviewers(p, users, tt.workload, selectionRules{}, tt.count)
+			assert.Equal(t, tt.want, userIDs(got))
+		})
+	}
+}
+
+func TestEvaluateCandidates_ExclusionReasons(t *testing.T) {
+	users := []models.User{
+		{UserID: "author", IsActive: true},
+		{UserID: "inactive", IsActive: false},
+		{UserID: "assigned", IsActive: true},
+		{UserID: "excluded", IsActive: true},
+		{UserID: "busy", IsActive: true},
+		{UserID: "free", IsActive: true},
+		{UserID: "light", IsActive: true},
+	}
+	workload := map[string]int64{"busy": 5, "free": 0, "light": 2}
+	p := policy.Policy{Strategy: config.StrategyLeastLoaded, WorkloadWeight: 1, MaxOpenReviews: 5, FallbackOverCapacity: true}
+
+	eval := evaluateCandidates(p, users, workload, selectionRules{
+		authorID: "author",
+		assigned: map[string]bool{"assigned": true},
+		excluded: map[string]bool{"excluded": true},
+	})
+
+	reasons := make(map[string]string)
+	for _, e := range eval.Excluded {
+		reasons[e.User.UserID] = e.Reason
+	}
+	assert.Equal(t, map[string]string{
+		"author":   ReasonAuthor,
+		"inactive": ReasonInactive,
+		"assigned": ReasonAlreadyAssigned,
+		"excluded": ReasonExcluded,
+		"busy":     ReasonAtCapacity,
+	}, reasons)
+
+	require.Len(t, eval.Ranked, 2)
+	assert.Equal(t, "free", eval.Ranked[0].User.UserID)
+	assert.Equal(t, "light", eval.Ranked[1].User.UserID)
+	assert.Equal(t, []ScoreComponent{{Name: ScoreWorkload, Value: -2}}, eval.Ranked[1].Breakdown)
+	assert.Equal(t, -2.0, eval.Ranked[1].Score)
+}
+
+func TestSelectReviewers_SkipsUnavailable(t *testing.T) {
+	users := []models.User{
+		{UserID: "vacation", IsActive: true},
+		{UserID: "busy", IsActive: true},
+	}
+	// Недоступный наименее загружен и остался бы единственным кандидатом
+	// при превышении лимита, но все равно не выбирается
+	workload := map[string]int64{"vacation": 0, "busy": 3}
+	p := policy.Policy{Strategy: config.StrategyLeastLoaded, WorkloadWeight: 1, MaxOpenReviews: 3, FallbackOverCapacity: true}
+	rules := selectionRules{unavailable: map[string]bool{"vacation": true}}
+
+	selected, _ := selectReviewers(p, users, workload, rules, 2)
+	require.Len(t, selected, 1)
+	assert.Equal(t, "busy", selected[0].UserID)
+
+	eval := evaluateCandidates(p, users, workload, rules)
+	require.Len(t, eval.Excluded, 1)
+	assert.Equal(t, "vacation", eval.Excluded[0].User.UserID)
+	assert.Equal(t, ReasonUnavailable, eval.Excluded[0].Reason)
+}
+
+func TestEvaluateCandidates_OverCapacityPenalty(t *testing.T) {
+	users := []models.User{{UserID: "u1", IsActive: true}, {UserID: "u2", IsActive: true}}
+	p := policy.Policy{Strategy: config.StrategyLeastLoaded, WorkloadWeight: 1, MaxOpenReviews: 2, FallbackOverCapacity: true}
+
+	eval := evaluateCandidates(p, users, map[string]int64{"u1": 4, "u2": 2}, selectionRules{})
+
+	require.Len(t, eval.Ranked, 2)
+	assert.Empty(t, eval.Excluded)
+	assert.Equal(t, "u2", eval.Ranked[0].User.UserID)
+	assert.Equal(t, []ScoreComponent{
+		{Name: ScoreWorkload, Value: -4},
+		{Name: ScoreOverCapacity, Value: -3},
+	}, eval.Ranked[1].Breakdown)
+}
+
+func TestEvaluateCandidates_SkillMatch(t *testing.T) {
+	users := []models.User{
+		{UserID: "junior", IsActive: true},
+		{UserID: "expert", IsActive: true},
+		{UserID: "idle", IsActive: true},
+	}
+	workload := map[string]int64{"junior": 0, "expert": 2, "idle": 0}
+	skills := newSkillMatch([]string{"go", "sql"}, []models.UserSkill{
+		{UserID: "junior", Tag: "go", Level: models.SkillLevelNovice},
+		{UserID: "expert", Tag: "go", Level: models.SkillLevelExpert},
+		{UserID: "expert", Tag: "sql", Level: models.SkillLevelExpert},
+	})
+
+	t.Run("навыки перевешивают нагрузку", func(t *testing.T) {
+		p := policy.Policy{Strategy: config.StrategyLeastLoaded, WorkloadWeight: 1, SkillWeight: 1}
+		eval := evaluateCandidates(p, users, workload, selectionRules{skills: skills})
+
+		assert.Equal(t, []string{"expert", "junior", "idle"}, userIDs(eval.top(3)))
+		assert.Equal(t, []ScoreComponent{
+			{Name: ScoreWorkload, Value: -2},
+			{Name: ScoreSkillMatch, Value: 3},
+		}, eval.Ranked[0].Breakdown)
+		assert.Equal(t, 0.5, eval.Ranked[1].Score)
+	})
+
+	t.Run("нулевой вес навыков", func(t *testing.T) {
+		p := policy.Policy{Strategy: config.StrategyLeastLoaded, WorkloadWeight: 1}
+		eval := evaluateCandidates(p, users, workload, selectionRules{skills: skills})
+
+		assert.Equal(t, []string{"junior", "idle", "expert"}, userIDs(eval.top(3)))
+		assert.Len(t, eval.Ranked[0].Breakdown, 1)
+	})
+}
+
+func TestSelectReviewers_Mentorship(t *testing.T) {
+	users := []models.User{
+		{UserID: "r1", IsActive: true, Seniority: models.SeniorityRegular},
+		{UserID: "r2", IsActive: true, Seniority: models.SeniorityRegular},
+		{UserID: "s1", IsActive: true, Seniority: models.SenioritySenior},
+		{UserID: "l1", IsActive: true, Seniority: models.SeniorityLearner},
+	}
+	workload := map[string]int64{"r1": 0, "r2": 1, "s1": 2, "l1": 3}
+	p := policy.Policy{Strategy: config.StrategyLeastLoaded, WorkloadWeight: 1}
+
+	tests := []struct {
+		name  string
+		m     mentorship
+		count int
+		want  []string
+	}{
+		{
+			name:  "наставничество выключено",
+			m:     newMentorship(false),
+			count: 2,
+			want:  []string{"r1", "r2"},
+		},
+		{
+			name:  "пара senior и learner",
+			m:     newMentorship(true),
+			count: 2,
+			want:  []string{"s1", "l1"},
+		},
+		{
+			name:  "одно место отдается senior",
+			m:     newMentorship(true),
+			count: 1,
+			want:  []string{"s1"},
+		},
+		{
+			name:  "senior уже назначен",
+			m:     newMentorship(true, models.SenioritySenior),
+			count: 2,
+			want:  []string{"r1", "l1"},
+		},
+		{
+			name:  "пара уже есть",
+			m:     newMentorship(true, models.SenioritySenior, models.SeniorityLearner),
+			count: 1,
+			want:  []string{"r1"},
+		},
+		{
+			name:  "оставшиеся места заполняются по оценке",
+			m:     newMentorship(true),
+			count: 3,
+			want:  []string{"r1", "s1", "l1"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := selectReviewers(p, users, workload, selectionRules{mentorship: tt.m}, tt.count)
+			assert.Equal(t, tt.want, userIDs(got))
+		})
+	}
+}
+
+func TestEvaluateCandidates_ReviewerRules(t *testing.T) {
+	users := []models.User{
+		{UserID: "u1", IsActive: true},
+		{UserID: "u2", IsActive: true},
+		{UserID: "u3", IsActive: true},
+		{UserID: "u4", IsActive: true},
+	}
+	workload := map[string]int64{"u1": 0, "u2": 1, "u3": 1, "u4": 0}
+	p := policy.Policy{Strategy: config.StrategyLeastLoaded, WorkloadWeight: 1}
+
+	author, team := "author", int64(1)
+	rules := newRuleSet([]models.ReviewerRule{
+		{ID: 1, Effect: models.ReviewerRuleBlock, ReviewerID: "u1", AuthorID: &author},
+		{ID: 2, Effect: models.ReviewerRulePrefer, ReviewerID: "u2", AuthorID: &author},
+		// Запрет для команды важнее предпочтения для автора
+		{ID: 3, Effect: models.ReviewerRuleBlock, ReviewerID: "u4", TeamID: &team},
+		{ID: 4, Effect: models.ReviewerRulePrefer, ReviewerID: "u4", AuthorID: &author},
+	})
+
+	eval := evaluateCandidates(p, users, workload, selectionRules{rules: rules})
+
+	require.Len(t, eval.Ranked, 2)
+	assert.Equal(t, "u2", eval.Ranked[0].User.UserID, "предпочтение компенсирует одно открытое ревью")
+	assert.Equal(t, 0.0, eval.Ranked[0].Score)
+	assert.Contains(t, eval.Ranked[0].Breakdown, ScoreComponent{Name: ScorePreferred, Value: preferredBonus})
+	assert.Equal(t, "u3", eval.Ranked[1].User.UserID)
+
+	reasons := make(map[string]string)
+	for _, e := range eval.Excluded {
+		reasons[e.User.UserID] = e.Reason
+	}
+	assert.Equal(t, map[string]string{"u1": ReasonBlocked, "u4": ReasonBlocked}, reasons)
+	assert.Equal(t, int64(3), rules.blocked["u4"].ID)
+}
+
+func TestSelectReviewers_TieBreaks(t *testing.T) {
+	users := []models.User{
+		{UserID: "u1", IsActive: true},
+		{UserID: "u2", IsActive: true},
+		{UserID: "u3", IsActive: true},
+		{UserID: "u4", IsActive: true},
+	}
+	p := policy.Policy{Strategy: config.StrategyLeastLoaded, WorkloadWeight: 1}
+
+	tests := []struct {
+		name     string
+		workload map[string]int64
+		count    int
+		want     map[string]bool
+	}{
+		{
+			name:     "выбор по нагрузке без равенства",
+			workload: map[string]int64{"u1": 0, "u2": 1, "u3": 2, "u4": 3},
+			count:    2,
+			want:     map[string]bool{"u1": false, "u2": false},
+		},
+		{
+			name:     "равная нагрузка с невыбранным кандидатом",
+			workload: map[string]int64{"u1": 0, "u2": 1, "u3": 1, "u4": 3},
+			count:    2,
+			want:     map[string]bool{"u1": false, "u2": true},
+		},
+		{
+			name:     "равенство только среди выбранных",
+			workload: map[string]int64{"u1": 1, "u2": 1, "u3": 2, "u4": 3},
+			count:    2,
+			want:     map[string]bool{"u1": false, "u2": false},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, ties := selectReviewers(p, users, tt.workload, selectionRules{}, tt.count)
+			assert.Equal(t, tt.want, ties)
+		})
+	}
+}
@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/health"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/logger"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/metrics"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/repository"
+)
+
+const (
+	// eventPollInterval - как часто журнал читается без уведомлений,
+	// на случай потерянного NOTIFY
+	eventPollInterval = 5 * time.Second
+	// eventRetryDelay - пауза перед повторной подпиской после ошибки соединения
+	eventRetryDelay = time.Second
+	// eventGapTimeout - сколько перечитывается пропущенный id. Id выдаются
+	// при вставке, а транзакции фиксируются в любом порядке, поэтому пропуск -
+	// это еще не зафиксированная транзакция или откат. По истечении таймаута
+	// пропуск считается откатом.
+	eventGapTimeout = time.Minute
+	// eventBatchSize - сколько событий читается из журнала за один запрос
+	eventBatchSize = 500
+	// eventSubscriberBuffer - очередь событий подписчика. Переполнение
+	// закрывает подписку, клиент продолжает с Last-Event-ID.
+	eventSubscriberBuffer = 256
+)
+
+// EventPruneInterval - период удаления событий старше срока хранения
+const EventPruneInterval = time.Hour
+
+// EventSubscription - подписка на новые события
+type EventSubscription struct {
+	filter models.EventFilter
+	events chan models.Event
+	hub    *EventServiceImpl
+
+	// highID и missing - положение рассылки в момент подписки: события до
+	// highID, кроме missing, разосланы раньше и читаются только из журнала
+	highID  int64
+	missing map[int64]bool
+}
+
+// Events возвращает канал событий. Канал закрывается, если подписчик не
+// успевает читать события, или после Close. Событие транзакции,
+// зафиксированной позже следующих, приходит после них.
+func (s *EventSubscription) Events() <-chan models.Event {
+	return s.events
+}
+
+// Close отменяет подписку
+func (s *EventSubscription) Close() {
+	s.hub.unsubscribe(s)
+}
+
+// Replay передает fn сохраненные события после afterID по порядку. Читаются
+// только события, разосланные до подписки: остальные придут в Events, так что
+// каждое событие отдается один раз.
+func (s *EventSubscription) Replay(ctx context.Context, afterID int64, fn func(models.Event) error) error {
+	if s.highID < 0 {
+		// Подписка раньше начала рассылки: все разосланное придет в Events
+		startID, err := s.hub.start(ctx)
+		if err != nil {
+			return err
+		}
+		s.highID = startID
+	}
+
+	for afterID < s.highID {
+		events, err := s.hub.repo.ListEventsAfter(ctx, afterID, s.filter, eventBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, e := range events {
+			if e.ID > s.highID {
+				return nil
+			}
+			afterID = e.ID
+			if s.missing[e.ID] {
+				continue
+			}
+			if err := fn(e); err != nil {
+				return err
+			}
+		}
+		if len(events) < eventBatchSize {
+			return nil
+		}
+	}
+	return nil
+}
+
+// EventServiceImpl реализует EventService
+type EventServiceImpl struct {
+	repo    repository.EventRepository
+	metrics *metrics.Metrics
+
+	// mu защищает подписчиков и положение рассылки, чтобы подписка видела
+	// положение, согласованное с уже разосланными событиями
+	mu   sync.Mutex
+	subs map[*EventSubscription]struct{}
+	// startID - последний id журнала в начале рассылки, highID - наибольший
+	// разосланный id, оба -1 до первого чтения журнала
+	startID int64
+	highID  int64
+	// missing - пропущенные id меньше highID и когда пропуск замечен.
+	// Они перечитываются при каждой рассылке до eventGapTimeout.
+	missing map[int64]time.Time
+	now     func() time.Time
+}
+
+// NewEventService создает новый EventService
+func NewEventService(repo repository.EventRepository, m *metrics.Metrics) EventService {
+	return &EventServiceImpl{
+		repo:    repo,
+		metrics: m,
+		subs:    make(map[*EventSubscription]struct{}),
+		startID: -1,
+		highID:  -1,
+		missing: make(map[int64]time.Time),
+		now:     time.Now,
+	}
+}
+
+// Run читает новые события и рассылает их подписчикам до отмены ctx.
+// После ошибки соединения подписка на уведомления повторяется, события,
+// записанные за это время, дочитываются из журнала.
+func (s *EventServiceImpl) Run(ctx context.Context) {
+	for {
+		err := s.repo.ListenEvents(ctx, eventPollInterval, func() error {
+			return s.dispatch(ctx)
+		})
+		if ctx.Err() != nil {
+			return
+		}
+		logger.FromContext(ctx).Error("Assignment event listener failed", zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(eventRetryDelay):
+		}
+	}
+}
+
+// start начинает рассылку с событий, записанных после первого вызова, и
+// возвращает последний id журнала в ее начале
+func (s *EventServiceImpl) start(ctx context.Context) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.startID < 0 {
+		latest, err := s.repo.GetLatestEventID(ctx)
+		if err != nil {
+			return 0, err
+		}
+		s.startID = latest
+		s.highID = latest
+	}
+	return s.startID, nil
+}
+
+// dispatch рассылает новые события журнала и события с пропущенными ранее
+// id, которые успели зафиксироваться. Новые события не ждут пропущенных:
+// событие долгой транзакции придет подписчикам позже следующих за ним.
+func (s *EventServiceImpl) dispatch(ctx context.Context) error {
+	if _, err := s.start(ctx); err != nil {
+		return err
+	}
+
+	if missing := s.missingIDs(); len(missing) > 0 {
+		events, err := s.repo.ListEventsByIDs(ctx, missing)
+		if err != nil {
+			return err
+		}
+		for _, e := range events {
+			s.publish(e)
+		}
+	}
+
+	s.mu.Lock()
+	highID := s.highID
+	s.mu.Unlock()
+	for {
+		events, err := s.repo.ListEventsAfter(ctx, highID, models.EventFilter{}, eventBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, e := range events {
+			s.publish(e)
+			highID = e.ID
+		}
+		if len(events) < eventBatchSize {
+			return nil
+		}
+	}
+}
+
+// missingIDs забывает пропуски старше eventGapTimeout и возвращает остальные
+func (s *EventServiceImpl) missingIDs() []int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	ids := make([]int64, 0, len(s.missing))
+	for id, since := range s.missing {
+		if now.Sub(since) >= eventGapTimeout {
+			delete(s.missing, id)
+			continue
+		}
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	return ids
+}
+
+// publish отмечает событие разосланным и отправляет его подходящим
+// подписчикам. Id между прошлым наибольшим и новым запоминаются как
+// пропущенные. Подписчик с полной очередью отключается, чтобы не
+// задерживать остальных.
+func (s *EventServiceImpl) publish(e models.Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch {
+	case e.ID > s.highID:
+		now := s.now()
+		for id := s.highID + 1; id < e.ID; id++ {
+			s.missing[id] = now
+		}
+		s.highID = e.ID
+	case s.missing[e.ID].IsZero():
+		return // уже разослано
+	default:
+		delete(s.missing, e.ID)
+	}
+
+	for sub := range s.subs {
+		if !sub.filter.Matches(e) {
+			continue
+		}
+		select {
+		case sub.events <- e:
+		default:
+			s.removeLocked(sub)
+		}
+	}
+}
+
+// Subscribe подписывает на новые события, подходящие под filter
+func (s *EventServiceImpl) Subscribe(filter models.EventFilter) *EventSubscription {
+	sub := &EventSubscription{
+		filter: filter,
+		events: make(chan models.Event, eventSubscriberBuffer),
+		hub:    s,
+	}
+
+	s.mu.Lock()
+	sub.highID = s.highID
+	sub.missing = make(map[int64]bool, len(s.missing))
+	for id := range s.missing {
+		sub.missing[id] = true
+	}
+	s.subs[sub] = struct{}{}
+	s.mu.Unlock()
+	s.metrics.EventSubscribers(1)
+
+	return sub
+}
+
+func (s *EventServiceImpl) unsubscribe(sub *EventSubscription) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.removeLocked(sub)
+}
+
+func (s *EventServiceImpl) removeLocked(sub *EventSubscription) {
+	if _, ok := s.subs[sub]; !ok {
+		return
+	}
+	delete(s.subs, sub)
+	close(sub.events)
+	s.metrics.EventSubscribers(-1)
+}
+
+// Prune удаляет события старше before и возвращает их количество
+func (s *EventServiceImpl) Prune(ctx context.Context, before time.Time) (int64, error) {
+	return s.repo.DeleteEventsBefore(ctx, before)
+}
+
+// RunEventPrune раз в interval удаляет события старше retention до отмены
+// ctx и отмечает каждое удаление в hb
+func RunEventPrune(ctx context.Context, svc EventService, retention, interval time.Duration, hb *health.Heartbeat) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := svc.Prune(ctx, time.Now().Add(-retention))
+			if err != nil && ctx.Err() == nil {
+				logger.FromContext(ctx).Error("Assignment event prune failed", zap.Error(err))
+			}
+			if deleted > 0 {
+				logger.FromContext(ctx).Info("Assignment events pruned", zap.Int64("deleted", deleted))
+			}
+			hb.Beat(err)
+		}
+	}
+}
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+)
+
+// fakeEventRepository хранит журнал событий в памяти
+type fakeEventRepository struct {
+	events []models.Event
+}
+
+func (r *fakeEventRepository) ListEventsAfter(_ context.Context, afterID int64, filter models.EventFilter, limit int) ([]models.Event, error) {
+	var events []models.Event
+	for _, e := range r.events {
+		if e.ID > afterID && filter.Matches(e) && len(events) < limit {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func (r *fakeEventRepository) ListEventsByIDs(_ context.Context, ids []int64) ([]models.Event, error) {
+	var events []models.Event
+	for _, e := range r.events {
+		if slices.Contains(ids, e.ID) {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func (r *fakeEventRepository) GetLatestEventID(context.Context) (int64, error) {
+	if len(r.events) == 0 {
+		return 0, nil
+	}
+	return r.events[len(r.events)-1].ID, nil
+}
+
+func (r *fakeEventRepository) DeleteEventsBefore(context.Context, time.Time) (int64, error) {
+	return 0, nil
+}
+
+func (r *fakeEventRepository) ListenEvents(context.Context, time.Duration, func() error) error {
+	return nil
+}
+
+func (r *fakeEventRepository) add(e models.Event) {
+	e.ID = int64(len(r.events) + 1)
+	r.events = append(r.events, e)
+}
+
+// commit добавляет событие с заданным id, как транзакция, зафиксированная
+// позже транзакций с большими id
+func (r *fakeEventRepository) commit(id int64) {
+	r.events = append(r.events, models.Event{ID: id, Type: models.EventPRMerged})
+	slices.SortFunc(r.events, func(a, b models.Event) int { return cmp.Compare(a.ID, b.ID) })
+}
+
+func ptr(s string) *string { return &s }
+
+// received забирает события, уже попавшие в очередь подписки
+func received(sub *EventSubscription) []int64 {
+	var ids []int64
+	for {
+		select {
+		case e, ok := <-sub.Events():
+			if !ok {
+				return ids
+			}
+			ids = append(ids, e.ID)
+		default:
+			return ids
+		}
+	}
+}
+
+func TestEventService_Dispatch(t *testing.T) {
+	repo := &fakeEventRepository{}
+	repo.add(models.Event{Type: models.EventPRMerged, PullRequestID: ptr("pr-0")})
+	svc := NewEventService(repo, nil).(*EventServiceImpl)
+
+	all := svc.Subscribe(models.EventFilter{})
+	byUser := svc.Subscribe(models.EventFilter{UserID: "u2"})
+	byTeam := svc.Subscribe(models.EventFilter{TeamName: "frontend"})
+	byPR := svc.Subscribe(models.EventFilter{PullRequestID: "pr-1", UserID: "u1"})
+
+	// События до запуска рассылки не отправляются
+	require.NoError(t, svc.dispatch(context.Background()))
+	assert.Empty(t, received(all))
+
+	repo.add(models.Event{Type: models.EventReviewerAssigned, PullRequestID: ptr("pr-1"), AuthorID: ptr("u1"), UserID: ptr("u2"), TeamName: ptr("backend")})
+	repo.add(models.Event{Type: models.EventReviewerReplaced, PullRequestID: ptr("pr-2"), AuthorID: ptr("u3"), UserID: ptr("u4"), PreviousUserID: ptr("u2"), TeamName: ptr("backend")})
+	repo.add(models.Event{Type: models.EventUserDeactivated, UserID: ptr("u5"), TeamName: ptr("frontend")})
+	require.NoError(t, svc.dispatch(context.Background()))
+
+	assert.Equal(t, []int64{2, 3, 4}, received(all))
+	assert.Equal(t, []int64{2, 3}, received(byUser))
+	assert.Equal(t, []int64{4}, received(byTeam))
+	assert.Equal(t, []int64{2}, received(byPR))
+}
+
+func TestEventService_SlowSubscriberClosed(t *testing.T) {
+	svc := NewEventService(&fakeEventRepository{}, nil).(*EventServiceImpl)
+	slow := svc.Subscribe(models.EventFilter{})
+	other := svc.Subscribe(models.EventFilter{UserID: "u1"})
+
+	for i := 1; i <= eventSubscriberBuffer+1; i++ {
+		svc.publish(models.Event{ID: int64(i)})
+	}
+
+	assert.Len(t, received(slow), eventSubscriberBuffer)
+	_, ok := <-slow.Events()
+	assert.False(t, ok, "переполненная подписка закрыта")
+
+	// Закрытие уже отключенной подписки безопасно
+	slow.Close()
+	other.Close()
+	_, ok = <-other.Events()
+	assert.False(t, ok)
+}
+
+func TestEventService_Replay(t *testing.T) {
+	repo := &fakeEventRepository{}
+	for i := 0; i < eventBatchSize+10; i++ {
+		user := "u1"
+		if i%2 == 1 {
+			user = "u2"
+		}
+		repo.add(models.Event{Type: models.EventReviewerAssigned, UserID: ptr(user)})
+	}
+	svc := NewEventService(repo, nil)
+	all := svc.Subscribe(models.EventFilter{})
+	defer all.Close()
+
+	var ids []int64
+	err := all.Replay(context.Background(), 3, func(e models.Event) error {
+		ids = append(ids, e.ID)
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, ids, eventBatchSize+7)
+	assert.Equal(t, int64(4), ids[0])
+	assert.Equal(t, int64(eventBatchSize+10), ids[len(ids)-1])
+
+	byUser := svc.Subscribe(models.EventFilter{UserID: "u2"})
+	defer byUser.Close()
+
+	var count int
+	err = byUser.Replay(context.Background(), 0, func(e models.Event) error {
+		assert.Equal(t, "u2", *e.UserID)
+		count++
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, (eventBatchSize+10)/2, count)
+}
+
+// replayed возвращает id сохраненных событий, которые подписка читает из журнала
+func replayed(t *testing.T, sub *EventSubscription, afterID int64) []int64 {
+	t.Helper()
+	var ids []int64
+	require.NoError(t, sub.Replay(context.Background(), afterID, func(e models.Event) error {
+		ids = append(ids, e.ID)
+		return nil
+	}))
+	return ids
+}
+
+func TestEventService_LateCommit(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeEventRepository{}
+	svc := NewEventService(repo, nil).(*EventServiceImpl)
+	now := time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	sub := svc.Subscribe(models.EventFilter{})
+	require.NoError(t, svc.dispatch(ctx))
+
+	// Событие 2 еще не зафиксировано: 3 не ждет его
+	repo.commit(1)
+	repo.commit(3)
+	require.NoError(t, svc.dispatch(ctx))
+	assert.Equal(t, []int64{1, 3}, received(sub))
+
+	// Подписка в этот момент читает из журнала 1 и 3, а 2 получит вживую
+	late := svc.Subscribe(models.EventFilter{})
+	assert.Equal(t, []int64{1, 3}, replayed(t, late, 0))
+
+	// Транзакция 2 фиксируется намного позже, но до таймаута
+	now = now.Add(eventGapTimeout - time.Second)
+	repo.commit(2)
+	repo.commit(4)
+	require.NoError(t, svc.dispatch(ctx))
+	assert.Equal(t, []int64{2, 4}, received(sub))
+	assert.Equal(t, []int64{2, 4}, received(late))
+
+	// Каждое событие рассылается один раз
+	require.NoError(t, svc.dispatch(ctx))
+	assert.Empty(t, received(sub))
+	assert.Empty(t, svc.missing)
+}
+
+func TestEventService_RolledBackID(t *testing.T) {
+	ctx := context.Background()
+	repo := &fakeEventRepository{}
+	svc := NewEventService(repo, nil).(*EventServiceImpl)
+	now := time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	sub := svc.Subscribe(models.EventFilter{})
+	require.NoError(t, svc.dispatch(ctx))
+
+	// Транзакция 1 откатилась, а 2 и 3 рассылаются сразу
+	repo.commit(2)
+	require.NoError(t, svc.dispatch(ctx))
+	repo.commit(3)
+	require.NoError(t, svc.dispatch(ctx))
+	assert.Equal(t, []int64{2, 3}, received(sub))
+	assert.Equal(t, map[int64]time.Time{1: now}, svc.missing)
+
+	// После таймаута пропуск считается откатом и больше не перечитывается
+	now = now.Add(eventGapTimeout)
+	require.NoError(t, svc.dispatch(ctx))
+	assert.Empty(t, svc.missing)
+	assert.Empty(t, received(sub))
+}
@@ -0,0 +1,95 @@
+package service
+
+import (
+	"slices"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+)
+
+// DefaultFairnessTolerance - отклонение от среднего по команде, в пределах
+// которого ревьюер считается загруженным поровну с остальными
+const DefaultFairnessTolerance = 0.2
+
+// gini вычисляет коэффициент Джини неотрицательных значений.
+// Для пустого набора и нулевой суммы возвращает 0.
+func gini(values []int64) float64 {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+
+	var sum, weighted float64
+	for i, v := range sorted {
+		sum += float64(v)
+		weighted += float64(i+1) * float64(v)
+	}
+	if sum == 0 {
+		return 0
+	}
+
+	n := float64(len(sorted))
+	return 2*weighted/(n*sum) - (n+1)/n
+}
+
+// buildTeamFairness считает неравенство назначений по командам. counts
+// должны быть упорядочены по имени команды.
+func buildTeamFairness(counts []models.ReviewerAssignmentCount, tolerance float64) []models.TeamFairness {
+	var teams []models.TeamFairness
+	for start := 0; start < len(counts); {
+		end := start
+		for end < len(counts) && counts[end].TeamName == counts[start].TeamName {
+			end++
+		}
+		teams = append(teams, teamFairness(counts[start:end], tolerance))
+		start = end
+	}
+	return teams
+}
+
+// teamFairness считает неравенство назначений внутри одной команды
+func teamFairness(members []models.ReviewerAssignmentCount, tolerance float64) models.TeamFairness {
+	team := models.TeamFairness{
+		TeamName:  members[0].TeamName,
+		Reviewers: len(members),
+		Members:   make([]models.ReviewerFairness, 0, len(members)),
+	}
+
+	assignments := make([]int64, 0, len(members))
+	withoutTies := make([]int64, 0, len(members))
+	for _, m := range members {
+		team.TotalAssignments += m.Assignments
+		team.PolicyAssignments += m.PolicyAssignments
+		team.TieBreakAssignments += m.TieBreakAssignments
+		assignments = append(assignments, m.Assignments)
+		withoutTies = append(withoutTies, m.Assignments-m.TieBreakAssignments)
+	}
+
+	team.Mean = float64(team.TotalAssignments) / float64(len(members))
+	team.Gini = gini(assignments)
+	team.GiniWithoutTieBreaks = gini(withoutTies)
+	if team.PolicyAssignments > 0 {
+		team.TieBreakShare = float64(team.TieBreakAssignments) / float64(team.PolicyAssignments)
+	}
+	if lo, hi := slices.Min(assignments), slices.Max(assignments); lo > 0 {
+		ratio := float64(hi) / float64(lo)
+		team.MaxMinRatio = &ratio
+	}
+
+	for _, m := range members {
+		r := models.ReviewerFairness{
+			ReviewerAssignmentCount: m,
+			Deviation:               float64(m.Assignments) - team.Mean,
+			Status:                  models.FairnessBalanced,
+		}
+		if team.Mean > 0 {
+			r.RelativeDeviation = r.Deviation / team.Mean
+		}
+		switch {
+		case r.RelativeDeviation > tolerance:
+			r.Status = models.FairnessOverAssigned
+		case r.RelativeDeviation < -tolerance:
+			r.Status = models.FairnessUnderAssigned
+		}
+		team.Members = append(team.Members, r)
+	}
+
+	return team
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+)
+
+func TestGini(t *testing.T) {
+	tests := []struct {
+		name   string
+		values []int64
+		want   float64
+	}{
+		{name: "пустой набор", values: nil, want: 0},
+		{name: "нет назначений", values: []int64{0, 0, 0}, want: 0},
+		{name: "поровну", values: []int64{5, 5, 5, 5}, want: 0},
+		{name: "все у одного", values: []int64{0, 0, 0, 8}, want: 0.75},
+		{name: "порядок не важен", values: []int64{3, 1, 2}, want: 2.0 / 9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.want, gini(tt.values), 1e-9)
+		})
+	}
+}
+
+func TestBuildTeamFairness(t *testing.T) {
+	counts := []models.ReviewerAssignmentCount{
+		{TeamName: "backend", UserID: "u1", Assignments: 6, PolicyAssignments: 6, TieBreakAssignments: 3},
+		{TeamName: "backend", UserID: "u2", Assignments: 3, PolicyAssignments: 3},
+		{TeamName: "backend", UserID: "u3", Assignments: 3, PolicyAssignments: 2},
+		{TeamName: "frontend", UserID: "u4", Assignments: 2, PolicyAssignments: 2},
+		{TeamName: "frontend", UserID: "u5", Assignments: 0},
+	}
+
+	teams := buildTeamFairness(counts, DefaultFairnessTolerance)
+	require.Len(t, teams, 2)
+
+	backend := teams[0]
+	assert.Equal(t, "backend", backend.TeamName)
+	assert.Equal(t, 3, backend.Reviewers)
+	assert.Equal(t, int64(12), backend.TotalAssignments)
+	assert.InDelta(t, 4.0, backend.Mean, 1e-9)
+	assert.InDelta(t, 1.0/6, backend.Gini, 1e-9)
+	// Без назначений, решенных равенством оценок, нагрузка равная
+	assert.InDelta(t, 0, backend.GiniWithoutTieBreaks, 1e-9)
+	if assert.NotNil(t, backend.MaxMinRatio) {
+		assert.InDelta(t, 2.0, *backend.MaxMinRatio, 1e-9)
+	}
+	assert.Equal(t, int64(11), backend.PolicyAssignments)
+	assert.InDelta(t, 3.0/11, backend.TieBreakShare, 1e-9)
+
+	statuses := map[string]models.FairnessStatus{}
+	for _, m := range backend.Members {
+		statuses[m.UserID] = m.Status
+	}
+	assert.Equal(t, map[string]models.FairnessStatus{
+		"u1": models.FairnessOverAssigned,
+		"u2": models.FairnessUnderAssigned,
+		"u3": models.FairnessUnderAssigned,
+	}, statuses)
+	assert.InDelta(t, 0.5, backend.Members[0].RelativeDeviation, 1e-9)
+
+	frontend := teams[1]
+	assert.Nil(t, frontend.MaxMinRatio, "у ревьюера без назначений отношение не определено")
+	assert.InDelta(t, 0.5, frontend.Gini, 1e-9)
+	assert.Zero(t, frontend.TieBreakShare)
+}
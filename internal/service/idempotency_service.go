@@ -0,0 +1,97 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/health"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/logger"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/repository"
+)
+
+// IdempotencyPruneInterval - период удаления ключей старше срока хранения
+const IdempotencyPruneInterval = time.Hour
+
+// IdempotencyServiceImpl реализует IdempotencyService
+type IdempotencyServiceImpl struct {
+	repo repository.IdempotencyRepository
+	// lease - время, после которого незавершенный запрос считается
+	// прерванным и повтор с тем же ключом выполняет его заново
+	lease time.Duration
+}
+
+// NewIdempotencyService создает новый IdempotencyService
+func NewIdempotencyService(repo repository.IdempotencyRepository, lease time.Duration) IdempotencyService {
+	return &IdempotencyServiceImpl{repo: repo, lease: lease}
+}
+
+func (s *IdempotencyServiceImpl) Begin(ctx context.Context, client, key, requestHash string) (*models.IdempotentResponse, error) {
+	reserved, err := s.repo.ReserveIdempotencyKey(ctx, client, key, requestHash, s.lease)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve idempotency key: %w", err)
+	}
+	if reserved {
+		return nil, nil
+	}
+
+	record, err := s.repo.GetIdempotencyKey(ctx, client, key)
+	if err != nil {
+		// Ключ освободили между вставкой и чтением: первый запрос еще
+		// завершается, клиент повторит запрос
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrIdempotencyInProgress
+		}
+		return nil, fmt.Errorf("failed to get idempotency key: %w", err)
+	}
+
+	switch {
+	case record.RequestHash != requestHash:
+		return nil, ErrIdempotencyKeyReused
+	case record.Response == nil:
+		return nil, ErrIdempotencyInProgress
+	default:
+		return record.Response, nil
+	}
+}
+
+func (s *IdempotencyServiceImpl) Complete(ctx context.Context, client, key string, resp models.IdempotentResponse) error {
+	return s.repo.CompleteIdempotencyKey(ctx, client, key, resp)
+}
+
+func (s *IdempotencyServiceImpl) Release(ctx context.Context, client, key string) error {
+	return s.repo.ReleaseIdempotencyKey(ctx, client, key)
+}
+
+func (s *IdempotencyServiceImpl) Prune(ctx context.Context, before time.Time) (int64, error) {
+	return s.repo.DeleteIdempotencyKeysBefore(ctx, before)
+}
+
+// RunIdempotencyPrune раз в interval удаляет ключи старше retention до
+// отмены ctx и отмечает каждое удаление в hb
+func RunIdempotencyPrune(ctx context.Context, svc IdempotencyService, retention, interval time.Duration, hb *health.Heartbeat) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := svc.Prune(ctx, time.Now().Add(-retention))
+			if err != nil && ctx.Err() == nil {
+				logger.FromContext(ctx).Error("Idempotency key prune failed", zap.Error(err))
+			}
+			if deleted > 0 {
+				logger.FromContext(ctx).Info("Idempotency keys pruned", zap.Int64("deleted", deleted))
+			}
+			hb.Beat(err)
+		}
+	}
+}
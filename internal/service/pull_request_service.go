@@ -4,21 +4,41 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.uber.org/zap"
 
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/logger"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/metrics"
 	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/policy"
 	"github.com/AtoyanMikhail/PRAssignmentService/internal/repository"
 )
 
+// uniqueViolation - код ошибки PostgreSQL при нарушении уникальности
+const uniqueViolation = "23505"
+
 // PullRequestServiceImpl реализует PullRequestService
 type PullRequestServiceImpl struct {
-	repo repository.PullRequestRepository
+	repo     repository.PullRequestRepository
+	store    *repository.Store
+	metrics  *metrics.Metrics
+	policies *policy.Store
 }
 
 // NewPullRequestService создает новый PullRequestService
-func NewPullRequestService(repo repository.PullRequestRepository) PullRequestService {
+func NewPullRequestService(
+	repo repository.PullRequestRepository,
+	store *repository.Store,
+	m *metrics.Metrics,
+	policies *policy.Store,
+) PullRequestService {
 	return &PullRequestServiceImpl{
-		repo: repo,
+		repo:     repo,
+		store:    store,
+		metrics:  m,
+		policies: policies,
 	}
 }
 
@@ -40,6 +60,110 @@ func (s *PullRequestServiceImpl) CreatePR(ctx context.Context, pullRequestID, pu
 	return pr, nil
 }
 
+// CreateWithReviewers создает Pull Request и назначает ревьюеров в одной транзакции
+func (s *PullRequestServiceImpl) CreateWithReviewers(ctx context.Context, pullRequestID, pullRequestName, authorID string, opts ReviewerOptions) (models.PullRequest, []models.PRReviewer, error) {
+	if err := opts.validate(authorID); err != nil {
+		return models.PullRequest{}, nil, err
+	}
+	requiredTags, err := normalizeTags(opts.RequiredTags)
+	if err != nil {
+		return models.PullRequest{}, nil, err
+	}
+
+	p := s.policies.FromContext(ctx).Policy
+	count := opts.Count
+	if count == 0 {
+		count = max(p.DefaultReviewerCount, len(opts.Preferred))
+	}
+
+	var (
+		pr         models.PullRequest
+		author     models.UserWithTeam
+		result     assignmentResult
+		noReviewer bool
+	)
+	err = s.store.ExecTx(ctx, func(txRepo *repository.PostgresRepository) error {
+		var err error
+		author, err = txRepo.GetWithTeam(ctx, authorID)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+				return ErrUserNotFound
+			}
+			return err
+		}
+
+		exists, err := txRepo.PRExists(ctx, pullRequestID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return ErrPullRequestAlreadyExists
+		}
+
+		pr, err = txRepo.CreatePR(ctx, pullRequestID, pullRequestName, authorID, models.PullRequestStatusOpen)
+		if err != nil {
+			// Параллельный запрос успел создать PR с тем же ID
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+				return ErrPullRequestAlreadyExists
+			}
+			return err
+		}
+		if len(requiredTags) > 0 {
+			if err := txRepo.SetRequiredTags(ctx, pullRequestID, requiredTags); err != nil {
+				return err
+			}
+			pr.RequiredTags = requiredTags
+		}
+
+		result, err = assignReviewersTx(ctx, txRepo, p, assignmentRequest{
+			pullRequestID: pullRequestID,
+			authorID:      authorID,
+			teamID:        author.TeamID,
+			mentorship:    author.TeamMentorshipEnabled,
+			count:         count,
+			preferred:     opts.Preferred,
+			excluded:      opts.Excluded,
+			requiredTags:  requiredTags,
+		})
+		if errors.Is(err, ErrNoActiveReviewers) {
+			// PR без ревьюеров лучше, чем невозможность открыть PR
+			noReviewer = true
+			return nil
+		}
+		return err
+	})
+	if err != nil {
+		return models.PullRequest{}, nil, err
+	}
+
+	log := logger.FromContext(ctx)
+	if noReviewer {
+		s.metrics.NoActiveReviewers(author.TeamName)
+		log.Warn("No active reviewers in team",
+			zap.String("pr_id", pullRequestID),
+			zap.String("team", author.TeamName),
+		)
+	}
+
+	s.metrics.ReviewersAssigned(metrics.SourceManual, len(result.preferred))
+	s.metrics.ReviewersAssigned(metrics.SourceAuto, len(result.selected))
+
+	reviewers := result.all()
+	reviewerIDs := make([]string, 0, len(reviewers))
+	for _, r := range reviewers {
+		reviewerIDs = append(reviewerIDs, r.UserID)
+	}
+	log.Info("Pull request created",
+		zap.String("pr_id", pullRequestID),
+		zap.String("author", authorID),
+		zap.Strings("reviewers", reviewerIDs),
+		zap.Int("preferred", len(result.preferred)),
+	)
+
+	return pr, reviewers, nil
+}
+
 // GetPR возвращает Pull Request по ID
 func (s *PullRequestServiceImpl) GetPR(ctx context.Context, pullRequestID string) (models.PullRequest, error) {
 	pr, err := s.repo.GetPullRequestByPRID(ctx, pullRequestID)
@@ -6,7 +6,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/config"
 	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/policy"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 )
@@ -46,6 +48,11 @@ func (m *MockPullRequestRepository) ListByStatus(ctx context.Context, status mod
 	return args.Get(0).([]models.PullRequest), args.Error(1)
 }
 
+func (m *MockPullRequestRepository) ListOpenByAuthorID(ctx context.Context, authorID string) ([]models.PullRequest, error) {
+	args := m.Called(ctx, authorID)
+	return args.Get(0).([]models.PullRequest), args.Error(1)
+}
+
 func (m *MockPullRequestRepository) PRExists(ctx context.Context, pullRequestID string) (bool, error) {
 	args := m.Called(ctx, pullRequestID)
 	return args.Bool(0), args.Error(1)
@@ -56,7 +63,7 @@ func TestPullRequestService_CreatePR(t *testing.T) {
 
 	t.Run("успешное создание PR", func(t *testing.T) {
 		mockRepo := new(MockPullRequestRepository)
-		service := NewPullRequestService(mockRepo)
+		service := NewPullRequestService(mockRepo, nil, nil, nil)
 
 		expectedPR := models.PullRequest{
 			ID:              1,
@@ -80,7 +87,7 @@ func TestPullRequestService_CreatePR(t *testing.T) {
 
 	t.Run("ошибка - PR уже существует", func(t *testing.T) {
 		mockRepo := new(MockPullRequestRepository)
-		service := NewPullRequestService(mockRepo)
+		service := NewPullRequestService(mockRepo, nil, nil, nil)
 
 		mockRepo.On("PRExists", ctx, "PR-123").Return(true, nil)
 
@@ -98,7 +105,7 @@ func TestPullRequestService_GetPR(t *testing.T) {
 
 	t.Run("успешное получение PR", func(t *testing.T) {
 		mockRepo := new(MockPullRequestRepository)
-		service := NewPullRequestService(mockRepo)
+		service := NewPullRequestService(mockRepo, nil, nil, nil)
 
 		expectedPR := models.PullRequest{
 			ID:              1,
@@ -120,7 +127,7 @@ func TestPullRequestService_GetPR(t *testing.T) {
 
 	t.Run("ошибка - PR не найден", func(t *testing.T) {
 		mockRepo := new(MockPullRequestRepository)
-		service := NewPullRequestService(mockRepo)
+		service := NewPullRequestService(mockRepo, nil, nil, nil)
 
 		mockRepo.On("GetPullRequestByPRID", ctx, "PR-999").Return(models.PullRequest{}, sql.ErrNoRows)
 
@@ -138,7 +145,7 @@ func TestPullRequestService_UpdatePRStatus(t *testing.T) {
 
 	t.Run("успешное обновление статуса", func(t *testing.T) {
 		mockRepo := new(MockPullRequestRepository)
-		service := NewPullRequestService(mockRepo)
+		service := NewPullRequestService(mockRepo, nil, nil, nil)
 
 		existingPR := models.PullRequest{
 			ID:              1,
@@ -164,7 +171,7 @@ func TestPullRequestService_UpdatePRStatus(t *testing.T) {
 
 	t.Run("ошибка - невалидный статус", func(t *testing.T) {
 		mockRepo := new(MockPullRequestRepository)
-		service := NewPullRequestService(mockRepo)
+		service := NewPullRequestService(mockRepo, nil, nil, nil)
 
 		existingPR := models.PullRequest{
 			ID:            1,
@@ -184,7 +191,7 @@ func TestPullRequestService_UpdatePRStatus(t *testing.T) {
 
 	t.Run("ошибка - PR не найден", func(t *testing.T) {
 		mockRepo := new(MockPullRequestRepository)
-		service := NewPullRequestService(mockRepo)
+		service := NewPullRequestService(mockRepo, nil, nil, nil)
 
 		mockRepo.On("GetPullRequestByPRID", ctx, "PR-999").Return(models.PullRequest{}, sql.ErrNoRows)
 
@@ -202,7 +209,7 @@ func TestPullRequestService_MergePR(t *testing.T) {
 
 	t.Run("успешный merge PR", func(t *testing.T) {
 		mockRepo := new(MockPullRequestRepository)
-		service := NewPullRequestService(mockRepo)
+		service := NewPullRequestService(mockRepo, nil, nil, nil)
 
 		existingPR := models.PullRequest{
 			ID:            1,
@@ -229,7 +236,7 @@ func TestPullRequestService_MergePR(t *testing.T) {
 
 	t.Run("ошибка - PR не найден", func(t *testing.T) {
 		mockRepo := new(MockPullRequestRepository)
-		service := NewPullRequestService(mockRepo)
+		service := NewPullRequestService(mockRepo, nil, nil, nil)
 
 		mockRepo.On("GetPullRequestByPRID", ctx, "PR-999").Return(models.PullRequest{}, sql.ErrNoRows)
 
@@ -247,7 +254,7 @@ func TestPullRequestService_ListOpenPRs(t *testing.T) {
 
 	t.Run("успешное получение открытых PR", func(t *testing.T) {
 		mockRepo := new(MockPullRequestRepository)
-		service := NewPullRequestService(mockRepo)
+		service := NewPullRequestService(mockRepo, nil, nil, nil)
 
 		expectedPRs := []models.PullRequest{
 			{ID: 1, PullRequestID: "PR-1", Status: models.PullRequestStatusOpen},
@@ -269,7 +276,7 @@ func TestPullRequestService_ListPRsByStatus(t *testing.T) {
 
 	t.Run("успешное получение PR по статусу", func(t *testing.T) {
 		mockRepo := new(MockPullRequestRepository)
-		service := NewPullRequestService(mockRepo)
+		service := NewPullRequestService(mockRepo, nil, nil, nil)
 
 		expectedPRs := []models.PullRequest{
 			{ID: 1, PullRequestID: "PR-1", Status: models.PullRequestStatusMerged},
@@ -287,7 +294,7 @@ func TestPullRequestService_ListPRsByStatus(t *testing.T) {
 
 	t.Run("ошибка - невалидный статус", func(t *testing.T) {
 		mockRepo := new(MockPullRequestRepository)
-		service := NewPullRequestService(mockRepo)
+		service := NewPullRequestService(mockRepo, nil, nil, nil)
 
 		prs, err := service.ListPRsByStatus(ctx, models.PullRequestStatus("INVALID"))
 
@@ -297,3 +304,57 @@ func TestPullRequestService_ListPRsByStatus(t *testing.T) {
 		mockRepo.AssertNotCalled(t, "ListByStatus")
 	})
 }
+
+func TestReviewerOptions_Validate(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    ReviewerOptions
+		wantErr bool
+	}{
+		{name: "без пожеланий", opts: ReviewerOptions{}},
+		{name: "предпочтительные в пределах количества", opts: ReviewerOptions{Count: 2, Preferred: []string{"u2"}, Excluded: []string{"u3"}}},
+		{name: "отрицательное количество", opts: ReviewerOptions{Count: -1}, wantErr: true},
+		{name: "предпочтительных больше количества", opts: ReviewerOptions{Count: 1, Preferred: []string{"u2", "u3"}}, wantErr: true},
+		{name: "автор среди предпочтительных", opts: ReviewerOptions{Preferred: []string{"u1"}}, wantErr: true},
+		{name: "повтор предпочтительного", opts: ReviewerOptions{Preferred: []string{"u2", "u2"}}, wantErr: true},
+		{name: "предпочтительный исключен", opts: ReviewerOptions{Preferred: []string{"u2"}, Excluded: []string{"u2"}}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.opts.validate("u1")
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidReviewerOptions)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAssignmentReasons(t *testing.T) {
+	p := policy.Policy{Strategy: config.StrategyLeastLoaded}
+	workloadMap := map[string]int64{"u2": 3}
+
+	auto := selectedAssignment(models.AssignmentSourceAuto, p, workloadMap, "u2", true)
+	assert.Equal(t, models.AssignmentSourceAuto, auto.Source)
+	assert.Equal(t, config.StrategyLeastLoaded, auto.Strategy)
+	if assert.NotNil(t, auto.WorkloadAtAssignment) {
+		assert.Equal(t, int64(3), *auto.WorkloadAtAssignment)
+	}
+	if assert.NotNil(t, auto.TieBreak) {
+		assert.True(t, *auto.TieBreak)
+	}
+
+	manual := manualAssignment(workloadMap, "u2")
+	assert.Equal(t, models.AssignmentSourceManual, manual.Source)
+	assert.Empty(t, manual.Strategy)
+	assert.Nil(t, manual.StrategyToDB())
+	if assert.NotNil(t, manual.WorkloadAtAssignment) {
+		assert.Equal(t, int64(3), *manual.WorkloadAtAssignment)
+	}
+
+	assert.Nil(t, manual.TieBreak)
+
+	assert.Nil(t, manualAssignment(workloadMap, "unknown").WorkloadAtAssignment)
+}
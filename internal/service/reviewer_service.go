@@ -5,20 +5,30 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/jackc/pgx/v5"
+	"go.uber.org/zap"
 
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/logger"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/metrics"
 	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/policy"
 	"github.com/AtoyanMikhail/PRAssignmentService/internal/repository"
 )
 
 // ReviewerServiceImpl реализует ReviewerService
 type ReviewerServiceImpl struct {
-	reviewerRepo repository.PRReviewerRepository
-	userRepo     repository.UserRepository
-	prRepo       repository.PullRequestRepository
-	statsRepo    repository.StatisticsRepository
-	store        *repository.Store
+	reviewerRepo       repository.PRReviewerRepository
+	userRepo           repository.UserRepository
+	prRepo             repository.PullRequestRepository
+	workloadRepo       repository.WorkloadRepository
+	skillRepo          repository.SkillRepository
+	ruleRepo           repository.ReviewerRuleRepository
+	unavailabilityRepo repository.UnavailabilityRepository
+	store              *repository.Store
+	metrics            *metrics.Metrics
+	policies           *policy.Store
 }
 
 // NewReviewerService создает новый ReviewerService
@@ -26,21 +36,73 @@ func NewReviewerService(
 	reviewerRepo repository.PRReviewerRepository,
 	userRepo repository.UserRepository,
 	prRepo repository.PullRequestRepository,
-	statsRepo repository.StatisticsRepository,
+	workloadRepo repository.WorkloadRepository,
+	skillRepo repository.SkillRepository,
+	ruleRepo repository.ReviewerRuleRepository,
+	unavailabilityRepo repository.UnavailabilityRepository,
 	store *repository.Store,
+	m *metrics.Metrics,
+	policies *policy.Store,
 ) ReviewerService {
 	return &ReviewerServiceImpl{
-		reviewerRepo: reviewerRepo,
-		userRepo:     userRepo,
-		prRepo:       prRepo,
-		statsRepo:    statsRepo,
-		store:        store,
+		reviewerRepo:       reviewerRepo,
+		userRepo:           userRepo,
+		prRepo:             prRepo,
+		workloadRepo:       workloadRepo,
+		skillRepo:          skillRepo,
+		ruleRepo:           ruleRepo,
+		unavailabilityRepo: unavailabilityRepo,
+		store:              store,
+		metrics:            m,
+		policies:           policies,
 	}
 }
 
-// AssignReviewer назначает ревьюера на Pull Request
-func (s *ReviewerServiceImpl) AssignReviewer(ctx context.Context, pullRequestID, userID string) (models.PRReviewer, error) {
-	pr, err := s.prRepo.GetPullRequestByPRID(ctx, pullRequestID)
+// AssignReviewer вручную назначает ревьюера на Pull Request
+func (s *ReviewerServiceImpl) AssignReviewer(ctx context.Context, pullRequestID, userID string, override Override) (models.PRReviewer, error) {
+	var reviewer models.PRReviewer
+	err := s.store.ExecTx(ctx, func(txRepo *repository.PostgresRepository) error {
+		var err error
+		reviewer, err = assignManualTx(ctx, txRepo, pullRequestID, userID, override)
+		return err
+	})
+	if err != nil {
+		return models.PRReviewer{}, err
+	}
+
+	s.metrics.ReviewersAssigned(metrics.SourceManual, 1)
+
+	return reviewer, nil
+}
+
+// AssignReviewers вручную назначает несколько ревьюеров на Pull Request
+func (s *ReviewerServiceImpl) AssignReviewers(ctx context.Context, pullRequestID string, userIDs []string, override Override) ([]models.PRReviewer, error) {
+	var reviewers []models.PRReviewer
+
+	// Выполнение в транзакции для атомарности
+	err := s.store.ExecTx(ctx, func(txRepo *repository.PostgresRepository) error {
+		for _, userID := range userIDs {
+			reviewer, err := assignManualTx(ctx, txRepo, pullRequestID, userID, override)
+			if err != nil {
+				return err
+			}
+			reviewers = append(reviewers, reviewer)
+		}
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	s.metrics.ReviewersAssigned(metrics.SourceManual, len(reviewers))
+
+	return reviewers, nil
+}
+
+// assignManualTx проверяет и выполняет ручное назначение внутри транзакции txRepo
+func assignManualTx(ctx context.Context, txRepo *repository.PostgresRepository, pullRequestID, userID string, override Override) (models.PRReviewer, error) {
+	pr, err := txRepo.GetPullRequestByPRID(ctx, pullRequestID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
 			return models.PRReviewer{}, ErrPullRequestNotFound
@@ -49,10 +111,10 @@ func (s *ReviewerServiceImpl) AssignReviewer(ctx context.Context, pullRequestID,
 	}
 
 	if pr.Status != models.PullRequestStatusOpen {
-		return models.PRReviewer{}, fmt.Errorf("cannot assign reviewer to non-open PR")
+		return models.PRReviewer{}, fmt.Errorf("%w: cannot assign reviewer to non-open PR", ErrInvalidStatus)
 	}
 
-	user, err := s.userRepo.GetByUserID(ctx, userID)
+	user, err := txRepo.GetByUserID(ctx, userID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
 			return models.PRReviewer{}, ErrUserNotFound
@@ -68,7 +130,7 @@ func (s *ReviewerServiceImpl) AssignReviewer(ctx context.Context, pullRequestID,
 		return models.PRReviewer{}, ErrCannotAssignAuthor
 	}
 
-	isAssigned, err := s.reviewerRepo.IsUserAssigned(ctx, pullRequestID, userID)
+	isAssigned, err := txRepo.IsUserAssigned(ctx, pullRequestID, userID)
 	if err != nil {
 		return models.PRReviewer{}, err
 	}
@@ -76,45 +138,12 @@ func (s *ReviewerServiceImpl) AssignReviewer(ctx context.Context, pullRequestID,
 		return models.PRReviewer{}, ErrReviewerAlreadyAssigned
 	}
 
-	// Назначение ревьюера
-	reviewer, err := s.reviewerRepo.Add(ctx, pullRequestID, userID)
-	if err != nil {
+	if err := checkManualReviewer(ctx, txRepo, pr, userID, override, "assign"); err != nil {
 		return models.PRReviewer{}, err
 	}
 
-	return reviewer, nil
-}
-
-// AssignReviewers назначает несколько ревьюеров на Pull Request
-func (s *ReviewerServiceImpl) AssignReviewers(ctx context.Context, pullRequestID string, userIDs []string) ([]models.PRReviewer, error) {
-	var reviewers []models.PRReviewer
-
-	// Выполнение в транзакции для атомарности
-	err := s.store.ExecTx(ctx, func(txRepo *repository.PostgresRepository) error {
-		for _, userID := range userIDs {
-			// Используем репозиторий из транзакции
-			reviewerService := &ReviewerServiceImpl{
-				reviewerRepo: txRepo,
-				userRepo:     txRepo,
-				prRepo:       txRepo,
-				statsRepo:    txRepo,
-				store:        s.store,
-			}
-
-			reviewer, err := reviewerService.AssignReviewer(ctx, pullRequestID, userID)
-			if err != nil {
-				return err
-			}
-			reviewers = append(reviewers, reviewer)
-		}
-		return nil
-	})
-
-	if err != nil {
-		return nil, err
-	}
-
-	return reviewers, nil
+	// Назначение ревьюера
+	return txRepo.Add(ctx, pullRequestID, userID, models.Assignment{Source: models.AssignmentSourceManual})
 }
 
 // RemoveReviewer удаляет ревьюера с Pull Request
@@ -140,9 +169,15 @@ func (s *ReviewerServiceImpl) RemoveReviewer(ctx context.Context, pullRequestID,
 }
 
 // ReplaceReviewer заменяет одного ревьюера другим
-func (s *ReviewerServiceImpl) ReplaceReviewer(ctx context.Context, pullRequestID, oldUserID, newUserID string) error {
+func (s *ReviewerServiceImpl) ReplaceReviewer(ctx context.Context, pullRequestID, oldUserID, newUserID string, override Override) error {
+	// Замена с ревьюером, выбранным по политике, учитывается отдельно от ручной
+	reason := metrics.ReasonManual
+	if newUserID == "" {
+		reason = metrics.ReasonAuto
+	}
+
 	// Выполнение в транзакции для атомарности
-	return s.store.ExecTx(ctx, func(txRepo *repository.PostgresRepository) error {
+	err := s.store.ExecTx(ctx, func(txRepo *repository.PostgresRepository) error {
 		pr, err := txRepo.GetPullRequestByPRID(ctx, pullRequestID)
 		if err != nil {
 			if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
@@ -152,7 +187,7 @@ func (s *ReviewerServiceImpl) ReplaceReviewer(ctx context.Context, pullRequestID
 		}
 
 		if pr.Status != models.PullRequestStatusOpen {
-			return fmt.Errorf("cannot replace reviewer in non-open PR")
+			return fmt.Errorf("%w: cannot replace reviewer in non-open PR", ErrInvalidStatus)
 		}
 
 		isAssigned, err := txRepo.IsUserAssigned(ctx, pullRequestID, oldUserID)
@@ -163,23 +198,26 @@ func (s *ReviewerServiceImpl) ReplaceReviewer(ctx context.Context, pullRequestID
 			return ErrReviewerNotAssigned
 		}
 
+		// Явно указанный пользователь считается ручным назначением
+		manual := newUserID != ""
+		assignment := models.Assignment{Source: models.AssignmentSourceManual}
+
 		// Если новый пользователь не указан, выбираем автоматически
-		if newUserID == "" {
+		if !manual {
 			// Получаем старого пользователя для определения команды
-			oldUser, err := txRepo.GetByUserID(ctx, oldUserID)
+			oldUser, err := txRepo.GetWithTeam(ctx, oldUserID)
 			if err != nil {
 				return err
 			}
 
-			// Получаем нагрузку пользователей
-			workloads, err := txRepo.GetUserWorkload(ctx)
-			if err != nil {
+			if err := txRepo.LockTeamAssignment(ctx, oldUser.TeamID); err != nil {
 				return err
 			}
 
-			workloadMap := make(map[string]int64)
-			for _, w := range workloads {
-				workloadMap[w.UserID] = w.OpenReviewsCount
+			// Получаем нагрузку участников команды
+			workloadMap, err := txRepo.GetTeamWorkload(ctx, oldUser.TeamID)
+			if err != nil {
+				return err
 			}
 
 			// Получаем активных пользователей команды (исключая автора PR)
@@ -195,8 +233,12 @@ func (s *ReviewerServiceImpl) ReplaceReviewer(ctx context.Context, pullRequestID
 			}
 
 			currentReviewerMap := make(map[string]bool)
+			mentor := newMentorship(oldUser.TeamMentorshipEnabled)
 			for _, r := range currentReviewers {
 				currentReviewerMap[r.UserID] = true
+				if r.UserID != oldUserID {
+					mentor.keep(r.Seniority)
+				}
 			}
 
 			// Фильтруем доступных кандидатов
@@ -208,15 +250,37 @@ func (s *ReviewerServiceImpl) ReplaceReviewer(ctx context.Context, pullRequestID
 			}
 
 			if len(candidates) == 0 {
+				s.metrics.NoActiveReviewers(oldUser.TeamName)
+				logger.FromContext(ctx).Warn("No active reviewers to replace with",
+					zap.String("pr_id", pullRequestID),
+					zap.String("team", oldUser.TeamName),
+				)
 				return ErrNoActiveReviewers
 			}
 
-			// Выбираем пользователя с минимальной нагрузкой
-			selectedUsers := selectUsersWithMinWorkload(candidates, workloadMap, 1)
+			skills, err := loadPRSkillMatch(ctx, txRepo, pullRequestID, oldUser.TeamID)
+			if err != nil {
+				return err
+			}
+			ruleSet, err := loadRuleSet(ctx, txRepo, pr.AuthorID)
+			if err != nil {
+				return err
+			}
+			unavailable, err := loadUnavailable(ctx, txRepo, oldUser.TeamID)
+			if err != nil {
+				return err
+			}
+
+			// Выбираем пользователя по политике назначения
+			p := s.policies.FromContext(ctx).Policy
+			rules := selectionRules{skills: skills, unavailable: unavailable, mentorship: mentor, rules: ruleSet}
+			selectedUsers, ties := selectReviewers(p, candidates, workloadMap, rules, 1)
 			if len(selectedUsers) == 0 {
+				s.metrics.NoActiveReviewers(oldUser.TeamName)
 				return ErrNoActiveReviewers
 			}
 			newUserID = selectedUsers[0].UserID
+			assignment = selectedAssignment(models.AssignmentSourceAuto, p, workloadMap, newUserID, ties[newUserID])
 		}
 
 		newUser, err := txRepo.GetByUserID(ctx, newUserID)
@@ -243,9 +307,29 @@ func (s *ReviewerServiceImpl) ReplaceReviewer(ctx context.Context, pullRequestID
 			return ErrReviewerAlreadyAssigned
 		}
 
+		if manual {
+			if err := checkManualReviewer(ctx, txRepo, pr, newUserID, override, "replace"); err != nil {
+				return err
+			}
+		}
+
 		// Замена ревьюера
-		return txRepo.Replace(ctx, pullRequestID, oldUserID, newUserID)
+		return txRepo.Replace(ctx, pullRequestID, oldUserID, newUserID, assignment)
 	})
+	if err != nil {
+		return err
+	}
+
+	s.metrics.ReviewerReassigned(reason, 1)
+
+	logger.FromContext(ctx).Info("Reviewer replaced",
+		zap.String("pr_id", pullRequestID),
+		zap.String("old_reviewer", oldUserID),
+		zap.String("new_reviewer", newUserID),
+		zap.String("reason", reason),
+	)
+
+	return nil
 }
 
 // GetPRReviewers возвращает список ревьюеров для Pull Request
@@ -284,8 +368,14 @@ func (s *ReviewerServiceImpl) GetUserPRs(ctx context.Context, userID string) ([]
 	return prs, nil
 }
 
-// AutoAssignReviewers автоматически назначает ревьюеров на Pull Request
+// AutoAssignReviewers автоматически назначает ревьюеров на Pull Request.
+// При count <= 0 назначается количество ревьюеров по умолчанию из настроек.
 func (s *ReviewerServiceImpl) AutoAssignReviewers(ctx context.Context, pullRequestID string, count int) ([]models.PRReviewer, error) {
+	p := s.policies.FromContext(ctx).Policy
+	if count <= 0 {
+		count = p.DefaultReviewerCount
+	}
+
 	pr, err := s.prRepo.GetPullRequestByPRID(ctx, pullRequestID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
@@ -295,7 +385,7 @@ func (s *ReviewerServiceImpl) AutoAssignReviewers(ctx context.Context, pullReque
 	}
 
 	// Получение автора PR для определения его команды
-	author, err := s.userRepo.GetByUserID(ctx, pr.AuthorID)
+	author, err := s.userRepo.GetWithTeam(ctx, pr.AuthorID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
 			return nil, ErrUserNotFound
@@ -303,53 +393,59 @@ func (s *ReviewerServiceImpl) AutoAssignReviewers(ctx context.Context, pullReque
 		return nil, err
 	}
 
-	// Получение нагрузки всех пользователей для балансировки
-	workloads, err := s.statsRepo.GetUserWorkload(ctx)
-	if err != nil {
+	var reviewers []models.PRReviewer
+	err = s.store.ExecTx(ctx, func(txRepo *repository.PostgresRepository) error {
+		requiredTags, err := txRepo.GetRequiredTags(ctx, pullRequestID)
+		if err != nil {
+			return err
+		}
+		result, err := assignReviewersTx(ctx, txRepo, p, assignmentRequest{
+			pullRequestID: pullRequestID,
+			authorID:      pr.AuthorID,
+			teamID:        author.TeamID,
+			mentorship:    author.TeamMentorshipEnabled,
+			count:         count,
+			requiredTags:  requiredTags,
+		})
+		reviewers = result.selected
+		return err
+	})
+	if errors.Is(err, ErrNoActiveReviewers) {
+		s.metrics.NoActiveReviewers(author.TeamName)
+		logger.FromContext(ctx).Warn("No active reviewers in team",
+			zap.String("pr_id", pullRequestID),
+			zap.String("team", author.TeamName),
+		)
 		return nil, err
 	}
-
-	workloadMap := make(map[string]int64)
-	for _, w := range workloads {
-		workloadMap[w.UserID] = w.OpenReviewsCount
-	}
-
-	// Получение активных пользователей команды (исключая автора)
-	activeUsers, err := s.userRepo.ListActiveByTeamIDExcludingUser(ctx, author.TeamID, pr.AuthorID)
 	if err != nil {
 		return nil, err
 	}
 
-	if len(activeUsers) == 0 {
-		return nil, ErrNoActiveReviewers
-	}
-
-	// Выбор пользователей с минимальной нагрузкой
-	selectedUsers := selectUsersWithMinWorkload(activeUsers, workloadMap, count)
+	s.metrics.ReviewersAssigned(metrics.SourceAuto, len(reviewers))
 
-	// Назначение выбранных ревьюеров
-	var reviewers []models.PRReviewer
-	err = s.store.ExecTx(ctx, func(txRepo *repository.PostgresRepository) error {
-		for _, user := range selectedUsers {
-			reviewer, err := txRepo.Add(ctx, pullRequestID, user.UserID)
-			if err != nil {
-				return err
-			}
-			reviewers = append(reviewers, reviewer)
-		}
-		return nil
-	})
-
-	if err != nil {
-		return nil, err
+	reviewerIDs := make([]string, 0, len(reviewers))
+	for _, r := range reviewers {
+		reviewerIDs = append(reviewerIDs, r.UserID)
 	}
+	logger.FromContext(ctx).Info("Reviewers assigned",
+		zap.String("pr_id", pullRequestID),
+		zap.Strings("reviewers", reviewerIDs),
+		zap.String("source", metrics.SourceAuto),
+	)
 
 	return reviewers, nil
 }
 
 // ReassignFromInactiveReviewers переназначает ревьюеров с неактивных на активных
 func (s *ReviewerServiceImpl) ReassignFromInactiveReviewers(ctx context.Context) error {
-	return s.store.ExecTx(ctx, func(txRepo *repository.PostgresRepository) error {
+	p := s.policies.FromContext(ctx).Policy
+
+	// Переназначения логируются только после фиксации транзакции
+	var replaced []reviewerReplacement
+	var removed []reviewerReplacement
+
+	err := s.store.ExecTx(ctx, func(txRepo *repository.PostgresRepository) error {
 		// Получение PR с неактивными ревьюерами
 		inactiveInfos, err := txRepo.GetOpenPRsWithInactiveReviewers(ctx)
 		if err != nil {
@@ -362,19 +458,20 @@ func (s *ReviewerServiceImpl) ReassignFromInactiveReviewers(ctx context.Context)
 
 		// Группировка по PR
 		prToInactive := make(map[string][]models.InactiveReviewerInfo)
+		teamIDs := make([]int64, 0, len(inactiveInfos))
 		for _, info := range inactiveInfos {
 			prToInactive[info.PullRequestID] = append(prToInactive[info.PullRequestID], info)
+			teamIDs = append(teamIDs, info.TeamID)
 		}
 
-		// Получение нагрузки пользователей
-		workloads, err := txRepo.GetUserWorkload(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to get user workload: %w", err)
-		}
-
-		workloadMap := make(map[string]int64)
-		for _, w := range workloads {
-			workloadMap[w.UserID] = w.OpenReviewsCount
+		// Добор не должен пересекаться с параллельными назначениями в командах.
+		// Блокировки берутся в порядке id, чтобы конкурентные проходы не
+		// взаимоблокировались.
+		slices.Sort(teamIDs)
+		for _, teamID := range slices.Compact(teamIDs) {
+			if err := txRepo.LockTeamAssignment(ctx, teamID); err != nil {
+				return fmt.Errorf("failed to lock team %d: %w", teamID, err)
+			}
 		}
 
 		// Обработка каждого PR
@@ -389,6 +486,10 @@ func (s *ReviewerServiceImpl) ReassignFromInactiveReviewers(ctx context.Context)
 			}
 
 			if len(activeUsers) == 0 {
+				if !p.FallbackRemoveInactive {
+					continue // Политика оставляет неактивных ревьюеров до появления замены
+				}
+
 				// Просто удаляем неактивных ревьюеров
 				inactiveUserIDs := make([]string, 0, len(inactives))
 				for _, info := range inactives {
@@ -397,69 +498,207 @@ func (s *ReviewerServiceImpl) ReassignFromInactiveReviewers(ctx context.Context)
 				if err := txRepo.RemoveInactiveReviewers(ctx, prID, inactiveUserIDs); err != nil {
 					return fmt.Errorf("failed to remove inactive reviewers: %w", err)
 				}
+				for _, id := range inactiveUserIDs {
+					removed = append(removed, reviewerReplacement{prID: prID, oldUserID: id})
+				}
 				continue
 			}
 
+			// Нагрузка читается для каждого PR: замены в предыдущих PR уже
+			// учтены триггерами в рамках транзакции
+			workloadMap, err := txRepo.GetTeamWorkload(ctx, teamID)
+			if err != nil {
+				return fmt.Errorf("failed to get workload of team %d: %w", teamID, err)
+			}
+
+			// Автор и уже назначенные ревьюеры не могут стать заменой
+			currentReviewers, err := txRepo.GetReviewersByPRID(ctx, prID)
+			if err != nil {
+				return fmt.Errorf("failed to get reviewers of PR %s: %w", prID, err)
+			}
+			skills, err := loadPRSkillMatch(ctx, txRepo, prID, teamID)
+			if err != nil {
+				return err
+			}
+			team, err := txRepo.GetTeamByID(ctx, teamID)
+			if err != nil {
+				return fmt.Errorf("failed to get team %d: %w", teamID, err)
+			}
+			ruleSet, err := loadRuleSet(ctx, txRepo, inactives[0].AuthorID)
+			if err != nil {
+				return err
+			}
+			unavailable, err := loadUnavailable(ctx, txRepo, teamID)
+			if err != nil {
+				return err
+			}
+			filter := selectionRules{
+				authorID:    inactives[0].AuthorID,
+				assigned:    make(map[string]bool, len(currentReviewers)),
+				unavailable: unavailable,
+				skills:      skills,
+				mentorship:  newMentorship(team.MentorshipEnabled),
+				rules:       ruleSet,
+			}
+			for _, r := range currentReviewers {
+				filter.assigned[r.UserID] = true
+				// Уровни неактивных ревьюеров не учитываются: их заменят
+				if r.IsActive {
+					filter.mentorship.keep(r.Seniority)
+				}
+			}
+
 			// Замена каждого неактивного ревьюера на активного
 			for _, inactive := range inactives {
-				// Выбор пользователя с минимальной нагрузкой
-				newReviewer := selectUserWithMinWorkload(activeUsers, workloadMap, prID)
-				if newReviewer != nil {
+				// Выбор пользователя по политике назначения
+				if selected, ties := selectReviewers(p, activeUsers, workloadMap, filter, 1); len(selected) > 0 {
+					newReviewer := selected[0]
 					// Замена ревьюера
-					if err := txRepo.Replace(ctx, prID, inactive.InactiveReviewerID, newReviewer.UserID); err != nil {
+					assignment := selectedAssignment(models.AssignmentSourceInactiveReassignment, p, workloadMap, newReviewer.UserID, ties[newReviewer.UserID])
+					if err := txRepo.Replace(ctx, prID, inactive.InactiveReviewerID, newReviewer.UserID, assignment); err != nil {
 						return fmt.Errorf("failed to replace reviewer: %w", err)
 					}
 					workloadMap[newReviewer.UserID]++
-				} else {
+					filter.assigned[newReviewer.UserID] = true
+					filter.mentorship.keep(newReviewer.Seniority)
+					replaced = append(replaced, reviewerReplacement{
+						prID:      prID,
+						oldUserID: inactive.InactiveReviewerID,
+						newUserID: newReviewer.UserID,
+					})
+				} else if p.FallbackRemoveInactive {
 					// Если нет подходящих ревьюеров, просто удаляем неактивного
 					if err := txRepo.Remove(ctx, prID, inactive.InactiveReviewerID); err != nil {
 						return fmt.Errorf("failed to remove inactive reviewer: %w", err)
 					}
+					removed = append(removed, reviewerReplacement{prID: prID, oldUserID: inactive.InactiveReviewerID})
 				}
 			}
 		}
 
 		return nil
 	})
+	if err != nil {
+		return err
+	}
+
+	s.metrics.ReviewerReassigned(metrics.ReasonInactiveReviewer, len(replaced))
+	log := logger.FromContext(ctx)
+	for _, r := range replaced {
+		log.Info("Reviewer replaced",
+			zap.String("pr_id", r.prID),
+			zap.String("old_reviewer", r.oldUserID),
+			zap.String("new_reviewer", r.newUserID),
+			zap.String("reason", metrics.ReasonInactiveReviewer),
+		)
+	}
+	for _, r := range removed {
+		log.Warn("Inactive reviewer removed without replacement",
+			zap.String("pr_id", r.prID),
+			zap.String("reviewer", r.oldUserID),
+		)
+	}
+
+	return nil
 }
 
-// selectUsersWithMinWorkload выбирает N пользователей с минимальной нагрузкой
-func selectUsersWithMinWorkload(users []models.User, workloadMap map[string]int64, count int) []models.User {
-	if count > len(users) {
-		count = len(users)
+// reviewerReplacement описывает замену ревьюера на PR
+type reviewerReplacement struct {
+	prID      string
+	oldUserID string
+	newUserID string
+}
+
+// PreviewReviewers выполняет тот же выбор, что и AutoAssignReviewers, но без записи в БД
+func (s *ReviewerServiceImpl) PreviewReviewers(ctx context.Context, req PreviewRequest) (Preview, error) {
+	if req.Count < 0 {
+		return Preview{}, fmt.Errorf("%w: reviewer count must not be negative", ErrInvalidReviewerOptions)
+	}
+	requiredTags, err := normalizeTags(req.RequiredTags)
+	if err != nil {
+		return Preview{}, err
 	}
 
-	// Копируем пользователей для сортировки
-	sortedUsers := make([]models.User, len(users))
-	copy(sortedUsers, users)
+	snap := s.policies.FromContext(ctx)
+	count := req.Count
+	if count == 0 {
+		count = snap.DefaultReviewerCount
+	}
 
-	// Простая сортировка по нагрузке (bubble sort для простоты)
-	for i := 0; i < len(sortedUsers)-1; i++ {
-		for j := 0; j < len(sortedUsers)-i-1; j++ {
-			workloadJ := workloadMap[sortedUsers[j].UserID]
-			workloadJNext := workloadMap[sortedUsers[j+1].UserID]
-			if workloadJ > workloadJNext {
-				sortedUsers[j], sortedUsers[j+1] = sortedUsers[j+1], sortedUsers[j]
-			}
+	author, err := s.userRepo.GetWithTeam(ctx, req.AuthorID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return Preview{}, ErrUserNotFound
 		}
+		return Preview{}, err
 	}
 
-	return sortedUsers[:count]
-}
+	filter := selectionRules{
+		authorID:   req.AuthorID,
+		assigned:   make(map[string]bool),
+		excluded:   make(map[string]bool, len(req.Excluded)),
+		mentorship: newMentorship(author.TeamMentorshipEnabled),
+	}
+	for _, id := range req.Excluded {
+		filter.excluded[id] = true
+	}
 
-// selectUserWithMinWorkload выбирает одного пользователя с минимальной нагрузкой
-// который еще не назначен на данный PR
-func selectUserWithMinWorkload(users []models.User, workloadMap map[string]int64, excludePRID string) *models.User {
-	var minUser *models.User
-	minWorkload := int64(-1)
-
-	for i := range users {
-		workload := workloadMap[users[i].UserID]
-		if minWorkload == -1 || workload < minWorkload {
-			minWorkload = workload
-			minUser = &users[i]
+	if req.PullRequestID != "" {
+		if _, err := s.prRepo.GetPullRequestByPRID(ctx, req.PullRequestID); err != nil {
+			if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+				return Preview{}, ErrPullRequestNotFound
+			}
+			return Preview{}, err
+		}
+		reviewers, err := s.reviewerRepo.GetReviewersByPRID(ctx, req.PullRequestID)
+		if err != nil {
+			return Preview{}, err
+		}
+		for _, r := range reviewers {
+			filter.assigned[r.UserID] = true
+			filter.mentorship.keep(r.Seniority)
+		}
+		if len(requiredTags) == 0 {
+			if requiredTags, err = s.skillRepo.GetRequiredTags(ctx, req.PullRequestID); err != nil {
+				return Preview{}, err
+			}
 		}
 	}
 
-	return minUser
+	filter.skills, err = loadSkillMatch(ctx, s.skillRepo, author.TeamID, requiredTags)
+	if err != nil {
+		return Preview{}, err
+	}
+	filter.rules, err = loadRuleSet(ctx, s.ruleRepo, req.AuthorID)
+	if err != nil {
+		return Preview{}, err
+	}
+	filter.unavailable, err = loadUnavailable(ctx, s.unavailabilityRepo, author.TeamID)
+	if err != nil {
+		return Preview{}, err
+	}
+
+	// В предпросмотр попадают все участники команды, чтобы показать причины исключения
+	users, err := s.userRepo.ListByTeamID(ctx, author.TeamID)
+	if err != nil {
+		return Preview{}, err
+	}
+
+	workloadMap, err := s.workloadRepo.GetTeamWorkload(ctx, author.TeamID)
+	if err != nil {
+		return Preview{}, err
+	}
+
+	eval := evaluateCandidates(snap.Policy, users, workloadMap, filter)
+
+	return Preview{
+		Author:        author,
+		Strategy:      snap.Strategy,
+		PolicyVersion: snap.Version,
+		Count:         count,
+		RequiredTags:  requiredTags,
+		Mentorship:    filter.mentorship.enabled,
+		Selected:      eval.selectPaired(count-len(filter.assigned), filter.mentorship),
+		Evaluation:    eval,
+	}, nil
 }
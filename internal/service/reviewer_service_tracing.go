@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/tracing"
+)
+
+// Атрибуты спанов сервисного слоя
+const (
+	attrPullRequestID = attribute.Key("pr.id")
+	attrUserID        = attribute.Key("user.id")
+	attrOldUserID     = attribute.Key("reviewer.old_user_id")
+	attrNewUserID     = attribute.Key("reviewer.new_user_id")
+	attrCount         = attribute.Key("reviewer.count")
+	attrResultCount   = attribute.Key("reviewer.result_count")
+	attrOverride      = attribute.Key("reviewer.override")
+)
+
+// tracedReviewerService оборачивает каждый метод ReviewerService в спан
+type tracedReviewerService struct {
+	next   ReviewerService
+	tracer trace.Tracer
+}
+
+// NewTracedReviewerService добавляет трассировку к ReviewerService
+func NewTracedReviewerService(next ReviewerService) ReviewerService {
+	return &tracedReviewerService{
+		next:   next,
+		tracer: tracing.Tracer(),
+	}
+}
+
+func (s *tracedReviewerService) start(ctx context.Context, method string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return s.tracer.Start(ctx, "ReviewerService."+method, trace.WithAttributes(attrs...))
+}
+
+// AssignReviewer реализует ReviewerService
+func (s *tracedReviewerService) AssignReviewer(ctx context.Context, pullRequestID, userID string, override Override) (models.PRReviewer, error) {
+	ctx, span := s.start(ctx, "AssignReviewer",
+		attrPullRequestID.String(pullRequestID),
+		attrUserID.String(userID),
+		attrOverride.Bool(override.Enabled),
+	)
+	reviewer, err := s.next.AssignReviewer(ctx, pullRequestID, userID, override)
+	tracing.End(span, err)
+	return reviewer, err
+}
+
+// AssignReviewers реализует ReviewerService
+func (s *tracedReviewerService) AssignReviewers(ctx context.Context, pullRequestID string, userIDs []string, override Override) ([]models.PRReviewer, error) {
+	ctx, span := s.start(ctx, "AssignReviewers",
+		attrPullRequestID.String(pullRequestID),
+		attrCount.Int(len(userIDs)),
+		attrOverride.Bool(override.Enabled),
+	)
+	reviewers, err := s.next.AssignReviewers(ctx, pullRequestID, userIDs, override)
+	span.SetAttributes(attrResultCount.Int(len(reviewers)))
+	tracing.End(span, err)
+	return reviewers, err
+}
+
+// RemoveReviewer реализует ReviewerService
+func (s *tracedReviewerService) RemoveReviewer(ctx context.Context, pullRequestID, userID string) error {
+	ctx, span := s.start(ctx, "RemoveReviewer", attrPullRequestID.String(pullRequestID), attrUserID.String(userID))
+	err := s.next.RemoveReviewer(ctx, pullRequestID, userID)
+	tracing.End(span, err)
+	return err
+}
+
+// ReplaceReviewer реализует ReviewerService
+func (s *tracedReviewerService) ReplaceReviewer(ctx context.Context, pullRequestID, oldUserID, newUserID string, override Override) error {
+	ctx, span := s.start(ctx, "ReplaceReviewer",
+		attrPullRequestID.String(pullRequestID),
+		attrOldUserID.String(oldUserID),
+		attrNewUserID.String(newUserID),
+		attrOverride.Bool(override.Enabled),
+	)
+	err := s.next.ReplaceReviewer(ctx, pullRequestID, oldUserID, newUserID, override)
+	tracing.End(span, err)
+	return err
+}
+
+// GetPRReviewers реализует ReviewerService
+func (s *tracedReviewerService) GetPRReviewers(ctx context.Context, pullRequestID string) ([]models.ReviewerInfo, error) {
+	ctx, span := s.start(ctx, "GetPRReviewers", attrPullRequestID.String(pullRequestID))
+	reviewers, err := s.next.GetPRReviewers(ctx, pullRequestID)
+	span.SetAttributes(attrResultCount.Int(len(reviewers)))
+	tracing.End(span, err)
+	return reviewers, err
+}
+
+// GetUserPRs реализует ReviewerService
+func (s *tracedReviewerService) GetUserPRs(ctx context.Context, userID string) ([]models.PullRequestShort, error) {
+	ctx, span := s.start(ctx, "GetUserPRs", attrUserID.String(userID))
+	prs, err := s.next.GetUserPRs(ctx, userID)
+	span.SetAttributes(attrResultCount.Int(len(prs)))
+	tracing.End(span, err)
+	return prs, err
+}
+
+// AutoAssignReviewers реализует ReviewerService
+func (s *tracedReviewerService) AutoAssignReviewers(ctx context.Context, pullRequestID string, count int) ([]models.PRReviewer, error) {
+	ctx, span := s.start(ctx, "AutoAssignReviewers", attrPullRequestID.String(pullRequestID), attrCount.Int(count))
+	reviewers, err := s.next.AutoAssignReviewers(ctx, pullRequestID, count)
+	span.SetAttributes(attrResultCount.Int(len(reviewers)))
+	tracing.End(span, err)
+	return reviewers, err
+}
+
+// ReassignFromInactiveReviewers реализует ReviewerService
+func (s *tracedReviewerService) ReassignFromInactiveReviewers(ctx context.Context) error {
+	ctx, span := s.start(ctx, "ReassignFromInactiveReviewers")
+	err := s.next.ReassignFromInactiveReviewers(ctx)
+	tracing.End(span, err)
+	return err
+}
+
+// PreviewReviewers реализует ReviewerService
+func (s *tracedReviewerService) PreviewReviewers(ctx context.Context, req PreviewRequest) (Preview, error) {
+	ctx, span := s.start(ctx, "PreviewReviewers", attrUserID.String(req.AuthorID), attrCount.Int(req.Count))
+	preview, err := s.next.PreviewReviewers(ctx, req)
+	span.SetAttributes(attrResultCount.Int(len(preview.Selected)))
+	tracing.End(span, err)
+	return preview, err
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/repository"
+)
+
+// RuleServiceImpl реализует RuleService
+type RuleServiceImpl struct {
+	ruleRepo repository.ReviewerRuleRepository
+	store    *repository.Store
+}
+
+// NewRuleService создает новый RuleService
+func NewRuleService(ruleRepo repository.ReviewerRuleRepository, store *repository.Store) RuleService {
+	return &RuleServiceImpl{
+		ruleRepo: ruleRepo,
+		store:    store,
+	}
+}
+
+// ListRules возвращает правила, где пользователь является ревьюером или
+// автором. При пустом userID возвращаются все правила.
+func (s *RuleServiceImpl) ListRules(ctx context.Context, userID string) ([]models.ReviewerRule, error) {
+	return s.ruleRepo.ListReviewerRules(ctx, userID)
+}
+
+// CreateRule создает правило назначения. Правило задается либо для автора
+// (AuthorID), либо для команды (TeamName); prefer - только для автора.
+func (s *RuleServiceImpl) CreateRule(ctx context.Context, rule models.ReviewerRule) (models.ReviewerRule, error) {
+	if err := validateRule(rule); err != nil {
+		return models.ReviewerRule{}, err
+	}
+	rule.CreatedBy = actorFromContext(ctx)
+
+	var created models.ReviewerRule
+	err := s.store.ExecTx(ctx, func(txRepo *repository.PostgresRepository) error {
+		for _, userID := range []*string{&rule.ReviewerID, rule.AuthorID} {
+			if userID == nil {
+				continue
+			}
+			exists, err := txRepo.UserExists(ctx, *userID)
+			if err != nil {
+				return err
+			}
+			if !exists {
+				return ErrUserNotFound
+			}
+		}
+
+		if rule.TeamName != nil {
+			team, err := txRepo.GetByName(ctx, *rule.TeamName)
+			if err != nil {
+				if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+					return ErrTeamNotFound
+				}
+				return err
+			}
+			rule.TeamID = &team.ID
+		}
+
+		var err error
+		created, err = txRepo.CreateReviewerRule(ctx, rule)
+		if err != nil {
+			var pgErr *pgconn.PgError
+			if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation {
+				return ErrRuleAlreadyExists
+			}
+			return err
+		}
+
+		return recordRuleChange(ctx, txRepo, models.AuditRuleCreated, created)
+	})
+	if err != nil {
+		return models.ReviewerRule{}, err
+	}
+
+	return created, nil
+}
+
+// DeleteRule удаляет правило и возвращает его
+func (s *RuleServiceImpl) DeleteRule(ctx context.Context, id int64) (models.ReviewerRule, error) {
+	var deleted models.ReviewerRule
+	err := s.store.ExecTx(ctx, func(txRepo *repository.PostgresRepository) error {
+		var err error
+		deleted, err = txRepo.DeleteReviewerRule(ctx, id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+				return ErrRuleNotFound
+			}
+			return err
+		}
+
+		if deleted.TeamID != nil {
+			team, err := txRepo.GetTeamByID(ctx, *deleted.TeamID)
+			if err != nil {
+				return err
+			}
+			deleted.TeamName = &team.TeamName
+		}
+
+		return recordRuleChange(ctx, txRepo, models.AuditRuleDeleted, deleted)
+	})
+	if err != nil {
+		return models.ReviewerRule{}, err
+	}
+
+	return deleted, nil
+}
+
+// validateRule проверяет согласованность правила до обращения к БД
+func validateRule(rule models.ReviewerRule) error {
+	switch {
+	case !rule.Effect.IsValid():
+		return fmt.Errorf("%w: unknown effect %q", ErrInvalidRule, rule.Effect)
+	case rule.ReviewerID == "":
+		return fmt.Errorf("%w: reviewer_id is required", ErrInvalidRule)
+	case (rule.AuthorID == nil) == (rule.TeamName == nil):
+		return fmt.Errorf("%w: exactly one of author_id and team_name is required", ErrInvalidRule)
+	case rule.Effect == models.ReviewerRulePrefer && rule.AuthorID == nil:
+		return fmt.Errorf("%w: prefer rules are set per author", ErrInvalidRule)
+	case rule.AuthorID != nil && *rule.AuthorID == rule.ReviewerID:
+		return fmt.Errorf("%w: %v", ErrInvalidRule, ErrCannotAssignAuthor)
+	}
+	return nil
+}
+
+// recordRuleChange записывает создание или удаление правила в журнал аудита
+func recordRuleChange(ctx context.Context, txRepo *repository.PostgresRepository, action models.AuditAction, rule models.ReviewerRule) error {
+	details := map[string]any{
+		"rule_id": rule.ID,
+		"effect":  rule.Effect,
+		"reason":  rule.Reason,
+	}
+	if rule.AuthorID != nil {
+		details["author_id"] = *rule.AuthorID
+	}
+	if rule.TeamName != nil {
+		details["team_name"] = *rule.TeamName
+	}
+
+	_, err := txRepo.AddAuditEntry(ctx, models.AuditEntry{
+		Action:  action,
+		Actor:   actorFromContext(ctx),
+		UserID:  &rule.ReviewerID,
+		Details: details,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to record rule change: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+)
+
+func TestValidateRule(t *testing.T) {
+	author, team := "u1", "backend"
+
+	tests := []struct {
+		name    string
+		rule    models.ReviewerRule
+		wantErr bool
+	}{
+		{
+			name: "запрет для автора",
+			rule: models.ReviewerRule{Effect: models.ReviewerRuleBlock, ReviewerID: "u2", AuthorID: &author},
+		},
+		{
+			name: "запрет для команды",
+			rule: models.ReviewerRule{Effect: models.ReviewerRuleBlock, ReviewerID: "u2", TeamName: &team},
+		},
+		{
+			name: "предпочтение для автора",
+			rule: models.ReviewerRule{Effect: models.ReviewerRulePrefer, ReviewerID: "u2", AuthorID: &author},
+		},
+		{
+			name:    "предпочтение для команды",
+			rule:    models.ReviewerRule{Effect: models.ReviewerRulePrefer, ReviewerID: "u2", TeamName: &team},
+			wantErr: true,
+		},
+		{
+			name:    "неизвестное действие",
+			rule:    models.ReviewerRule{Effect: "allow", ReviewerID: "u2", AuthorID: &author},
+			wantErr: true,
+		},
+		{
+			name:    "не указаны ни автор, ни команда",
+			rule:    models.ReviewerRule{Effect: models.ReviewerRuleBlock, ReviewerID: "u2"},
+			wantErr: true,
+		},
+		{
+			name:    "указаны и автор, и команда",
+			rule:    models.ReviewerRule{Effect: models.ReviewerRuleBlock, ReviewerID: "u2", AuthorID: &author, TeamName: &team},
+			wantErr: true,
+		},
+		{
+			name:    "ревьюер совпадает с автором",
+			rule:    models.ReviewerRule{Effect: models.ReviewerRuleBlock, ReviewerID: "u1", AuthorID: &author},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRule(tt.rule)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidRule)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package service
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
 )
@@ -21,6 +22,19 @@ var (
 	ErrCannotAssignAuthor       = errors.New("cannot assign PR author as reviewer")
 	ErrNoActiveReviewers        = errors.New("no active reviewers available")
 	ErrInvalidStatus            = errors.New("invalid pull request status")
+	ErrInvalidReviewerOptions   = errors.New("invalid reviewer options")
+	ErrInvalidSkill             = errors.New("invalid skill")
+	ErrInvalidSeniority         = errors.New("invalid seniority")
+	ErrReviewerBlocked          = errors.New("reviewer is blocked by a rule")
+	ErrInvalidRule              = errors.New("invalid reviewer rule")
+	ErrRuleNotFound             = errors.New("reviewer rule not found")
+	ErrRuleAlreadyExists        = errors.New("reviewer rule already exists")
+	ErrInvalidTimeWindow        = errors.New("invalid time window")
+	ErrInvalidFairnessTolerance = errors.New("invalid fairness tolerance")
+	ErrInvalidUnavailability    = errors.New("invalid unavailability period")
+	ErrUnavailabilityNotFound   = errors.New("unavailability period not found")
+	ErrIdempotencyKeyReused     = errors.New("idempotency key reused with a different request")
+	ErrIdempotencyInProgress    = errors.New("request with this idempotency key is in progress")
 )
 
 // TeamService управляет операциями с командами
@@ -36,6 +50,9 @@ type TeamService interface {
 
 	// ListTeams возвращает список всех команд
 	ListTeams(ctx context.Context) ([]models.Team, error)
+
+	// SetMentorship включает или выключает наставничество в команде
+	SetMentorship(ctx context.Context, teamName string, enabled bool) (models.Team, error)
 }
 
 // UserService управляет операциями с пользователями
@@ -58,6 +75,9 @@ type UserService interface {
 	// ActivateUser активирует пользователя
 	ActivateUser(ctx context.Context, userID string) (models.User, error)
 
+	// SetSeniority задает уровень пользователя для наставничества
+	SetSeniority(ctx context.Context, userID string, seniority models.Seniority) (models.User, error)
+
 	// ListTeamUsers возвращает всех пользователей команды
 	ListTeamUsers(ctx context.Context, teamID int64) ([]models.User, error)
 
@@ -67,6 +87,22 @@ type UserService interface {
 	// DeactivateTeamUsers деактивирует всех пользователей команды и перераспределяет их PR
 	// Возвращает количество деактивированных пользователей и количество переназначенных PR
 	DeactivateTeamUsers(ctx context.Context, teamID int64) (deactivatedUsers int, reassignedPRs int, err error)
+
+	// AddUnavailability добавляет период недоступности пользователя.
+	// Период должен быть непустым и еще не закончиться.
+	AddUnavailability(ctx context.Context, period models.Unavailability) (models.Unavailability, error)
+
+	// DeleteUnavailability удаляет период недоступности
+	DeleteUnavailability(ctx context.Context, id int64) (models.Unavailability, error)
+}
+
+// DashboardService собирает персональную сводку ревьюера
+type DashboardService interface {
+	// GetDashboard возвращает открытые PR, ожидающие ревью пользователя,
+	// старые первыми, его открытые PR с ревьюерами, нагрузку относительно
+	// лимита политики, текущие и будущие периоды недоступности и статистику
+	// ревью за DashboardStatsWindow
+	GetDashboard(ctx context.Context, userID string) (models.Dashboard, error)
 }
 
 // PullRequestService управляет операциями с Pull Request'ами
@@ -74,6 +110,11 @@ type PullRequestService interface {
 	// CreatePR создает новый Pull Request
 	CreatePR(ctx context.Context, pullRequestID, pullRequestName, authorID string) (models.PullRequest, error)
 
+	// CreateWithReviewers в одной транзакции создает Pull Request и назначает
+	// ревьюеров из команды автора. Отсутствие активных ревьюеров не считается
+	// ошибкой: PR создается без ревьюеров.
+	CreateWithReviewers(ctx context.Context, pullRequestID, pullRequestName, authorID string, opts ReviewerOptions) (models.PullRequest, []models.PRReviewer, error)
+
 	// GetPR возвращает Pull Request по ID
 	GetPR(ctx context.Context, pullRequestID string) (models.PullRequest, error)
 
@@ -92,17 +133,20 @@ type PullRequestService interface {
 
 // ReviewerService управляет назначением ревьюеров
 type ReviewerService interface {
-	// AssignReviewer назначает ревьюера на Pull Request
-	AssignReviewer(ctx context.Context, pullRequestID, userID string) (models.PRReviewer, error)
+	// AssignReviewer вручную назначает ревьюера на Pull Request. Ревьюер,
+	// запрещенный правилом, назначается только с переопределением.
+	AssignReviewer(ctx context.Context, pullRequestID, userID string, override Override) (models.PRReviewer, error)
 
-	// AssignReviewers назначает несколько ревьюеров на Pull Request
-	AssignReviewers(ctx context.Context, pullRequestID string, userIDs []string) ([]models.PRReviewer, error)
+	// AssignReviewers вручную назначает несколько ревьюеров на Pull Request
+	AssignReviewers(ctx context.Context, pullRequestID string, userIDs []string, override Override) ([]models.PRReviewer, error)
 
 	// RemoveReviewer удаляет ревьюера с Pull Request
 	RemoveReviewer(ctx context.Context, pullRequestID, userID string) error
 
-	// ReplaceReviewer заменяет одного ревьюера другим
-	ReplaceReviewer(ctx context.Context, pullRequestID, oldUserID, newUserID string) error
+	// ReplaceReviewer заменяет одного ревьюера другим. При пустом newUserID
+	// замена выбирается автоматически, override применяется только к явно
+	// указанному ревьюеру.
+	ReplaceReviewer(ctx context.Context, pullRequestID, oldUserID, newUserID string, override Override) error
 
 	// GetPRReviewers возвращает список ревьюеров для Pull Request
 	GetPRReviewers(ctx context.Context, pullRequestID string) ([]models.ReviewerInfo, error)
@@ -111,12 +155,51 @@ type ReviewerService interface {
 	GetUserPRs(ctx context.Context, userID string) ([]models.PullRequestShort, error)
 
 	// AutoAssignReviewers автоматически назначает ревьюеров на Pull Request
-	// на основе алгоритма балансировки нагрузки
+	// по стратегии из настроек. При count <= 0 назначается количество по умолчанию.
 	AutoAssignReviewers(ctx context.Context, pullRequestID string, count int) ([]models.PRReviewer, error)
 
 	// ReassignFromInactiveReviewers находит все PR с неактивными ревьюерами
 	// и переназначает их на активных пользователей
 	ReassignFromInactiveReviewers(ctx context.Context) error
+
+	// PreviewReviewers выполняет выбор ревьюеров для гипотетического PR без
+	// записи в БД и возвращает оценку всех участников команды автора
+	PreviewReviewers(ctx context.Context, req PreviewRequest) (Preview, error)
+}
+
+// SkillService управляет навыками ревьюеров и тегами PR
+type SkillService interface {
+	// GetUserSkills возвращает навыки пользователя
+	GetUserSkills(ctx context.Context, userID string) ([]models.UserSkill, error)
+
+	// SetUserSkills заменяет навыки пользователя
+	SetUserSkills(ctx context.Context, userID string, skills []models.UserSkill) ([]models.UserSkill, error)
+
+	// GetRequiredTags возвращает навыки, нужные для ревью PR
+	GetRequiredTags(ctx context.Context, pullRequestID string) ([]string, error)
+
+	// SetRequiredTags заменяет навыки, нужные для ревью PR
+	SetRequiredTags(ctx context.Context, pullRequestID string, tags []string) ([]string, error)
+}
+
+// RuleService управляет постоянными правилами назначения ревьюеров
+type RuleService interface {
+	// ListRules возвращает правила, где пользователь является ревьюером или
+	// автором. При пустом userID возвращаются все правила.
+	ListRules(ctx context.Context, userID string) ([]models.ReviewerRule, error)
+
+	// CreateRule создает правило назначения
+	CreateRule(ctx context.Context, rule models.ReviewerRule) (models.ReviewerRule, error)
+
+	// DeleteRule удаляет правило назначения
+	DeleteRule(ctx context.Context, id int64) (models.ReviewerRule, error)
+}
+
+// AuditService предоставляет журнал аудита
+type AuditService interface {
+	// ListEntries возвращает последние записи журнала, новые первыми. При
+	// пустом pullRequestID возвращаются записи по всем PR.
+	ListEntries(ctx context.Context, pullRequestID string, limit int) ([]models.AuditEntry, error)
 }
 
 // StatisticsService предоставляет статистику
@@ -124,12 +207,82 @@ type StatisticsService interface {
 	// GetAssignmentStats возвращает статистику по назначениям ревьюеров
 	GetAssignmentStats(ctx context.Context) ([]models.AssignmentStats, error)
 
-	// GetPRStats возвращает статистику по Pull Request'ам
-	GetPRStats(ctx context.Context) ([]models.PRStats, error)
+	// GetPRStats возвращает статистику по Pull Request'ам, созданным в окне
+	GetPRStats(ctx context.Context, window models.TimeWindow) ([]models.PRStats, error)
 
-	// GetTeamStats возвращает статистику по командам
-	GetTeamStats(ctx context.Context) ([]models.TeamStats, error)
+	// GetTeamStats возвращает статистику по командам за окно
+	GetTeamStats(ctx context.Context, window models.TimeWindow) ([]models.TeamStats, error)
 
 	// GetUserWorkload возвращает нагрузку пользователей
 	GetUserWorkload(ctx context.Context) ([]models.UserWorkload, error)
+
+	// GetFairness возвращает неравенство назначений внутри команд за окно по
+	// всей истории PR. При пустом teamName возвращаются все команды.
+	// tolerance - допустимое относительное отклонение от среднего по команде.
+	GetFairness(ctx context.Context, teamName string, window models.TimeWindow, tolerance float64) ([]models.TeamFairness, error)
+
+	// ForEachAssignmentStats, ForEachPRStats, ForEachTeamStats и
+	// ForEachUserWorkload передают строки статистики в fn по мере чтения,
+	// не собирая их в память. Ошибка fn прерывает чтение и возвращается.
+	ForEachAssignmentStats(ctx context.Context, fn func(models.AssignmentStats) error) error
+	ForEachPRStats(ctx context.Context, window models.TimeWindow, fn func(models.PRStats) error) error
+	ForEachTeamStats(ctx context.Context, window models.TimeWindow, fn func(models.TeamStats) error) error
+	ForEachUserWorkload(ctx context.Context, fn func(models.UserWorkload) error) error
+}
+
+// WorkloadService поддерживает учтенную нагрузку ревьюеров. Нагрузка
+// обновляется триггерами БД при изменении назначений и статуса PR, сервис
+// только сверяет ее с фактическими назначениями.
+type WorkloadService interface {
+	// Reconcile исправляет расхождения учтенной нагрузки с фактической и
+	// возвращает исправленные записи
+	Reconcile(ctx context.Context) ([]models.WorkloadDrift, error)
+}
+
+// EventService рассылает события назначения из журнала событий. События
+// пишутся триггерами БД, сервис читает их по уведомлениям LISTEN/NOTIFY.
+type EventService interface {
+	// Run читает новые события и рассылает их подписчикам до отмены ctx
+	Run(ctx context.Context)
+
+	// Subscribe подписывает на новые события, подходящие под filter.
+	// Сохраненные события читаются через Replay подписки, подписку нужно
+	// закрыть через Close.
+	Subscribe(filter models.EventFilter) *EventSubscription
+
+	// Prune удаляет события старше before и возвращает их количество
+	Prune(ctx context.Context, before time.Time) (int64, error)
+}
+
+// IdempotencyService сохраняет ответы на запросы с ключом идемпотентности,
+// чтобы повтор запроса клиентом не выполнял операцию второй раз
+type IdempotencyService interface {
+	// Begin занимает ключ клиента для запроса с хешем requestHash. Если запрос
+	// с этим ключом уже выполнен, возвращает его ответ. Для другого запроса
+	// возвращает ErrIdempotencyKeyReused, для незавершенного - ErrIdempotencyInProgress.
+	Begin(ctx context.Context, client, key, requestHash string) (*models.IdempotentResponse, error)
+
+	// Complete сохраняет ответ на запрос с занятым ключом
+	Complete(ctx context.Context, client, key string, resp models.IdempotentResponse) error
+
+	// Release освобождает ключ без ответа, повтор запроса выполнит его заново
+	Release(ctx context.Context, client, key string) error
+
+	// Prune удаляет ключи старше before и возвращает их количество
+	Prune(ctx context.Context, before time.Time) (int64, error)
+}
+
+// AnalyticsService предоставляет временные ряды аналитики ревью. Окно
+// задается полуинтервалом [From, To), интервалы выравниваются по началу
+// дня, недели (понедельник) или месяца в UTC.
+type AnalyticsService interface {
+	// GetReviewTimes возвращает перцентили времени до первого назначения
+	// и до слияния для PR, созданных в каждом интервале
+	GetReviewTimes(ctx context.Context, bucket models.AnalyticsBucket, window models.TimeWindow) ([]models.ReviewTimeBucket, error)
+
+	// GetReviewerThroughput возвращает назначения каждого ревьюера по интервалам
+	GetReviewerThroughput(ctx context.Context, bucket models.AnalyticsBucket, window models.TimeWindow) ([]models.ReviewerThroughput, error)
+
+	// GetTeamThroughput возвращает открытые и слитые PR каждой команды по интервалам
+	GetTeamThroughput(ctx context.Context, bucket models.AnalyticsBucket, window models.TimeWindow) ([]models.TeamThroughput, error)
 }
@@ -1,6 +1,12 @@
 package service
 
-import "github.com/AtoyanMikhail/PRAssignmentService/internal/repository"
+import (
+	"time"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/metrics"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/policy"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/repository"
+)
 
 // Services содержит все сервисы приложения
 type Services struct {
@@ -8,16 +14,33 @@ type Services struct {
 	User        UserService
 	PullRequest PullRequestService
 	Reviewer    ReviewerService
+	Skill       SkillService
+	Rule        RuleService
+	Audit       AuditService
 	Statistics  StatisticsService
+	Analytics   AnalyticsService
+	Workload    WorkloadService
+	Dashboard   DashboardService
+	Events      EventService
+	Idempotency IdempotencyService
 }
 
-// NewServices создает новый экземпляр Services
-func NewServices(store *repository.Store) *Services {
+// NewServices создает новый экземпляр Services. idempotencyLease - время,
+// после которого незавершенный запрос с ключом идемпотентности можно повторить.
+func NewServices(store *repository.Store, m *metrics.Metrics, policies *policy.Store, idempotencyLease time.Duration) *Services {
 	return &Services{
 		Team:        NewTeamService(store),
-		User:        NewUserService(store, store, store, store),
-		PullRequest: NewPullRequestService(store),
-		Reviewer:    NewReviewerService(store, store, store, store, store),
-		Statistics:  NewStatisticsService(store),
+		User:        NewUserService(store, store, store, store, store, m, policies),
+		PullRequest: NewPullRequestService(store, store, m, policies),
+		Reviewer:    NewTracedReviewerService(NewReviewerService(store, store, store, store, store, store, store, store, m, policies)),
+		Skill:       NewSkillService(store, store, store, store),
+		Rule:        NewRuleService(store, store),
+		Audit:       NewAuditService(store),
+		Statistics:  NewStatisticsService(store, store),
+		Analytics:   NewAnalyticsService(store),
+		Workload:    NewWorkloadService(store, m),
+		Dashboard:   NewDashboardService(store, store, store, store, policies),
+		Events:      NewEventService(store, m),
+		Idempotency: NewIdempotencyService(store, idempotencyLease),
 	}
 }
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/repository"
+)
+
+// tagPattern - допустимый тег навыка: go, sql, c++, frontend/react
+var tagPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9._+#/-]{0,63}$`)
+
+// normalizeTags приводит теги к нижнему регистру, убирает повторы и сортирует
+func normalizeTags(tags []string) ([]string, error) {
+	normalized := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if !tagPattern.MatchString(tag) {
+			return nil, fmt.Errorf("%w: invalid tag %q", ErrInvalidSkill, tag)
+		}
+		normalized = append(normalized, tag)
+	}
+	slices.Sort(normalized)
+	return slices.Compact(normalized), nil
+}
+
+// normalizeSkills проверяет уровни навыков и нормализует теги. Один тег
+// может встречаться только один раз.
+func normalizeSkills(userID string, skills []models.UserSkill) ([]models.UserSkill, error) {
+	normalized := make([]models.UserSkill, 0, len(skills))
+	seen := make(map[string]bool, len(skills))
+	for _, skill := range skills {
+		tags, err := normalizeTags([]string{skill.Tag})
+		if err != nil {
+			return nil, err
+		}
+		if !skill.Level.IsValid() {
+			return nil, fmt.Errorf("%w: invalid level %q for tag %s", ErrInvalidSkill, skill.Level, tags[0])
+		}
+		if seen[tags[0]] {
+			return nil, fmt.Errorf("%w: tag %s is listed twice", ErrInvalidSkill, tags[0])
+		}
+		seen[tags[0]] = true
+		normalized = append(normalized, models.UserSkill{UserID: userID, Tag: tags[0], Level: skill.Level})
+	}
+	slices.SortFunc(normalized, func(a, b models.UserSkill) int { return strings.Compare(a.Tag, b.Tag) })
+	return normalized, nil
+}
+
+// SkillServiceImpl реализует SkillService
+type SkillServiceImpl struct {
+	userRepo  repository.UserRepository
+	prRepo    repository.PullRequestRepository
+	skillRepo repository.SkillRepository
+	store     *repository.Store
+}
+
+// NewSkillService создает новый SkillService
+func NewSkillService(
+	userRepo repository.UserRepository,
+	prRepo repository.PullRequestRepository,
+	skillRepo repository.SkillRepository,
+	store *repository.Store,
+) SkillService {
+	return &SkillServiceImpl{
+		userRepo:  userRepo,
+		prRepo:    prRepo,
+		skillRepo: skillRepo,
+		store:     store,
+	}
+}
+
+// GetUserSkills возвращает навыки пользователя
+func (s *SkillServiceImpl) GetUserSkills(ctx context.Context, userID string) ([]models.UserSkill, error) {
+	exists, err := s.userRepo.UserExists(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, ErrUserNotFound
+	}
+
+	return s.skillRepo.GetUserSkills(ctx, userID)
+}
+
+// SetUserSkills заменяет навыки пользователя
+func (s *SkillServiceImpl) SetUserSkills(ctx context.Context, userID string, skills []models.UserSkill) ([]models.UserSkill, error) {
+	normalized, err := normalizeSkills(userID, skills)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.store.ExecTx(ctx, func(txRepo *repository.PostgresRepository) error {
+		exists, err := txRepo.UserExists(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ErrUserNotFound
+		}
+		return txRepo.SetUserSkills(ctx, userID, normalized)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return normalized, nil
+}
+
+// GetRequiredTags возвращает навыки, нужные для ревью PR
+func (s *SkillServiceImpl) GetRequiredTags(ctx context.Context, pullRequestID string) ([]string, error) {
+	if err := s.checkPRExists(ctx, s.prRepo, pullRequestID); err != nil {
+		return nil, err
+	}
+	return s.skillRepo.GetRequiredTags(ctx, pullRequestID)
+}
+
+// SetRequiredTags заменяет навыки, нужные для ревью PR. Уже назначенные
+// ревьюеры не меняются, теги учитываются при следующих назначениях.
+func (s *SkillServiceImpl) SetRequiredTags(ctx context.Context, pullRequestID string, tags []string) ([]string, error) {
+	normalized, err := normalizeTags(tags)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.store.ExecTx(ctx, func(txRepo *repository.PostgresRepository) error {
+		if err := s.checkPRExists(ctx, txRepo, pullRequestID); err != nil {
+			return err
+		}
+		return txRepo.SetRequiredTags(ctx, pullRequestID, normalized)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return normalized, nil
+}
+
+// checkPRExists возвращает ErrPullRequestNotFound для неизвестного PR
+func (s *SkillServiceImpl) checkPRExists(ctx context.Context, prRepo repository.PullRequestRepository, pullRequestID string) error {
+	_, err := prRepo.GetPullRequestByPRID(ctx, pullRequestID)
+	if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+		return ErrPullRequestNotFound
+	}
+	return err
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormalizeSkills(t *testing.T) {
+	skills, err := normalizeSkills("u1", []models.UserSkill{
+		{Tag: " SQL ", Level: models.SkillLevelIntermediate},
+		{Tag: "go", Level: models.SkillLevelExpert},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []models.UserSkill{
+		{UserID: "u1", Tag: "go", Level: models.SkillLevelExpert},
+		{UserID: "u1", Tag: "sql", Level: models.SkillLevelIntermediate},
+	}, skills)
+
+	_, err = normalizeSkills("u1", []models.UserSkill{{Tag: "go", Level: "guru"}})
+	assert.ErrorIs(t, err, ErrInvalidSkill)
+
+	_, err = normalizeSkills("u1", []models.UserSkill{
+		{Tag: "go", Level: models.SkillLevelNovice},
+		{Tag: "Go", Level: models.SkillLevelExpert},
+	})
+	assert.ErrorIs(t, err, ErrInvalidSkill)
+
+	tags, err := normalizeTags([]string{"sql", "Go", "go", "frontend/react"})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"frontend/react", "go", "sql"}, tags)
+
+	_, err = normalizeTags([]string{""})
+	assert.ErrorIs(t, err, ErrInvalidSkill)
+}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
 	"github.com/AtoyanMikhail/PRAssignmentService/internal/repository"
@@ -9,13 +11,15 @@ import (
 
 // StatisticsServiceImpl реализует StatisticsService
 type StatisticsServiceImpl struct {
-	repo repository.StatisticsRepository
+	repo     repository.StatisticsRepository
+	teamRepo repository.TeamRepository
 }
 
 // NewStatisticsService создает новый StatisticsService
-func NewStatisticsService(repo repository.StatisticsRepository) StatisticsService {
+func NewStatisticsService(repo repository.StatisticsRepository, teamRepo repository.TeamRepository) StatisticsService {
 	return &StatisticsServiceImpl{
-		repo: repo,
+		repo:     repo,
+		teamRepo: teamRepo,
 	}
 }
 
@@ -24,17 +28,84 @@ func (s *StatisticsServiceImpl) GetAssignmentStats(ctx context.Context) ([]model
 	return s.repo.GetAssignmentStats(ctx)
 }
 
-// GetPRStats возвращает статистику по Pull Request'ам
-func (s *StatisticsServiceImpl) GetPRStats(ctx context.Context) ([]models.PRStats, error) {
-	return s.repo.GetPRStats(ctx)
+// GetPRStats возвращает статистику по Pull Request'ам, созданным в окне
+func (s *StatisticsServiceImpl) GetPRStats(ctx context.Context, window models.TimeWindow) ([]models.PRStats, error) {
+	if err := validateWindow(window); err != nil {
+		return nil, err
+	}
+	return s.repo.GetPRStats(ctx, window)
 }
 
-// GetTeamStats возвращает статистику по командам
-func (s *StatisticsServiceImpl) GetTeamStats(ctx context.Context) ([]models.TeamStats, error) {
-	return s.repo.GetTeamStats(ctx)
+// GetTeamStats возвращает статистику по командам за окно
+func (s *StatisticsServiceImpl) GetTeamStats(ctx context.Context, window models.TimeWindow) ([]models.TeamStats, error) {
+	if err := validateWindow(window); err != nil {
+		return nil, err
+	}
+	return s.repo.GetTeamStats(ctx, window)
 }
 
 // GetUserWorkload возвращает нагрузку пользователей
 func (s *StatisticsServiceImpl) GetUserWorkload(ctx context.Context) ([]models.UserWorkload, error) {
 	return s.repo.GetUserWorkload(ctx)
 }
+
+// GetFairness возвращает неравенство назначений по командам за окно
+func (s *StatisticsServiceImpl) GetFairness(ctx context.Context, teamName string, window models.TimeWindow, tolerance float64) ([]models.TeamFairness, error) {
+	if err := validateWindow(window); err != nil {
+		return nil, err
+	}
+	if tolerance < 0 || tolerance > 1 {
+		return nil, fmt.Errorf("%w: tolerance %v is outside [0, 1]", ErrInvalidFairnessTolerance, tolerance)
+	}
+
+	if teamName != "" {
+		exists, err := s.teamRepo.Exists(ctx, teamName)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			return nil, ErrTeamNotFound
+		}
+	}
+
+	counts, err := s.repo.GetReviewerAssignmentCounts(ctx, teamName, window)
+	if err != nil {
+		return nil, err
+	}
+	return buildTeamFairness(counts, tolerance), nil
+}
+
+// ForEachAssignmentStats передает статистику назначений в fn построчно
+func (s *StatisticsServiceImpl) ForEachAssignmentStats(ctx context.Context, fn func(models.AssignmentStats) error) error {
+	return s.repo.ForEachAssignmentStats(ctx, fn)
+}
+
+// ForEachPRStats передает статистику PR, созданных в окне, в fn построчно
+func (s *StatisticsServiceImpl) ForEachPRStats(ctx context.Context, window models.TimeWindow, fn func(models.PRStats) error) error {
+	if err := validateWindow(window); err != nil {
+		return err
+	}
+	return s.repo.ForEachPRStats(ctx, window, fn)
+}
+
+// ForEachTeamStats передает статистику команд за окно в fn построчно
+func (s *StatisticsServiceImpl) ForEachTeamStats(ctx context.Context, window models.TimeWindow, fn func(models.TeamStats) error) error {
+	if err := validateWindow(window); err != nil {
+		return err
+	}
+	return s.repo.ForEachTeamStats(ctx, window, fn)
+}
+
+// ForEachUserWorkload передает нагрузку пользователей в fn построчно
+func (s *StatisticsServiceImpl) ForEachUserWorkload(ctx context.Context, fn func(models.UserWorkload) error) error {
+	return s.repo.ForEachUserWorkload(ctx, fn)
+}
+
+// validateWindow проверяет, что начало окна раньше его конца
+func validateWindow(window models.TimeWindow) error {
+	if !window.From.IsZero() && !window.To.IsZero() && !window.From.Before(window.To) {
+		return fmt.Errorf("%w: from %s is not before to %s", ErrInvalidTimeWindow,
+			window.From.Format(time.RFC3339), window.To.Format(time.RFC3339))
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+)
+
+func TestValidateWindow(t *testing.T) {
+	day := time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		window  models.TimeWindow
+		wantErr bool
+	}{
+		{name: "без границ"},
+		{name: "только начало", window: models.TimeWindow{From: day}},
+		{name: "только конец", window: models.TimeWindow{To: day}},
+		{name: "начало раньше конца", window: models.TimeWindow{From: day, To: day.Add(time.Hour)}},
+		{name: "пустое окно", window: models.TimeWindow{From: day, To: day}, wantErr: true},
+		{name: "конец раньше начала", window: models.TimeWindow{From: day.Add(time.Hour), To: day}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateWindow(tt.window)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidTimeWindow)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
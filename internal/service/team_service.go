@@ -72,3 +72,15 @@ func (s *TeamServiceImpl) ListTeams(ctx context.Context) ([]models.Team, error)
 	}
 	return teams, nil
 }
+
+// SetMentorship включает или выключает наставничество в команде
+func (s *TeamServiceImpl) SetMentorship(ctx context.Context, teamName string, enabled bool) (models.Team, error) {
+	team, err := s.repo.SetMentorship(ctx, teamName, enabled)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return models.Team{}, ErrTeamNotFound
+		}
+		return models.Team{}, err
+	}
+	return team, nil
+}
@@ -42,6 +42,16 @@ func (m *MockTeamRepository) Exists(ctx context.Context, teamName string) (bool,
 	return args.Bool(0), args.Error(1)
 }
 
+func (m *MockTeamRepository) SetMentorship(ctx context.Context, teamName string, enabled bool) (models.Team, error) {
+	args := m.Called(ctx, teamName, enabled)
+	return args.Get(0).(models.Team), args.Error(1)
+}
+
+func (m *MockTeamRepository) LockTeamAssignment(ctx context.Context, teamID int64) error {
+	args := m.Called(ctx, teamID)
+	return args.Error(0)
+}
+
 func TestTeamService_CreateTeam(t *testing.T) {
 	ctx := context.Background()
 
@@ -5,10 +5,19 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/logger"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/metrics"
 	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/policy"
 	"github.com/AtoyanMikhail/PRAssignmentService/internal/repository"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/tracing"
 	"github.com/jackc/pgx/v5"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
 )
 
 // UserServiceImpl реализует UserService
@@ -16,7 +25,10 @@ type UserServiceImpl struct {
 	userRepo     repository.UserRepository
 	teamRepo     repository.TeamRepository
 	reviewerRepo repository.PRReviewerRepository
+	unavailRepo  repository.UnavailabilityRepository
 	store        *repository.Store
+	metrics      *metrics.Metrics
+	policies     *policy.Store
 }
 
 // NewUserService создает новый UserService
@@ -24,13 +36,19 @@ func NewUserService(
 	userRepo repository.UserRepository,
 	teamRepo repository.TeamRepository,
 	reviewerRepo repository.PRReviewerRepository,
+	unavailRepo repository.UnavailabilityRepository,
 	store *repository.Store,
+	m *metrics.Metrics,
+	policies *policy.Store,
 ) UserService {
 	return &UserServiceImpl{
 		userRepo:     userRepo,
 		teamRepo:     teamRepo,
 		reviewerRepo: reviewerRepo,
+		unavailRepo:  unavailRepo,
 		store:        store,
+		metrics:      m,
+		policies:     policies,
 	}
 }
 
@@ -139,6 +157,56 @@ func (s *UserServiceImpl) ActivateUser(ctx context.Context, userID string) (mode
 	return user, nil
 }
 
+// SetSeniority задает уровень пользователя. Уже назначенные ревьюеры
+// не меняются, уровень учитывается при следующих назначениях.
+func (s *UserServiceImpl) SetSeniority(ctx context.Context, userID string, seniority models.Seniority) (models.User, error) {
+	if !seniority.IsValid() {
+		return models.User{}, fmt.Errorf("%w: %q", ErrInvalidSeniority, seniority)
+	}
+
+	user, err := s.userRepo.UpdateSeniority(ctx, userID, seniority)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return models.User{}, ErrUserNotFound
+		}
+		return models.User{}, err
+	}
+
+	return user, nil
+}
+
+// AddUnavailability добавляет период недоступности пользователя
+func (s *UserServiceImpl) AddUnavailability(ctx context.Context, period models.Unavailability) (models.Unavailability, error) {
+	if period.StartsAt.IsZero() || !period.StartsAt.Before(period.EndsAt) {
+		return models.Unavailability{}, fmt.Errorf("%w: starts_at must be before ends_at", ErrInvalidUnavailability)
+	}
+	if !period.EndsAt.After(time.Now()) {
+		return models.Unavailability{}, fmt.Errorf("%w: period has already ended", ErrInvalidUnavailability)
+	}
+
+	exists, err := s.userRepo.UserExists(ctx, period.UserID)
+	if err != nil {
+		return models.Unavailability{}, err
+	}
+	if !exists {
+		return models.Unavailability{}, ErrUserNotFound
+	}
+
+	return s.unavailRepo.AddUnavailability(ctx, period)
+}
+
+// DeleteUnavailability удаляет период недоступности
+func (s *UserServiceImpl) DeleteUnavailability(ctx context.Context, id int64) (models.Unavailability, error) {
+	period, err := s.unavailRepo.DeleteUnavailability(ctx, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
+			return models.Unavailability{}, ErrUnavailabilityNotFound
+		}
+		return models.Unavailability{}, err
+	}
+	return period, nil
+}
+
 // ListTeamUsers возвращает всех пользователей команды
 func (s *UserServiceImpl) ListTeamUsers(ctx context.Context, teamID int64) ([]models.User, error) {
 	_, err := s.teamRepo.GetTeamByID(ctx, teamID)
@@ -177,8 +245,18 @@ func (s *UserServiceImpl) ListActiveTeamUsers(ctx context.Context, teamID int64)
 
 // DeactivateTeamUsers деактивирует всех пользователей команды и перераспределяет их PR
 // Возвращает количество деактивированных пользователей и количество переназначенных PR
-func (s *UserServiceImpl) DeactivateTeamUsers(ctx context.Context, teamID int64) (int, int, error) {
-	_, err := s.teamRepo.GetTeamByID(ctx, teamID)
+func (s *UserServiceImpl) DeactivateTeamUsers(ctx context.Context, teamID int64) (deactivatedCount int, reassignedCount int, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "UserService.DeactivateTeamUsers",
+		trace.WithAttributes(attribute.Int64("team.id", teamID)))
+	defer func() {
+		span.SetAttributes(
+			attribute.Int("team.deactivated_users", deactivatedCount),
+			attribute.Int("team.reassigned_reviews", reassignedCount),
+		)
+		tracing.End(span, err)
+	}()
+
+	_, err = s.teamRepo.GetTeamByID(ctx, teamID)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, pgx.ErrNoRows) {
 			return 0, 0, ErrTeamNotFound
@@ -186,11 +264,13 @@ func (s *UserServiceImpl) DeactivateTeamUsers(ctx context.Context, teamID int64)
 		return 0, 0, err
 	}
 
-	var deactivatedCount int
-	var reassignedCount int
-
 	// Выполнение в транзакции для атомарности
 	err = s.store.ExecTx(ctx, func(txRepo *repository.PostgresRepository) error {
+		// Добор ревьюеров не должен пересекаться с параллельными назначениями в команде
+		if err := txRepo.LockTeamAssignment(ctx, teamID); err != nil {
+			return err
+		}
+
 		// 1. Деактивировать всех пользователей команды
 		deactivatedUsers, err := txRepo.DeactivateTeamUsers(ctx, teamID)
 		if err != nil {
@@ -211,8 +291,21 @@ func (s *UserServiceImpl) DeactivateTeamUsers(ctx context.Context, teamID int64)
 
 		// Группировка по PR для batch операций
 		prToInactiveUsers := make(map[string][]string)
+		authorTeamIDs := make([]int64, 0, len(inactiveReviewerInfos))
 		for _, info := range inactiveReviewerInfos {
 			prToInactiveUsers[info.PullRequestID] = append(prToInactiveUsers[info.PullRequestID], info.InactiveReviewerID)
+			if info.AuthorTeamID != teamID {
+				authorTeamIDs = append(authorTeamIDs, info.AuthorTeamID)
+			}
+		}
+
+		// Добор идет в командах авторов PR: их назначения тоже блокируются,
+		// в порядке id, как в ReassignFromInactiveReviewers
+		slices.Sort(authorTeamIDs)
+		for _, authorTeamID := range slices.Compact(authorTeamIDs) {
+			if err := txRepo.LockTeamAssignment(ctx, authorTeamID); err != nil {
+				return fmt.Errorf("failed to lock team %d: %w", authorTeamID, err)
+			}
 		}
 
 		// 3. Для каждого PR удалить неактивных ревьюеров
@@ -223,82 +316,13 @@ func (s *UserServiceImpl) DeactivateTeamUsers(ctx context.Context, teamID int64)
 			}
 		}
 
-		// 4. Получить нагрузку пользователей для балансировки
-		workloads, err := txRepo.GetUserWorkload(ctx)
-		if err != nil {
-			return fmt.Errorf("failed to get user workload: %w", err)
-		}
-
-		workloadMap := make(map[string]int64)
-		for _, w := range workloads {
-			workloadMap[w.UserID] = w.OpenReviewsCount
-		}
-
-		// 5. Для PR с недостаточным количеством ревьюеров назначить новых
+		// 4. Для PR с недостаточным количеством ревьюеров назначить новых
 		for prID := range prToInactiveUsers {
-			// Проверить текущее количество ревьюеров
-			currentCount, err := txRepo.Count(ctx, prID)
+			assigned, err := s.refillPRReviewers(ctx, txRepo, prID)
 			if err != nil {
-				return fmt.Errorf("failed to count reviewers for PR %s: %w", prID, err)
-			}
-
-			// Если ревьюеров меньше 2, назначить недостающих
-			if currentCount < 2 {
-				needed := 2 - int(currentCount)
-
-				// Получить информацию о PR для определения команды автора
-				pr, err := txRepo.GetPullRequestByPRID(ctx, prID)
-				if err != nil {
-					return fmt.Errorf("failed to get PR %s: %w", prID, err)
-				}
-
-				// Получить автора для определения его команды
-				author, err := txRepo.GetByUserID(ctx, pr.AuthorID)
-				if err != nil {
-					return fmt.Errorf("failed to get author %s: %w", pr.AuthorID, err)
-				}
-
-				// Получить активных пользователей команды (исключая автора)
-				activeUsers, err := txRepo.ListActiveByTeamIDExcludingUser(ctx, author.TeamID, pr.AuthorID)
-				if err != nil {
-					return fmt.Errorf("failed to get active users for team %d: %w", author.TeamID, err)
-				}
-
-				if len(activeUsers) == 0 {
-					continue // Нет доступных ревьюеров для этого PR
-				}
-
-				// Исключить уже назначенных ревьюеров
-				assignedReviewers, err := txRepo.GetReviewersByPRID(ctx, prID)
-				if err != nil {
-					return fmt.Errorf("failed to get assigned reviewers for PR %s: %w", prID, err)
-				}
-
-				assignedMap := make(map[string]bool)
-				for _, r := range assignedReviewers {
-					assignedMap[r.UserID] = true
-				}
-
-				// Фильтрация доступных пользователей
-				var availableUsers []models.User
-				for _, user := range activeUsers {
-					if !assignedMap[user.UserID] {
-						availableUsers = append(availableUsers, user)
-					}
-				}
-
-				// Выбор пользователей с минимальной нагрузкой
-				selectedUsers := selectUsersWithMinWorkload(availableUsers, workloadMap, needed)
-
-				// Назначение выбранных ревьюеров
-				for _, user := range selectedUsers {
-					_, err := txRepo.Add(ctx, prID, user.UserID)
-					if err != nil {
-						return fmt.Errorf("failed to assign reviewer %s to PR %s: %w", user.UserID, prID, err)
-					}
-					reassignedCount++ // Увеличиваем счетчик переназначенных PR
-				}
+				return err
 			}
+			reassignedCount += assigned
 		}
 
 		return nil
@@ -308,5 +332,123 @@ func (s *UserServiceImpl) DeactivateTeamUsers(ctx context.Context, teamID int64)
 		return 0, 0, err
 	}
 
+	s.metrics.ReviewerReassigned(metrics.ReasonTeamDeactivation, reassignedCount)
+
+	logger.FromContext(ctx).Info("Team users deactivated",
+		zap.Int64("team_id", teamID),
+		zap.Int("deactivated_users", deactivatedCount),
+		zap.Int("reassigned_reviews", reassignedCount),
+	)
+
 	return deactivatedCount, reassignedCount, nil
 }
+
+// refillPRReviewers добирает ревьюеров PR до количества по умолчанию из активных участников команды автора
+// Возвращает количество назначенных ревьюеров
+func (s *UserServiceImpl) refillPRReviewers(
+	ctx context.Context,
+	txRepo *repository.PostgresRepository,
+	prID string,
+) (assigned int, err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "UserService.refillPRReviewers",
+		trace.WithAttributes(attrPullRequestID.String(prID)))
+	defer func() {
+		span.SetAttributes(attrResultCount.Int(assigned))
+		tracing.End(span, err)
+	}()
+
+	// Проверить текущее количество ревьюеров
+	currentCount, err := txRepo.Count(ctx, prID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count reviewers for PR %s: %w", prID, err)
+	}
+
+	// Если ревьюеров меньше нужного, назначить недостающих
+	p := s.policies.FromContext(ctx).Policy
+	needed := p.DefaultReviewerCount - int(currentCount)
+	if needed <= 0 {
+		return 0, nil
+	}
+
+	// Получить информацию о PR для определения команды автора
+	pr, err := txRepo.GetPullRequestByPRID(ctx, prID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get PR %s: %w", prID, err)
+	}
+
+	// Получить автора для определения его команды
+	author, err := txRepo.GetWithTeam(ctx, pr.AuthorID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get author %s: %w", pr.AuthorID, err)
+	}
+
+	// Получить активных пользователей команды (исключая автора)
+	activeUsers, err := txRepo.ListActiveByTeamIDExcludingUser(ctx, author.TeamID, pr.AuthorID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get active users for team %d: %w", author.TeamID, err)
+	}
+
+	if len(activeUsers) == 0 {
+		logger.FromContext(ctx).Warn("No active reviewers to refill PR",
+			zap.String("pr_id", prID),
+			zap.Int64("team_id", author.TeamID),
+		)
+		return 0, nil // Нет доступных ревьюеров для этого PR
+	}
+
+	// Исключить уже назначенных ревьюеров
+	assignedReviewers, err := txRepo.GetReviewersByPRID(ctx, prID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get assigned reviewers for PR %s: %w", prID, err)
+	}
+
+	assignedMap := make(map[string]bool)
+	mentor := newMentorship(author.TeamMentorshipEnabled)
+	for _, r := range assignedReviewers {
+		assignedMap[r.UserID] = true
+		mentor.keep(r.Seniority)
+	}
+
+	// Фильтрация доступных пользователей
+	var availableUsers []models.User
+	for _, user := range activeUsers {
+		if !assignedMap[user.UserID] {
+			availableUsers = append(availableUsers, user)
+		}
+	}
+
+	// Нагрузка команды автора с учетом назначений, уже сделанных в транзакции
+	workloadMap, err := txRepo.GetTeamWorkload(ctx, author.TeamID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to get workload of team %d: %w", author.TeamID, err)
+	}
+
+	skills, err := loadPRSkillMatch(ctx, txRepo, prID, author.TeamID)
+	if err != nil {
+		return 0, err
+	}
+
+	ruleSet, err := loadRuleSet(ctx, txRepo, pr.AuthorID)
+	if err != nil {
+		return 0, err
+	}
+	unavailable, err := loadUnavailable(ctx, txRepo, author.TeamID)
+	if err != nil {
+		return 0, err
+	}
+
+	// Выбор пользователей по политике назначения
+	rules := selectionRules{skills: skills, unavailable: unavailable, mentorship: mentor, rules: ruleSet}
+	selectedUsers, ties := selectReviewers(p, availableUsers, workloadMap, rules, needed)
+
+	// Назначение выбранных ревьюеров
+	for _, user := range selectedUsers {
+		assignment := selectedAssignment(models.AssignmentSourceInactiveReassignment, p, workloadMap, user.UserID, ties[user.UserID])
+		if _, err := txRepo.Add(ctx, prID, user.UserID, assignment); err != nil {
+			return assigned, fmt.Errorf("failed to assign reviewer %s to PR %s: %w", user.UserID, prID, err)
+		}
+		assigned++
+	}
+
+	return assigned, nil
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/health"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/logger"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/metrics"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/models"
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/repository"
+)
+
+// WorkloadServiceImpl реализует WorkloadService
+type WorkloadServiceImpl struct {
+	store   *repository.Store
+	metrics *metrics.Metrics
+}
+
+// NewWorkloadService создает новый WorkloadService
+func NewWorkloadService(store *repository.Store, m *metrics.Metrics) WorkloadService {
+	return &WorkloadServiceImpl{
+		store:   store,
+		metrics: m,
+	}
+}
+
+// Reconcile пересчитывает нагрузку по назначениям и исправляет расхождения.
+// Таблица нагрузки блокируется на время сверки, поэтому назначения,
+// выполняемые параллельно, не создают ложных расхождений.
+func (s *WorkloadServiceImpl) Reconcile(ctx context.Context) ([]models.WorkloadDrift, error) {
+	var drift []models.WorkloadDrift
+	err := s.store.ExecTx(ctx, func(txRepo *repository.PostgresRepository) error {
+		if err := txRepo.LockReviewerWorkload(ctx); err != nil {
+			return err
+		}
+		var err error
+		drift, err = txRepo.ReconcileReviewerWorkload(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.metrics.WorkloadDrift(len(drift))
+	for _, d := range drift {
+		logger.FromContext(ctx).Warn("Reviewer workload drift fixed",
+			zap.String("user_id", d.UserID),
+			zap.Int64("recorded", d.Recorded),
+			zap.Int64("actual", d.Actual),
+		)
+	}
+
+	return drift, nil
+}
+
+// RunWorkloadReconcile сверяет нагрузку раз в interval до отмены ctx и
+// отмечает каждую сверку в hb
+func RunWorkloadReconcile(ctx context.Context, svc WorkloadService, interval time.Duration, hb *health.Heartbeat) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			_, err := svc.Reconcile(ctx)
+			if err != nil && ctx.Err() == nil {
+				logger.FromContext(ctx).Error("Reviewer workload reconcile failed", zap.Error(err))
+			}
+			hb.Beat(err)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	sqlcNamePrefix   = "-- name: "
+	spanNamePrefix   = "db."
+	unknownQueryName = "unnamed"
+	defaultQueryKind = "raw"
+)
+
+// Атрибуты спанов запросов к БД
+var (
+	attrDBSystem     = attribute.String("db.system.name", "postgresql")
+	keyOperationName = attribute.Key("db.operation.name")
+	keyQueryKind     = attribute.Key("db.query.kind")
+	keyReturnedRows  = attribute.Key("db.response.returned_rows")
+	keyRowsAffected  = attribute.Key("db.response.rows_affected")
+)
+
+// DBTX повторяет интерфейс db.DBTX, сгенерированный sqlc
+type DBTX interface {
+	Exec(context.Context, string, ...interface{}) (pgconn.CommandTag, error)
+	Query(context.Context, string, ...interface{}) (pgx.Rows, error)
+	QueryRow(context.Context, string, ...interface{}) pgx.Row
+}
+
+// tracedDBTX создает спан на каждый запрос sqlc
+type tracedDBTX struct {
+	next   DBTX
+	tracer trace.Tracer
+}
+
+// WrapDBTX оборачивает пул или транзакцию, создавая спаны для запросов.
+// Имя запроса берется из комментария "-- name: X :kind", который sqlc
+// добавляет в начало каждого запроса.
+func WrapDBTX(next DBTX) DBTX {
+	return &tracedDBTX{
+		next:   next,
+		tracer: Tracer(),
+	}
+}
+
+// Exec реализует DBTX
+func (t *tracedDBTX) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	ctx, span := t.start(ctx, sql)
+	tag, err := t.next.Exec(ctx, sql, args...)
+	if err == nil {
+		span.SetAttributes(keyRowsAffected.Int64(tag.RowsAffected()))
+	}
+	End(span, err)
+	return tag, err
+}
+
+// Query реализует DBTX. Спан завершается при закрытии rows.
+func (t *tracedDBTX) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	ctx, span := t.start(ctx, sql)
+	rows, err := t.next.Query(ctx, sql, args...)
+	if err != nil {
+		End(span, err)
+		return nil, err
+	}
+	return &tracedRows{Rows: rows, span: span}, nil
+}
+
+// QueryRow реализует DBTX. Спан завершается при вызове Scan.
+func (t *tracedDBTX) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	ctx, span := t.start(ctx, sql)
+	return &tracedRow{row: t.next.QueryRow(ctx, sql, args...), span: span}
+}
+
+func (t *tracedDBTX) start(ctx context.Context, sql string) (context.Context, trace.Span) {
+	name, kind := parseQueryName(sql)
+	return t.tracer.Start(ctx, spanNamePrefix+name,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attrDBSystem,
+			keyOperationName.String(name),
+			keyQueryKind.String(kind),
+		),
+	)
+}
+
+// tracedRows считает прочитанные строки и завершает спан при Close
+type tracedRows struct {
+	pgx.Rows
+	span   trace.Span
+	count  int64
+	closed bool
+}
+
+// Next реализует pgx.Rows
+func (r *tracedRows) Next() bool {
+	ok := r.Rows.Next()
+	if ok {
+		r.count++
+	}
+	return ok
+}
+
+// Close реализует pgx.Rows
+func (r *tracedRows) Close() {
+	r.Rows.Close()
+	if r.closed {
+		return
+	}
+	r.closed = true
+	r.span.SetAttributes(keyReturnedRows.Int64(r.count))
+	End(r.span, r.Rows.Err())
+}
+
+// tracedRow завершает спан при Scan
+type tracedRow struct {
+	row  pgx.Row
+	span trace.Span
+}
+
+// Scan реализует pgx.Row
+func (r *tracedRow) Scan(dest ...any) error {
+	err := r.row.Scan(dest...)
+	switch {
+	case err == nil:
+		r.span.SetAttributes(keyReturnedRows.Int64(1))
+		End(r.span, nil)
+	case errors.Is(err, pgx.ErrNoRows):
+		// Отсутствие строки - ожидаемый результат, а не ошибка запроса
+		r.span.SetAttributes(keyReturnedRows.Int64(0))
+		End(r.span, nil)
+	default:
+		End(r.span, err)
+	}
+	return err
+}
+
+// parseQueryName извлекает имя и тип запроса из комментария sqlc
+func parseQueryName(sql string) (string, string) {
+	if !strings.HasPrefix(sql, sqlcNamePrefix) {
+		return unknownQueryName, defaultQueryKind
+	}
+
+	line := sql[len(sqlcNamePrefix):]
+	if i := strings.IndexByte(line, '\n'); i >= 0 {
+		line = line[:i]
+	}
+
+	fields := strings.Fields(line)
+	switch len(fields) {
+	case 0:
+		return unknownQueryName, defaultQueryKind
+	case 1:
+		return fields[0], defaultQueryKind
+	default:
+		return fields[0], strings.TrimPrefix(fields[1], ":")
+	}
+}
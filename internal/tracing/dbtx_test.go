@@ -0,0 +1,182 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+// fakeDBTX отдает заранее заданные результаты без обращения к БД
+type fakeDBTX struct {
+	tag    pgconn.CommandTag
+	rows   int
+	rowErr error
+	err    error
+}
+
+func (f *fakeDBTX) Exec(context.Context, string, ...interface{}) (pgconn.CommandTag, error) {
+	return f.tag, f.err
+}
+
+func (f *fakeDBTX) Query(context.Context, string, ...interface{}) (pgx.Rows, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &fakeRows{left: f.rows}, nil
+}
+
+func (f *fakeDBTX) QueryRow(context.Context, string, ...interface{}) pgx.Row {
+	return fakeRow{err: f.rowErr}
+}
+
+type fakeRows struct {
+	pgx.Rows
+	left int
+}
+
+func (r *fakeRows) Next() bool {
+	if r.left == 0 {
+		return false
+	}
+	r.left--
+	return true
+}
+
+func (r *fakeRows) Close()     {}
+func (r *fakeRows) Err() error { return nil }
+
+type fakeRow struct {
+	err error
+}
+
+func (r fakeRow) Scan(...any) error { return r.err }
+
+// setupExporter подменяет глобальный провайдер и возвращает функцию,
+// отдающую завершенные спаны
+func setupExporter(t *testing.T) func() tracetest.SpanStubs {
+	t.Helper()
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := NewProvider(exporter, nil, 1)
+
+	prev := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	t.Cleanup(func() {
+		otel.SetTracerProvider(prev)
+		_ = provider.Shutdown(context.Background())
+	})
+
+	return func() tracetest.SpanStubs {
+		require.NoError(t, provider.ForceFlush(context.Background()))
+		return exporter.GetSpans()
+	}
+}
+
+func attrValue(attrs []attribute.KeyValue, key attribute.Key) (attribute.Value, bool) {
+	for _, a := range attrs {
+		if a.Key == key {
+			return a.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestParseQueryName(t *testing.T) {
+	tests := []struct {
+		name     string
+		sql      string
+		wantName string
+		wantKind string
+	}{
+		{"sqlc many", "-- name: ListTeams :many\nSELECT 1", "ListTeams", "many"},
+		{"sqlc one", "-- name: GetUser :one\nSELECT 1", "GetUser", "one"},
+		{"без типа", "-- name: Ping\nSELECT 1", "Ping", defaultQueryKind},
+		{"без комментария", "SELECT 1", unknownQueryName, defaultQueryKind},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			name, kind := parseQueryName(tt.sql)
+			assert.Equal(t, tt.wantName, name)
+			assert.Equal(t, tt.wantKind, kind)
+		})
+	}
+}
+
+func TestWrapDBTX_Query(t *testing.T) {
+	spansOf := setupExporter(t)
+	dbtx := WrapDBTX(&fakeDBTX{rows: 3})
+
+	rows, err := dbtx.Query(context.Background(), "-- name: ListTeams :many\nSELECT * FROM teams")
+	require.NoError(t, err)
+	for rows.Next() {
+	}
+	rows.Close()
+	rows.Close()
+
+	spans := spansOf()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "db.ListTeams", spans[0].Name)
+
+	op, ok := attrValue(spans[0].Attributes, keyOperationName)
+	require.True(t, ok)
+	assert.Equal(t, "ListTeams", op.AsString())
+
+	count, ok := attrValue(spans[0].Attributes, keyReturnedRows)
+	require.True(t, ok)
+	assert.Equal(t, int64(3), count.AsInt64())
+}
+
+func TestWrapDBTX_Exec(t *testing.T) {
+	spansOf := setupExporter(t)
+	dbtx := WrapDBTX(&fakeDBTX{tag: pgconn.NewCommandTag("UPDATE 5")})
+
+	_, err := dbtx.Exec(context.Background(), "-- name: DeactivateUsers :exec\nUPDATE users SET is_active = false")
+	require.NoError(t, err)
+
+	spans := spansOf()
+	require.Len(t, spans, 1)
+
+	affected, ok := attrValue(spans[0].Attributes, keyRowsAffected)
+	require.True(t, ok)
+	assert.Equal(t, int64(5), affected.AsInt64())
+}
+
+func TestWrapDBTX_QueryRow(t *testing.T) {
+	t.Run("строка не найдена не считается ошибкой", func(t *testing.T) {
+		spansOf := setupExporter(t)
+		dbtx := WrapDBTX(&fakeDBTX{rowErr: pgx.ErrNoRows})
+
+		err := dbtx.QueryRow(context.Background(), "-- name: GetUser :one\nSELECT 1").Scan()
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+
+		spans := spansOf()
+		require.Len(t, spans, 1)
+		assert.Equal(t, codes.Unset, spans[0].Status.Code)
+
+		count, ok := attrValue(spans[0].Attributes, keyReturnedRows)
+		require.True(t, ok)
+		assert.Equal(t, int64(0), count.AsInt64())
+	})
+
+	t.Run("ошибка запроса", func(t *testing.T) {
+		spansOf := setupExporter(t)
+		dbtx := WrapDBTX(&fakeDBTX{rowErr: errors.New("connection reset")})
+
+		err := dbtx.QueryRow(context.Background(), "-- name: GetUser :one\nSELECT 1").Scan()
+		assert.Error(t, err)
+
+		spans := spansOf()
+		require.Len(t, spans, 1)
+		assert.Equal(t, codes.Error, spans[0].Status.Code)
+	})
+}
@@ -0,0 +1,130 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/internal/config"
+)
+
+// instrumentationName - имя библиотеки инструментирования для всех спанов сервиса
+const instrumentationName = "github.com/AtoyanMikhail/PRAssignmentService"
+
+// ShutdownFunc сбрасывает накопленные спаны и останавливает экспортер
+type ShutdownFunc func(ctx context.Context) error
+
+// Init настраивает глобальный TracerProvider и W3C propagator.
+// Propagator настраивается всегда, чтобы trace context входящих запросов
+// передавался дальше даже при выключенном экспорте.
+func Init(ctx context.Context, cfg config.TracingConfig) (ShutdownFunc, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if !cfg.Enabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := newExporter(ctx, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(cfg.ServiceName),
+	))
+	if err != nil {
+		return nil, fmt.Errorf("failed to build tracing resource: %w", err)
+	}
+
+	provider := NewProvider(exporter, res, cfg.SampleRatio)
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// NewProvider создает TracerProvider с батчевым экспортом.
+// В тестах сюда передается tracetest.InMemoryExporter.
+func NewProvider(exporter sdktrace.SpanExporter, res *resource.Resource, sampleRatio float64) *sdktrace.TracerProvider {
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio))),
+	}
+	if res != nil {
+		opts = append(opts, sdktrace.WithResource(res))
+	}
+	return sdktrace.NewTracerProvider(opts...)
+}
+
+// newExporter создает экспортер спанов по настройкам
+func newExporter(ctx context.Context, cfg config.TracingConfig) (sdktrace.SpanExporter, error) {
+	switch cfg.Exporter {
+	case "stdout":
+		return stdouttrace.New(stdouttrace.WithPrettyPrint())
+	case "otlp":
+		switch cfg.Protocol {
+		case "grpc":
+			opts := []otlptracegrpc.Option{
+				otlptracegrpc.WithEndpoint(cfg.Endpoint),
+				otlptracegrpc.WithTimeout(cfg.ExportTimeout),
+			}
+			if cfg.Insecure {
+				opts = append(opts, otlptracegrpc.WithInsecure())
+			}
+			return otlptracegrpc.New(ctx, opts...)
+		case "http":
+			opts := []otlptracehttp.Option{
+				otlptracehttp.WithEndpoint(cfg.Endpoint),
+				otlptracehttp.WithTimeout(cfg.ExportTimeout),
+			}
+			if cfg.Insecure {
+				opts = append(opts, otlptracehttp.WithInsecure())
+			}
+			return otlptracehttp.New(ctx, opts...)
+		default:
+			return nil, fmt.Errorf("unknown OTLP protocol %q", cfg.Protocol)
+		}
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %q", cfg.Exporter)
+	}
+}
+
+// Tracer возвращает трейсер сервиса из глобального провайдера
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// End завершает спан, отмечая ошибку, если она есть
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// SkipRoute возвращает фильтр otelgin, исключающий служебные маршруты из трассировки
+func SkipRoute(paths ...string) func(*http.Request) bool {
+	return func(r *http.Request) bool {
+		for _, p := range paths {
+			if r.URL.Path == p {
+				return false
+			}
+		}
+		return true
+	}
+}
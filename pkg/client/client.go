@@ -0,0 +1,90 @@
+// Package client - Go клиент REST API сервиса назначения ревьюеров.
+//
+// Типы и методы в generated.go генерируются oapi-codegen из docs/openapi.yaml
+// (make generate-client). New добавляет к ним повторы с экспоненциальной
+// задержкой, ключи идемпотентности для изменяющих запросов и типизированные
+// ошибки *APIError:
+//
+//	c, err := client.New("https://pr-assignment:8443", client.WithTLSConfig(tlsConfig))
+//	resp, err := c.PostPullRequestCreateWithResponse(ctx, client.PostPullRequestCreateJSONRequestBody{...})
+//	if errors.Is(err, client.ErrPRExists) { ... }
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+
+	"github.com/google/uuid"
+)
+
+// IdempotencyKeyHeader - заголовок с ключом идемпотентности запроса
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// New создает клиент API. По умолчанию изменяющие запросы получают ключ
+// идемпотентности, а запросы повторяются по DefaultRetryPolicy. Ответы
+// со статусом не 2xx возвращаются ошибкой *APIError.
+func New(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	opts = append([]ClientOption{WithIdempotencyKeys()}, opts...)
+	opts = append(opts, withDefaultDoer)
+	return NewClientWithResponses(server, opts...)
+}
+
+// WithRetry задает политику повторов. Оборачивает клиент HTTP, заданный
+// ранее WithHTTPClient, поэтому указывается после него.
+func WithRetry(policy RetryPolicy) ClientOption {
+	return func(c *Client) error {
+		next := c.Client
+		if d, ok := next.(*Doer); ok {
+			next = d.Client
+		}
+		if next == nil {
+			next = &http.Client{}
+		}
+		c.Client = &Doer{Client: next, Retry: policy}
+		return nil
+	}
+}
+
+// WithTLSConfig выполняет запросы клиентом HTTP с заданной конфигурацией TLS
+func WithTLSConfig(cfg *tls.Config) ClientOption {
+	return func(c *Client) error {
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = cfg
+		httpClient := &http.Client{Transport: transport}
+		if d, ok := c.Client.(*Doer); ok {
+			d.Client = httpClient
+			return nil
+		}
+		c.Client = httpClient
+		return nil
+	}
+}
+
+// WithIdempotencyKeys добавляет к запросам POST и PUT без заголовка
+// Idempotency-Key случайный ключ. Повторы запроса отправляются с тем же ключом.
+func WithIdempotencyKeys() ClientOption {
+	return WithRequestEditorFn(func(_ context.Context, req *http.Request) error {
+		if (req.Method == http.MethodPost || req.Method == http.MethodPut) && req.Header.Get(IdempotencyKeyHeader) == "" {
+			req.Header.Set(IdempotencyKeyHeader, uuid.NewString())
+		}
+		return nil
+	})
+}
+
+// WithIdempotencyKey задает ключ идемпотентности одного запроса, например
+// чтобы повторить операцию после перезапуска клиента
+func WithIdempotencyKey(key string) RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		req.Header.Set(IdempotencyKeyHeader, key)
+		return nil
+	}
+}
+
+// withDefaultDoer оборачивает клиент HTTP в Doer, если WithRetry не указан
+func withDefaultDoer(c *Client) error {
+	if _, ok := c.Client.(*Doer); ok {
+		return nil
+	}
+	return WithRetry(DefaultRetryPolicy)(c)
+}
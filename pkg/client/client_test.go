@@ -0,0 +1,134 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var fastRetry = RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: 2 * time.Millisecond}
+
+// newTestServer вызывает handle с номером попытки и считает попытки
+func newTestServer(t *testing.T, handle func(attempt int, w http.ResponseWriter, r *http.Request)) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var attempts atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		handle(int(attempts.Add(1)), w, r)
+	}))
+	t.Cleanup(srv.Close)
+	return srv, &attempts
+}
+
+func writeError(w http.ResponseWriter, status int, code string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write([]byte(`{"error":{"code":"` + code + `","message":"failed"}}`))
+}
+
+func TestClient_RetriesWithSameIdempotencyKey(t *testing.T) {
+	var keys []string
+	srv, attempts := newTestServer(t, func(attempt int, w http.ResponseWriter, r *http.Request) {
+		keys = append(keys, r.Header.Get(IdempotencyKeyHeader))
+		if attempt < 3 {
+			writeError(w, http.StatusServiceUnavailable, "NOT_FOUND")
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"team":{"team_name":"backend","members":[]}}`))
+	})
+
+	c, err := New(srv.URL, WithRetry(fastRetry))
+	require.NoError(t, err)
+
+	resp, err := c.PostTeamAddWithResponse(context.Background(), Team{TeamName: "backend", Members: []TeamMember{}})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, resp.StatusCode())
+	assert.Equal(t, "backend", resp.JSON201.Team.TeamName)
+
+	assert.Equal(t, int32(3), attempts.Load())
+	require.Len(t, keys, 3)
+	assert.NotEmpty(t, keys[0])
+	assert.Equal(t, keys[0], keys[1])
+	assert.Equal(t, keys[0], keys[2])
+}
+
+func TestClient_DoesNotRetryPostWithoutKey(t *testing.T) {
+	srv, attempts := newTestServer(t, func(_ int, w http.ResponseWriter, _ *http.Request) {
+		writeError(w, http.StatusServiceUnavailable, "NOT_FOUND")
+	})
+
+	c, err := NewClientWithResponses(srv.URL, WithRetry(fastRetry))
+	require.NoError(t, err)
+
+	_, err = c.PostTeamAddWithResponse(context.Background(), Team{TeamName: "backend", Members: []TeamMember{}})
+	require.Error(t, err)
+	assert.Equal(t, int32(1), attempts.Load())
+}
+
+func TestClient_RetriesRateLimited(t *testing.T) {
+	srv, attempts := newTestServer(t, func(attempt int, w http.ResponseWriter, _ *http.Request) {
+		if attempt == 1 {
+			writeError(w, http.StatusTooManyRequests, "RATE_LIMITED")
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"team_name":"backend","members":[]}`))
+	})
+
+	c, err := New(srv.URL, WithRetry(fastRetry))
+	require.NoError(t, err)
+
+	resp, err := c.GetTeamGetWithResponse(context.Background(), &GetTeamGetParams{TeamName: "backend"})
+	require.NoError(t, err)
+	assert.Equal(t, "backend", resp.JSON200.TeamName)
+	assert.Equal(t, int32(2), attempts.Load())
+}
+
+func TestClient_TypedErrors(t *testing.T) {
+	srv, attempts := newTestServer(t, func(_ int, w http.ResponseWriter, _ *http.Request) {
+		writeError(w, http.StatusConflict, "PR_EXISTS")
+	})
+
+	c, err := New(srv.URL, WithRetry(fastRetry))
+	require.NoError(t, err)
+
+	_, err = c.PostPullRequestCreateWithResponse(context.Background(), PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   "pr-1",
+		PullRequestName: "Feature",
+		AuthorId:        "u1",
+	})
+	assert.ErrorIs(t, err, ErrPRExists)
+	assert.NotErrorIs(t, err, ErrNotFound)
+
+	var apiErr *APIError
+	require.True(t, errors.As(err, &apiErr))
+	assert.Equal(t, http.StatusConflict, apiErr.StatusCode)
+	assert.Equal(t, "failed", apiErr.Message)
+	assert.Equal(t, int32(1), attempts.Load())
+}
+
+func TestNewAPIError_PlainBody(t *testing.T) {
+	resp := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"3"}}}
+	err := newAPIError(resp, []byte("slow down\n"))
+	assert.Equal(t, ErrorResponseErrorCode(""), err.Code)
+	assert.Equal(t, "slow down", err.Message)
+	assert.Equal(t, 3*time.Second, err.RetryAfter)
+}
+
+func TestNewAPIError_ValidationDetails(t *testing.T) {
+	resp := &http.Response{StatusCode: http.StatusBadRequest}
+	body := `{"error":{"code":"VALIDATION_ERROR","message":"Request validation failed"},` +
+		`"details":[{"location":"body","field":"/members/0/user_id","message":"maximum string length is 255"}]}`
+	err := newAPIError(resp, []byte(body))
+	assert.ErrorIs(t, err, ErrValidation)
+	require.Len(t, err.Details, 1)
+	assert.Equal(t, ValidationIssue{Location: Body, Field: "/members/0/user_id", Message: "maximum string length is 255"}, err.Details[0])
+}
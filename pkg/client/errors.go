@@ -0,0 +1,103 @@
+package client
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// APIError - ответ API со статусом не 2xx. Code и Message берутся из
+// ErrorResponse, для ответов другого формата Code пустой.
+type APIError struct {
+	StatusCode int
+	Code       ErrorResponseErrorCode
+	Message    string
+	// Details - нарушения схемы запроса при коде VALIDATION_ERROR
+	Details []ValidationIssue
+	// RetryAfter - задержка из заголовка Retry-After, 0 - заголовка нет
+	RetryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	if e.Code == "" {
+		return fmt.Sprintf("api error: status %d: %s", e.StatusCode, e.Message)
+	}
+	return fmt.Sprintf("api error: status %d: %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+// Is сравнивает ошибки по коду, чтобы errors.Is(err, ErrNotFound) находил
+// любую ошибку с кодом NOT_FOUND
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	return ok && t.Code != "" && t.Code == e.Code
+}
+
+// Ошибки API по кодам ErrorResponse для сравнения через errors.Is
+var (
+	ErrTeamExists            = &APIError{Code: TEAMEXISTS}
+	ErrPRExists              = &APIError{Code: PREXISTS}
+	ErrPRMerged              = &APIError{Code: PRMERGED}
+	ErrNotAssigned           = &APIError{Code: NOTASSIGNED}
+	ErrNoCandidate           = &APIError{Code: NOCANDIDATE}
+	ErrNotFound              = &APIError{Code: NOTFOUND}
+	ErrInvalidPolicy         = &APIError{Code: INVALIDPOLICY}
+	ErrRateLimited           = &APIError{Code: RATELIMITED}
+	ErrPayloadTooLarge       = &APIError{Code: PAYLOADTOOLARGE}
+	ErrInvalidReviewers      = &APIError{Code: INVALIDREVIEWERS}
+	ErrInvalidSkill          = &APIError{Code: INVALIDSKILL}
+	ErrInvalidSeniority      = &APIError{Code: INVALIDSENIORITY}
+	ErrReviewerBlocked       = &APIError{Code: REVIEWERBLOCKED}
+	ErrInvalidRule           = &APIError{Code: INVALIDRULE}
+	ErrRuleExists            = &APIError{Code: RULEEXISTS}
+	ErrInvalidTimeRange      = &APIError{Code: INVALIDTIMERANGE}
+	ErrInvalidTolerance      = &APIError{Code: INVALIDTOLERANCE}
+	ErrInvalidUnavailability = &APIError{Code: INVALIDUNAVAILABILITY}
+	ErrIdempotencyKeyReused  = &APIError{Code: IDEMPOTENCYKEYREUSED}
+	ErrIdempotencyInProgress = &APIError{Code: IDEMPOTENCYINPROGRESS}
+	ErrValidation            = &APIError{Code: VALIDATIONERROR}
+	ErrUnauthorized          = &APIError{Code: UNAUTHORIZED}
+	ErrForbidden             = &APIError{Code: FORBIDDEN}
+)
+
+// newAPIError разбирает ответ с ошибкой
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		RetryAfter: retryAfter(resp.Header.Get("Retry-After")),
+	}
+
+	var errResp ErrorResponse
+	if json.Unmarshal(body, &errResp) == nil && errResp.Error.Code != "" {
+		apiErr.Code = errResp.Error.Code
+		apiErr.Message = errResp.Error.Message
+		if errResp.Details != nil {
+			apiErr.Details = *errResp.Details
+		}
+		return apiErr
+	}
+
+	apiErr.Message = strings.TrimSpace(string(body))
+	if apiErr.Message == "" {
+		apiErr.Message = http.StatusText(resp.StatusCode)
+	}
+	return apiErr
+}
+
+// retryAfter разбирает Retry-After в секундах или как дату HTTP
+func retryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(value); err == nil {
+		if d := time.Until(at); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
@@ -0,0 +1,7416 @@
+// Package client provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	AdminTokenScopes = "adminToken.Scopes"
+)
+
+// Defines values for AnalyticsBucket.
+const (
+	Day   AnalyticsBucket = "day"
+	Month AnalyticsBucket = "month"
+	Week  AnalyticsBucket = "week"
+)
+
+// Defines values for AssignmentEventType.
+const (
+	PrMerged         AssignmentEventType = "pr.merged"
+	ReviewerAssigned AssignmentEventType = "reviewer.assigned"
+	ReviewerReplaced AssignmentEventType = "reviewer.replaced"
+	UserDeactivated  AssignmentEventType = "user.deactivated"
+)
+
+// Defines values for AssignmentPolicyStrategy.
+const (
+	AssignmentPolicyStrategyLeastLoaded AssignmentPolicyStrategy = "least_loaded"
+	AssignmentPolicyStrategyRandom      AssignmentPolicyStrategy = "random"
+)
+
+// Defines values for AssignmentPolicySnapshotSource.
+const (
+	Admin   AssignmentPolicySnapshotSource = "admin"
+	Sighup  AssignmentPolicySnapshotSource = "sighup"
+	Startup AssignmentPolicySnapshotSource = "startup"
+)
+
+// Defines values for AuditEntryAction.
+const (
+	RuleCreated    AuditEntryAction = "rule_created"
+	RuleDeleted    AuditEntryAction = "rule_deleted"
+	RuleOverridden AuditEntryAction = "rule_overridden"
+)
+
+// Defines values for AuthoredPullRequestReviewerSource.
+const (
+	AuthoredPullRequestReviewerSourceAuto                 AuthoredPullRequestReviewerSource = "auto"
+	AuthoredPullRequestReviewerSourceCodeOwner            AuthoredPullRequestReviewerSource = "code_owner"
+	AuthoredPullRequestReviewerSourceFallbackTeam         AuthoredPullRequestReviewerSource = "fallback_team"
+	AuthoredPullRequestReviewerSourceInactiveReassignment AuthoredPullRequestReviewerSource = "inactive_reassignment"
+	AuthoredPullRequestReviewerSourceManual               AuthoredPullRequestReviewerSource = "manual"
+	AuthoredPullRequestReviewerSourceSlaEscalation        AuthoredPullRequestReviewerSource = "sla_escalation"
+)
+
+// Defines values for ErrorResponseErrorCode.
+const (
+	FORBIDDEN             ErrorResponseErrorCode = "FORBIDDEN"
+	IDEMPOTENCYINPROGRESS ErrorResponseErrorCode = "IDEMPOTENCY_IN_PROGRESS"
+	IDEMPOTENCYKEYREUSED  ErrorResponseErrorCode = "IDEMPOTENCY_KEY_REUSED"
+	INVALIDPOLICY         ErrorResponseErrorCode = "INVALID_POLICY"
+	INVALIDREVIEWERS      ErrorResponseErrorCode = "INVALID_REVIEWERS"
+	INVALIDRULE           ErrorResponseErrorCode = "INVALID_RULE"
+	INVALIDSENIORITY      ErrorResponseErrorCode = "INVALID_SENIORITY"
+	INVALIDSKILL          ErrorResponseErrorCode = "INVALID_SKILL"
+	INVALIDTIMERANGE      ErrorResponseErrorCode = "INVALID_TIME_RANGE"
+	INVALIDTOLERANCE      ErrorResponseErrorCode = "INVALID_TOLERANCE"
+	INVALIDUNAVAILABILITY ErrorResponseErrorCode = "INVALID_UNAVAILABILITY"
+	NOCANDIDATE           ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED           ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND              ErrorResponseErrorCode = "NOT_FOUND"
+	PAYLOADTOOLARGE       ErrorResponseErrorCode = "PAYLOAD_TOO_LARGE"
+	PREXISTS              ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED              ErrorResponseErrorCode = "PR_MERGED"
+	RATELIMITED           ErrorResponseErrorCode = "RATE_LIMITED"
+	REVIEWERBLOCKED       ErrorResponseErrorCode = "REVIEWER_BLOCKED"
+	RULEEXISTS            ErrorResponseErrorCode = "RULE_EXISTS"
+	TEAMEXISTS            ErrorResponseErrorCode = "TEAM_EXISTS"
+	UNAUTHORIZED          ErrorResponseErrorCode = "UNAUTHORIZED"
+	VALIDATIONERROR       ErrorResponseErrorCode = "VALIDATION_ERROR"
+)
+
+// Defines values for ExcludedCandidateReason.
+const (
+	AlreadyAssigned ExcludedCandidateReason = "already_assigned"
+	AtCapacity      ExcludedCandidateReason = "at_capacity"
+	Author          ExcludedCandidateReason = "author"
+	Blocked         ExcludedCandidateReason = "blocked"
+	Excluded        ExcludedCandidateReason = "excluded"
+	Inactive        ExcludedCandidateReason = "inactive"
+	Unavailable     ExcludedCandidateReason = "unavailable"
+)
+
+// Defines values for HealthStatus.
+const (
+	Degraded HealthStatus = "degraded"
+	Down     HealthStatus = "down"
+	Ok       HealthStatus = "ok"
+)
+
+// Defines values for PullRequestStatus.
+const (
+	PullRequestStatusMERGED PullRequestStatus = "MERGED"
+	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
+)
+
+// Defines values for PullRequestShortStatus.
+const (
+	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
+	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
+)
+
+// Defines values for PullRequestStatsStatus.
+const (
+	MERGED PullRequestStatsStatus = "MERGED"
+	OPEN   PullRequestStatsStatus = "OPEN"
+)
+
+// Defines values for ReviewerAssignmentSource.
+const (
+	ReviewerAssignmentSourceAuto                 ReviewerAssignmentSource = "auto"
+	ReviewerAssignmentSourceCodeOwner            ReviewerAssignmentSource = "code_owner"
+	ReviewerAssignmentSourceFallbackTeam         ReviewerAssignmentSource = "fallback_team"
+	ReviewerAssignmentSourceInactiveReassignment ReviewerAssignmentSource = "inactive_reassignment"
+	ReviewerAssignmentSourceManual               ReviewerAssignmentSource = "manual"
+	ReviewerAssignmentSourceSlaEscalation        ReviewerAssignmentSource = "sla_escalation"
+)
+
+// Defines values for ReviewerFairnessStatus.
+const (
+	Balanced      ReviewerFairnessStatus = "balanced"
+	OverAssigned  ReviewerFairnessStatus = "over_assigned"
+	UnderAssigned ReviewerFairnessStatus = "under_assigned"
+)
+
+// Defines values for ReviewerRuleEffect.
+const (
+	ReviewerRuleEffectBlock  ReviewerRuleEffect = "block"
+	ReviewerRuleEffectPrefer ReviewerRuleEffect = "prefer"
+)
+
+// Defines values for ScoreComponentName.
+const (
+	ScoreComponentNameOverCapacity ScoreComponentName = "over_capacity"
+	ScoreComponentNamePreferred    ScoreComponentName = "preferred"
+	ScoreComponentNameRandom       ScoreComponentName = "random"
+	ScoreComponentNameSkillMatch   ScoreComponentName = "skill_match"
+	ScoreComponentNameWorkload     ScoreComponentName = "workload"
+)
+
+// Defines values for Seniority.
+const (
+	Learner Seniority = "learner"
+	Regular Seniority = "regular"
+	Senior  Seniority = "senior"
+)
+
+// Defines values for UserSkillLevel.
+const (
+	Expert       UserSkillLevel = "expert"
+	Intermediate UserSkillLevel = "intermediate"
+	Novice       UserSkillLevel = "novice"
+)
+
+// Defines values for ValidationIssueLocation.
+const (
+	Body   ValidationIssueLocation = "body"
+	Cookie ValidationIssueLocation = "cookie"
+	Header ValidationIssueLocation = "header"
+	Path   ValidationIssueLocation = "path"
+	Query  ValidationIssueLocation = "query"
+)
+
+// Defines values for PostRulesAddJSONBodyEffect.
+const (
+	PostRulesAddJSONBodyEffectBlock  PostRulesAddJSONBodyEffect = "block"
+	PostRulesAddJSONBodyEffectPrefer PostRulesAddJSONBodyEffect = "prefer"
+)
+
+// AnalyticsBucket Шаг временного ряда. Интервалы выравниваются по началу дня, недели (понедельник)
+// или месяца в UTC, поэтому первый интервал может начинаться раньше from.
+// Диапазон содержит не больше 366 интервалов.
+type AnalyticsBucket string
+
+// AssignmentEvent Данные события SSE. Поле `id` совпадает с id события потока и
+// передается в Last-Event-ID для продолжения.
+type AssignmentEvent struct {
+	// AuthorId Автор PR для событий PR
+	AuthorId  *string   `json:"author_id"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Details source и strategy назначения, pull_request_name для pr.merged
+	Details map[string]interface{} `json:"details"`
+	Id      int64                  `json:"id"`
+
+	// PreviousUserId Замененный ревьюер для reviewer.replaced
+	PreviousUserId *string `json:"previous_user_id"`
+	PullRequestId  *string `json:"pull_request_id"`
+
+	// TeamName Команда автора PR или деактивированного пользователя
+	TeamName *string             `json:"team_name"`
+	Type     AssignmentEventType `json:"type"`
+
+	// UserId Назначенный ревьюер или деактивированный пользователь
+	UserId *string `json:"user_id"`
+}
+
+// AssignmentEventType defines model for AssignmentEvent.Type.
+type AssignmentEventType string
+
+// AssignmentPolicy defines model for AssignmentPolicy.
+type AssignmentPolicy struct {
+	DefaultReviewerCount int `json:"default_reviewer_count"`
+
+	// FallbackOverCapacity Назначать сверх лимита, если все кандидаты его достигли
+	FallbackOverCapacity bool `json:"fallback_over_capacity"`
+
+	// FallbackRemoveInactive Снимать неактивного ревьюера, если замену найти не удалось
+	FallbackRemoveInactive bool `json:"fallback_remove_inactive"`
+
+	// MaxOpenReviews Лимит открытых ревью на ревьюера, 0 - без ограничения
+	MaxOpenReviews int `json:"max_open_reviews"`
+
+	// ReviewSla Длительность в формате Go, например 48h
+	ReviewSla string `json:"review_sla"`
+
+	// SkillWeight Вес совпадения навыков кандидата с тегами PR
+	SkillWeight float64                  `json:"skill_weight"`
+	Strategy    AssignmentPolicyStrategy `json:"strategy"`
+
+	// WorkloadWeight Вес количества открытых ревью в оценке кандидата
+	WorkloadWeight float64 `json:"workload_weight"`
+}
+
+// AssignmentPolicyStrategy defines model for AssignmentPolicy.Strategy.
+type AssignmentPolicyStrategy string
+
+// AssignmentPolicySnapshot defines model for AssignmentPolicySnapshot.
+type AssignmentPolicySnapshot struct {
+	Policy    AssignmentPolicy               `json:"policy"`
+	Source    AssignmentPolicySnapshotSource `json:"source"`
+	UpdatedAt time.Time                      `json:"updated_at"`
+	Version   int64                          `json:"version"`
+}
+
+// AssignmentPolicySnapshotSource defines model for AssignmentPolicySnapshot.Source.
+type AssignmentPolicySnapshotSource string
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	Action AuditEntryAction `json:"action"`
+
+	// Actor Идентичность клиента, выполнившего операцию
+	Actor         string                 `json:"actor"`
+	CreatedAt     time.Time              `json:"created_at"`
+	Details       map[string]interface{} `json:"details"`
+	Id            int64                  `json:"id"`
+	PullRequestId *string                `json:"pull_request_id,omitempty"`
+	UserId        *string                `json:"user_id,omitempty"`
+}
+
+// AuditEntryAction defines model for AuditEntry.Action.
+type AuditEntryAction string
+
+// AuthoredPullRequest defines model for AuthoredPullRequest.
+type AuthoredPullRequest struct {
+	CreatedAt       time.Time                     `json:"created_at"`
+	PullRequestId   string                        `json:"pull_request_id"`
+	PullRequestName string                        `json:"pull_request_name"`
+	Reviewers       []AuthoredPullRequestReviewer `json:"reviewers"`
+}
+
+// AuthoredPullRequestReviewer defines model for AuthoredPullRequestReviewer.
+type AuthoredPullRequestReviewer struct {
+	AssignedAt time.Time `json:"assigned_at"`
+	DueAt      time.Time `json:"due_at"`
+	IsActive   bool      `json:"is_active"`
+
+	// Overdue Срок ревью истек, а PR еще открыт
+	Overdue  bool                              `json:"overdue"`
+	Source   AuthoredPullRequestReviewerSource `json:"source"`
+	UserId   string                            `json:"user_id"`
+	Username string                            `json:"username"`
+}
+
+// AuthoredPullRequestReviewerSource defines model for AuthoredPullRequestReviewer.Source.
+type AuthoredPullRequestReviewerSource string
+
+// ComponentHealth defines model for ComponentHealth.
+type ComponentHealth struct {
+	Details *map[string]interface{} `json:"details,omitempty"`
+
+	// LatencyMs Время проверки компонента в миллисекундах
+	LatencyMs float64 `json:"latency_ms"`
+	Message   *string `json:"message,omitempty"`
+	Name      string  `json:"name"`
+
+	// Status ok - компонент работает нормально;
+	// degraded - компонент работает, но с проблемами (трафик принимается);
+	// down - компонент недоступен (трафик не принимается)
+	Status HealthStatus `json:"status"`
+}
+
+// EntityId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+type EntityId = string
+
+// EntityName Имя команды, пользователя или Pull Request, непустое и до 255 символов
+type EntityName = string
+
+// ErrorResponse defines model for ErrorResponse.
+type ErrorResponse struct {
+	// Details Нарушения схемы запроса при коде VALIDATION_ERROR
+	Details *[]ValidationIssue `json:"details,omitempty"`
+	Error   struct {
+		Code    ErrorResponseErrorCode `json:"code"`
+		Message string                 `json:"message"`
+	} `json:"error"`
+}
+
+// ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
+type ErrorResponseErrorCode string
+
+// ExcludedCandidate defines model for ExcludedCandidate.
+type ExcludedCandidate struct {
+	Reason   ExcludedCandidateReason `json:"reason"`
+	UserId   string                  `json:"user_id"`
+	Username string                  `json:"username"`
+	Workload int                     `json:"workload"`
+}
+
+// ExcludedCandidateReason defines model for ExcludedCandidate.Reason.
+type ExcludedCandidateReason string
+
+// FairnessReport defines model for FairnessReport.
+type FairnessReport struct {
+	Teams     []TeamFairness `json:"teams"`
+	Tolerance float64        `json:"tolerance"`
+}
+
+// HealthReport defines model for HealthReport.
+type HealthReport struct {
+	Components []ComponentHealth `json:"components"`
+
+	// Status ok - компонент работает нормально;
+	// degraded - компонент работает, но с проблемами (трафик принимается);
+	// down - компонент недоступен (трафик не принимается)
+	Status    HealthStatus `json:"status"`
+	Timestamp time.Time    `json:"timestamp"`
+}
+
+// HealthStatus ok - компонент работает нормально;
+// degraded - компонент работает, но с проблемами (трафик принимается);
+// down - компонент недоступен (трафик не принимается)
+type HealthStatus string
+
+// PendingReview defines model for PendingReview.
+type PendingReview struct {
+	// AgeSeconds Сколько секунд прошло с создания PR
+	AgeSeconds int64              `json:"age_seconds"`
+	Assignment ReviewerAssignment `json:"assignment"`
+	AuthorId   string             `json:"author_id"`
+	CreatedAt  time.Time          `json:"created_at"`
+
+	// DueAt Срок ревью - момент назначения плюс review_sla политики
+	DueAt           time.Time `json:"due_at"`
+	Overdue         bool      `json:"overdue"`
+	PullRequestId   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+}
+
+// Percentiles Перцентили длительности в секундах
+type Percentiles struct {
+	P50Seconds int64 `json:"p50_seconds"`
+	P90Seconds int64 `json:"p90_seconds"`
+	P99Seconds int64 `json:"p99_seconds"`
+}
+
+// PullRequest defines model for PullRequest.
+type PullRequest struct {
+	// AssignedReviewers user_id назначенных ревьюверов (0..2)
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	CreatedAt         *time.Time `json:"createdAt"`
+	MergedAt          *time.Time `json:"mergedAt"`
+	PullRequestId     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+
+	// RequiredTags Навыки, нужные для ревью PR
+	RequiredTags *[]string `json:"required_tags,omitempty"`
+
+	// ReviewerAssignments Почему был выбран каждый ревьювер
+	ReviewerAssignments *[]ReviewerAssignment `json:"reviewer_assignments,omitempty"`
+	Status              PullRequestStatus     `json:"status"`
+}
+
+// PullRequestStatus defines model for PullRequest.Status.
+type PullRequestStatus string
+
+// PullRequestShort defines model for PullRequestShort.
+type PullRequestShort struct {
+	AuthorId        string                 `json:"author_id"`
+	PullRequestId   string                 `json:"pull_request_id"`
+	PullRequestName string                 `json:"pull_request_name"`
+	Status          PullRequestShortStatus `json:"status"`
+}
+
+// PullRequestShortStatus defines model for PullRequestShort.Status.
+type PullRequestShortStatus string
+
+// PullRequestStats defines model for PullRequestStats.
+type PullRequestStats struct {
+	AuthorId        string                 `json:"author_id"`
+	CreatedAt       time.Time              `json:"created_at"`
+	MergedAt        *time.Time             `json:"merged_at,omitempty"`
+	PullRequestId   string                 `json:"pull_request_id"`
+	PullRequestName string                 `json:"pull_request_name"`
+	ReviewersCount  int64                  `json:"reviewers_count"`
+	Status          PullRequestStatsStatus `json:"status"`
+}
+
+// PullRequestStatsStatus defines model for PullRequestStats.Status.
+type PullRequestStatsStatus string
+
+// PullRequestStatsList defines model for PullRequestStatsList.
+type PullRequestStatsList struct {
+	Statistics []PullRequestStats `json:"statistics"`
+}
+
+// PullRequestTags defines model for PullRequestTags.
+type PullRequestTags struct {
+	// PullRequestId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	PullRequestId EntityId `json:"pull_request_id"`
+	RequiredTags  []string `json:"required_tags"`
+}
+
+// RankedCandidate defines model for RankedCandidate.
+type RankedCandidate struct {
+	Breakdown []ScoreComponent `json:"breakdown"`
+	Rank      int              `json:"rank"`
+	Score     float64          `json:"score"`
+
+	// Seniority Уровень ревьювера для наставничества
+	Seniority *Seniority `json:"seniority,omitempty"`
+	UserId    string     `json:"user_id"`
+	Username  string     `json:"username"`
+
+	// Workload Количество открытых ревью
+	Workload int `json:"workload"`
+}
+
+// RecentReviewStats defines model for RecentReviewStats.
+type RecentReviewStats struct {
+	From time.Time `json:"from"`
+
+	// ReviewsAssigned Назначения на ревью в окне
+	ReviewsAssigned int64 `json:"reviews_assigned"`
+
+	// ReviewsMerged Из них на PR, которые уже слиты
+	ReviewsMerged int64     `json:"reviews_merged"`
+	To            time.Time `json:"to"`
+}
+
+// ReviewCapacity defines model for ReviewCapacity.
+type ReviewCapacity struct {
+	// AtCapacity Лимит достигнут, автоназначение пропускает пользователя
+	AtCapacity bool `json:"at_capacity"`
+
+	// MaxOpenReviews Лимит политики, 0 - без ограничения
+	MaxOpenReviews int   `json:"max_open_reviews"`
+	OpenReviews    int64 `json:"open_reviews"`
+
+	// Remaining Сколько ревью еще можно назначить, отсутствует без лимита
+	Remaining *int64 `json:"remaining"`
+
+	// Unavailable Пользователь сейчас в периоде недоступности
+	Unavailable bool `json:"unavailable"`
+}
+
+// ReviewTimeBucket defines model for ReviewTimeBucket.
+type ReviewTimeBucket struct {
+	BucketStart time.Time `json:"bucket_start"`
+
+	// PrsMerged Из них уже слитые
+	PrsMerged int64 `json:"prs_merged"`
+
+	// PrsOpened PR, созданные в интервале
+	PrsOpened int64 `json:"prs_opened"`
+
+	// TimeToFirstAssignment Перцентили длительности в секундах
+	TimeToFirstAssignment *Percentiles `json:"time_to_first_assignment,omitempty"`
+
+	// TimeToMerge Перцентили длительности в секундах
+	TimeToMerge *Percentiles `json:"time_to_merge,omitempty"`
+}
+
+// ReviewTimeSeries defines model for ReviewTimeSeries.
+type ReviewTimeSeries struct {
+	// Bucket Шаг временного ряда. Интервалы выравниваются по началу дня, недели (понедельник)
+	// или месяца в UTC, поэтому первый интервал может начинаться раньше from.
+	// Диапазон содержит не больше 366 интервалов.
+	Bucket AnalyticsBucket    `json:"bucket"`
+	Series []ReviewTimeBucket `json:"series"`
+}
+
+// ReviewerAssignment defines model for ReviewerAssignment.
+type ReviewerAssignment struct {
+	AssignedAt time.Time `json:"assigned_at"`
+
+	// Source Каким путем ревьювер попал на PR
+	Source ReviewerAssignmentSource `json:"source"`
+
+	// Strategy Стратегия выбора, отсутствует при ручном назначении
+	Strategy *string `json:"strategy"`
+
+	// TieBreak Выбор решил порядок кандидатов: невыбранный кандидат имел ту же оценку.
+	// Отсутствует для назначений не по политике.
+	TieBreak *bool   `json:"tie_break"`
+	UserId   string  `json:"user_id"`
+	Username *string `json:"username,omitempty"`
+
+	// WorkloadAtAssignment Количество открытых ревью кандидата в момент назначения
+	WorkloadAtAssignment *int64 `json:"workload_at_assignment"`
+}
+
+// ReviewerAssignmentSource Каким путем ревьювер попал на PR
+type ReviewerAssignmentSource string
+
+// ReviewerFairness defines model for ReviewerFairness.
+type ReviewerFairness struct {
+	Assignments int64 `json:"assignments"`
+
+	// Deviation Отклонение от среднего по команде в назначениях
+	Deviation float64 `json:"deviation"`
+	IsActive  bool    `json:"is_active"`
+
+	// RelativeDeviation Отклонение в долях среднего по команде
+	RelativeDeviation float64                `json:"relative_deviation"`
+	Status            ReviewerFairnessStatus `json:"status"`
+
+	// TieBreakAssignments Назначения, решенные порядком среди кандидатов с равной оценкой
+	TieBreakAssignments int64  `json:"tie_break_assignments"`
+	UserId              string `json:"user_id"`
+	Username            string `json:"username"`
+}
+
+// ReviewerFairnessStatus defines model for ReviewerFairness.Status.
+type ReviewerFairnessStatus string
+
+// ReviewerPreview defines model for ReviewerPreview.
+type ReviewerPreview struct {
+	AuthorId string `json:"author_id"`
+
+	// Candidates Кандидаты в порядке выбора
+	Candidates []RankedCandidate   `json:"candidates"`
+	Excluded   []ExcludedCandidate `json:"excluded"`
+
+	// Mentorship Выбор учитывает пару senior и learner
+	Mentorship    bool  `json:"mentorship"`
+	PolicyVersion int64 `json:"policy_version"`
+
+	// RequiredTags Навыки, по которым оценивались кандидаты
+	RequiredTags  []string `json:"required_tags"`
+	ReviewerCount int      `json:"reviewer_count"`
+
+	// Selected Ревьюверы, которые были бы назначены
+	Selected []string `json:"selected"`
+	Strategy string   `json:"strategy"`
+	TeamName string   `json:"team_name"`
+}
+
+// ReviewerRule Постоянное правило назначения. block запрещает reviewer_id ревьюить PR автора
+// author_id или всех участников команды team_name; prefer повышает оценку
+// reviewer_id при выборе для PR автора author_id.
+type ReviewerRule struct {
+	AuthorId   *string            `json:"author_id,omitempty"`
+	CreatedAt  time.Time          `json:"created_at"`
+	CreatedBy  string             `json:"created_by"`
+	Effect     ReviewerRuleEffect `json:"effect"`
+	Id         int64              `json:"id"`
+	Reason     string             `json:"reason"`
+	ReviewerId string             `json:"reviewer_id"`
+	TeamName   *string            `json:"team_name,omitempty"`
+}
+
+// ReviewerRuleEffect defines model for ReviewerRule.Effect.
+type ReviewerRuleEffect string
+
+// ReviewerThroughput defines model for ReviewerThroughput.
+type ReviewerThroughput struct {
+	BucketStart     time.Time `json:"bucket_start"`
+	ReviewsAssigned int64     `json:"reviews_assigned"`
+
+	// ReviewsMerged Назначения на PR, которые уже слиты
+	ReviewsMerged int64 `json:"reviews_merged"`
+
+	// Share Доля ревьюера среди всех назначений интервала
+	Share    float64 `json:"share"`
+	TeamName string  `json:"team_name"`
+	UserId   string  `json:"user_id"`
+	Username string  `json:"username"`
+}
+
+// ReviewerThroughputSeries defines model for ReviewerThroughputSeries.
+type ReviewerThroughputSeries struct {
+	// Bucket Шаг временного ряда. Интервалы выравниваются по началу дня, недели (понедельник)
+	// или месяца в UTC, поэтому первый интервал может начинаться раньше from.
+	// Диапазон содержит не больше 366 интервалов.
+	Bucket AnalyticsBucket      `json:"bucket"`
+	Series []ReviewerThroughput `json:"series"`
+}
+
+// ScoreComponent defines model for ScoreComponent.
+type ScoreComponent struct {
+	// Name workload - минус количество открытых ревью с весом workload_weight (least_loaded),
+	// over_capacity - штраф за превышение лимита, random - случайная оценка (random),
+	// skill_match - средний уровень навыков по тегам PR с весом skill_weight,
+	// preferred - надбавка 1 за правило prefer для автора
+	Name  ScoreComponentName `json:"name"`
+	Value float64            `json:"value"`
+}
+
+// ScoreComponentName workload - минус количество открытых ревью с весом workload_weight (least_loaded),
+// over_capacity - штраф за превышение лимита, random - случайная оценка (random),
+// skill_match - средний уровень навыков по тегам PR с весом skill_weight,
+// preferred - надбавка 1 за правило prefer для автора
+type ScoreComponentName string
+
+// Seniority Уровень ревьювера для наставничества
+type Seniority string
+
+// Team defines model for Team.
+type Team struct {
+	Members []TeamMember `json:"members"`
+
+	// MentorshipEnabled На каждый PR назначается senior и, если возможно, learner
+	MentorshipEnabled *bool `json:"mentorship_enabled,omitempty"`
+
+	// TeamName Имя команды, пользователя или Pull Request, непустое и до 255 символов
+	TeamName EntityName `json:"team_name"`
+}
+
+// TeamFairness defines model for TeamFairness.
+type TeamFairness struct {
+	// Gini Коэффициент Джини назначений, 0 - поровну, 1 - все у одного
+	Gini float64 `json:"gini"`
+
+	// GiniWithoutTieBreaks Коэффициент Джини без назначений, решенных равенством оценок
+	GiniWithoutTieBreaks float64 `json:"gini_without_tie_breaks"`
+
+	// MaxMinRatio Отношение наибольшего числа назначений к наименьшему, отсутствует, если у кого-то их нет
+	MaxMinRatio *float64           `json:"max_min_ratio"`
+	Mean        float64            `json:"mean"`
+	Members     []ReviewerFairness `json:"members"`
+
+	// PolicyAssignments Назначения, выбранные политикой после появления учета равенства оценок
+	PolicyAssignments int64 `json:"policy_assignments"`
+
+	// Reviewers Активные участники и неактивные, получившие назначения в окне
+	Reviewers           int    `json:"reviewers"`
+	TeamName            string `json:"team_name"`
+	TieBreakAssignments int64  `json:"tie_break_assignments"`
+
+	// TieBreakShare Доля решенных равенством оценок среди назначений по политике
+	TieBreakShare    float64 `json:"tie_break_share"`
+	TotalAssignments int64   `json:"total_assignments"`
+}
+
+// TeamMember defines model for TeamMember.
+type TeamMember struct {
+	IsActive *bool `json:"is_active,omitempty"`
+
+	// Seniority Уровень ревьювера для наставничества
+	Seniority *Seniority `json:"seniority,omitempty"`
+
+	// UserId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	UserId EntityId `json:"user_id"`
+
+	// Username Имя команды, пользователя или Pull Request, непустое и до 255 символов
+	Username EntityName `json:"username"`
+}
+
+// TeamStats defines model for TeamStats.
+type TeamStats struct {
+	ActiveMembers int64 `json:"active_members"`
+
+	// PrsAuthored PR участников команды, созданные в окне
+	PrsAuthored int64 `json:"prs_authored"`
+
+	// PrsMerged Из них слитые
+	PrsMerged int64 `json:"prs_merged"`
+
+	// PrsReviewed Различные PR, на которые участники команды назначены в окне
+	PrsReviewed int64 `json:"prs_reviewed"`
+
+	// ReviewAssignments Все назначения участников команды, сделанные в окне
+	ReviewAssignments int64  `json:"review_assignments"`
+	TeamName          string `json:"team_name"`
+	TotalMembers      int64  `json:"total_members"`
+}
+
+// TeamStatsList defines model for TeamStatsList.
+type TeamStatsList struct {
+	Statistics []TeamStats `json:"statistics"`
+}
+
+// TeamThroughput defines model for TeamThroughput.
+type TeamThroughput struct {
+	BucketStart time.Time `json:"bucket_start"`
+	PrsMerged   int64     `json:"prs_merged"`
+
+	// PrsMergedCumulative Слитые PR с начала диапазона по этот интервал включительно
+	PrsMergedCumulative int64  `json:"prs_merged_cumulative"`
+	PrsOpened           int64  `json:"prs_opened"`
+	TeamName            string `json:"team_name"`
+}
+
+// TeamThroughputSeries defines model for TeamThroughputSeries.
+type TeamThroughputSeries struct {
+	// Bucket Шаг временного ряда. Интервалы выравниваются по началу дня, недели (понедельник)
+	// или месяца в UTC, поэтому первый интервал может начинаться раньше from.
+	// Диапазон содержит не больше 366 интервалов.
+	Bucket AnalyticsBucket  `json:"bucket"`
+	Series []TeamThroughput `json:"series"`
+}
+
+// Unavailability Период [starts_at, ends_at), когда пользователь не может ревьюить.
+// Показывается в сводке и не влияет на автоназначение.
+type Unavailability struct {
+	EndsAt   time.Time `json:"ends_at"`
+	Id       int64     `json:"id"`
+	Reason   string    `json:"reason"`
+	StartsAt time.Time `json:"starts_at"`
+	UserId   string    `json:"user_id"`
+}
+
+// User defines model for User.
+type User struct {
+	IsActive bool `json:"is_active"`
+
+	// Seniority Уровень ревьювера для наставничества
+	Seniority *Seniority `json:"seniority,omitempty"`
+	TeamName  string     `json:"team_name"`
+	UserId    string     `json:"user_id"`
+	Username  string     `json:"username"`
+}
+
+// UserDashboard defines model for UserDashboard.
+type UserDashboard struct {
+	// AuthoredPullRequests Открытые PR пользователя
+	AuthoredPullRequests []AuthoredPullRequest `json:"authored_pull_requests"`
+	Capacity             ReviewCapacity        `json:"capacity"`
+
+	// PendingReviews Открытые PR, ожидающие ревью пользователя, самые старые первыми
+	PendingReviews []PendingReview   `json:"pending_reviews"`
+	RecentStats    RecentReviewStats `json:"recent_stats"`
+
+	// Unavailability Текущие и будущие периоды недоступности
+	Unavailability []Unavailability `json:"unavailability"`
+	User           User             `json:"user"`
+}
+
+// UserSkill defines model for UserSkill.
+type UserSkill struct {
+	Level UserSkillLevel `json:"level"`
+
+	// Tag Тег навыка в нижнем регистре, например go или frontend/react
+	Tag string `json:"tag"`
+}
+
+// UserSkillLevel defines model for UserSkill.Level.
+type UserSkillLevel string
+
+// UserSkills defines model for UserSkills.
+type UserSkills struct {
+	Skills []UserSkill `json:"skills"`
+
+	// UserId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	UserId EntityId `json:"user_id"`
+}
+
+// ValidationIssue defines model for ValidationIssue.
+type ValidationIssue struct {
+	// Field Имя параметра или JSON Pointer поля тела, например /members/0/user_id
+	Field    string                  `json:"field"`
+	Location ValidationIssueLocation `json:"location"`
+	Message  string                  `json:"message"`
+}
+
+// ValidationIssueLocation defines model for ValidationIssue.Location.
+type ValidationIssueLocation string
+
+// WorkloadDrift defines model for WorkloadDrift.
+type WorkloadDrift struct {
+	// Actual Фактическое количество открытых PR, где пользователь назначен ревьюером
+	Actual int64 `json:"actual"`
+
+	// Recorded Учтенное количество открытых ревью до исправления
+	Recorded int64  `json:"recorded"`
+	UserId   string `json:"user_id"`
+}
+
+// WorkloadReconcileResult defines model for WorkloadReconcileResult.
+type WorkloadReconcileResult struct {
+	// Drift Исправленные расхождения, пустой массив - расхождений не было
+	Drift []WorkloadDrift `json:"drift"`
+}
+
+// BucketQuery Шаг временного ряда. Интервалы выравниваются по началу дня, недели (понедельник)
+// или месяца в UTC, поэтому первый интервал может начинаться раньше from.
+// Диапазон содержит не больше 366 интервалов.
+type BucketQuery = AnalyticsBucket
+
+// PullRequestIdQuery Идентификатор пользователя или Pull Request, непустой и до 255 символов
+type PullRequestIdQuery = EntityId
+
+// RangeFromQuery defines model for RangeFromQuery.
+type RangeFromQuery = time.Time
+
+// RangeToQuery defines model for RangeToQuery.
+type RangeToQuery = time.Time
+
+// TeamNameQuery Имя команды, пользователя или Pull Request, непустое и до 255 символов
+type TeamNameQuery = EntityName
+
+// UserIdQuery Идентификатор пользователя или Pull Request, непустой и до 255 символов
+type UserIdQuery = EntityId
+
+// WindowFromQuery defines model for WindowFromQuery.
+type WindowFromQuery = time.Time
+
+// WindowToQuery defines model for WindowToQuery.
+type WindowToQuery = time.Time
+
+// Forbidden defines model for Forbidden.
+type Forbidden = ErrorResponse
+
+// PayloadTooLarge defines model for PayloadTooLarge.
+type PayloadTooLarge = ErrorResponse
+
+// TooManyRequests defines model for TooManyRequests.
+type TooManyRequests = ErrorResponse
+
+// Unauthorized defines model for Unauthorized.
+type Unauthorized = ErrorResponse
+
+// GetAnalyticsReviewTimesParams defines parameters for GetAnalyticsReviewTimes.
+type GetAnalyticsReviewTimesParams struct {
+	// From Начало диапазона включительно (RFC 3339)
+	From RangeFromQuery `form:"from" json:"from"`
+
+	// To Конец диапазона, не включается (RFC 3339)
+	To RangeToQuery `form:"to" json:"to"`
+
+	// Bucket Шаг временного ряда, по умолчанию week
+	Bucket *BucketQuery `form:"bucket,omitempty" json:"bucket,omitempty"`
+}
+
+// GetAnalyticsReviewerThroughputParams defines parameters for GetAnalyticsReviewerThroughput.
+type GetAnalyticsReviewerThroughputParams struct {
+	// From Начало диапазона включительно (RFC 3339)
+	From RangeFromQuery `form:"from" json:"from"`
+
+	// To Конец диапазона, не включается (RFC 3339)
+	To RangeToQuery `form:"to" json:"to"`
+
+	// Bucket Шаг временного ряда, по умолчанию week
+	Bucket *BucketQuery `form:"bucket,omitempty" json:"bucket,omitempty"`
+}
+
+// GetAnalyticsTeamThroughputParams defines parameters for GetAnalyticsTeamThroughput.
+type GetAnalyticsTeamThroughputParams struct {
+	// From Начало диапазона включительно (RFC 3339)
+	From RangeFromQuery `form:"from" json:"from"`
+
+	// To Конец диапазона, не включается (RFC 3339)
+	To RangeToQuery `form:"to" json:"to"`
+
+	// Bucket Шаг временного ряда, по умолчанию week
+	Bucket *BucketQuery `form:"bucket,omitempty" json:"bucket,omitempty"`
+}
+
+// GetAuditListParams defines parameters for GetAuditList.
+type GetAuditListParams struct {
+	PullRequestId *string `form:"pull_request_id,omitempty" json:"pull_request_id,omitempty"`
+	Limit         *int    `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetEventsStreamParams defines parameters for GetEventsStream.
+type GetEventsStreamParams struct {
+	UserId        *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+	TeamName      *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+	PullRequestId *string `form:"pull_request_id,omitempty" json:"pull_request_id,omitempty"`
+
+	// LastEventID id последнего полученного события
+	LastEventID *int64 `json:"Last-Event-ID,omitempty"`
+}
+
+// PostPullRequestAssignJSONBody defines parameters for PostPullRequestAssign.
+type PostPullRequestAssignJSONBody struct {
+	Override       *bool   `json:"override,omitempty"`
+	OverrideReason *string `json:"override_reason,omitempty"`
+
+	// PullRequestId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	PullRequestId EntityId `json:"pull_request_id"`
+
+	// UserId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	UserId EntityId `json:"user_id"`
+}
+
+// PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
+type PostPullRequestCreateJSONBody struct {
+	// AuthorId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	AuthorId EntityId `json:"author_id"`
+
+	// ExcludedReviewers Пользователи, которых нельзя назначать
+	ExcludedReviewers *[]EntityId `json:"excluded_reviewers,omitempty"`
+
+	// PreferredReviewers Активные участники команды автора, назначаются первыми
+	PreferredReviewers *[]EntityId `json:"preferred_reviewers,omitempty"`
+
+	// PullRequestId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	PullRequestId EntityId `json:"pull_request_id"`
+
+	// PullRequestName Имя команды, пользователя или Pull Request, непустое и до 255 символов
+	PullRequestName EntityName `json:"pull_request_name"`
+
+	// RequiredTags Навыки, нужные для ревью; кандидаты с ними получают более высокую оценку
+	RequiredTags *[]string `json:"required_tags,omitempty"`
+
+	// ReviewerCount Общее количество ревьюверов, по умолчанию - из политики назначения
+	ReviewerCount *int `json:"reviewer_count,omitempty"`
+}
+
+// PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
+type PostPullRequestMergeJSONBody struct {
+	// PullRequestId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	PullRequestId EntityId `json:"pull_request_id"`
+}
+
+// PostPullRequestPreviewReviewersJSONBody defines parameters for PostPullRequestPreviewReviewers.
+type PostPullRequestPreviewReviewersJSONBody struct {
+	// AuthorId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	AuthorId          EntityId    `json:"author_id"`
+	ExcludedReviewers *[]EntityId `json:"excluded_reviewers,omitempty"`
+
+	// PullRequestId Существующий PR, чьи ревьюверы учитываются как уже назначенные
+	PullRequestId *string `json:"pull_request_id,omitempty"`
+
+	// RequiredTags Навыки, нужные для ревью. По умолчанию - теги pull_request_id
+	RequiredTags  *[]string `json:"required_tags,omitempty"`
+	ReviewerCount *int      `json:"reviewer_count,omitempty"`
+}
+
+// PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
+type PostPullRequestReassignJSONBody struct {
+	// NewUserId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	NewUserId *EntityId `json:"new_user_id,omitempty"`
+
+	// OldUserId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	OldUserId      EntityId `json:"old_user_id"`
+	Override       *bool    `json:"override,omitempty"`
+	OverrideReason *string  `json:"override_reason,omitempty"`
+
+	// PullRequestId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	PullRequestId EntityId `json:"pull_request_id"`
+}
+
+// GetPullRequestRequiredTagsParams defines parameters for GetPullRequestRequiredTags.
+type GetPullRequestRequiredTagsParams struct {
+	// PullRequestId Идентификатор Pull Request
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
+}
+
+// GetPullRequestReviewersParams defines parameters for GetPullRequestReviewers.
+type GetPullRequestReviewersParams struct {
+	// PullRequestId Идентификатор Pull Request
+	PullRequestId PullRequestIdQuery `form:"pull_request_id" json:"pull_request_id"`
+}
+
+// PostRulesAddJSONBody defines parameters for PostRulesAdd.
+type PostRulesAddJSONBody struct {
+	// AuthorId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	AuthorId *EntityId                  `json:"author_id,omitempty"`
+	Effect   PostRulesAddJSONBodyEffect `json:"effect"`
+	Reason   *string                    `json:"reason,omitempty"`
+
+	// ReviewerId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	ReviewerId EntityId `json:"reviewer_id"`
+
+	// TeamName Имя команды, пользователя или Pull Request, непустое и до 255 символов
+	TeamName *EntityName `json:"team_name,omitempty"`
+}
+
+// PostRulesAddJSONBodyEffect defines parameters for PostRulesAdd.
+type PostRulesAddJSONBodyEffect string
+
+// PostRulesDeleteJSONBody defines parameters for PostRulesDelete.
+type PostRulesDeleteJSONBody struct {
+	RuleId int64 `json:"rule_id"`
+}
+
+// GetRulesListParams defines parameters for GetRulesList.
+type GetRulesListParams struct {
+	// UserId Только правила, где пользователь является ревьювером или автором
+	UserId *string `form:"user_id,omitempty" json:"user_id,omitempty"`
+}
+
+// GetStatisticsFairnessParams defines parameters for GetStatisticsFairness.
+type GetStatisticsFairnessParams struct {
+	// TeamName Только указанная команда
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+
+	// From Начало окна статистики включительно (RFC 3339)
+	From *WindowFromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна статистики, не включается (RFC 3339)
+	To        *WindowToQuery `form:"to,omitempty" json:"to,omitempty"`
+	Tolerance *float64       `form:"tolerance,omitempty" json:"tolerance,omitempty"`
+}
+
+// GetStatisticsPullRequestsParams defines parameters for GetStatisticsPullRequests.
+type GetStatisticsPullRequestsParams struct {
+	// From Начало окна статистики включительно (RFC 3339)
+	From *WindowFromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна статистики, не включается (RFC 3339)
+	To *WindowToQuery `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// GetStatisticsTeamsParams defines parameters for GetStatisticsTeams.
+type GetStatisticsTeamsParams struct {
+	// From Начало окна статистики включительно (RFC 3339)
+	From *WindowFromQuery `form:"from,omitempty" json:"from,omitempty"`
+
+	// To Конец окна статистики, не включается (RFC 3339)
+	To *WindowToQuery `form:"to,omitempty" json:"to,omitempty"`
+}
+
+// PostTeamDeactivateJSONBody defines parameters for PostTeamDeactivate.
+type PostTeamDeactivateJSONBody struct {
+	// TeamName Имя команды, пользователя или Pull Request, непустое и до 255 символов
+	TeamName EntityName `json:"team_name"`
+}
+
+// GetTeamGetParams defines parameters for GetTeamGet.
+type GetTeamGetParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostTeamSetMentorshipJSONBody defines parameters for PostTeamSetMentorship.
+type PostTeamSetMentorshipJSONBody struct {
+	Enabled bool `json:"enabled"`
+
+	// TeamName Имя команды, пользователя или Pull Request, непустое и до 255 символов
+	TeamName EntityName `json:"team_name"`
+}
+
+// PostUsersAddUnavailabilityJSONBody defines parameters for PostUsersAddUnavailability.
+type PostUsersAddUnavailabilityJSONBody struct {
+	EndsAt   time.Time `json:"ends_at"`
+	Reason   *string   `json:"reason,omitempty"`
+	StartsAt time.Time `json:"starts_at"`
+
+	// UserId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	UserId EntityId `json:"user_id"`
+}
+
+// GetUsersDashboardParams defines parameters for GetUsersDashboard.
+type GetUsersDashboardParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// PostUsersDeleteUnavailabilityJSONBody defines parameters for PostUsersDeleteUnavailability.
+type PostUsersDeleteUnavailabilityJSONBody struct {
+	Id int64 `json:"id"`
+}
+
+// GetUsersGetReviewParams defines parameters for GetUsersGetReview.
+type GetUsersGetReviewParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
+type PostUsersSetIsActiveJSONBody struct {
+	IsActive bool `json:"is_active"`
+
+	// UserId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	UserId EntityId `json:"user_id"`
+}
+
+// PostUsersSetSeniorityJSONBody defines parameters for PostUsersSetSeniority.
+type PostUsersSetSeniorityJSONBody struct {
+	// Seniority Уровень ревьювера для наставничества
+	Seniority Seniority `json:"seniority"`
+
+	// UserId Идентификатор пользователя или Pull Request, непустой и до 255 символов
+	UserId EntityId `json:"user_id"`
+}
+
+// GetUsersSkillsParams defines parameters for GetUsersSkills.
+type GetUsersSkillsParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
+// PutAdminPolicyJSONRequestBody defines body for PutAdminPolicy for application/json ContentType.
+type PutAdminPolicyJSONRequestBody = AssignmentPolicy
+
+// PostPullRequestAssignJSONRequestBody defines body for PostPullRequestAssign for application/json ContentType.
+type PostPullRequestAssignJSONRequestBody PostPullRequestAssignJSONBody
+
+// PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
+type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
+
+// PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
+type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
+
+// PostPullRequestPreviewReviewersJSONRequestBody defines body for PostPullRequestPreviewReviewers for application/json ContentType.
+type PostPullRequestPreviewReviewersJSONRequestBody PostPullRequestPreviewReviewersJSONBody
+
+// PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
+type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
+
+// PostPullRequestSetRequiredTagsJSONRequestBody defines body for PostPullRequestSetRequiredTags for application/json ContentType.
+type PostPullRequestSetRequiredTagsJSONRequestBody = PullRequestTags
+
+// PostRulesAddJSONRequestBody defines body for PostRulesAdd for application/json ContentType.
+type PostRulesAddJSONRequestBody PostRulesAddJSONBody
+
+// PostRulesDeleteJSONRequestBody defines body for PostRulesDelete for application/json ContentType.
+type PostRulesDeleteJSONRequestBody PostRulesDeleteJSONBody
+
+// PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
+type PostTeamAddJSONRequestBody = Team
+
+// PostTeamDeactivateJSONRequestBody defines body for PostTeamDeactivate for application/json ContentType.
+type PostTeamDeactivateJSONRequestBody PostTeamDeactivateJSONBody
+
+// PostTeamSetMentorshipJSONRequestBody defines body for PostTeamSetMentorship for application/json ContentType.
+type PostTeamSetMentorshipJSONRequestBody PostTeamSetMentorshipJSONBody
+
+// PostUsersAddUnavailabilityJSONRequestBody defines body for PostUsersAddUnavailability for application/json ContentType.
+type PostUsersAddUnavailabilityJSONRequestBody PostUsersAddUnavailabilityJSONBody
+
+// PostUsersDeleteUnavailabilityJSONRequestBody defines body for PostUsersDeleteUnavailability for application/json ContentType.
+type PostUsersDeleteUnavailabilityJSONRequestBody PostUsersDeleteUnavailabilityJSONBody
+
+// PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
+type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
+
+// PostUsersSetSeniorityJSONRequestBody defines body for PostUsersSetSeniority for application/json ContentType.
+type PostUsersSetSeniorityJSONRequestBody PostUsersSetSeniorityJSONBody
+
+// PostUsersSetSkillsJSONRequestBody defines body for PostUsersSetSkills for application/json ContentType.
+type PostUsersSetSkillsJSONRequestBody = UserSkills
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetAdminPolicy request
+	GetAdminPolicy(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminPolicyWithBody request with any body
+	PutAdminPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAdminPolicy(ctx context.Context, body PutAdminPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminWorkloadReconcile request
+	PostAdminWorkloadReconcile(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAnalyticsReviewTimes request
+	GetAnalyticsReviewTimes(ctx context.Context, params *GetAnalyticsReviewTimesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAnalyticsReviewerThroughput request
+	GetAnalyticsReviewerThroughput(ctx context.Context, params *GetAnalyticsReviewerThroughputParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAnalyticsTeamThroughput request
+	GetAnalyticsTeamThroughput(ctx context.Context, params *GetAnalyticsTeamThroughputParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAuditList request
+	GetAuditList(ctx context.Context, params *GetAuditListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEventsStream request
+	GetEventsStream(ctx context.Context, params *GetEventsStreamParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHealth request
+	GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHealthLive request
+	GetHealthLive(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetHealthReady request
+	GetHealthReady(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPullRequestAssignWithBody request with any body
+	PostPullRequestAssignWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestAssign(ctx context.Context, body PostPullRequestAssignJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPullRequestCreateWithBody request with any body
+	PostPullRequestCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestCreate(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPullRequestMergeWithBody request with any body
+	PostPullRequestMergeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestMerge(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPullRequestPreviewReviewersWithBody request with any body
+	PostPullRequestPreviewReviewersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestPreviewReviewers(ctx context.Context, body PostPullRequestPreviewReviewersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPullRequestReassignWithBody request with any body
+	PostPullRequestReassignWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestReassign(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPullRequestRequiredTags request
+	GetPullRequestRequiredTags(ctx context.Context, params *GetPullRequestRequiredTagsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPullRequestReviewers request
+	GetPullRequestReviewers(ctx context.Context, params *GetPullRequestReviewersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPullRequestSetRequiredTagsWithBody request with any body
+	PostPullRequestSetRequiredTagsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPullRequestSetRequiredTags(ctx context.Context, body PostPullRequestSetRequiredTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRulesAddWithBody request with any body
+	PostRulesAddWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRulesAdd(ctx context.Context, body PostRulesAddJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRulesDeleteWithBody request with any body
+	PostRulesDeleteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRulesDelete(ctx context.Context, body PostRulesDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRulesList request
+	GetRulesList(ctx context.Context, params *GetRulesListParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStatisticsAssignments request
+	GetStatisticsAssignments(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStatisticsFairness request
+	GetStatisticsFairness(ctx context.Context, params *GetStatisticsFairnessParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStatisticsPullRequests request
+	GetStatisticsPullRequests(ctx context.Context, params *GetStatisticsPullRequestsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStatisticsTeams request
+	GetStatisticsTeams(ctx context.Context, params *GetStatisticsTeamsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetStatisticsWorkload request
+	GetStatisticsWorkload(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTeamAddWithBody request with any body
+	PostTeamAddWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamAdd(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTeamDeactivateWithBody request with any body
+	PostTeamDeactivateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamDeactivate(ctx context.Context, body PostTeamDeactivateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetTeamGet request
+	GetTeamGet(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTeamSetMentorshipWithBody request with any body
+	PostTeamSetMentorshipWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTeamSetMentorship(ctx context.Context, body PostTeamSetMentorshipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersAddUnavailabilityWithBody request with any body
+	PostUsersAddUnavailabilityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersAddUnavailability(ctx context.Context, body PostUsersAddUnavailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersDashboard request
+	GetUsersDashboard(ctx context.Context, params *GetUsersDashboardParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersDeleteUnavailabilityWithBody request with any body
+	PostUsersDeleteUnavailabilityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersDeleteUnavailability(ctx context.Context, body PostUsersDeleteUnavailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersGetReview request
+	GetUsersGetReview(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersSetIsActiveWithBody request with any body
+	PostUsersSetIsActiveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersSetIsActive(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersSetSeniorityWithBody request with any body
+	PostUsersSetSeniorityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersSetSeniority(ctx context.Context, body PostUsersSetSeniorityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostUsersSetSkillsWithBody request with any body
+	PostUsersSetSkillsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostUsersSetSkills(ctx context.Context, body PostUsersSetSkillsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUsersSkills request
+	GetUsersSkills(ctx context.Context, params *GetUsersSkillsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetAdminPolicy(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminPolicyRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminPolicyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminPolicyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminPolicy(ctx context.Context, body PutAdminPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminPolicyRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminWorkloadReconcile(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminWorkloadReconcileRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAnalyticsReviewTimes(ctx context.Context, params *GetAnalyticsReviewTimesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAnalyticsReviewTimesRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAnalyticsReviewerThroughput(ctx context.Context, params *GetAnalyticsReviewerThroughputParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAnalyticsReviewerThroughputRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAnalyticsTeamThroughput(ctx context.Context, params *GetAnalyticsTeamThroughputParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAnalyticsTeamThroughputRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAuditList(ctx context.Context, params *GetAuditListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuditListRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEventsStream(ctx context.Context, params *GetEventsStreamParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEventsStreamRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHealth(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHealthRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHealthLive(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHealthLiveRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetHealthReady(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetHealthReadyRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestAssignWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestAssignRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestAssign(ctx context.Context, body PostPullRequestAssignJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestAssignRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestCreateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestCreateRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestCreate(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestCreateRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestMergeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestMergeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestMerge(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestMergeRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestPreviewReviewersWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestPreviewReviewersRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestPreviewReviewers(ctx context.Context, body PostPullRequestPreviewReviewersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestPreviewReviewersRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestReassignWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestReassignRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestReassign(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestReassignRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPullRequestRequiredTags(ctx context.Context, params *GetPullRequestRequiredTagsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPullRequestRequiredTagsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPullRequestReviewers(ctx context.Context, params *GetPullRequestReviewersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPullRequestReviewersRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestSetRequiredTagsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestSetRequiredTagsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPullRequestSetRequiredTags(ctx context.Context, body PostPullRequestSetRequiredTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPullRequestSetRequiredTagsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRulesAddWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRulesAddRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRulesAdd(ctx context.Context, body PostRulesAddJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRulesAddRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRulesDeleteWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRulesDeleteRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRulesDelete(ctx context.Context, body PostRulesDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRulesDeleteRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRulesList(ctx context.Context, params *GetRulesListParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRulesListRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStatisticsAssignments(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatisticsAssignmentsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStatisticsFairness(ctx context.Context, params *GetStatisticsFairnessParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatisticsFairnessRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStatisticsPullRequests(ctx context.Context, params *GetStatisticsPullRequestsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatisticsPullRequestsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStatisticsTeams(ctx context.Context, params *GetStatisticsTeamsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatisticsTeamsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetStatisticsWorkload(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetStatisticsWorkloadRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTeamAddWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamAddRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTeamAdd(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamAddRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTeamDeactivateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamDeactivateRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTeamDeactivate(ctx context.Context, body PostTeamDeactivateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamDeactivateRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetTeamGet(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetTeamGetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTeamSetMentorshipWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetMentorshipRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTeamSetMentorship(ctx context.Context, body PostTeamSetMentorshipJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTeamSetMentorshipRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersAddUnavailabilityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersAddUnavailabilityRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersAddUnavailability(ctx context.Context, body PostUsersAddUnavailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersAddUnavailabilityRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsersDashboard(ctx context.Context, params *GetUsersDashboardParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersDashboardRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersDeleteUnavailabilityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersDeleteUnavailabilityRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersDeleteUnavailability(ctx context.Context, body PostUsersDeleteUnavailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersDeleteUnavailabilityRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsersGetReview(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersGetReviewRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersSetIsActiveWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetIsActiveRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersSetIsActive(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetIsActiveRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersSetSeniorityWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetSeniorityRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersSetSeniority(ctx context.Context, body PostUsersSetSeniorityJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetSeniorityRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersSetSkillsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetSkillsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostUsersSetSkills(ctx context.Context, body PostUsersSetSkillsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostUsersSetSkillsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUsersSkills(ctx context.Context, params *GetUsersSkillsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUsersSkillsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetAdminPolicyRequest generates requests for GetAdminPolicy
+func NewGetAdminPolicyRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/policy")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminPolicyRequest calls the generic PutAdminPolicy builder with application/json body
+func NewPutAdminPolicyRequest(server string, body PutAdminPolicyJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAdminPolicyRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPutAdminPolicyRequestWithBody generates requests for PutAdminPolicy with any type of body
+func NewPutAdminPolicyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/policy")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostAdminWorkloadReconcileRequest generates requests for PostAdminWorkloadReconcile
+func NewPostAdminWorkloadReconcileRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/workload/reconcile")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAnalyticsReviewTimesRequest generates requests for GetAnalyticsReviewTimes
+func NewGetAnalyticsReviewTimesRequest(server string, params *GetAnalyticsReviewTimesParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/analytics/reviewTimes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Bucket != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bucket", runtime.ParamLocationQuery, *params.Bucket); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAnalyticsReviewerThroughputRequest generates requests for GetAnalyticsReviewerThroughput
+func NewGetAnalyticsReviewerThroughputRequest(server string, params *GetAnalyticsReviewerThroughputParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/analytics/reviewerThroughput")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Bucket != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bucket", runtime.ParamLocationQuery, *params.Bucket); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAnalyticsTeamThroughputRequest generates requests for GetAnalyticsTeamThroughput
+func NewGetAnalyticsTeamThroughputRequest(server string, params *GetAnalyticsTeamThroughputParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/analytics/teamThroughput")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, params.From); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, params.To); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Bucket != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "bucket", runtime.ParamLocationQuery, *params.Bucket); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAuditListRequest generates requests for GetAuditList
+func NewGetAuditListRequest(server string, params *GetAuditListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/audit/list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.PullRequestId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pull_request_id", runtime.ParamLocationQuery, *params.PullRequestId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetEventsStreamRequest generates requests for GetEventsStream
+func NewGetEventsStreamRequest(server string, params *GetEventsStreamParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/events/stream")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.TeamName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, *params.TeamName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.PullRequestId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pull_request_id", runtime.ParamLocationQuery, *params.PullRequestId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+
+		if params.LastEventID != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "Last-Event-ID", runtime.ParamLocationHeader, *params.LastEventID)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("Last-Event-ID", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetHealthRequest generates requests for GetHealth
+func NewGetHealthRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/health")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetHealthLiveRequest generates requests for GetHealthLive
+func NewGetHealthLiveRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/health/live")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetHealthReadyRequest generates requests for GetHealthReady
+func NewGetHealthReadyRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/health/ready")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostPullRequestAssignRequest calls the generic PostPullRequestAssign builder with application/json body
+func NewPostPullRequestAssignRequest(server string, body PostPullRequestAssignJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestAssignRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestAssignRequestWithBody generates requests for PostPullRequestAssign with any type of body
+func NewPostPullRequestAssignRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/assign")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPullRequestCreateRequest calls the generic PostPullRequestCreate builder with application/json body
+func NewPostPullRequestCreateRequest(server string, body PostPullRequestCreateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestCreateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestCreateRequestWithBody generates requests for PostPullRequestCreate with any type of body
+func NewPostPullRequestCreateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/create")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPullRequestMergeRequest calls the generic PostPullRequestMerge builder with application/json body
+func NewPostPullRequestMergeRequest(server string, body PostPullRequestMergeJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestMergeRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestMergeRequestWithBody generates requests for PostPullRequestMerge with any type of body
+func NewPostPullRequestMergeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/merge")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPullRequestPreviewReviewersRequest calls the generic PostPullRequestPreviewReviewers builder with application/json body
+func NewPostPullRequestPreviewReviewersRequest(server string, body PostPullRequestPreviewReviewersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestPreviewReviewersRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestPreviewReviewersRequestWithBody generates requests for PostPullRequestPreviewReviewers with any type of body
+func NewPostPullRequestPreviewReviewersRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/previewReviewers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostPullRequestReassignRequest calls the generic PostPullRequestReassign builder with application/json body
+func NewPostPullRequestReassignRequest(server string, body PostPullRequestReassignJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestReassignRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestReassignRequestWithBody generates requests for PostPullRequestReassign with any type of body
+func NewPostPullRequestReassignRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/reassign")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPullRequestRequiredTagsRequest generates requests for GetPullRequestRequiredTags
+func NewGetPullRequestRequiredTagsRequest(server string, params *GetPullRequestRequiredTagsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/requiredTags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pull_request_id", runtime.ParamLocationQuery, params.PullRequestId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetPullRequestReviewersRequest generates requests for GetPullRequestReviewers
+func NewGetPullRequestReviewersRequest(server string, params *GetPullRequestReviewersParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/reviewers")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "pull_request_id", runtime.ParamLocationQuery, params.PullRequestId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostPullRequestSetRequiredTagsRequest calls the generic PostPullRequestSetRequiredTags builder with application/json body
+func NewPostPullRequestSetRequiredTagsRequest(server string, body PostPullRequestSetRequiredTagsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostPullRequestSetRequiredTagsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostPullRequestSetRequiredTagsRequestWithBody generates requests for PostPullRequestSetRequiredTags with any type of body
+func NewPostPullRequestSetRequiredTagsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/pullRequest/setRequiredTags")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostRulesAddRequest calls the generic PostRulesAdd builder with application/json body
+func NewPostRulesAddRequest(server string, body PostRulesAddJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRulesAddRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostRulesAddRequestWithBody generates requests for PostRulesAdd with any type of body
+func NewPostRulesAddRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rules/add")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostRulesDeleteRequest calls the generic PostRulesDelete builder with application/json body
+func NewPostRulesDeleteRequest(server string, body PostRulesDeleteJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRulesDeleteRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostRulesDeleteRequestWithBody generates requests for PostRulesDelete with any type of body
+func NewPostRulesDeleteRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rules/delete")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetRulesListRequest generates requests for GetRulesList
+func NewGetRulesListRequest(server string, params *GetRulesListParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/rules/list")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStatisticsAssignmentsRequest generates requests for GetStatisticsAssignments
+func NewGetStatisticsAssignmentsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/statistics/assignments")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStatisticsFairnessRequest generates requests for GetStatisticsFairness
+func NewGetStatisticsFairnessRequest(server string, params *GetStatisticsFairnessParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/statistics/fairness")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.TeamName != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, *params.TeamName); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Tolerance != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "tolerance", runtime.ParamLocationQuery, *params.Tolerance); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStatisticsPullRequestsRequest generates requests for GetStatisticsPullRequests
+func NewGetStatisticsPullRequestsRequest(server string, params *GetStatisticsPullRequestsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/statistics/pullRequests")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStatisticsTeamsRequest generates requests for GetStatisticsTeams
+func NewGetStatisticsTeamsRequest(server string, params *GetStatisticsTeamsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/statistics/teams")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.From != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "from", runtime.ParamLocationQuery, *params.From); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.To != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "to", runtime.ParamLocationQuery, *params.To); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetStatisticsWorkloadRequest generates requests for GetStatisticsWorkload
+func NewGetStatisticsWorkloadRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/statistics/workload")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostTeamAddRequest calls the generic PostTeamAdd builder with application/json body
+func NewPostTeamAddRequest(server string, body PostTeamAddJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamAddRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamAddRequestWithBody generates requests for PostTeamAdd with any type of body
+func NewPostTeamAddRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/add")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostTeamDeactivateRequest calls the generic PostTeamDeactivate builder with application/json body
+func NewPostTeamDeactivateRequest(server string, body PostTeamDeactivateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamDeactivateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamDeactivateRequestWithBody generates requests for PostTeamDeactivate with any type of body
+func NewPostTeamDeactivateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/deactivate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetTeamGetRequest generates requests for GetTeamGet
+func NewGetTeamGetRequest(server string, params *GetTeamGetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/get")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "team_name", runtime.ParamLocationQuery, params.TeamName); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostTeamSetMentorshipRequest calls the generic PostTeamSetMentorship builder with application/json body
+func NewPostTeamSetMentorshipRequest(server string, body PostTeamSetMentorshipJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostTeamSetMentorshipRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostTeamSetMentorshipRequestWithBody generates requests for PostTeamSetMentorship with any type of body
+func NewPostTeamSetMentorshipRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/team/setMentorship")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostUsersAddUnavailabilityRequest calls the generic PostUsersAddUnavailability builder with application/json body
+func NewPostUsersAddUnavailabilityRequest(server string, body PostUsersAddUnavailabilityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersAddUnavailabilityRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersAddUnavailabilityRequestWithBody generates requests for PostUsersAddUnavailability with any type of body
+func NewPostUsersAddUnavailabilityRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/addUnavailability")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUsersDashboardRequest generates requests for GetUsersDashboard
+func NewGetUsersDashboardRequest(server string, params *GetUsersDashboardParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/dashboard")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostUsersDeleteUnavailabilityRequest calls the generic PostUsersDeleteUnavailability builder with application/json body
+func NewPostUsersDeleteUnavailabilityRequest(server string, body PostUsersDeleteUnavailabilityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersDeleteUnavailabilityRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersDeleteUnavailabilityRequestWithBody generates requests for PostUsersDeleteUnavailability with any type of body
+func NewPostUsersDeleteUnavailabilityRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/deleteUnavailability")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUsersGetReviewRequest generates requests for GetUsersGetReview
+func NewGetUsersGetReviewRequest(server string, params *GetUsersGetReviewParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/getReview")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostUsersSetIsActiveRequest calls the generic PostUsersSetIsActive builder with application/json body
+func NewPostUsersSetIsActiveRequest(server string, body PostUsersSetIsActiveJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersSetIsActiveRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersSetIsActiveRequestWithBody generates requests for PostUsersSetIsActive with any type of body
+func NewPostUsersSetIsActiveRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/setIsActive")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostUsersSetSeniorityRequest calls the generic PostUsersSetSeniority builder with application/json body
+func NewPostUsersSetSeniorityRequest(server string, body PostUsersSetSeniorityJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersSetSeniorityRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersSetSeniorityRequestWithBody generates requests for PostUsersSetSeniority with any type of body
+func NewPostUsersSetSeniorityRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/setSeniority")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostUsersSetSkillsRequest calls the generic PostUsersSetSkills builder with application/json body
+func NewPostUsersSetSkillsRequest(server string, body PostUsersSetSkillsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostUsersSetSkillsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostUsersSetSkillsRequestWithBody generates requests for PostUsersSetSkills with any type of body
+func NewPostUsersSetSkillsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/setSkills")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUsersSkillsRequest generates requests for GetUsersSkills
+func NewGetUsersSkillsRequest(server string, params *GetUsersSkillsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/users/skills")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, params.UserId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAdminPolicyWithResponse request
+	GetAdminPolicyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAdminPolicyResponse, error)
+
+	// PutAdminPolicyWithBodyWithResponse request with any body
+	PutAdminPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminPolicyResponse, error)
+
+	PutAdminPolicyWithResponse(ctx context.Context, body PutAdminPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminPolicyResponse, error)
+
+	// PostAdminWorkloadReconcileWithResponse request
+	PostAdminWorkloadReconcileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostAdminWorkloadReconcileResponse, error)
+
+	// GetAnalyticsReviewTimesWithResponse request
+	GetAnalyticsReviewTimesWithResponse(ctx context.Context, params *GetAnalyticsReviewTimesParams, reqEditors ...RequestEditorFn) (*GetAnalyticsReviewTimesResponse, error)
+
+	// GetAnalyticsReviewerThroughputWithResponse request
+	GetAnalyticsReviewerThroughputWithResponse(ctx context.Context, params *GetAnalyticsReviewerThroughputParams, reqEditors ...RequestEditorFn) (*GetAnalyticsReviewerThroughputResponse, error)
+
+	// GetAnalyticsTeamThroughputWithResponse request
+	GetAnalyticsTeamThroughputWithResponse(ctx context.Context, params *GetAnalyticsTeamThroughputParams, reqEditors ...RequestEditorFn) (*GetAnalyticsTeamThroughputResponse, error)
+
+	// GetAuditListWithResponse request
+	GetAuditListWithResponse(ctx context.Context, params *GetAuditListParams, reqEditors ...RequestEditorFn) (*GetAuditListResponse, error)
+
+	// GetEventsStreamWithResponse request
+	GetEventsStreamWithResponse(ctx context.Context, params *GetEventsStreamParams, reqEditors ...RequestEditorFn) (*GetEventsStreamResponse, error)
+
+	// GetHealthWithResponse request
+	GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error)
+
+	// GetHealthLiveWithResponse request
+	GetHealthLiveWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthLiveResponse, error)
+
+	// GetHealthReadyWithResponse request
+	GetHealthReadyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthReadyResponse, error)
+
+	// PostPullRequestAssignWithBodyWithResponse request with any body
+	PostPullRequestAssignWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestAssignResponse, error)
+
+	PostPullRequestAssignWithResponse(ctx context.Context, body PostPullRequestAssignJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestAssignResponse, error)
+
+	// PostPullRequestCreateWithBodyWithResponse request with any body
+	PostPullRequestCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error)
+
+	PostPullRequestCreateWithResponse(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error)
+
+	// PostPullRequestMergeWithBodyWithResponse request with any body
+	PostPullRequestMergeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error)
+
+	PostPullRequestMergeWithResponse(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error)
+
+	// PostPullRequestPreviewReviewersWithBodyWithResponse request with any body
+	PostPullRequestPreviewReviewersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestPreviewReviewersResponse, error)
+
+	PostPullRequestPreviewReviewersWithResponse(ctx context.Context, body PostPullRequestPreviewReviewersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestPreviewReviewersResponse, error)
+
+	// PostPullRequestReassignWithBodyWithResponse request with any body
+	PostPullRequestReassignWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error)
+
+	PostPullRequestReassignWithResponse(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error)
+
+	// GetPullRequestRequiredTagsWithResponse request
+	GetPullRequestRequiredTagsWithResponse(ctx context.Context, params *GetPullRequestRequiredTagsParams, reqEditors ...RequestEditorFn) (*GetPullRequestRequiredTagsResponse, error)
+
+	// GetPullRequestReviewersWithResponse request
+	GetPullRequestReviewersWithResponse(ctx context.Context, params *GetPullRequestReviewersParams, reqEditors ...RequestEditorFn) (*GetPullRequestReviewersResponse, error)
+
+	// PostPullRequestSetRequiredTagsWithBodyWithResponse request with any body
+	PostPullRequestSetRequiredTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestSetRequiredTagsResponse, error)
+
+	PostPullRequestSetRequiredTagsWithResponse(ctx context.Context, body PostPullRequestSetRequiredTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestSetRequiredTagsResponse, error)
+
+	// PostRulesAddWithBodyWithResponse request with any body
+	PostRulesAddWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRulesAddResponse, error)
+
+	PostRulesAddWithResponse(ctx context.Context, body PostRulesAddJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRulesAddResponse, error)
+
+	// PostRulesDeleteWithBodyWithResponse request with any body
+	PostRulesDeleteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRulesDeleteResponse, error)
+
+	PostRulesDeleteWithResponse(ctx context.Context, body PostRulesDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRulesDeleteResponse, error)
+
+	// GetRulesListWithResponse request
+	GetRulesListWithResponse(ctx context.Context, params *GetRulesListParams, reqEditors ...RequestEditorFn) (*GetRulesListResponse, error)
+
+	// GetStatisticsAssignmentsWithResponse request
+	GetStatisticsAssignmentsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatisticsAssignmentsResponse, error)
+
+	// GetStatisticsFairnessWithResponse request
+	GetStatisticsFairnessWithResponse(ctx context.Context, params *GetStatisticsFairnessParams, reqEditors ...RequestEditorFn) (*GetStatisticsFairnessResponse, error)
+
+	// GetStatisticsPullRequestsWithResponse request
+	GetStatisticsPullRequestsWithResponse(ctx context.Context, params *GetStatisticsPullRequestsParams, reqEditors ...RequestEditorFn) (*GetStatisticsPullRequestsResponse, error)
+
+	// GetStatisticsTeamsWithResponse request
+	GetStatisticsTeamsWithResponse(ctx context.Context, params *GetStatisticsTeamsParams, reqEditors ...RequestEditorFn) (*GetStatisticsTeamsResponse, error)
+
+	// GetStatisticsWorkloadWithResponse request
+	GetStatisticsWorkloadWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatisticsWorkloadResponse, error)
+
+	// PostTeamAddWithBodyWithResponse request with any body
+	PostTeamAddWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error)
+
+	PostTeamAddWithResponse(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error)
+
+	// PostTeamDeactivateWithBodyWithResponse request with any body
+	PostTeamDeactivateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamDeactivateResponse, error)
+
+	PostTeamDeactivateWithResponse(ctx context.Context, body PostTeamDeactivateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamDeactivateResponse, error)
+
+	// GetTeamGetWithResponse request
+	GetTeamGetWithResponse(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*GetTeamGetResponse, error)
+
+	// PostTeamSetMentorshipWithBodyWithResponse request with any body
+	PostTeamSetMentorshipWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetMentorshipResponse, error)
+
+	PostTeamSetMentorshipWithResponse(ctx context.Context, body PostTeamSetMentorshipJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetMentorshipResponse, error)
+
+	// PostUsersAddUnavailabilityWithBodyWithResponse request with any body
+	PostUsersAddUnavailabilityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersAddUnavailabilityResponse, error)
+
+	PostUsersAddUnavailabilityWithResponse(ctx context.Context, body PostUsersAddUnavailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersAddUnavailabilityResponse, error)
+
+	// GetUsersDashboardWithResponse request
+	GetUsersDashboardWithResponse(ctx context.Context, params *GetUsersDashboardParams, reqEditors ...RequestEditorFn) (*GetUsersDashboardResponse, error)
+
+	// PostUsersDeleteUnavailabilityWithBodyWithResponse request with any body
+	PostUsersDeleteUnavailabilityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersDeleteUnavailabilityResponse, error)
+
+	PostUsersDeleteUnavailabilityWithResponse(ctx context.Context, body PostUsersDeleteUnavailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersDeleteUnavailabilityResponse, error)
+
+	// GetUsersGetReviewWithResponse request
+	GetUsersGetReviewWithResponse(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*GetUsersGetReviewResponse, error)
+
+	// PostUsersSetIsActiveWithBodyWithResponse request with any body
+	PostUsersSetIsActiveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error)
+
+	PostUsersSetIsActiveWithResponse(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error)
+
+	// PostUsersSetSeniorityWithBodyWithResponse request with any body
+	PostUsersSetSeniorityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetSeniorityResponse, error)
+
+	PostUsersSetSeniorityWithResponse(ctx context.Context, body PostUsersSetSeniorityJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetSeniorityResponse, error)
+
+	// PostUsersSetSkillsWithBodyWithResponse request with any body
+	PostUsersSetSkillsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetSkillsResponse, error)
+
+	PostUsersSetSkillsWithResponse(ctx context.Context, body PostUsersSetSkillsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetSkillsResponse, error)
+
+	// GetUsersSkillsWithResponse request
+	GetUsersSkillsWithResponse(ctx context.Context, params *GetUsersSkillsParams, reqEditors ...RequestEditorFn) (*GetUsersSkillsResponse, error)
+}
+
+type GetAdminPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AssignmentPolicySnapshot
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminPolicyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AssignmentPolicySnapshot
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON413      *PayloadTooLarge
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminPolicyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminPolicyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminWorkloadReconcileResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *WorkloadReconcileResult
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminWorkloadReconcileResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminWorkloadReconcileResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAnalyticsReviewTimesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReviewTimeSeries
+	JSON400      *ErrorResponse
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAnalyticsReviewTimesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAnalyticsReviewTimesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAnalyticsReviewerThroughputResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReviewerThroughputSeries
+	JSON400      *ErrorResponse
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAnalyticsReviewerThroughputResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAnalyticsReviewerThroughputResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAnalyticsTeamThroughputResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamThroughputSeries
+	JSON400      *ErrorResponse
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAnalyticsTeamThroughputResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAnalyticsTeamThroughputResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuditListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Entries []AuditEntry `json:"entries"`
+	}
+	JSON429 *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuditListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuditListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEventsStreamResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEventsStreamResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEventsStreamResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHealthResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Status    *string    `json:"status,omitempty"`
+		Timestamp *time.Time `json:"timestamp,omitempty"`
+	}
+	JSON503 *struct {
+		Error  *string `json:"error,omitempty"`
+		Status *string `json:"status,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHealthResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHealthLiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// Status ok - компонент работает нормально;
+		// degraded - компонент работает, но с проблемами (трафик принимается);
+		// down - компонент недоступен (трафик не принимается)
+		Status    HealthStatus `json:"status"`
+		Timestamp time.Time    `json:"timestamp"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHealthLiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthLiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetHealthReadyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *HealthReport
+	JSON503      *HealthReport
+}
+
+// Status returns HTTPResponse.Status
+func (r GetHealthReadyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetHealthReadyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestAssignResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReviewerAssignment
+	JSON400      *ErrorResponse
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON413      *PayloadTooLarge
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestAssignResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestAssignResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestCreateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Pr *PullRequest `json:"pr,omitempty"`
+	}
+	JSON400 *ErrorResponse
+	JSON404 *ErrorResponse
+	JSON409 *ErrorResponse
+	JSON413 *PayloadTooLarge
+	JSON429 *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestCreateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestCreateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestMergeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Pr *PullRequest `json:"pr,omitempty"`
+	}
+	JSON404 *ErrorResponse
+	JSON413 *PayloadTooLarge
+	JSON429 *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestMergeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestMergeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestPreviewReviewersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReviewerPreview
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON413      *PayloadTooLarge
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestPreviewReviewersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestPreviewReviewersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestReassignResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Pr PullRequest `json:"pr"`
+
+		// ReplacedBy user_id нового ревьювера
+		ReplacedBy string `json:"replaced_by"`
+	}
+	JSON403 *Forbidden
+	JSON404 *ErrorResponse
+	JSON409 *ErrorResponse
+	JSON413 *PayloadTooLarge
+	JSON429 *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestReassignResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestReassignResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPullRequestRequiredTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PullRequestTags
+	JSON404      *ErrorResponse
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPullRequestRequiredTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPullRequestRequiredTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPullRequestReviewersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		PullRequestId string               `json:"pull_request_id"`
+		Reviewers     []ReviewerAssignment `json:"reviewers"`
+	}
+	JSON404 *ErrorResponse
+	JSON429 *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPullRequestReviewersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPullRequestReviewersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPullRequestSetRequiredTagsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PullRequestTags
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON413      *PayloadTooLarge
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPullRequestSetRequiredTagsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPullRequestSetRequiredTagsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostRulesAddResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ReviewerRule
+	JSON400      *ErrorResponse
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+	JSON409      *ErrorResponse
+	JSON413      *PayloadTooLarge
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRulesAddResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRulesAddResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostRulesDeleteResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ReviewerRule
+	JSON401      *Unauthorized
+	JSON403      *Forbidden
+	JSON404      *ErrorResponse
+	JSON413      *PayloadTooLarge
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRulesDeleteResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRulesDeleteResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRulesListResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Rules []ReviewerRule `json:"rules"`
+	}
+	JSON429 *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRulesListResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRulesListResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatisticsAssignmentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Statistics *[]struct {
+			MergedPrs        *int    `json:"merged_prs,omitempty"`
+			OpenPrs          *int    `json:"open_prs,omitempty"`
+			TeamName         *string `json:"team_name,omitempty"`
+			TotalAssignments *int    `json:"total_assignments,omitempty"`
+			UserId           *string `json:"user_id,omitempty"`
+			Username         *string `json:"username,omitempty"`
+		} `json:"statistics,omitempty"`
+	}
+	JSON429 *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatisticsAssignmentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatisticsAssignmentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatisticsFairnessResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *FairnessReport
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatisticsFairnessResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatisticsFairnessResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatisticsPullRequestsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PullRequestStatsList
+	JSON400      *ErrorResponse
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatisticsPullRequestsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatisticsPullRequestsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatisticsTeamsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TeamStatsList
+	JSON400      *ErrorResponse
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatisticsTeamsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatisticsTeamsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetStatisticsWorkloadResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Workload *[]struct {
+			IsActive         *bool   `json:"is_active,omitempty"`
+			OpenReviewsCount *int    `json:"open_reviews_count,omitempty"`
+			TeamName         *string `json:"team_name,omitempty"`
+			UserId           *string `json:"user_id,omitempty"`
+			Username         *string `json:"username,omitempty"`
+		} `json:"workload,omitempty"`
+	}
+	JSON429 *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r GetStatisticsWorkloadResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetStatisticsWorkloadResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamAddResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *struct {
+		Team *Team `json:"team,omitempty"`
+	}
+	JSON400 *ErrorResponse
+	JSON413 *PayloadTooLarge
+	JSON429 *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamAddResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamAddResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamDeactivateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		// DeactivatedUsers Количество деактивированных пользователей
+		DeactivatedUsers *int `json:"deactivated_users,omitempty"`
+
+		// DurationMs Время выполнения операции в миллисекундах
+		DurationMs *int `json:"duration_ms,omitempty"`
+
+		// ReassignedPrs Количество переназначенных PR
+		ReassignedPrs *int `json:"reassigned_prs,omitempty"`
+	}
+	JSON404 *ErrorResponse
+	JSON413 *PayloadTooLarge
+	JSON429 *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamDeactivateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamDeactivateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetTeamGetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Team
+	JSON404      *ErrorResponse
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r GetTeamGetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetTeamGetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTeamSetMentorshipResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		MentorshipEnabled bool   `json:"mentorship_enabled"`
+		TeamName          string `json:"team_name"`
+	}
+	JSON404 *ErrorResponse
+	JSON413 *PayloadTooLarge
+	JSON429 *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTeamSetMentorshipResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTeamSetMentorshipResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersAddUnavailabilityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Unavailability
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON413      *PayloadTooLarge
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersAddUnavailabilityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersAddUnavailabilityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersDashboardResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserDashboard
+	JSON404      *ErrorResponse
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersDashboardResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersDashboardResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersDeleteUnavailabilityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Unavailability
+	JSON404      *ErrorResponse
+	JSON413      *PayloadTooLarge
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersDeleteUnavailabilityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersDeleteUnavailabilityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersGetReviewResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		PullRequests []PullRequestShort `json:"pull_requests"`
+		UserId       string             `json:"user_id"`
+	}
+	JSON429 *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersGetReviewResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersGetReviewResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersSetIsActiveResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		User *User `json:"user,omitempty"`
+	}
+	JSON404 *ErrorResponse
+	JSON413 *PayloadTooLarge
+	JSON429 *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersSetIsActiveResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersSetIsActiveResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersSetSeniorityResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON413      *PayloadTooLarge
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersSetSeniorityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersSetSeniorityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostUsersSetSkillsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserSkills
+	JSON400      *ErrorResponse
+	JSON404      *ErrorResponse
+	JSON413      *PayloadTooLarge
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r PostUsersSetSkillsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostUsersSetSkillsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUsersSkillsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserSkills
+	JSON404      *ErrorResponse
+	JSON429      *TooManyRequests
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUsersSkillsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUsersSkillsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetAdminPolicyWithResponse request returning *GetAdminPolicyResponse
+func (c *ClientWithResponses) GetAdminPolicyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAdminPolicyResponse, error) {
+	rsp, err := c.GetAdminPolicy(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminPolicyResponse(rsp)
+}
+
+// PutAdminPolicyWithBodyWithResponse request with arbitrary body returning *PutAdminPolicyResponse
+func (c *ClientWithResponses) PutAdminPolicyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminPolicyResponse, error) {
+	rsp, err := c.PutAdminPolicyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminPolicyResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminPolicyWithResponse(ctx context.Context, body PutAdminPolicyJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminPolicyResponse, error) {
+	rsp, err := c.PutAdminPolicy(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminPolicyResponse(rsp)
+}
+
+// PostAdminWorkloadReconcileWithResponse request returning *PostAdminWorkloadReconcileResponse
+func (c *ClientWithResponses) PostAdminWorkloadReconcileWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostAdminWorkloadReconcileResponse, error) {
+	rsp, err := c.PostAdminWorkloadReconcile(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminWorkloadReconcileResponse(rsp)
+}
+
+// GetAnalyticsReviewTimesWithResponse request returning *GetAnalyticsReviewTimesResponse
+func (c *ClientWithResponses) GetAnalyticsReviewTimesWithResponse(ctx context.Context, params *GetAnalyticsReviewTimesParams, reqEditors ...RequestEditorFn) (*GetAnalyticsReviewTimesResponse, error) {
+	rsp, err := c.GetAnalyticsReviewTimes(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAnalyticsReviewTimesResponse(rsp)
+}
+
+// GetAnalyticsReviewerThroughputWithResponse request returning *GetAnalyticsReviewerThroughputResponse
+func (c *ClientWithResponses) GetAnalyticsReviewerThroughputWithResponse(ctx context.Context, params *GetAnalyticsReviewerThroughputParams, reqEditors ...RequestEditorFn) (*GetAnalyticsReviewerThroughputResponse, error) {
+	rsp, err := c.GetAnalyticsReviewerThroughput(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAnalyticsReviewerThroughputResponse(rsp)
+}
+
+// GetAnalyticsTeamThroughputWithResponse request returning *GetAnalyticsTeamThroughputResponse
+func (c *ClientWithResponses) GetAnalyticsTeamThroughputWithResponse(ctx context.Context, params *GetAnalyticsTeamThroughputParams, reqEditors ...RequestEditorFn) (*GetAnalyticsTeamThroughputResponse, error) {
+	rsp, err := c.GetAnalyticsTeamThroughput(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAnalyticsTeamThroughputResponse(rsp)
+}
+
+// GetAuditListWithResponse request returning *GetAuditListResponse
+func (c *ClientWithResponses) GetAuditListWithResponse(ctx context.Context, params *GetAuditListParams, reqEditors ...RequestEditorFn) (*GetAuditListResponse, error) {
+	rsp, err := c.GetAuditList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuditListResponse(rsp)
+}
+
+// GetEventsStreamWithResponse request returning *GetEventsStreamResponse
+func (c *ClientWithResponses) GetEventsStreamWithResponse(ctx context.Context, params *GetEventsStreamParams, reqEditors ...RequestEditorFn) (*GetEventsStreamResponse, error) {
+	rsp, err := c.GetEventsStream(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEventsStreamResponse(rsp)
+}
+
+// GetHealthWithResponse request returning *GetHealthResponse
+func (c *ClientWithResponses) GetHealthWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthResponse, error) {
+	rsp, err := c.GetHealth(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHealthResponse(rsp)
+}
+
+// GetHealthLiveWithResponse request returning *GetHealthLiveResponse
+func (c *ClientWithResponses) GetHealthLiveWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthLiveResponse, error) {
+	rsp, err := c.GetHealthLive(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHealthLiveResponse(rsp)
+}
+
+// GetHealthReadyWithResponse request returning *GetHealthReadyResponse
+func (c *ClientWithResponses) GetHealthReadyWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetHealthReadyResponse, error) {
+	rsp, err := c.GetHealthReady(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetHealthReadyResponse(rsp)
+}
+
+// PostPullRequestAssignWithBodyWithResponse request with arbitrary body returning *PostPullRequestAssignResponse
+func (c *ClientWithResponses) PostPullRequestAssignWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestAssignResponse, error) {
+	rsp, err := c.PostPullRequestAssignWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestAssignResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestAssignWithResponse(ctx context.Context, body PostPullRequestAssignJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestAssignResponse, error) {
+	rsp, err := c.PostPullRequestAssign(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestAssignResponse(rsp)
+}
+
+// PostPullRequestCreateWithBodyWithResponse request with arbitrary body returning *PostPullRequestCreateResponse
+func (c *ClientWithResponses) PostPullRequestCreateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error) {
+	rsp, err := c.PostPullRequestCreateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestCreateResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestCreateWithResponse(ctx context.Context, body PostPullRequestCreateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestCreateResponse, error) {
+	rsp, err := c.PostPullRequestCreate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestCreateResponse(rsp)
+}
+
+// PostPullRequestMergeWithBodyWithResponse request with arbitrary body returning *PostPullRequestMergeResponse
+func (c *ClientWithResponses) PostPullRequestMergeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error) {
+	rsp, err := c.PostPullRequestMergeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestMergeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestMergeWithResponse(ctx context.Context, body PostPullRequestMergeJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestMergeResponse, error) {
+	rsp, err := c.PostPullRequestMerge(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestMergeResponse(rsp)
+}
+
+// PostPullRequestPreviewReviewersWithBodyWithResponse request with arbitrary body returning *PostPullRequestPreviewReviewersResponse
+func (c *ClientWithResponses) PostPullRequestPreviewReviewersWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestPreviewReviewersResponse, error) {
+	rsp, err := c.PostPullRequestPreviewReviewersWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestPreviewReviewersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestPreviewReviewersWithResponse(ctx context.Context, body PostPullRequestPreviewReviewersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestPreviewReviewersResponse, error) {
+	rsp, err := c.PostPullRequestPreviewReviewers(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestPreviewReviewersResponse(rsp)
+}
+
+// PostPullRequestReassignWithBodyWithResponse request with arbitrary body returning *PostPullRequestReassignResponse
+func (c *ClientWithResponses) PostPullRequestReassignWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error) {
+	rsp, err := c.PostPullRequestReassignWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestReassignResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestReassignWithResponse(ctx context.Context, body PostPullRequestReassignJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestReassignResponse, error) {
+	rsp, err := c.PostPullRequestReassign(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestReassignResponse(rsp)
+}
+
+// GetPullRequestRequiredTagsWithResponse request returning *GetPullRequestRequiredTagsResponse
+func (c *ClientWithResponses) GetPullRequestRequiredTagsWithResponse(ctx context.Context, params *GetPullRequestRequiredTagsParams, reqEditors ...RequestEditorFn) (*GetPullRequestRequiredTagsResponse, error) {
+	rsp, err := c.GetPullRequestRequiredTags(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPullRequestRequiredTagsResponse(rsp)
+}
+
+// GetPullRequestReviewersWithResponse request returning *GetPullRequestReviewersResponse
+func (c *ClientWithResponses) GetPullRequestReviewersWithResponse(ctx context.Context, params *GetPullRequestReviewersParams, reqEditors ...RequestEditorFn) (*GetPullRequestReviewersResponse, error) {
+	rsp, err := c.GetPullRequestReviewers(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPullRequestReviewersResponse(rsp)
+}
+
+// PostPullRequestSetRequiredTagsWithBodyWithResponse request with arbitrary body returning *PostPullRequestSetRequiredTagsResponse
+func (c *ClientWithResponses) PostPullRequestSetRequiredTagsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPullRequestSetRequiredTagsResponse, error) {
+	rsp, err := c.PostPullRequestSetRequiredTagsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestSetRequiredTagsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPullRequestSetRequiredTagsWithResponse(ctx context.Context, body PostPullRequestSetRequiredTagsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostPullRequestSetRequiredTagsResponse, error) {
+	rsp, err := c.PostPullRequestSetRequiredTags(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPullRequestSetRequiredTagsResponse(rsp)
+}
+
+// PostRulesAddWithBodyWithResponse request with arbitrary body returning *PostRulesAddResponse
+func (c *ClientWithResponses) PostRulesAddWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRulesAddResponse, error) {
+	rsp, err := c.PostRulesAddWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRulesAddResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostRulesAddWithResponse(ctx context.Context, body PostRulesAddJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRulesAddResponse, error) {
+	rsp, err := c.PostRulesAdd(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRulesAddResponse(rsp)
+}
+
+// PostRulesDeleteWithBodyWithResponse request with arbitrary body returning *PostRulesDeleteResponse
+func (c *ClientWithResponses) PostRulesDeleteWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRulesDeleteResponse, error) {
+	rsp, err := c.PostRulesDeleteWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRulesDeleteResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostRulesDeleteWithResponse(ctx context.Context, body PostRulesDeleteJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRulesDeleteResponse, error) {
+	rsp, err := c.PostRulesDelete(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRulesDeleteResponse(rsp)
+}
+
+// GetRulesListWithResponse request returning *GetRulesListResponse
+func (c *ClientWithResponses) GetRulesListWithResponse(ctx context.Context, params *GetRulesListParams, reqEditors ...RequestEditorFn) (*GetRulesListResponse, error) {
+	rsp, err := c.GetRulesList(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRulesListResponse(rsp)
+}
+
+// GetStatisticsAssignmentsWithResponse request returning *GetStatisticsAssignmentsResponse
+func (c *ClientWithResponses) GetStatisticsAssignmentsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatisticsAssignmentsResponse, error) {
+	rsp, err := c.GetStatisticsAssignments(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatisticsAssignmentsResponse(rsp)
+}
+
+// GetStatisticsFairnessWithResponse request returning *GetStatisticsFairnessResponse
+func (c *ClientWithResponses) GetStatisticsFairnessWithResponse(ctx context.Context, params *GetStatisticsFairnessParams, reqEditors ...RequestEditorFn) (*GetStatisticsFairnessResponse, error) {
+	rsp, err := c.GetStatisticsFairness(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatisticsFairnessResponse(rsp)
+}
+
+// GetStatisticsPullRequestsWithResponse request returning *GetStatisticsPullRequestsResponse
+func (c *ClientWithResponses) GetStatisticsPullRequestsWithResponse(ctx context.Context, params *GetStatisticsPullRequestsParams, reqEditors ...RequestEditorFn) (*GetStatisticsPullRequestsResponse, error) {
+	rsp, err := c.GetStatisticsPullRequests(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatisticsPullRequestsResponse(rsp)
+}
+
+// GetStatisticsTeamsWithResponse request returning *GetStatisticsTeamsResponse
+func (c *ClientWithResponses) GetStatisticsTeamsWithResponse(ctx context.Context, params *GetStatisticsTeamsParams, reqEditors ...RequestEditorFn) (*GetStatisticsTeamsResponse, error) {
+	rsp, err := c.GetStatisticsTeams(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatisticsTeamsResponse(rsp)
+}
+
+// GetStatisticsWorkloadWithResponse request returning *GetStatisticsWorkloadResponse
+func (c *ClientWithResponses) GetStatisticsWorkloadWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetStatisticsWorkloadResponse, error) {
+	rsp, err := c.GetStatisticsWorkload(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetStatisticsWorkloadResponse(rsp)
+}
+
+// PostTeamAddWithBodyWithResponse request with arbitrary body returning *PostTeamAddResponse
+func (c *ClientWithResponses) PostTeamAddWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error) {
+	rsp, err := c.PostTeamAddWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamAddResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamAddWithResponse(ctx context.Context, body PostTeamAddJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamAddResponse, error) {
+	rsp, err := c.PostTeamAdd(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamAddResponse(rsp)
+}
+
+// PostTeamDeactivateWithBodyWithResponse request with arbitrary body returning *PostTeamDeactivateResponse
+func (c *ClientWithResponses) PostTeamDeactivateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamDeactivateResponse, error) {
+	rsp, err := c.PostTeamDeactivateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamDeactivateResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamDeactivateWithResponse(ctx context.Context, body PostTeamDeactivateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamDeactivateResponse, error) {
+	rsp, err := c.PostTeamDeactivate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamDeactivateResponse(rsp)
+}
+
+// GetTeamGetWithResponse request returning *GetTeamGetResponse
+func (c *ClientWithResponses) GetTeamGetWithResponse(ctx context.Context, params *GetTeamGetParams, reqEditors ...RequestEditorFn) (*GetTeamGetResponse, error) {
+	rsp, err := c.GetTeamGet(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetTeamGetResponse(rsp)
+}
+
+// PostTeamSetMentorshipWithBodyWithResponse request with arbitrary body returning *PostTeamSetMentorshipResponse
+func (c *ClientWithResponses) PostTeamSetMentorshipWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTeamSetMentorshipResponse, error) {
+	rsp, err := c.PostTeamSetMentorshipWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetMentorshipResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTeamSetMentorshipWithResponse(ctx context.Context, body PostTeamSetMentorshipJSONRequestBody, reqEditors ...RequestEditorFn) (*PostTeamSetMentorshipResponse, error) {
+	rsp, err := c.PostTeamSetMentorship(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTeamSetMentorshipResponse(rsp)
+}
+
+// PostUsersAddUnavailabilityWithBodyWithResponse request with arbitrary body returning *PostUsersAddUnavailabilityResponse
+func (c *ClientWithResponses) PostUsersAddUnavailabilityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersAddUnavailabilityResponse, error) {
+	rsp, err := c.PostUsersAddUnavailabilityWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersAddUnavailabilityResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersAddUnavailabilityWithResponse(ctx context.Context, body PostUsersAddUnavailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersAddUnavailabilityResponse, error) {
+	rsp, err := c.PostUsersAddUnavailability(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersAddUnavailabilityResponse(rsp)
+}
+
+// GetUsersDashboardWithResponse request returning *GetUsersDashboardResponse
+func (c *ClientWithResponses) GetUsersDashboardWithResponse(ctx context.Context, params *GetUsersDashboardParams, reqEditors ...RequestEditorFn) (*GetUsersDashboardResponse, error) {
+	rsp, err := c.GetUsersDashboard(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersDashboardResponse(rsp)
+}
+
+// PostUsersDeleteUnavailabilityWithBodyWithResponse request with arbitrary body returning *PostUsersDeleteUnavailabilityResponse
+func (c *ClientWithResponses) PostUsersDeleteUnavailabilityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersDeleteUnavailabilityResponse, error) {
+	rsp, err := c.PostUsersDeleteUnavailabilityWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersDeleteUnavailabilityResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersDeleteUnavailabilityWithResponse(ctx context.Context, body PostUsersDeleteUnavailabilityJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersDeleteUnavailabilityResponse, error) {
+	rsp, err := c.PostUsersDeleteUnavailability(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersDeleteUnavailabilityResponse(rsp)
+}
+
+// GetUsersGetReviewWithResponse request returning *GetUsersGetReviewResponse
+func (c *ClientWithResponses) GetUsersGetReviewWithResponse(ctx context.Context, params *GetUsersGetReviewParams, reqEditors ...RequestEditorFn) (*GetUsersGetReviewResponse, error) {
+	rsp, err := c.GetUsersGetReview(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersGetReviewResponse(rsp)
+}
+
+// PostUsersSetIsActiveWithBodyWithResponse request with arbitrary body returning *PostUsersSetIsActiveResponse
+func (c *ClientWithResponses) PostUsersSetIsActiveWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error) {
+	rsp, err := c.PostUsersSetIsActiveWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetIsActiveResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersSetIsActiveWithResponse(ctx context.Context, body PostUsersSetIsActiveJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetIsActiveResponse, error) {
+	rsp, err := c.PostUsersSetIsActive(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetIsActiveResponse(rsp)
+}
+
+// PostUsersSetSeniorityWithBodyWithResponse request with arbitrary body returning *PostUsersSetSeniorityResponse
+func (c *ClientWithResponses) PostUsersSetSeniorityWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetSeniorityResponse, error) {
+	rsp, err := c.PostUsersSetSeniorityWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetSeniorityResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersSetSeniorityWithResponse(ctx context.Context, body PostUsersSetSeniorityJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetSeniorityResponse, error) {
+	rsp, err := c.PostUsersSetSeniority(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetSeniorityResponse(rsp)
+}
+
+// PostUsersSetSkillsWithBodyWithResponse request with arbitrary body returning *PostUsersSetSkillsResponse
+func (c *ClientWithResponses) PostUsersSetSkillsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostUsersSetSkillsResponse, error) {
+	rsp, err := c.PostUsersSetSkillsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetSkillsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostUsersSetSkillsWithResponse(ctx context.Context, body PostUsersSetSkillsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostUsersSetSkillsResponse, error) {
+	rsp, err := c.PostUsersSetSkills(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostUsersSetSkillsResponse(rsp)
+}
+
+// GetUsersSkillsWithResponse request returning *GetUsersSkillsResponse
+func (c *ClientWithResponses) GetUsersSkillsWithResponse(ctx context.Context, params *GetUsersSkillsParams, reqEditors ...RequestEditorFn) (*GetUsersSkillsResponse, error) {
+	rsp, err := c.GetUsersSkills(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUsersSkillsResponse(rsp)
+}
+
+// ParseGetAdminPolicyResponse parses an HTTP response from a GetAdminPolicyWithResponse call
+func ParseGetAdminPolicyResponse(rsp *http.Response) (*GetAdminPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AssignmentPolicySnapshot
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAdminPolicyResponse parses an HTTP response from a PutAdminPolicyWithResponse call
+func ParsePutAdminPolicyResponse(rsp *http.Response) (*PutAdminPolicyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAdminPolicyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AssignmentPolicySnapshot
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest PayloadTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminWorkloadReconcileResponse parses an HTTP response from a PostAdminWorkloadReconcileWithResponse call
+func ParsePostAdminWorkloadReconcileResponse(rsp *http.Response) (*PostAdminWorkloadReconcileResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminWorkloadReconcileResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest WorkloadReconcileResult
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAnalyticsReviewTimesResponse parses an HTTP response from a GetAnalyticsReviewTimesWithResponse call
+func ParseGetAnalyticsReviewTimesResponse(rsp *http.Response) (*GetAnalyticsReviewTimesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAnalyticsReviewTimesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReviewTimeSeries
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAnalyticsReviewerThroughputResponse parses an HTTP response from a GetAnalyticsReviewerThroughputWithResponse call
+func ParseGetAnalyticsReviewerThroughputResponse(rsp *http.Response) (*GetAnalyticsReviewerThroughputResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAnalyticsReviewerThroughputResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReviewerThroughputSeries
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAnalyticsTeamThroughputResponse parses an HTTP response from a GetAnalyticsTeamThroughputWithResponse call
+func ParseGetAnalyticsTeamThroughputResponse(rsp *http.Response) (*GetAnalyticsTeamThroughputResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAnalyticsTeamThroughputResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamThroughputSeries
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAuditListResponse parses an HTTP response from a GetAuditListWithResponse call
+func ParseGetAuditListResponse(rsp *http.Response) (*GetAuditListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuditListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Entries []AuditEntry `json:"entries"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEventsStreamResponse parses an HTTP response from a GetEventsStreamWithResponse call
+func ParseGetEventsStreamResponse(rsp *http.Response) (*GetEventsStreamResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEventsStreamResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHealthResponse parses an HTTP response from a GetHealthWithResponse call
+func ParseGetHealthResponse(rsp *http.Response) (*GetHealthResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHealthResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Status    *string    `json:"status,omitempty"`
+			Timestamp *time.Time `json:"timestamp,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest struct {
+			Error  *string `json:"error,omitempty"`
+			Status *string `json:"status,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHealthLiveResponse parses an HTTP response from a GetHealthLiveWithResponse call
+func ParseGetHealthLiveResponse(rsp *http.Response) (*GetHealthLiveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHealthLiveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// Status ok - компонент работает нормально;
+			// degraded - компонент работает, но с проблемами (трафик принимается);
+			// down - компонент недоступен (трафик не принимается)
+			Status    HealthStatus `json:"status"`
+			Timestamp time.Time    `json:"timestamp"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetHealthReadyResponse parses an HTTP response from a GetHealthReadyWithResponse call
+func ParseGetHealthReadyResponse(rsp *http.Response) (*GetHealthReadyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetHealthReadyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest HealthReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 503:
+		var dest HealthReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON503 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestAssignResponse parses an HTTP response from a PostPullRequestAssignWithResponse call
+func ParsePostPullRequestAssignResponse(rsp *http.Response) (*PostPullRequestAssignResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestAssignResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReviewerAssignment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest PayloadTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestCreateResponse parses an HTTP response from a PostPullRequestCreateWithResponse call
+func ParsePostPullRequestCreateResponse(rsp *http.Response) (*PostPullRequestCreateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestCreateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Pr *PullRequest `json:"pr,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest PayloadTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestMergeResponse parses an HTTP response from a PostPullRequestMergeWithResponse call
+func ParsePostPullRequestMergeResponse(rsp *http.Response) (*PostPullRequestMergeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestMergeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Pr *PullRequest `json:"pr,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest PayloadTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestPreviewReviewersResponse parses an HTTP response from a PostPullRequestPreviewReviewersWithResponse call
+func ParsePostPullRequestPreviewReviewersResponse(rsp *http.Response) (*PostPullRequestPreviewReviewersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestPreviewReviewersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReviewerPreview
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest PayloadTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestReassignResponse parses an HTTP response from a PostPullRequestReassignWithResponse call
+func ParsePostPullRequestReassignResponse(rsp *http.Response) (*PostPullRequestReassignResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestReassignResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Pr PullRequest `json:"pr"`
+
+			// ReplacedBy user_id нового ревьювера
+			ReplacedBy string `json:"replaced_by"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest PayloadTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPullRequestRequiredTagsResponse parses an HTTP response from a GetPullRequestRequiredTagsWithResponse call
+func ParseGetPullRequestRequiredTagsResponse(rsp *http.Response) (*GetPullRequestRequiredTagsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPullRequestRequiredTagsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PullRequestTags
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPullRequestReviewersResponse parses an HTTP response from a GetPullRequestReviewersWithResponse call
+func ParseGetPullRequestReviewersResponse(rsp *http.Response) (*GetPullRequestReviewersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPullRequestReviewersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			PullRequestId string               `json:"pull_request_id"`
+			Reviewers     []ReviewerAssignment `json:"reviewers"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostPullRequestSetRequiredTagsResponse parses an HTTP response from a PostPullRequestSetRequiredTagsWithResponse call
+func ParsePostPullRequestSetRequiredTagsResponse(rsp *http.Response) (*PostPullRequestSetRequiredTagsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPullRequestSetRequiredTagsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PullRequestTags
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest PayloadTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostRulesAddResponse parses an HTTP response from a PostRulesAddWithResponse call
+func ParsePostRulesAddResponse(rsp *http.Response) (*PostRulesAddResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostRulesAddResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ReviewerRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest PayloadTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostRulesDeleteResponse parses an HTTP response from a PostRulesDeleteWithResponse call
+func ParsePostRulesDeleteResponse(rsp *http.Response) (*PostRulesDeleteResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostRulesDeleteResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ReviewerRule
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Unauthorized
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Forbidden
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest PayloadTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRulesListResponse parses an HTTP response from a GetRulesListWithResponse call
+func ParseGetRulesListResponse(rsp *http.Response) (*GetRulesListResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRulesListResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Rules []ReviewerRule `json:"rules"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatisticsAssignmentsResponse parses an HTTP response from a GetStatisticsAssignmentsWithResponse call
+func ParseGetStatisticsAssignmentsResponse(rsp *http.Response) (*GetStatisticsAssignmentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatisticsAssignmentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Statistics *[]struct {
+				MergedPrs        *int    `json:"merged_prs,omitempty"`
+				OpenPrs          *int    `json:"open_prs,omitempty"`
+				TeamName         *string `json:"team_name,omitempty"`
+				TotalAssignments *int    `json:"total_assignments,omitempty"`
+				UserId           *string `json:"user_id,omitempty"`
+				Username         *string `json:"username,omitempty"`
+			} `json:"statistics,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatisticsFairnessResponse parses an HTTP response from a GetStatisticsFairnessWithResponse call
+func ParseGetStatisticsFairnessResponse(rsp *http.Response) (*GetStatisticsFairnessResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatisticsFairnessResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest FairnessReport
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatisticsPullRequestsResponse parses an HTTP response from a GetStatisticsPullRequestsWithResponse call
+func ParseGetStatisticsPullRequestsResponse(rsp *http.Response) (*GetStatisticsPullRequestsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatisticsPullRequestsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PullRequestStatsList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatisticsTeamsResponse parses an HTTP response from a GetStatisticsTeamsWithResponse call
+func ParseGetStatisticsTeamsResponse(rsp *http.Response) (*GetStatisticsTeamsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatisticsTeamsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TeamStatsList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParseGetStatisticsWorkloadResponse parses an HTTP response from a GetStatisticsWorkloadWithResponse call
+func ParseGetStatisticsWorkloadResponse(rsp *http.Response) (*GetStatisticsWorkloadResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetStatisticsWorkloadResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Workload *[]struct {
+				IsActive         *bool   `json:"is_active,omitempty"`
+				OpenReviewsCount *int    `json:"open_reviews_count,omitempty"`
+				TeamName         *string `json:"team_name,omitempty"`
+				UserId           *string `json:"user_id,omitempty"`
+				Username         *string `json:"username,omitempty"`
+			} `json:"workload,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	case rsp.StatusCode == 200:
+		// Content-type (text/csv) unsupported
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamAddResponse parses an HTTP response from a PostTeamAddWithResponse call
+func ParsePostTeamAddResponse(rsp *http.Response) (*PostTeamAddResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamAddResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest struct {
+			Team *Team `json:"team,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest PayloadTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamDeactivateResponse parses an HTTP response from a PostTeamDeactivateWithResponse call
+func ParsePostTeamDeactivateResponse(rsp *http.Response) (*PostTeamDeactivateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamDeactivateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			// DeactivatedUsers Количество деактивированных пользователей
+			DeactivatedUsers *int `json:"deactivated_users,omitempty"`
+
+			// DurationMs Время выполнения операции в миллисекундах
+			DurationMs *int `json:"duration_ms,omitempty"`
+
+			// ReassignedPrs Количество переназначенных PR
+			ReassignedPrs *int `json:"reassigned_prs,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest PayloadTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetTeamGetResponse parses an HTTP response from a GetTeamGetWithResponse call
+func ParseGetTeamGetResponse(rsp *http.Response) (*GetTeamGetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetTeamGetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Team
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostTeamSetMentorshipResponse parses an HTTP response from a PostTeamSetMentorshipWithResponse call
+func ParsePostTeamSetMentorshipResponse(rsp *http.Response) (*PostTeamSetMentorshipResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTeamSetMentorshipResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			MentorshipEnabled bool   `json:"mentorship_enabled"`
+			TeamName          string `json:"team_name"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest PayloadTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersAddUnavailabilityResponse parses an HTTP response from a PostUsersAddUnavailabilityWithResponse call
+func ParsePostUsersAddUnavailabilityResponse(rsp *http.Response) (*PostUsersAddUnavailabilityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersAddUnavailabilityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Unavailability
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest PayloadTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersDashboardResponse parses an HTTP response from a GetUsersDashboardWithResponse call
+func ParseGetUsersDashboardResponse(rsp *http.Response) (*GetUsersDashboardResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersDashboardResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserDashboard
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersDeleteUnavailabilityResponse parses an HTTP response from a PostUsersDeleteUnavailabilityWithResponse call
+func ParsePostUsersDeleteUnavailabilityResponse(rsp *http.Response) (*PostUsersDeleteUnavailabilityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersDeleteUnavailabilityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Unavailability
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest PayloadTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersGetReviewResponse parses an HTTP response from a GetUsersGetReviewWithResponse call
+func ParseGetUsersGetReviewResponse(rsp *http.Response) (*GetUsersGetReviewResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersGetReviewResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			PullRequests []PullRequestShort `json:"pull_requests"`
+			UserId       string             `json:"user_id"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersSetIsActiveResponse parses an HTTP response from a PostUsersSetIsActiveWithResponse call
+func ParsePostUsersSetIsActiveResponse(rsp *http.Response) (*PostUsersSetIsActiveResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersSetIsActiveResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			User *User `json:"user,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest PayloadTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersSetSeniorityResponse parses an HTTP response from a PostUsersSetSeniorityWithResponse call
+func ParsePostUsersSetSeniorityResponse(rsp *http.Response) (*PostUsersSetSeniorityResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersSetSeniorityResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest PayloadTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostUsersSetSkillsResponse parses an HTTP response from a PostUsersSetSkillsWithResponse call
+func ParsePostUsersSetSkillsResponse(rsp *http.Response) (*PostUsersSetSkillsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostUsersSetSkillsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserSkills
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 413:
+		var dest PayloadTooLarge
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON413 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUsersSkillsResponse parses an HTTP response from a GetUsersSkillsWithResponse call
+func ParseGetUsersSkillsResponse(rsp *http.Response) (*GetUsersSkillsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUsersSkillsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserSkills
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ErrorResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest TooManyRequests
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
@@ -0,0 +1,132 @@
+package client
+
+import (
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+// maxErrorBodyBytes ограничивает читаемое тело ответа с ошибкой
+const maxErrorBodyBytes = 64 << 10
+
+// RetryPolicy задает повторы запросов с экспоненциальной задержкой
+type RetryPolicy struct {
+	// MaxAttempts - число попыток вместе с первой, 1 отключает повторы
+	MaxAttempts int
+	// MinBackoff - задержка перед первым повтором, дальше она удваивается
+	// до MaxBackoff. К задержке добавляется случайный разброс до половины.
+	MinBackoff time.Duration
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy - политика повторов клиента из New
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 4,
+	MinBackoff:  200 * time.Millisecond,
+	MaxBackoff:  5 * time.Second,
+}
+
+// backoff возвращает задержку перед повтором после попытки attempt
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	if p.MinBackoff <= 0 {
+		return 0
+	}
+	d := p.MinBackoff
+	for i := 1; i < attempt && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	if p.MaxBackoff > 0 && d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	return d/2 + rand.N(d/2+1)
+}
+
+// Doer выполняет запросы с повторами и превращает ответы со статусом 4xx
+// и 5xx в *APIError.
+//
+// Повторяются ответы 429, запросы с ключом идемпотентности, получившие 409
+// IDEMPOTENCY_IN_PROGRESS, и при ошибке соединения или ответе 502, 503, 504 -
+// идемпотентные запросы: GET, HEAD, OPTIONS, PUT, DELETE и запросы с ключом
+// идемпотентности. Задержка не меньше Retry-After из ответа.
+type Doer struct {
+	Client HttpRequestDoer
+	Retry  RetryPolicy
+}
+
+func (d *Doer) Do(req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+
+		resp, err := d.Client.Do(req)
+		if err == nil && resp.StatusCode < http.StatusBadRequest {
+			return resp, nil
+		}
+
+		var apiErr *APIError
+		if err == nil {
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+			_ = resp.Body.Close()
+			apiErr = newAPIError(resp, body)
+			err = apiErr
+		}
+
+		if attempt >= d.Retry.MaxAttempts || !retryable(req, apiErr) {
+			return nil, err
+		}
+
+		delay := d.Retry.backoff(attempt)
+		if apiErr != nil && apiErr.RetryAfter > delay {
+			delay = apiErr.RetryAfter
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, err
+		case <-timer.C:
+		}
+	}
+}
+
+// retryable проверяет, можно ли повторить запрос после ошибки. apiErr
+// пустой для ошибки соединения.
+func retryable(req *http.Request, apiErr *APIError) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+	if apiErr == nil {
+		return idempotent(req)
+	}
+
+	switch apiErr.StatusCode {
+	case http.StatusTooManyRequests:
+		// Запрос отклонен до обработки
+		return true
+	case http.StatusConflict:
+		return apiErr.Code == IDEMPOTENCYINPROGRESS
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return idempotent(req)
+	default:
+		return false
+	}
+}
+
+// idempotent проверяет, что повтор запроса не выполнит операцию дважды
+func idempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return req.Header.Get(IdempotencyKeyHeader) != ""
+	}
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// TLSOptions описывает проверку сервера и клиентский сертификат
+type TLSOptions struct {
+	// CAFile - PEM с CA для проверки сертификата сервера, пусто - системные CA
+	CAFile string
+	// CertFile и KeyFile - клиентский сертификат для mTLS
+	CertFile string
+	KeyFile  string
+	// ServerName переопределяет имя сервера для проверки сертификата
+	ServerName string
+	// InsecureSkipVerify отключает проверку сертификата сервера. Только для
+	// локальной разработки с самоподписанным сертификатом.
+	InsecureSkipVerify bool
+}
+
+// TLSConfig собирает конфигурацию TLS клиента
+func TLSConfig(opts TLSOptions) (*tls.Config, error) {
+	cfg := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		ServerName:         opts.ServerName,
+		InsecureSkipVerify: opts.InsecureSkipVerify,
+	}
+
+	if opts.CAFile != "" {
+		pem, err := os.ReadFile(opts.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", opts.CAFile)
+		}
+		cfg.RootCAs = pool
+	}
+
+	if (opts.CertFile == "") != (opts.KeyFile == "") {
+		return nil, errors.New("client certificate requires both cert and key files")
+	}
+	if opts.CertFile != "" {
+		cert, err := tls.LoadX509KeyPair(opts.CertFile, opts.KeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	return cfg, nil
+}
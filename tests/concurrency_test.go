@@ -0,0 +1,85 @@
+package tests
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/AtoyanMikhail/PRAssignmentService/pkg/client"
+)
+
+// TestE2EConcurrentAssignment создает PR параллельно и проверяет, что нагрузка
+// распределилась так же, как при последовательном создании
+func TestE2EConcurrentAssignment(t *testing.T) {
+	const (
+		reviewersInTeam = 4
+		prCount         = 20
+		perPR           = 2
+	)
+
+	suffix := time.Now().UnixNano()
+	teamName := fmt.Sprintf("concurrent-team-%d", suffix)
+	authorID := fmt.Sprintf("concurrent-author-%d", suffix)
+
+	members := []client.TeamMember{{UserId: authorID, Username: "Concurrent Author"}}
+	members = append(members, teamMembers(reviewersInTeam, func(i int) string {
+		return fmt.Sprintf("concurrent-user%d-%d", i, suffix)
+	}, "Concurrent User")...)
+	addTeam(t, teamName, members)
+
+	var (
+		mu             sync.Mutex
+		reviewerCount  = make(map[string]int)
+		wg             sync.WaitGroup
+		start          = make(chan struct{})
+		failedRequests []string
+	)
+
+	for i := 1; i <= prCount; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			<-start
+
+			resp, err := api.PostPullRequestCreateWithResponse(t.Context(), client.PostPullRequestCreateJSONRequestBody{
+				PullRequestId:   fmt.Sprintf("concurrent-pr-%d-%d", suffix, i),
+				PullRequestName: fmt.Sprintf("Concurrent PR %d", i),
+				AuthorId:        authorID,
+			})
+			if err != nil {
+				mu.Lock()
+				failedRequests = append(failedRequests, err.Error())
+				mu.Unlock()
+				return
+			}
+
+			mu.Lock()
+			for _, r := range resp.JSON201.Pr.AssignedReviewers {
+				reviewerCount[r]++
+			}
+			mu.Unlock()
+		}(i)
+	}
+
+	close(start)
+	wg.Wait()
+
+	if len(failedRequests) > 0 {
+		t.Fatalf("Failed to create %d PRs: %v", len(failedRequests), failedRequests)
+	}
+
+	t.Logf("Reviewer distribution: %v", reviewerCount)
+
+	if len(reviewerCount) != reviewersInTeam {
+		t.Fatalf("Expected %d reviewers to get assignments, got %d", reviewersInTeam, len(reviewerCount))
+	}
+
+	// При последовательном создании least_loaded раздает всем поровну
+	expected := prCount * perPR / reviewersInTeam
+	for reviewer, count := range reviewerCount {
+		if count != expected {
+			t.Errorf("Reviewer %s has %d assignments, expected %d", reviewer, count, expected)
+		}
+	}
+}
@@ -1,545 +1,1397 @@
 package tests
 
 import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
 	"net/http"
+	"net/url"
+	"os"
+	"strings"
 	"testing"
 	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/AtoyanMikhail/PRAssignmentService/internal/grpcapi/prassignmentv1"
+	"github.com/AtoyanMikhail/PRAssignmentService/pkg/client"
 )
 
-func TestE2EHealthCheck(t *testing.T) {
-	resp, err := client.Get(baseURL + "/health")
+// api - типизированный клиент REST API запущенного сервиса
+var api = newAPIClient()
+
+// adminAPI - клиент с токеном администратора из ADMIN_TOKEN
+var adminAPI = newAPIClient(client.WithRequestEditorFn(bearerToken(adminToken())))
+
+func newAPIClient(opts ...client.ClientOption) *client.ClientWithResponses {
+	c, err := client.New(baseURL, append([]client.ClientOption{client.WithHTTPClient(httpClient)}, opts...)...)
 	if err != nil {
-		t.Fatalf("Failed to call health endpoint: %v", err)
+		panic(err)
 	}
-	defer func() { _ = resp.Body.Close() }()
+	return c
+}
 
-	if resp.StatusCode != http.StatusOK {
-		t.Errorf("Expected status 200, got %d", resp.StatusCode)
+// adminToken возвращает токен администратора тестового окружения,
+// по умолчанию - значение ADMIN_TOKEN из .env
+func adminToken() string {
+	if token := os.Getenv("ADMIN_TOKEN"); token != "" {
+		return token
 	}
+	return "local-admin-token-change-me"
+}
 
-	var result map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		t.Fatalf("Failed to decode response: %v", err)
+func bearerToken(token string) client.RequestEditorFn {
+	return func(_ context.Context, req *http.Request) error {
+		req.Header.Set("Authorization", "Bearer "+token)
+		return nil
 	}
+}
 
-	if result["status"] != "ok" {
-		t.Errorf("Expected status 'ok', got '%v'", result["status"])
-	}
+func ptr[T any](v T) *T {
+	return &v
 }
 
-func TestE2EReassignment(t *testing.T) {
-	teamName := fmt.Sprintf("reassign-team-%d", time.Now().Unix())
+// statusCode возвращает статус ответа с ошибкой API, 0 - ошибка не от API
+func statusCode(err error) int {
+	var apiErr *client.APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
 
-	payload := map[string]interface{}{
-		"team_name": teamName,
-		"members": []map[string]interface{}{
-			{"user_id": "reassign-user1", "username": "Reassign User 1"},
-			{"user_id": "reassign-user2", "username": "Reassign User 2"},
-			{"user_id": "reassign-user3", "username": "Reassign User 3"},
-			{"user_id": "reassign-user4", "username": "Reassign User 4"},
-		},
+// teamMembers возвращает count участников с идентификаторами user(1..count)
+func teamMembers(count int, user func(int) string, name string) []client.TeamMember {
+	members := make([]client.TeamMember, 0, count)
+	for i := 1; i <= count; i++ {
+		members = append(members, client.TeamMember{UserId: user(i), Username: fmt.Sprintf("%s %d", name, i)})
 	}
+	return members
+}
 
-	resp, err := postJSON(baseURL+"/team/add", payload)
-	if err != nil {
+func addTeam(t *testing.T, teamName string, members []client.TeamMember) {
+	t.Helper()
+	if _, err := api.PostTeamAddWithResponse(t.Context(), client.Team{TeamName: teamName, Members: members}); err != nil {
 		t.Fatalf("Failed to create team: %v", err)
 	}
-	_ = resp.Body.Close()
+}
 
-	prID := fmt.Sprintf("reassign-pr-%d", time.Now().Unix())
-	prPayload := map[string]interface{}{
-		"pull_request_id":   prID,
-		"pull_request_name": "Reassignment Test PR",
-		"author_id":         "reassign-user1",
+func createPR(t *testing.T, body client.PostPullRequestCreateJSONRequestBody) client.PullRequest {
+	t.Helper()
+	resp, err := api.PostPullRequestCreateWithResponse(t.Context(), body)
+	if err != nil {
+		t.Fatalf("Failed to create PR %s: %v", body.PullRequestId, err)
 	}
+	return *resp.JSON201.Pr
+}
 
-	resp, err = postJSON(baseURL+"/pullRequest/create", prPayload)
+func mergePR(t *testing.T, prID string) client.PullRequest {
+	t.Helper()
+	resp, err := api.PostPullRequestMergeWithResponse(t.Context(), client.PostPullRequestMergeJSONRequestBody{PullRequestId: prID})
 	if err != nil {
-		t.Fatalf("Failed to create PR: %v", err)
+		t.Fatalf("Failed to merge PR %s: %v", prID, err)
 	}
+	return *resp.JSON200.Pr
+}
 
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
-		body, _ := io.ReadAll(resp.Body)
-		_ = resp.Body.Close()
-		t.Fatalf("Failed to create PR: status %d: %s", resp.StatusCode, string(body))
+func TestE2EHealthCheck(t *testing.T) {
+	resp, err := api.GetHealthWithResponse(t.Context())
+	if err != nil {
+		t.Fatalf("Failed to call health endpoint: %v", err)
 	}
+	if resp.StatusCode() != http.StatusOK {
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode())
+	}
+	if resp.JSON200.Status == nil || *resp.JSON200.Status != "ok" {
+		t.Errorf("Expected status 'ok', got %v", resp.JSON200.Status)
+	}
+}
 
-	var prResult map[string]interface{}
-	_ = json.NewDecoder(resp.Body).Decode(&prResult)
-	_ = resp.Body.Close()
+func TestE2EReassignment(t *testing.T) {
+	teamName := fmt.Sprintf("reassign-team-%d", time.Now().Unix())
+	user := func(i int) string { return fmt.Sprintf("reassign-user%d", i) }
+	addTeam(t, teamName, teamMembers(4, user, "Reassign User"))
 
-	reviewers := prResult["assigned_reviewers"].([]interface{})
-	if len(reviewers) == 0 {
+	prID := fmt.Sprintf("reassign-pr-%d", time.Now().Unix())
+	pr := createPR(t, client.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   prID,
+		PullRequestName: "Reassignment Test PR",
+		AuthorId:        user(1),
+	})
+	if len(pr.AssignedReviewers) == 0 {
 		t.Fatal("No reviewers assigned")
 	}
-
-	oldReviewer := reviewers[0].(string)
+	oldReviewer := pr.AssignedReviewers[0]
 
 	t.Run("ReassignReviewer", func(t *testing.T) {
-		reassignPayload := map[string]interface{}{
-			"pull_request_id": prID,
-			"old_user_id":     oldReviewer,
-		}
-
-		resp, err := postJSON(baseURL+"/pullRequest/reassign", reassignPayload)
+		resp, err := api.PostPullRequestReassignWithResponse(t.Context(), client.PostPullRequestReassignJSONRequestBody{
+			PullRequestId: prID,
+			OldUserId:     oldReviewer,
+		})
 		if err != nil {
 			t.Fatalf("Failed to reassign reviewer: %v", err)
 		}
-		defer func() { _ = resp.Body.Close() }()
 
-		if resp.StatusCode != http.StatusOK {
-			body, _ := io.ReadAll(resp.Body)
-			t.Fatalf("Expected status 200, got %d: %s", resp.StatusCode, string(body))
+		if resp.JSON200.ReplacedBy == "" || resp.JSON200.ReplacedBy == oldReviewer {
+			t.Errorf("Expected %s to be replaced, got %q", oldReviewer, resp.JSON200.ReplacedBy)
 		}
-
-		var result map[string]interface{}
-		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-			t.Fatalf("Failed to decode response: %v", err)
-		}
-
-		if result["status"] != "reassigned" {
-			t.Errorf("Expected status 'reassigned', got %v", result["status"])
+		for _, r := range resp.JSON200.Pr.AssignedReviewers {
+			if r == oldReviewer {
+				t.Errorf("Expected %s to be unassigned, got %v", oldReviewer, resp.JSON200.Pr.AssignedReviewers)
+			}
 		}
 	})
 }
 
 func TestE2ETeamOperations(t *testing.T) {
 	teamName := fmt.Sprintf("team-ops-%d", time.Now().Unix())
+	addTeam(t, teamName, teamMembers(2, func(i int) string { return fmt.Sprintf("team-user%d", i) }, "Team User"))
 
-	payload := map[string]interface{}{
-		"team_name": teamName,
-		"members": []map[string]interface{}{
-			{"user_id": "team-user1", "username": "Team User 1"},
-			{"user_id": "team-user2", "username": "Team User 2"},
-		},
+	resp, err := api.GetTeamGetWithResponse(t.Context(), &client.GetTeamGetParams{TeamName: teamName})
+	if err != nil {
+		t.Fatalf("Failed to get team: %v", err)
 	}
 
-	resp, err := postJSON(baseURL+"/team/add", payload)
-	if err != nil {
-		t.Fatalf("Failed to create team: %v", err)
+	if resp.JSON200.TeamName != teamName {
+		t.Errorf("Expected team name %s, got %v", teamName, resp.JSON200.TeamName)
 	}
-	_ = resp.Body.Close()
+	if len(resp.JSON200.Members) != 2 {
+		t.Errorf("Expected 2 members, got %d", len(resp.JSON200.Members))
+	}
+
+	_, err = api.PostTeamAddWithResponse(t.Context(), client.Team{TeamName: teamName, Members: resp.JSON200.Members})
+	if !errors.Is(err, client.ErrTeamExists) {
+		t.Errorf("Expected TEAM_EXISTS for duplicate team, got %v", err)
+	}
+}
+
+func TestE2EUserOperations(t *testing.T) {
+	teamName := fmt.Sprintf("user-ops-%d", time.Now().Unix())
+	addTeam(t, teamName, []client.TeamMember{{UserId: "user-ops-1", Username: "User Ops 1"}})
 
-	resp, err = client.Get(baseURL + "/team/get?team_name=" + teamName)
+	resp, err := api.PostUsersSetIsActiveWithResponse(t.Context(), client.PostUsersSetIsActiveJSONRequestBody{
+		UserId:   "user-ops-1",
+		IsActive: false,
+	})
 	if err != nil {
-		t.Fatalf("Failed to get team: %v", err)
+		t.Fatalf("Failed to set user inactive: %v", err)
 	}
-	defer func() { _ = resp.Body.Close() }()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		t.Fatalf("Expected status 200, got %d: %s", resp.StatusCode, string(body))
+	if resp.JSON200.User == nil || resp.JSON200.User.IsActive {
+		t.Errorf("Expected user to be inactive, got %+v", resp.JSON200.User)
+	}
+}
+
+func TestE2EPullRequestLifecycle(t *testing.T) {
+	teamName := fmt.Sprintf("pr-lifecycle-%d", time.Now().Unix())
+	addTeam(t, teamName, teamMembers(3, func(i int) string { return fmt.Sprintf("pr-user%d", i) }, "PR User"))
+
+	prID := fmt.Sprintf("pr-lifecycle-%d", time.Now().Unix())
+	pr := createPR(t, client.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   prID,
+		PullRequestName: "Lifecycle Test PR",
+		AuthorId:        "pr-user1",
+	})
+
+	if pr.Status != client.PullRequestStatusOPEN {
+		t.Errorf("Expected PR status OPEN, got %v", pr.Status)
+	}
+	if len(pr.AssignedReviewers) == 0 {
+		t.Fatal("No reviewers assigned")
 	}
 
-	var teamResult map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&teamResult); err != nil {
-		t.Fatalf("Failed to decode team response: %v", err)
+	if merged := mergePR(t, prID); merged.Status != client.PullRequestStatusMERGED {
+		t.Errorf("Expected PR status MERGED, got %v", merged.Status)
 	}
+}
+
+func TestE2EUserReviews(t *testing.T) {
+	teamName := fmt.Sprintf("reviews-%d", time.Now().Unix())
+	addTeam(t, teamName, teamMembers(2, func(i int) string { return fmt.Sprintf("review-user%d", i) }, "Review User"))
+
+	createPR(t, client.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   fmt.Sprintf("review-pr-%d", time.Now().Unix()),
+		PullRequestName: "Review Test PR",
+		AuthorId:        "review-user1",
+	})
 
-	if teamResult["team_name"] != teamName {
-		t.Errorf("Expected team name %s, got %v", teamName, teamResult["team_name"])
+	resp, err := api.GetUsersGetReviewWithResponse(t.Context(), &client.GetUsersGetReviewParams{UserId: "review-user2"})
+	if err != nil {
+		t.Fatalf("Failed to get user reviews: %v", err)
 	}
 
-	members := teamResult["members"].([]interface{})
-	if len(members) != 2 {
-		t.Errorf("Expected 2 members, got %d", len(members))
+	if resp.JSON200.UserId != "review-user2" {
+		t.Errorf("Expected user_id review-user2, got %v", resp.JSON200.UserId)
+	}
+	if len(resp.JSON200.PullRequests) == 0 {
+		t.Error("Expected at least one PR for reviewer")
 	}
 }
 
-func TestE2EUserOperations(t *testing.T) {
-	teamName := fmt.Sprintf("user-ops-%d", time.Now().Unix())
-	payload := map[string]interface{}{
-		"team_name": teamName,
-		"members": []map[string]interface{}{
-			{"user_id": "user-ops-1", "username": "User Ops 1"},
-		},
+func TestE2EStatistics(t *testing.T) {
+	teamName := fmt.Sprintf("stats-%d", time.Now().Unix())
+	addTeam(t, teamName, teamMembers(2, func(i int) string { return fmt.Sprintf("stats-user%d", i) }, "Stats User"))
+
+	for i := 1; i <= 3; i++ {
+		createPR(t, client.PostPullRequestCreateJSONRequestBody{
+			PullRequestId:   fmt.Sprintf("stats-pr-%d-%d", time.Now().Unix(), i),
+			PullRequestName: fmt.Sprintf("Stats PR %d", i),
+			AuthorId:        "stats-user1",
+		})
 	}
 
-	resp, err := postJSON(baseURL+"/team/add", payload)
+	assignments, err := api.GetStatisticsAssignmentsWithResponse(t.Context())
 	if err != nil {
-		t.Fatalf("Failed to create team: %v", err)
+		t.Fatalf("Failed to get assignments statistics: %v", err)
+	}
+	if assignments.JSON200.Statistics == nil || len(*assignments.JSON200.Statistics) == 0 {
+		t.Error("Expected assignments statistics, got empty response")
 	}
-	resp.Body.Close()
 
-	setActivePayload := map[string]interface{}{
-		"user_id":   "user-ops-1",
-		"is_active": false,
+	workload, err := api.GetStatisticsWorkloadWithResponse(t.Context())
+	if err != nil {
+		t.Fatalf("Failed to get workload statistics: %v", err)
+	}
+	if workload.JSON200.Workload == nil || len(*workload.JSON200.Workload) == 0 {
+		t.Error("Expected workload statistics, got empty array")
 	}
+}
+
+func TestE2ETeamDeactivation(t *testing.T) {
+	teamName := fmt.Sprintf("deactivate-%d", time.Now().Unix())
+	addTeam(t, teamName, teamMembers(2, func(i int) string { return fmt.Sprintf("deactivate-user%d", i) }, "Deactivate User"))
 
-	resp, err = postJSON(baseURL+"/users/setIsActive", setActivePayload)
+	createPR(t, client.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   fmt.Sprintf("deactivate-pr-%d", time.Now().Unix()),
+		PullRequestName: "Deactivate Test PR",
+		AuthorId:        "deactivate-user1",
+	})
+
+	resp, err := api.PostTeamDeactivateWithResponse(t.Context(), client.PostTeamDeactivateJSONRequestBody{TeamName: teamName})
 	if err != nil {
-		t.Fatalf("Failed to set user inactive: %v", err)
+		t.Fatalf("Failed to deactivate team: %v", err)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		t.Fatalf("Expected status 200, got %d: %s", resp.StatusCode, string(body))
+	if resp.JSON200.DeactivatedUsers == nil {
+		t.Errorf("Expected 'deactivated_users' field in response, got: %s", resp.Body)
+	}
+	if resp.JSON200.ReassignedPrs == nil {
+		t.Errorf("Expected 'reassigned_prs' field in response, got: %s", resp.Body)
+	}
+}
+
+func TestE2ELoadBalancing(t *testing.T) {
+	teamName := fmt.Sprintf("balance-team-%d", time.Now().Unix())
+	addTeam(t, teamName, teamMembers(5, func(i int) string { return fmt.Sprintf("balance-user%d", i) }, "Balance User"))
+
+	reviewerCount := make(map[string]int)
+	for i := 1; i <= 10; i++ {
+		pr := createPR(t, client.PostPullRequestCreateJSONRequestBody{
+			PullRequestId:   fmt.Sprintf("balance-pr-%d-%d", time.Now().Unix(), i),
+			PullRequestName: fmt.Sprintf("Load Balance PR %d", i),
+			AuthorId:        "balance-user1",
+		})
+		for _, reviewer := range pr.AssignedReviewers {
+			reviewerCount[reviewer]++
+		}
+
+		time.Sleep(10 * time.Millisecond)
 	}
 
-	var result map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		t.Fatalf("Failed to decode response: %v", err)
+	t.Logf("Reviewer distribution: %v", reviewerCount)
+
+	if count, exists := reviewerCount["balance-user1"]; exists && count > 0 {
+		t.Errorf("Author should not be assigned as reviewer, but has %d assignments", count)
 	}
 
-	var user map[string]interface{}
-	if u, ok := result["user"].(map[string]interface{}); ok {
-		user = u
-	} else {
-		user = result
+	expectedReviewers := []string{"balance-user2", "balance-user3", "balance-user4", "balance-user5"}
+	for _, reviewer := range expectedReviewers {
+		if count, exists := reviewerCount[reviewer]; !exists || count == 0 {
+			t.Errorf("Reviewer %s should have assignments, but has %d", reviewer, count)
+		}
 	}
 
-	if user["is_active"] != false {
-		t.Errorf("Expected user to be inactive, got %v", user["is_active"])
+	minCount, maxCount := 999, 0
+	for _, count := range reviewerCount {
+		if count < minCount {
+			minCount = count
+		}
+		if count > maxCount {
+			maxCount = count
+		}
+	}
+
+	if maxCount-minCount > 5 {
+		t.Errorf("Load imbalance too high: min=%d, max=%d, diff=%d", minCount, maxCount, maxCount-minCount)
 	}
 }
 
-func TestE2EPullRequestLifecycle(t *testing.T) {
-	teamName := fmt.Sprintf("pr-lifecycle-%d", time.Now().Unix())
+func TestE2ECreatePRWithReviewerOptions(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	user := func(i int) string { return fmt.Sprintf("options-user%d-%d", i, suffix) }
+	addTeam(t, fmt.Sprintf("options-team-%d", suffix), teamMembers(4, user, "Options User"))
+
+	prID := fmt.Sprintf("options-pr-%d", suffix)
+	pr := createPR(t, client.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:      prID,
+		PullRequestName:    "Reviewer Options PR",
+		AuthorId:           user(1),
+		ReviewerCount:      ptr(2),
+		PreferredReviewers: &[]string{user(4)},
+		ExcludedReviewers:  &[]string{user(2)},
+	})
 
-	payload := map[string]interface{}{
-		"team_name": teamName,
-		"members": []map[string]interface{}{
-			{"user_id": "pr-user1", "username": "PR User 1"},
-			{"user_id": "pr-user2", "username": "PR User 2"},
-			{"user_id": "pr-user3", "username": "PR User 3"},
-		},
+	reviewers := pr.AssignedReviewers
+	if len(reviewers) != 2 || reviewers[0] != user(4) || reviewers[1] != user(3) {
+		t.Errorf("Expected reviewers [%s %s], got %v", user(4), user(3), reviewers)
 	}
 
-	resp, err := postJSON(baseURL+"/team/add", payload)
+	// Каждое назначение хранит свою причину
+	assignments, err := api.GetPullRequestReviewersWithResponse(t.Context(), &client.GetPullRequestReviewersParams{PullRequestId: prID})
 	if err != nil {
-		t.Fatalf("Failed to create team: %v", err)
+		t.Fatalf("Failed to get PR reviewers: %v", err)
 	}
-	resp.Body.Close()
 
-	prID := fmt.Sprintf("pr-lifecycle-%d", time.Now().Unix())
-	prPayload := map[string]interface{}{
-		"pull_request_id":   prID,
-		"pull_request_name": "Lifecycle Test PR",
-		"author_id":         "pr-user1",
+	sources := map[string]client.ReviewerAssignmentSource{}
+	for _, r := range assignments.JSON200.Reviewers {
+		sources[r.UserId] = r.Source
+		if r.Source == client.ReviewerAssignmentSourceAuto && r.Strategy == nil {
+			t.Errorf("Expected strategy for auto-assigned reviewer %s", r.UserId)
+		}
+	}
+	if sources[user(4)] != client.ReviewerAssignmentSourceManual || sources[user(3)] != client.ReviewerAssignmentSourceAuto {
+		t.Errorf("Unexpected assignment sources: %v", sources)
 	}
 
-	resp, err = postJSON(baseURL+"/pullRequest/create", prPayload)
-	if err != nil {
-		t.Fatalf("Failed to create PR: %v", err)
+	// Несуществующий автор не должен приводить к созданию PR
+	_, err = api.PostPullRequestCreateWithResponse(t.Context(), client.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   fmt.Sprintf("options-pr-missing-%d", suffix),
+		PullRequestName: "Missing Author PR",
+		AuthorId:        fmt.Sprintf("missing-author-%d", suffix),
+	})
+	if statusCode(err) != http.StatusNotFound {
+		t.Errorf("Expected status 404 for unknown author, got %v", err)
 	}
 
-	var prResult map[string]interface{}
-	_ = json.NewDecoder(resp.Body).Decode(&prResult)
-	_ = resp.Body.Close()
+	// Противоречивые пожелания отклоняются
+	_, err = api.PostPullRequestCreateWithResponse(t.Context(), client.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:      fmt.Sprintf("options-pr-invalid-%d", suffix),
+		PullRequestName:    "Invalid Options PR",
+		AuthorId:           user(1),
+		PreferredReviewers: &[]string{user(2)},
+		ExcludedReviewers:  &[]string{user(2)},
+	})
+	if statusCode(err) != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for conflicting options, got %v", err)
+	}
+}
+
+func TestE2EPreviewReviewers(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	user := func(i int) string { return fmt.Sprintf("preview-user%d-%d", i, suffix) }
 
-	if prResult["status"] != "OPEN" {
-		t.Errorf("Expected PR status OPEN, got %v", prResult["status"])
+	members := teamMembers(4, user, "Preview User")
+	members[3].IsActive = ptr(false)
+	addTeam(t, fmt.Sprintf("preview-team-%d", suffix), members)
+
+	resp, err := api.PostPullRequestPreviewReviewersWithResponse(t.Context(), client.PostPullRequestPreviewReviewersJSONRequestBody{
+		AuthorId: user(1),
+	})
+	if err != nil {
+		t.Fatalf("Failed to preview reviewers: %v", err)
 	}
 
-	reviewers := prResult["assigned_reviewers"].([]interface{})
-	if len(reviewers) == 0 {
-		t.Fatal("No reviewers assigned")
+	preview := resp.JSON200
+	if len(preview.Selected) != 2 || len(preview.Candidates) != 2 {
+		t.Errorf("Expected 2 selected of 2 candidates, got %v", preview.Selected)
 	}
 
-	mergePayload := map[string]interface{}{
-		"pull_request_id": prID,
+	reasons := map[string]client.ExcludedCandidateReason{}
+	for _, e := range preview.Excluded {
+		reasons[e.UserId] = e.Reason
+	}
+	if reasons[user(1)] != client.Author || reasons[user(4)] != client.Inactive {
+		t.Errorf("Unexpected exclusion reasons: %v", reasons)
 	}
 
-	resp, err = postJSON(baseURL+"/pullRequest/merge", mergePayload)
+	// Предпросмотр ничего не записывает
+	reviews, err := api.GetUsersGetReviewWithResponse(t.Context(), &client.GetUsersGetReviewParams{UserId: user(2)})
 	if err != nil {
-		t.Fatalf("Failed to merge PR: %v", err)
+		t.Fatalf("Failed to get reviews: %v", err)
 	}
-	defer resp.Body.Close()
-
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		t.Fatalf("Expected status 200, got %d: %s", resp.StatusCode, string(body))
+	if prs := reviews.JSON200.PullRequests; len(prs) != 0 {
+		t.Errorf("Expected no reviews after preview, got %v", prs)
 	}
+}
 
-	var mergeResult map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&mergeResult); err != nil {
-		t.Fatalf("Failed to decode merge response: %v", err)
+func TestE2ESkillMatching(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	user := func(i int) string { return fmt.Sprintf("skills-user%d-%d", i, suffix) }
+	addTeam(t, fmt.Sprintf("skills-team-%d", suffix), teamMembers(3, user, "Skills User"))
+
+	_, err := api.PostUsersSetSkillsWithResponse(t.Context(), client.PostUsersSetSkillsJSONRequestBody{
+		UserId: user(3),
+		Skills: []client.UserSkill{
+			{Tag: "Go", Level: client.Expert},
+			{Tag: "sql", Level: client.Intermediate},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to set skills: %v", err)
 	}
 
-	var pr map[string]interface{}
-	if p, ok := mergeResult["pr"].(map[string]interface{}); ok {
-		pr = p
-	} else {
-		pr = mergeResult
+	// Эксперт выбирается, несмотря на одинаковую нагрузку с остальными
+	pr := createPR(t, client.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   fmt.Sprintf("skills-pr-%d", suffix),
+		PullRequestName: "Skills PR",
+		AuthorId:        user(1),
+		ReviewerCount:   ptr(1),
+		RequiredTags:    &[]string{"go"},
+	})
+	if len(pr.AssignedReviewers) != 1 || pr.AssignedReviewers[0] != user(3) {
+		t.Errorf("Expected reviewer %s, got %v", user(3), pr.AssignedReviewers)
 	}
 
-	if pr["status"] != "MERGED" {
-		t.Errorf("Expected PR status MERGED, got %v", pr["status"])
+	// Неизвестный уровень навыка отклоняется
+	_, err = api.PostUsersSetSkillsWithResponse(t.Context(), client.PostUsersSetSkillsJSONRequestBody{
+		UserId: user(2),
+		Skills: []client.UserSkill{{Tag: "go", Level: "guru"}},
+	})
+	if statusCode(err) != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for invalid level, got %v", err)
 	}
 }
 
-func TestE2EUserReviews(t *testing.T) {
-	teamName := fmt.Sprintf("reviews-%d", time.Now().Unix())
-
-	payload := map[string]interface{}{
-		"team_name": teamName,
-		"members": []map[string]interface{}{
-			{"user_id": "review-user1", "username": "Review User 1"},
-			{"user_id": "review-user2", "username": "Review User 2"},
-		},
+func TestE2EMentorshipPairing(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	user := func(i int) string { return fmt.Sprintf("mentor-user%d-%d", i, suffix) }
+	teamName := fmt.Sprintf("mentor-team-%d", suffix)
+	addTeam(t, teamName, teamMembers(5, user, "Mentor User"))
+
+	// user3 и user5 - senior, user4 - learner, user2 остается regular
+	seniorities := map[int]client.Seniority{3: client.Senior, 4: client.Learner, 5: client.Senior}
+	for i, seniority := range seniorities {
+		_, err := api.PostUsersSetSeniorityWithResponse(t.Context(), client.PostUsersSetSeniorityJSONRequestBody{
+			UserId:    user(i),
+			Seniority: seniority,
+		})
+		if err != nil {
+			t.Fatalf("Failed to set seniority: %v", err)
+		}
 	}
 
-	resp, err := postJSON(baseURL+"/team/add", payload)
+	_, err := api.PostTeamSetMentorshipWithResponse(t.Context(), client.PostTeamSetMentorshipJSONRequestBody{
+		TeamName: teamName,
+		Enabled:  true,
+	})
 	if err != nil {
-		t.Fatalf("Failed to create team: %v", err)
+		t.Fatalf("Failed to enable mentorship: %v", err)
 	}
-	resp.Body.Close()
 
-	prID := fmt.Sprintf("review-pr-%d", time.Now().Unix())
-	prPayload := map[string]interface{}{
-		"pull_request_id":   prID,
-		"pull_request_name": "Review Test PR",
-		"author_id":         "review-user1",
+	prID := fmt.Sprintf("mentor-pr-%d", suffix)
+	pr := createPR(t, client.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   prID,
+		PullRequestName: "Mentorship PR",
+		AuthorId:        user(1),
+		ReviewerCount:   ptr(2),
+	})
+
+	reviewers := map[string]bool{}
+	var senior string
+	for _, r := range pr.AssignedReviewers {
+		reviewers[r] = true
+		if r == user(3) || r == user(5) {
+			senior = r
+		}
+	}
+	if senior == "" || !reviewers[user(4)] {
+		t.Fatalf("Expected a senior and learner %s, got %v", user(4), pr.AssignedReviewers)
 	}
 
-	resp, err = postJSON(baseURL+"/pullRequest/create", prPayload)
+	// Замена senior должна сохранить пару: вместо regular выбирается другой senior
+	_, err = api.PostPullRequestReassignWithResponse(t.Context(), client.PostPullRequestReassignJSONRequestBody{
+		PullRequestId: prID,
+		OldUserId:     senior,
+	})
 	if err != nil {
-		t.Fatalf("Failed to create PR: %v", err)
+		t.Fatalf("Failed to reassign reviewer: %v", err)
 	}
-	resp.Body.Close()
 
-	resp, err = client.Get(baseURL + "/users/getReview?user_id=review-user2")
+	result, err := api.GetPullRequestReviewersWithResponse(t.Context(), &client.GetPullRequestReviewersParams{PullRequestId: prID})
 	if err != nil {
-		t.Fatalf("Failed to get user reviews: %v", err)
+		t.Fatalf("Failed to get reviewers: %v", err)
+	}
+	for _, r := range result.JSON200.Reviewers {
+		if r.UserId == user(2) || r.UserId == senior {
+			t.Errorf("Expected the senior to be replaced by another senior, got %v", result.JSON200.Reviewers)
+		}
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		t.Fatalf("Expected status 200, got %d: %s", resp.StatusCode, string(body))
+	// Неизвестный уровень отклоняется
+	_, err = api.PostUsersSetSeniorityWithResponse(t.Context(), client.PostUsersSetSeniorityJSONRequestBody{
+		UserId:    user(2),
+		Seniority: "lead",
+	})
+	if statusCode(err) != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for invalid seniority, got %v", err)
 	}
+}
 
-	var result interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		t.Fatalf("Failed to decode response: %v", err)
+func TestE2EReviewerRules(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	user := func(i int) string { return fmt.Sprintf("rules-user%d-%d", i, suffix) }
+	addTeam(t, fmt.Sprintf("rules-team-%d", suffix), teamMembers(3, user, "Rules User"))
+
+	// user2 никогда не ревьюит PR user1
+	rule, err := adminAPI.PostRulesAddWithResponse(t.Context(), client.PostRulesAddJSONRequestBody{
+		Effect:     client.PostRulesAddJSONBodyEffectBlock,
+		ReviewerId: user(2),
+		AuthorId:   ptr(user(1)),
+		Reason:     ptr("pair programming partners"),
+	})
+	if err != nil {
+		t.Fatalf("Failed to add rule: %v", err)
 	}
 
-	var prs []interface{}
+	prID := fmt.Sprintf("rules-pr-%d", suffix)
+	pr := createPR(t, client.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   prID,
+		PullRequestName: "Rules PR",
+		AuthorId:        user(1),
+		ReviewerCount:   ptr(2),
+	})
+	if len(pr.AssignedReviewers) != 1 || pr.AssignedReviewers[0] != user(3) {
+		t.Fatalf("Expected only %s to be assigned, got %v", user(3), pr.AssignedReviewers)
+	}
 
-	if resultMap, ok := result.(map[string]interface{}); ok {
-		if resultMap["user_id"] != "review-user2" {
-			t.Errorf("Expected user_id review-user2, got %v", resultMap["user_id"])
-		}
-		if pullRequests, exists := resultMap["pull_requests"]; exists {
-			prs = pullRequests.([]interface{})
-		}
-	} else if resultArray, ok := result.([]interface{}); ok {
-		prs = resultArray
+	// Ручное назначение запрещенного ревьюера без переопределения отклоняется
+	_, err = api.PostPullRequestAssignWithResponse(t.Context(), client.PostPullRequestAssignJSONRequestBody{
+		PullRequestId: prID,
+		UserId:        user(2),
+	})
+	if !errors.Is(err, client.ErrReviewerBlocked) || statusCode(err) != http.StatusConflict {
+		t.Fatalf("Expected status 409 REVIEWER_BLOCKED for blocked reviewer, got %v", err)
 	}
 
-	if len(prs) == 0 {
-		t.Error("Expected at least one PR for reviewer")
+	// Переопределение правил доступно только администратору
+	overrideBody := client.PostPullRequestAssignJSONRequestBody{
+		PullRequestId:  prID,
+		UserId:         user(2),
+		Override:       ptr(true),
+		OverrideReason: ptr("only expert on this module"),
+	}
+	_, err = api.PostPullRequestAssignWithResponse(t.Context(), overrideBody)
+	if !errors.Is(err, client.ErrForbidden) {
+		t.Fatalf("Expected status 403 FORBIDDEN for override without admin token, got %v", err)
 	}
-}
 
-func TestE2EStatistics(t *testing.T) {
-	teamName := fmt.Sprintf("stats-%d", time.Now().Unix())
+	_, err = adminAPI.PostPullRequestAssignWithResponse(t.Context(), overrideBody)
+	if err != nil {
+		t.Fatalf("Failed to assign reviewer with override: %v", err)
+	}
 
-	payload := map[string]interface{}{
-		"team_name": teamName,
-		"members": []map[string]interface{}{
-			{"user_id": "stats-user1", "username": "Stats User 1"},
-			{"user_id": "stats-user2", "username": "Stats User 2"},
-		},
+	audit, err := api.GetAuditListWithResponse(t.Context(), &client.GetAuditListParams{PullRequestId: &prID})
+	if err != nil {
+		t.Fatalf("Failed to get audit log: %v", err)
+	}
+	entries := audit.JSON200.Entries
+	if len(entries) != 1 || entries[0].Action != client.RuleOverridden || entries[0].UserId == nil || *entries[0].UserId != user(2) {
+		t.Errorf("Expected one rule_overridden entry for %s, got %+v", user(2), entries)
 	}
 
-	resp, err := postJSON(baseURL+"/team/add", payload)
+	_, err = adminAPI.PostRulesDeleteWithResponse(t.Context(), client.PostRulesDeleteJSONRequestBody{RuleId: rule.JSON201.Id})
 	if err != nil {
-		t.Fatalf("Failed to create team: %v", err)
+		t.Errorf("Failed to delete rule: %v", err)
+	}
+	_, err = adminAPI.PostRulesDeleteWithResponse(t.Context(), client.PostRulesDeleteJSONRequestBody{RuleId: rule.JSON201.Id})
+	if statusCode(err) != http.StatusNotFound {
+		t.Errorf("Expected status 404 for deleted rule, got %v", err)
 	}
-	resp.Body.Close()
+}
 
-	for i := 1; i <= 3; i++ {
-		prPayload := map[string]interface{}{
-			"pull_request_id":   fmt.Sprintf("stats-pr-%d-%d", time.Now().Unix(), i),
-			"pull_request_name": fmt.Sprintf("Stats PR %d", i),
-			"author_id":         "stats-user1",
-		}
+func TestE2ETeamAndPullRequestStatistics(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	teamName := fmt.Sprintf("team-stats-%d", suffix)
+	user := func(i int) string { return fmt.Sprintf("team-stats-user%d-%d", i, suffix) }
+	addTeam(t, teamName, teamMembers(3, user, "Team Stats User"))
+
+	// Два PR автора user1, на каждый назначены user2 и user3, один PR слит
+	prIDs := []string{fmt.Sprintf("team-stats-pr1-%d", suffix), fmt.Sprintf("team-stats-pr2-%d", suffix)}
+	for _, prID := range prIDs {
+		createPR(t, client.PostPullRequestCreateJSONRequestBody{
+			PullRequestId:   prID,
+			PullRequestName: "Team Stats PR",
+			AuthorId:        user(1),
+			ReviewerCount:   ptr(2),
+		})
+	}
+	mergePR(t, prIDs[0])
 
-		resp, err := postJSON(baseURL+"/pullRequest/create", prPayload)
+	findTeam := func(params *client.GetStatisticsTeamsParams) client.TeamStats {
+		resp, err := api.GetStatisticsTeamsWithResponse(t.Context(), params)
 		if err != nil {
-			t.Fatalf("Failed to create PR %d: %v", i, err)
+			t.Fatalf("Failed to get team statistics: %v", err)
+		}
+		for _, s := range resp.JSON200.Statistics {
+			if s.TeamName == teamName {
+				return s
+			}
 		}
-		resp.Body.Close()
+		t.Fatalf("Team %s not found in statistics", teamName)
+		return client.TeamStats{}
 	}
 
-	resp, err = client.Get(baseURL + "/statistics/assignments")
-	if err != nil {
-		t.Fatalf("Failed to get assignments statistics: %v", err)
+	// Каждая величина считается один раз, независимо от числа участников и ревьюеров
+	want := client.TeamStats{
+		TeamName:          teamName,
+		TotalMembers:      3,
+		ActiveMembers:     3,
+		PrsAuthored:       2,
+		PrsMerged:         1,
+		PrsReviewed:       2,
+		ReviewAssignments: 4,
+	}
+	if got := findTeam(&client.GetStatisticsTeamsParams{}); got != want {
+		t.Errorf("Expected team statistics %+v, got %+v", want, got)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		t.Fatalf("Expected status 200, got %d: %s", resp.StatusCode, string(body))
+	// Окно в будущем не содержит ни PR, ни назначений
+	from := time.Now().Add(24 * time.Hour).UTC().Truncate(time.Second)
+	want.PrsAuthored, want.PrsMerged, want.PrsReviewed, want.ReviewAssignments = 0, 0, 0, 0
+	if got := findTeam(&client.GetStatisticsTeamsParams{From: &from}); got != want {
+		t.Errorf("Expected empty window statistics %+v, got %+v", want, got)
 	}
 
-	var assignmentsResult map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&assignmentsResult); err != nil {
-		t.Fatalf("Failed to decode assignments response: %v", err)
+	prStats, err := api.GetStatisticsPullRequestsWithResponse(t.Context(), &client.GetStatisticsPullRequestsParams{})
+	if err != nil {
+		t.Fatalf("Failed to get PR statistics: %v", err)
+	}
+	found := 0
+	for _, s := range prStats.JSON200.Statistics {
+		switch s.PullRequestId {
+		case prIDs[0]:
+			found++
+			if s.Status != client.MERGED || s.MergedAt == nil || s.ReviewersCount != 2 {
+				t.Errorf("Unexpected statistics for merged PR: %+v", s)
+			}
+		case prIDs[1]:
+			found++
+			if s.Status != client.OPEN || s.MergedAt != nil || s.ReviewersCount != 2 {
+				t.Errorf("Unexpected statistics for open PR: %+v", s)
+			}
+		}
+	}
+	if found != len(prIDs) {
+		t.Errorf("Expected %d PRs in statistics, found %d", len(prIDs), found)
 	}
 
-	if len(assignmentsResult) == 0 {
-		t.Error("Expected assignments statistics, got empty response")
+	to := time.Now().Add(-24 * time.Hour).UTC().Truncate(time.Second)
+	_, err = api.GetStatisticsPullRequestsWithResponse(t.Context(), &client.GetStatisticsPullRequestsParams{From: &from, To: &to})
+	if statusCode(err) != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for inverted window, got %v", err)
+	}
+}
+
+func TestE2EReviewAnalytics(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	teamName := fmt.Sprintf("analytics-team-%d", suffix)
+	user := func(i int) string { return fmt.Sprintf("analytics-user%d-%d", i, suffix) }
+	addTeam(t, teamName, teamMembers(3, user, "Analytics User"))
+
+	prIDs := []string{fmt.Sprintf("analytics-pr1-%d", suffix), fmt.Sprintf("analytics-pr2-%d", suffix)}
+	for _, prID := range prIDs {
+		createPR(t, client.PostPullRequestCreateJSONRequestBody{
+			PullRequestId:   prID,
+			PullRequestName: "Analytics PR",
+			AuthorId:        user(1),
+			ReviewerCount:   ptr(2),
+		})
 	}
+	mergePR(t, prIDs[0])
 
-	resp, err = client.Get(baseURL + "/statistics/workload")
+	now := time.Now().UTC().Truncate(time.Second)
+	from, to, bucket := now.Add(-48*time.Hour), now.Add(48*time.Hour), client.Day
+
+	teams, err := api.GetAnalyticsTeamThroughputWithResponse(t.Context(), &client.GetAnalyticsTeamThroughputParams{From: from, To: to, Bucket: &bucket})
 	if err != nil {
-		t.Fatalf("Failed to get workload statistics: %v", err)
+		t.Fatalf("Failed to get team throughput: %v", err)
+	}
+	var opened, merged, cumulative, buckets int64
+	for _, s := range teams.JSON200.Series {
+		if s.TeamName == teamName {
+			buckets++
+			opened += s.PrsOpened
+			merged += s.PrsMerged
+			cumulative = s.PrsMergedCumulative
+		}
+	}
+	// Диапазон в четыре дня дает четыре или пять дневных интервалов
+	if buckets < 4 || buckets > 5 {
+		t.Errorf("Expected 4-5 daily buckets for team, got %d", buckets)
+	}
+	if opened != 2 || merged != 1 || cumulative != 1 {
+		t.Errorf("Expected 2 opened, 1 merged and cumulative 1, got %d, %d, %d", opened, merged, cumulative)
 	}
-	defer resp.Body.Close()
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		t.Fatalf("Expected status 200, got %d: %s", resp.StatusCode, string(body))
+	reviewers, err := api.GetAnalyticsReviewerThroughputWithResponse(t.Context(), &client.GetAnalyticsReviewerThroughputParams{From: from, To: to, Bucket: &bucket})
+	if err != nil {
+		t.Fatalf("Failed to get reviewer throughput: %v", err)
+	}
+	var assigned, assignedMerged int64
+	for _, s := range reviewers.JSON200.Series {
+		if s.UserId == user(2) || s.UserId == user(3) {
+			assigned += s.ReviewsAssigned
+			assignedMerged += s.ReviewsMerged
+			if s.Share <= 0 || s.Share > 1 {
+				t.Errorf("Expected share in (0, 1], got %v", s.Share)
+			}
+		}
+	}
+	if assigned != 4 || assignedMerged != 2 {
+		t.Errorf("Expected 4 assignments with 2 merged, got %d and %d", assigned, assignedMerged)
 	}
 
-	var workloadResult map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&workloadResult); err != nil {
-		t.Fatalf("Failed to decode workload response: %v", err)
+	times, err := api.GetAnalyticsReviewTimesWithResponse(t.Context(), &client.GetAnalyticsReviewTimesParams{From: from, To: to, Bucket: &bucket})
+	if err != nil {
+		t.Fatalf("Failed to get review times: %v", err)
+	}
+	withMerge := false
+	for _, s := range times.JSON200.Series {
+		if s.PrsOpened > 0 && s.TimeToMerge != nil {
+			withMerge = true
+		}
+	}
+	if !withMerge {
+		t.Error("Expected a bucket with time to merge percentiles")
 	}
 
-	workload := workloadResult["workload"].([]interface{})
-	if len(workload) == 0 {
-		t.Error("Expected workload statistics, got empty array")
+	hour := client.AnalyticsBucket("hour")
+	_, err = api.GetAnalyticsReviewTimesWithResponse(t.Context(), &client.GetAnalyticsReviewTimesParams{From: from, To: to, Bucket: &hour})
+	if statusCode(err) != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for unknown bucket, got %v", err)
 	}
 }
 
-func TestE2ETeamDeactivation(t *testing.T) {
-	teamName := fmt.Sprintf("deactivate-%d", time.Now().Unix())
+func TestE2EFairnessReport(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	teamName := fmt.Sprintf("fairness-team-%d", suffix)
+	user := func(i int) string { return fmt.Sprintf("fairness-user%d-%d", i, suffix) }
+	addTeam(t, teamName, teamMembers(3, user, "Fairness User"))
 
-	payload := map[string]interface{}{
-		"team_name": teamName,
-		"members": []map[string]interface{}{
-			{"user_id": "deactivate-user1", "username": "Deactivate User 1"},
-			{"user_id": "deactivate-user2", "username": "Deactivate User 2"},
-		},
+	// Каждый участник создает PR с одним ревьюером
+	for i := 1; i <= 3; i++ {
+		createPR(t, client.PostPullRequestCreateJSONRequestBody{
+			PullRequestId:   fmt.Sprintf("fairness-pr%d-%d", i, suffix),
+			PullRequestName: "Fairness PR",
+			AuthorId:        user(i),
+			ReviewerCount:   ptr(1),
+		})
 	}
 
-	resp, err := postJSON(baseURL+"/team/add", payload)
+	resp, err := api.GetStatisticsFairnessWithResponse(t.Context(), &client.GetStatisticsFairnessParams{TeamName: &teamName})
 	if err != nil {
-		t.Fatalf("Failed to create team: %v", err)
+		t.Fatalf("Failed to get fairness report: %v", err)
+	}
+	report := resp.JSON200
+	if len(report.Teams) != 1 || report.Teams[0].TeamName != teamName {
+		t.Fatalf("Expected only team %s, got %+v", teamName, report.Teams)
+	}
+
+	team := report.Teams[0]
+	if team.Reviewers != 3 || team.TotalAssignments != 3 || team.PolicyAssignments != 3 {
+		t.Errorf("Expected 3 reviewers with 3 policy assignments, got %+v", team)
+	}
+	var sum int64
+	for _, m := range team.Members {
+		sum += m.Assignments
+	}
+	if sum != team.TotalAssignments {
+		t.Errorf("Expected member assignments to add up to %d, got %d", team.TotalAssignments, sum)
+	}
+	if team.Gini < 0 || team.Gini > 1 || team.TieBreakAssignments > team.PolicyAssignments {
+		t.Errorf("Unexpected inequality metrics: %+v", team)
+	}
+	if report.Tolerance != 0.2 {
+		t.Errorf("Expected default tolerance 0.2, got %v", report.Tolerance)
+	}
+
+	_, err = api.GetStatisticsFairnessWithResponse(t.Context(), &client.GetStatisticsFairnessParams{TeamName: &teamName, Tolerance: ptr(2.0)})
+	if statusCode(err) != http.StatusBadRequest {
+		t.Errorf("Expected status 400 for tolerance out of range, got %v", err)
 	}
-	resp.Body.Close()
 
-	prID := fmt.Sprintf("deactivate-pr-%d", time.Now().Unix())
-	prPayload := map[string]interface{}{
-		"pull_request_id":   prID,
-		"pull_request_name": "Deactivate Test PR",
-		"author_id":         "deactivate-user1",
+	_, err = api.GetStatisticsFairnessWithResponse(t.Context(), &client.GetStatisticsFairnessParams{TeamName: ptr("missing-" + teamName)})
+	if statusCode(err) != http.StatusNotFound {
+		t.Errorf("Expected status 404 for unknown team, got %v", err)
 	}
+}
 
-	resp, err = postJSON(baseURL+"/pullRequest/create", prPayload)
+func TestE2EWorkloadReconcile(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	teamName := fmt.Sprintf("workload-team-%d", suffix)
+	user := func(i int) string { return fmt.Sprintf("workload-user%d-%d", i, suffix) }
+	addTeam(t, teamName, teamMembers(4, user, "Workload User"))
+
+	prIDs := []string{fmt.Sprintf("workload-pr1-%d", suffix), fmt.Sprintf("workload-pr2-%d", suffix)}
+	var created client.PullRequest
+	for i, prID := range prIDs {
+		pr := createPR(t, client.PostPullRequestCreateJSONRequestBody{
+			PullRequestId:   prID,
+			PullRequestName: "Workload PR",
+			AuthorId:        user(1),
+		})
+		if i == 0 {
+			created = pr
+		}
+	}
+	if len(created.AssignedReviewers) == 0 {
+		t.Fatal("No reviewers assigned")
+	}
+
+	// Замена и слияние меняют учтенную нагрузку через триггеры
+	_, err := api.PostPullRequestReassignWithResponse(t.Context(), client.PostPullRequestReassignJSONRequestBody{
+		PullRequestId: prIDs[0],
+		OldUserId:     created.AssignedReviewers[0],
+	})
 	if err != nil {
-		t.Fatalf("Failed to create PR: %v", err)
+		t.Fatalf("Failed to reassign reviewer: %v", err)
 	}
-	resp.Body.Close()
+	mergePR(t, prIDs[0])
 
-	deactivatePayload := map[string]interface{}{
-		"team_name": teamName,
+	// Открытым остался только второй PR
+	workload, err := api.GetStatisticsWorkloadWithResponse(t.Context())
+	if err != nil {
+		t.Fatalf("Failed to get workload statistics: %v", err)
+	}
+	var openReviews int
+	if workload.JSON200.Workload != nil {
+		for _, w := range *workload.JSON200.Workload {
+			if w.UserId != nil && w.OpenReviewsCount != nil && strings.HasSuffix(*w.UserId, fmt.Sprintf("-%d", suffix)) {
+				openReviews += *w.OpenReviewsCount
+			}
+		}
+	}
+	if openReviews != 2 {
+		t.Errorf("Expected 2 open reviews in team, got %d", openReviews)
 	}
 
-	resp, err = postJSON(baseURL+"/team/deactivate", deactivatePayload)
+	result, err := adminAPI.PostAdminWorkloadReconcileWithResponse(t.Context())
 	if err != nil {
-		t.Fatalf("Failed to deactivate team: %v", err)
+		t.Fatalf("Failed to reconcile workload: %v", err)
+	}
+	for _, d := range result.JSON200.Drift {
+		if strings.HasSuffix(d.UserId, fmt.Sprintf("-%d", suffix)) {
+			t.Errorf("Unexpected workload drift for %s", d.UserId)
+		}
 	}
-	defer resp.Body.Close()
+}
 
-	if resp.StatusCode != http.StatusOK {
-		body, _ := io.ReadAll(resp.Body)
-		t.Fatalf("Expected status 200, got %d: %s", resp.StatusCode, string(body))
+// TestE2EIdempotency проверяет, что повтор запроса с тем же ключом
+// идемпотентности возвращает сохраненный ответ без повторного выполнения
+func TestE2EIdempotency(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	user := func(i int) string { return fmt.Sprintf("idempotency-user%d-%d", i, suffix) }
+	addTeam(t, fmt.Sprintf("idempotency-team-%d", suffix), teamMembers(3, user, "Idempotency User"))
+
+	key := fmt.Sprintf("idempotency-key-%d", suffix)
+	body := client.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   fmt.Sprintf("idempotency-pr-%d", suffix),
+		PullRequestName: "Idempotency PR",
+		AuthorId:        user(1),
+	}
+
+	first, err := api.PostPullRequestCreateWithResponse(t.Context(), body, client.WithIdempotencyKey(key))
+	if err != nil {
+		t.Fatalf("Failed to create PR: %v", err)
 	}
 
-	var result map[string]interface{}
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		t.Fatalf("Failed to decode response: %v", err)
+	// Без ключа идемпотентности повтор вернул бы PR_EXISTS
+	replayed, err := api.PostPullRequestCreateWithResponse(t.Context(), body, client.WithIdempotencyKey(key))
+	if err != nil {
+		t.Fatalf("Expected replayed response, got %v", err)
+	}
+	if replayed.HTTPResponse.Header.Get("Idempotent-Replayed") != "true" {
+		t.Error("Expected Idempotent-Replayed header on replayed response")
+	}
+	if string(replayed.Body) != string(first.Body) {
+		t.Errorf("Expected replayed body %s, got %s", first.Body, replayed.Body)
 	}
 
-	if _, hasDeactivated := result["deactivated_users"]; !hasDeactivated {
-		t.Errorf("Expected 'deactivated_users' field in response, got: %v", result)
+	body.PullRequestName = "Another Idempotency PR"
+	_, err = api.PostPullRequestCreateWithResponse(t.Context(), body, client.WithIdempotencyKey(key))
+	if !errors.Is(err, client.ErrIdempotencyKeyReused) {
+		t.Errorf("Expected IDEMPOTENCY_KEY_REUSED for a different request, got %v", err)
 	}
 
-	if _, hasReassigned := result["reassigned_prs"]; !hasReassigned {
-		t.Errorf("Expected 'reassigned_prs' field in response, got: %v", result)
+	// С новым ключом запрос выполняется заново
+	_, err = api.PostPullRequestCreateWithResponse(t.Context(), body)
+	if !errors.Is(err, client.ErrPRExists) {
+		t.Errorf("Expected PR_EXISTS with a new idempotency key, got %v", err)
 	}
 }
 
-func TestE2ELoadBalancing(t *testing.T) {
-	teamName := fmt.Sprintf("balance-team-%d", time.Now().Unix())
+func TestE2EUserDashboard(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	teamName := fmt.Sprintf("dashboard-team-%d", suffix)
+	user := func(i int) string { return fmt.Sprintf("dashboard-user%d-%d", i, suffix) }
+	addTeam(t, teamName, []client.TeamMember{
+		{UserId: user(1), Username: "Dashboard Author"},
+		{UserId: user(2), Username: "Dashboard Reviewer"},
+	})
 
-	members := []map[string]interface{}{}
-	for i := 1; i <= 5; i++ {
-		members = append(members, map[string]interface{}{
-			"user_id":  fmt.Sprintf("balance-user%d", i),
-			"username": fmt.Sprintf("Balance User %d", i),
+	prIDs := []string{fmt.Sprintf("dashboard-pr1-%d", suffix), fmt.Sprintf("dashboard-pr2-%d", suffix)}
+	for _, prID := range prIDs {
+		createPR(t, client.PostPullRequestCreateJSONRequestBody{
+			PullRequestId:   prID,
+			PullRequestName: "Dashboard PR",
+			AuthorId:        user(1),
 		})
 	}
 
-	payload := map[string]interface{}{
-		"team_name": teamName,
-		"members":   members,
-	}
+	t.Run("InvalidUnavailability", func(t *testing.T) {
+		now := time.Now().UTC()
+		_, err := api.PostUsersAddUnavailabilityWithResponse(t.Context(), client.PostUsersAddUnavailabilityJSONRequestBody{
+			UserId:   user(2),
+			StartsAt: now.Add(time.Hour),
+			EndsAt:   now,
+		})
+		if statusCode(err) != http.StatusBadRequest {
+			t.Errorf("Expected status 400, got %v", err)
+		}
+	})
 
-	resp, err := postJSON(baseURL+"/team/add", payload)
+	now := time.Now().UTC().Truncate(time.Second)
+	added, err := api.PostUsersAddUnavailabilityWithResponse(t.Context(), client.PostUsersAddUnavailabilityJSONRequestBody{
+		UserId:   user(2),
+		StartsAt: now.Add(-time.Hour),
+		EndsAt:   now.Add(24 * time.Hour),
+		Reason:   ptr("vacation"),
+	})
 	if err != nil {
-		t.Fatalf("Failed to create team: %v", err)
+		t.Fatalf("Failed to add unavailability: %v", err)
 	}
-	resp.Body.Close()
+	period := added.JSON201
 
-	reviewerCount := make(map[string]int)
-	for i := 1; i <= 10; i++ {
-		prPayload := map[string]interface{}{
-			"pull_request_id":   fmt.Sprintf("balance-pr-%d-%d", time.Now().Unix(), i),
-			"pull_request_name": fmt.Sprintf("Load Balance PR %d", i),
-			"author_id":         "balance-user1",
+	getDashboard := func(t *testing.T, userID string) client.UserDashboard {
+		t.Helper()
+		resp, err := api.GetUsersDashboardWithResponse(t.Context(), &client.GetUsersDashboardParams{UserId: userID})
+		if err != nil {
+			t.Fatalf("Failed to get dashboard: %v", err)
 		}
+		return *resp.JSON200
+	}
 
-		resp, err := postJSON(baseURL+"/pullRequest/create", prPayload)
+	t.Run("Reviewer", func(t *testing.T) {
+		d := getDashboard(t, user(2))
+		// Самый старый PR первым
+		if len(d.PendingReviews) != 2 || d.PendingReviews[0].PullRequestId != prIDs[0] {
+			t.Errorf("Unexpected pending reviews: %+v", d.PendingReviews)
+		}
+		if d.Capacity.OpenReviews != 2 || !d.Capacity.Unavailable {
+			t.Errorf("Unexpected capacity: %+v", d.Capacity)
+		}
+		if len(d.Unavailability) != 1 || d.Unavailability[0].Id != period.Id {
+			t.Errorf("Unexpected unavailability: %+v", d.Unavailability)
+		}
+		if d.RecentStats.ReviewsAssigned != 2 {
+			t.Errorf("Expected 2 recent reviews, got %d", d.RecentStats.ReviewsAssigned)
+		}
+	})
+
+	t.Run("Author", func(t *testing.T) {
+		d := getDashboard(t, user(1))
+		if len(d.AuthoredPullRequests) != 2 {
+			t.Fatalf("Expected 2 authored PRs, got %d", len(d.AuthoredPullRequests))
+		}
+		for _, pr := range d.AuthoredPullRequests {
+			if len(pr.Reviewers) != 1 || pr.Reviewers[0].UserId != user(2) {
+				t.Errorf("Unexpected reviewers for %s: %+v", pr.PullRequestId, pr.Reviewers)
+			}
+		}
+		if len(d.PendingReviews) != 0 {
+			t.Errorf("Expected no pending reviews, got %d", len(d.PendingReviews))
+		}
+	})
+
+	t.Run("DeleteUnavailability", func(t *testing.T) {
+		body := client.PostUsersDeleteUnavailabilityJSONRequestBody{Id: period.Id}
+		if _, err := api.PostUsersDeleteUnavailabilityWithResponse(t.Context(), body); err != nil {
+			t.Errorf("Failed to delete unavailability: %v", err)
+		}
+		if _, err := api.PostUsersDeleteUnavailabilityWithResponse(t.Context(), body); statusCode(err) != http.StatusNotFound {
+			t.Errorf("Expected status 404, got %v", err)
+		}
+	})
+
+	t.Run("UnknownUser", func(t *testing.T) {
+		_, err := api.GetUsersDashboardWithResponse(t.Context(), &client.GetUsersDashboardParams{UserId: "dashboard-missing-user"})
+		if statusCode(err) != http.StatusNotFound {
+			t.Errorf("Expected status 404, got %v", err)
+		}
+	})
+}
+
+func TestE2EStatisticsExport(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	teamName := fmt.Sprintf("export-team-%d", suffix)
+
+	addTeam(t, teamName, []client.TeamMember{
+		{UserId: fmt.Sprintf("export-user1-%d", suffix), Username: "Export User 1"},
+		{UserId: fmt.Sprintf("export-user2-%d", suffix), Username: "Export User 2"},
+	})
+
+	// Выгрузки CSV и NDJSON не описаны в клиенте и читаются напрямую
+	get := func(path, accept string) *http.Response {
+		req, err := http.NewRequest(http.MethodGet, baseURL+path, nil)
 		if err != nil {
-			t.Fatalf("Failed to create PR %d: %v", i, err)
+			t.Fatalf("Failed to build request: %v", err)
+		}
+		req.Header.Set("Accept", accept)
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			t.Fatalf("Failed to get %s: %v", path, err)
+		}
+		if resp.StatusCode != http.StatusOK {
+			_ = resp.Body.Close()
+			t.Fatalf("Expected status 200 for %s, got %d", path, resp.StatusCode)
+		}
+		return resp
+	}
+
+	t.Run("CSV", func(t *testing.T) {
+		resp := get("/statistics/teams", "text/csv")
+		defer func() { _ = resp.Body.Close() }()
+		if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/csv") {
+			t.Fatalf("Expected text/csv, got %s", ct)
 		}
 
-		var result map[string]interface{}
-		_ = json.NewDecoder(resp.Body).Decode(&result)
+		records, err := csv.NewReader(resp.Body).ReadAll()
+		if err != nil {
+			t.Fatalf("Failed to parse CSV: %v", err)
+		}
+		if len(records) == 0 || strings.Join(records[0], ",") != "team_name,total_members,active_members,prs_authored,prs_merged,prs_reviewed,review_assignments" {
+			t.Fatalf("Unexpected CSV header: %v", records)
+		}
+		found := false
+		for _, r := range records[1:] {
+			if r[0] == teamName {
+				found = true
+				if r[1] != "2" || r[2] != "2" {
+					t.Errorf("Expected 2 total and 2 active members, got %v", r)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("Team %s not found in CSV export", teamName)
+		}
+	})
+
+	t.Run("NDJSON", func(t *testing.T) {
+		resp := get("/statistics/workload", "application/x-ndjson")
+		defer func() { _ = resp.Body.Close() }()
+
+		found := 0
+		scanner := bufio.NewScanner(resp.Body)
+		for scanner.Scan() {
+			var row struct {
+				UserID           string  `json:"user_id"`
+				TeamName         *string `json:"team_name"`
+				OpenReviewsCount int64   `json:"open_reviews_count"`
+			}
+			if err := json.Unmarshal(scanner.Bytes(), &row); err != nil {
+				t.Fatalf("Failed to parse NDJSON line %q: %v", scanner.Text(), err)
+			}
+			if row.TeamName != nil && *row.TeamName == teamName {
+				found++
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			t.Fatalf("Failed to read NDJSON: %v", err)
+		}
+		if found != 2 {
+			t.Errorf("Expected 2 workload rows for team %s, got %d", teamName, found)
+		}
+	})
+}
+
+// sseEvent - событие потока /events/stream
+type sseEvent struct {
+	ID    string
+	Event string
+	Data  string
+}
+
+// openEventStream подключается к потоку событий и читает события в канал до
+// закрытия ответа
+func openEventStream(t *testing.T, ctx context.Context, query url.Values, lastEventID string) <-chan sseEvent {
+	t.Helper()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, baseURL+"/events/stream?"+query.Encode(), nil)
+	if err != nil {
+		t.Fatalf("Failed to build stream request: %v", err)
+	}
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		t.Fatalf("Failed to open event stream: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
 		_ = resp.Body.Close()
+		t.Fatalf("Expected status 200, got %d", resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); !strings.HasPrefix(ct, "text/event-stream") {
+		t.Errorf("Expected text/event-stream, got %s", ct)
+	}
 
-		reviewers := result["assigned_reviewers"].([]interface{})
-		for _, r := range reviewers {
-			reviewer := r.(string)
-			reviewerCount[reviewer]++
+	events := make(chan sseEvent, 16)
+	go func() {
+		defer close(events)
+		defer func() { _ = resp.Body.Close() }()
+		scanner := bufio.NewScanner(resp.Body)
+		var e sseEvent
+		for scanner.Scan() {
+			field, value, _ := strings.Cut(scanner.Text(), ":")
+			value = strings.TrimSpace(value)
+			switch field {
+			case "id":
+				e.ID = value
+			case "event":
+				e.Event = value
+			case "data":
+				e.Data = value
+			case "":
+				if e.Event != "" {
+					events <- e
+				}
+				e = sseEvent{}
+			}
 		}
+	}()
+	return events
+}
 
-		time.Sleep(10 * time.Millisecond)
+func nextEvent(t *testing.T, events <-chan sseEvent) sseEvent {
+	t.Helper()
+	select {
+	case e, ok := <-events:
+		if !ok {
+			t.Fatal("Event stream closed")
+		}
+		return e
+	case <-time.After(10 * time.Second):
+		t.Fatal("Timed out waiting for event")
 	}
+	return sseEvent{}
+}
 
-	t.Logf("Reviewer distribution: %v", reviewerCount)
+func TestE2EEventStream(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	teamName := fmt.Sprintf("events-team-%d", suffix)
+	user := func(i int) string { return fmt.Sprintf("events-user%d-%d", i, suffix) }
+	prID := fmt.Sprintf("events-pr-%d", suffix)
 
-	if count, exists := reviewerCount["balance-user1"]; exists && count > 0 {
-		t.Errorf("Author should not be assigned as reviewer, but has %d assignments", count)
+	addTeam(t, teamName, []client.TeamMember{
+		{UserId: user(1), Username: "Events Author"},
+		{UserId: user(2), Username: "Events Reviewer"},
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+	events := openEventStream(t, ctx, url.Values{"team_name": {teamName}}, "")
+
+	createPR(t, client.PostPullRequestCreateJSONRequestBody{
+		PullRequestId:   prID,
+		PullRequestName: "Events PR",
+		AuthorId:        user(1),
+	})
+	mergePR(t, prID)
+
+	assigned := nextEvent(t, events)
+	if assigned.Event != "reviewer.assigned" {
+		t.Fatalf("Expected reviewer.assigned, got %s", assigned.Event)
+	}
+	var data struct {
+		ID            int64  `json:"id"`
+		PullRequestID string `json:"pull_request_id"`
+		UserID        string `json:"user_id"`
+		TeamName      string `json:"team_name"`
+	}
+	if err := json.Unmarshal([]byte(assigned.Data), &data); err != nil {
+		t.Fatalf("Failed to decode event data: %v", err)
+	}
+	if data.PullRequestID != prID || data.UserID != user(2) || data.TeamName != teamName {
+		t.Errorf("Unexpected event data: %+v", data)
+	}
+	if assigned.ID != fmt.Sprint(data.ID) {
+		t.Errorf("Event id %s does not match data id %d", assigned.ID, data.ID)
 	}
 
-	expectedReviewers := []string{"balance-user2", "balance-user3", "balance-user4", "balance-user5"}
-	for _, reviewer := range expectedReviewers {
-		if count, exists := reviewerCount[reviewer]; !exists || count == 0 {
-			t.Errorf("Reviewer %s should have assignments, but has %d", reviewer, count)
-		}
+	merged := nextEvent(t, events)
+	if merged.Event != "pr.merged" {
+		t.Fatalf("Expected pr.merged, got %s", merged.Event)
 	}
 
-	minCount, maxCount := 999, 0
-	for _, count := range reviewerCount {
-		if count < minCount {
-			minCount = count
+	t.Run("ResumeFromLastEventID", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+		defer cancel()
+		resumed := openEventStream(t, ctx, url.Values{"pull_request_id": {prID}}, assigned.ID)
+		e := nextEvent(t, resumed)
+		if e.Event != "pr.merged" || e.ID != merged.ID {
+			t.Errorf("Expected replayed pr.merged %s, got %s %s", merged.ID, e.Event, e.ID)
 		}
-		if count > maxCount {
-			maxCount = count
+	})
+
+	t.Run("UserDeactivated", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+		defer cancel()
+		byUser := openEventStream(t, ctx, url.Values{"user_id": {user(2)}}, "")
+
+		_, err := api.PostUsersSetIsActiveWithResponse(t.Context(), client.PostUsersSetIsActiveJSONRequestBody{
+			UserId:   user(2),
+			IsActive: false,
+		})
+		if err != nil {
+			t.Fatalf("Failed to deactivate user: %v", err)
+		}
+
+		if e := nextEvent(t, byUser); e.Event != "user.deactivated" {
+			t.Errorf("Expected user.deactivated, got %s", e.Event)
 		}
+	})
+}
+
+// grpcAddr - адрес gRPC API запущенного сервиса
+const grpcAddr = "localhost:9443"
+
+func dialGRPC(t *testing.T) *grpc.ClientConn {
+	t.Helper()
+	conn, err := grpc.NewClient(grpcAddr,
+		grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{InsecureSkipVerify: true})))
+	if err != nil {
+		t.Fatalf("Failed to create gRPC client: %v", err)
 	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return conn
+}
 
-	if maxCount-minCount > 5 {
-		t.Errorf("Load imbalance too high: min=%d, max=%d, diff=%d", minCount, maxCount, maxCount-minCount)
+// grpcReason возвращает код ошибки REST API из google.rpc.ErrorInfo
+func grpcReason(err error) string {
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			return info.GetReason()
+		}
+	}
+	return ""
+}
+
+func TestE2EGRPC(t *testing.T) {
+	suffix := time.Now().UnixNano()
+	teamName := fmt.Sprintf("grpc-team-%d", suffix)
+	user := func(i int) string { return fmt.Sprintf("grpc-user%d-%d", i, suffix) }
+	prID := fmt.Sprintf("grpc-pr-%d", suffix)
+
+	conn := dialGRPC(t)
+	teams := pb.NewTeamServiceClient(conn)
+	prs := pb.NewPullRequestServiceClient(conn)
+	reviewers := pb.NewReviewerServiceClient(conn)
+	events := pb.NewEventServiceClient(conn)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Second)
+	defer cancel()
+
+	_, err := teams.AddTeam(ctx, &pb.AddTeamRequest{
+		TeamName: teamName,
+		Members: []*pb.TeamMember{
+			{UserId: user(1), Username: "gRPC User 1"},
+			{UserId: user(2), Username: "gRPC User 2"},
+			{UserId: user(3), Username: "gRPC User 3"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Failed to add team: %v", err)
+	}
+
+	team, err := teams.GetTeam(ctx, &pb.GetTeamRequest{TeamName: teamName})
+	if err != nil {
+		t.Fatalf("Failed to get team: %v", err)
+	}
+	if len(team.GetMembers()) != 3 {
+		t.Errorf("Expected 3 members, got %d", len(team.GetMembers()))
+	}
+
+	stream, err := events.StreamEvents(ctx, &pb.StreamEventsRequest{PullRequestId: prID})
+	if err != nil {
+		t.Fatalf("Failed to open event stream: %v", err)
+	}
+
+	pr, err := prs.CreatePullRequest(ctx, &pb.CreatePullRequestRequest{
+		PullRequestId:   prID,
+		PullRequestName: "gRPC PR",
+		AuthorId:        user(1),
+	})
+	if err != nil {
+		t.Fatalf("Failed to create PR: %v", err)
+	}
+	if len(pr.GetAssignedReviewers()) != 2 {
+		t.Errorf("Expected 2 reviewers, got %v", pr.GetAssignedReviewers())
+	}
+
+	e, err := stream.Recv()
+	if err != nil {
+		t.Fatalf("Failed to receive event: %v", err)
+	}
+	if e.GetType() != "reviewer.assigned" || e.GetPullRequestId() != prID {
+		t.Errorf("Expected reviewer.assigned for %s, got %s %s", prID, e.GetType(), e.GetPullRequestId())
+	}
+
+	// Коды ошибок совпадают с REST API
+	_, err = prs.CreatePullRequest(ctx, &pb.CreatePullRequestRequest{
+		PullRequestId:   prID,
+		PullRequestName: "gRPC PR",
+		AuthorId:        user(1),
+	})
+	if status.Code(err) != codes.AlreadyExists || grpcReason(err) != "PR_EXISTS" {
+		t.Errorf("Expected AlreadyExists PR_EXISTS, got %v", err)
+	}
+
+	_, err = reviewers.AssignReviewer(ctx, &pb.AssignReviewerRequest{PullRequestId: prID, UserId: user(1)})
+	if status.Code(err) != codes.InvalidArgument || grpcReason(err) != "INVALID_REVIEWERS" {
+		t.Errorf("Expected InvalidArgument INVALID_REVIEWERS, got %v", err)
+	}
+
+	if _, err := prs.MergePullRequest(ctx, &pb.MergePullRequestRequest{PullRequestId: prID}); err != nil {
+		t.Fatalf("Failed to merge PR: %v", err)
+	}
+	_, err = reviewers.ReassignReviewer(ctx, &pb.ReassignReviewerRequest{
+		PullRequestId: prID,
+		OldUserId:     pr.GetAssignedReviewers()[0],
+	})
+	if status.Code(err) != codes.FailedPrecondition || grpcReason(err) != "PR_MERGED" {
+		t.Errorf("Expected FailedPrecondition PR_MERGED, got %v", err)
+	}
+}
+
+func TestE2EAdminAccess(t *testing.T) {
+	_, err := api.PostAdminWorkloadReconcileWithResponse(t.Context())
+	if !errors.Is(err, client.ErrUnauthorized) || statusCode(err) != http.StatusUnauthorized {
+		t.Errorf("Expected status 401 without credentials, got %v", err)
+	}
+
+	_, err = api.GetAdminPolicyWithResponse(t.Context(), bearerToken("wrong-admin-token"))
+	if !errors.Is(err, client.ErrForbidden) || statusCode(err) != http.StatusForbidden {
+		t.Errorf("Expected status 403 with wrong token, got %v", err)
+	}
+
+	if _, err := adminAPI.GetAdminPolicyWithResponse(t.Context()); err != nil {
+		t.Errorf("Failed to get policy with admin token: %v", err)
 	}
 }
@@ -8,6 +8,7 @@ import (
 	"io"
 	"math/rand"
 	"net/http"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -15,7 +16,8 @@ import (
 
 const baseURL = "https://localhost:8080"
 
-var client = &http.Client{
+// httpClient - клиент HTTP нагрузочных тестов и запросов в обход клиента API
+var httpClient = &http.Client{
 	Transport: &http.Transport{
 		TLSClientConfig: &tls.Config{InsecureSkipVerify: true},
 	},
@@ -463,17 +465,36 @@ func atomicAddInt64(val *int64, delta int64) {
 	*val += delta
 }
 
+// maxRateLimitRetries - сколько раз postJSON повторяет запрос после 429
+const maxRateLimitRetries = 5
+
+// postJSON отправляет POST с JSON телом. Ответ 429 повторяется после паузы
+// из Retry-After: все запросы теста идут с одного адреса и упираются в лимит
+// сервиса на клиента и маршрут.
 func postJSON(url string, payload interface{}) (*http.Response, error) {
 	jsonData, err := json.Marshal(payload)
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonData))
-	if err != nil {
-		return nil, err
-	}
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequest("POST", url, bytes.NewReader(jsonData))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/json")
 
-	req.Header.Set("Content-Type", "application/json")
-	return client.Do(req)
+		resp, err := httpClient.Do(req)
+		if err != nil || resp.StatusCode != http.StatusTooManyRequests || attempt == maxRateLimitRetries {
+			return resp, err
+		}
+
+		wait := time.Second
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds > 0 {
+			wait = time.Duration(seconds) * time.Second
+		}
+		io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+		time.Sleep(wait)
+	}
 }